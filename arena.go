@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+const (
+	// arenaChunkWords is the size in words of the first chunk of an Arena.
+	arenaChunkWords = 512
+	// arenaMaxPooledWords is the largest chunk an Arena keeps in the pool.
+	arenaMaxPooledWords = 64 << 10
+)
+
+// Arena is a bump allocator for memory that is only used during a call of a C function.
+// A call converts its string, []string and struct pointer arguments into memory of an Arena
+// instead of allocating each of them separately and resets the Arena once the C function returned.
+//
+// A function registered with RegisterFunc can have a parameter of type *Arena which isn't passed
+// to C. The call then uses that Arena for its arguments so memory the caller allocated from it,
+// for example with Alloc or CString, lives until the call returned and is then reused:
+//
+//	// void fill(char *buf, size_t len);
+//	var fill func(a *purego.Arena, buf unsafe.Pointer, len uintptr)
+//	var a purego.Arena
+//	buf := a.Alloc(64, 1)
+//	fill(&a, buf, 64) // buf must not be used after this
+//
+// If the argument is nil the call uses an Arena of its own. The zero value is an empty Arena ready to use.
+// An Arena must not be used by multiple goroutines at the same time. Its memory is not scanned by the
+// garbage collector, so it must not hold the only reference to Go memory.
+type Arena struct {
+	// chunk is the memory allocations are made from and used is the number of words already allocated.
+	chunk []uint64
+	used  int
+	// full are the earlier chunks which didn't have enough space left for an allocation.
+	full [][]uint64
+}
+
+var arenaType = reflect.TypeOf((*Arena)(nil))
+
+var arenaPool = sync.Pool{
+	New: func() interface{} {
+		return new(Arena)
+	},
+}
+
+// words returns n zeroed words of the arena.
+func (a *Arena) words(n int) []uint64 {
+	if a.used+n > len(a.chunk) {
+		if a.chunk != nil {
+			a.full = append(a.full, a.chunk)
+		}
+		size := arenaChunkWords
+		for size < n || size < len(a.chunk) {
+			size *= 2
+		}
+		a.chunk = make([]uint64, size)
+		a.used = 0
+	}
+	w := a.chunk[a.used : a.used+n : a.used+n]
+	a.used += n
+	for i := range w {
+		w[i] = 0
+	}
+	return w
+}
+
+// Alloc returns a pointer to size zeroed bytes aligned to align, which must be a power of two.
+// The memory is valid until the next call of Reset.
+func (a *Arena) Alloc(size, align uintptr) unsafe.Pointer {
+	if align == 0 || align&(align-1) != 0 {
+		panic("purego: alignment must be a power of two")
+	}
+	if align <= 8 {
+		// the words give zero-sized allocations an address too
+		return unsafe.Pointer(&a.words(int(size+7)/8 + 1)[0])
+	}
+	w := a.words(int(size+align+7) / 8)
+	p := unsafe.Pointer(&w[0])
+	return unsafe.Add(p, (align-uintptr(p)&(align-1))&(align-1))
+}
+
+// CString returns a copy of s terminated by a NUL that is valid until the next call of Reset.
+// A string that already ends in a NUL is returned without copying it.
+func (a *Arena) CString(s string) *byte {
+	if len(s) > 0 && s[len(s)-1] == 0 {
+		return &(*(*[]byte)(unsafe.Pointer(&s)))[0]
+	}
+	b := unsafe.Slice((*byte)(a.Alloc(uintptr(len(s))+1, 1)), len(s)+1)
+	copy(b, s)
+	return &b[0]
+}
+
+// byteSlice returns a NULL terminated array of copies of the strings s like strings.ByteSlice.
+func (a *Arena) byteSlice(s []string) **byte {
+	if s == nil {
+		return nil
+	}
+	// the addresses are stored as uintptr since the arena is not scanned by the garbage collector
+	ptrs := unsafe.Slice((*uintptr)(a.Alloc(unsafe.Sizeof(uintptr(0))*uintptr(len(s)+1), unsafe.Alignof(uintptr(0)))), len(s)+1)
+	for i, v := range s {
+		ptrs[i] = uintptr(unsafe.Pointer(a.CString(v)))
+	}
+	return (**byte)(unsafe.Pointer(&ptrs[0]))
+}
+
+// Reset frees all the memory allocated from the arena so that it is reused by the next allocations.
+func (a *Arena) Reset() {
+	if len(a.full) > 0 {
+		// the next calls likely need as much memory so make the chunk big enough for all of it
+		size := len(a.chunk)
+		for _, c := range a.full {
+			size += len(c)
+		}
+		a.full = nil
+		a.chunk = make([]uint64, size)
+	}
+	a.used = 0
+}
+
+// putArena resets a and puts it back into the pool unless it grew too large.
+func putArena(a *Arena) {
+	a.Reset()
+	if len(a.chunk) > arenaMaxPooledWords {
+		return
+	}
+	arenaPool.Put(a)
+}
+
+// callArena is the Arena the arguments of a call are converted into.
+type callArena struct {
+	*Arena
+	// pooled is true if the Arena is from the pool rather than an argument of the call.
+	pooled bool
+}
+
+// argArena returns the Arena passed in args or one from the pool if there is none.
+func argArena(args []reflect.Value) callArena {
+	for _, v := range args {
+		if v.Type() == arenaType && !v.IsNil() {
+			return callArena{Arena: v.Interface().(*Arena)}
+		}
+	}
+	return callArena{Arena: arenaPool.Get().(*Arena), pooled: true}
+}
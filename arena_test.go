@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestArenaAlloc(t *testing.T) {
+	var a purego.Arena
+	for _, align := range []uintptr{1, 2, 4, 8, 16, 64} {
+		p := a.Alloc(3, align)
+		if uintptr(p)%align != 0 {
+			t.Errorf("Alloc(3, %d) = %p is not aligned", align, p)
+		}
+	}
+	// allocations larger than a chunk must not overlap
+	big := unsafe.Slice((*byte)(a.Alloc(1<<16, 1)), 1<<16)
+	small := unsafe.Slice((*byte)(a.Alloc(8, 1)), 8)
+	for i := range big {
+		big[i] = 0xff
+	}
+	for i, b := range small {
+		if b != 0 {
+			t.Fatalf("small[%d] = %#x after writing big, want 0", i, b)
+		}
+	}
+	a.Reset()
+	// the memory is zeroed again after it is reused
+	reused := unsafe.Slice((*byte)(a.Alloc(1<<16, 1)), 1<<16)
+	for i, b := range reused {
+		if b != 0 {
+			t.Fatalf("reused[%d] = %#x, want 0", i, b)
+		}
+	}
+
+	s := a.CString("hello")
+	if got := unsafe.Slice(s, 6); string(got) != "hello\x00" {
+		t.Errorf("CString(hello) = %q", got)
+	}
+}
+
+func TestArenaArgument(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var strcpy func(a *purego.Arena, dst *byte, src string) uintptr
+	purego.RegisterLibFunc(&strcpy, libc, "strcpy")
+	var strlen func(s string, a *purego.Arena) int
+	purego.RegisterLibFunc(&strlen, libc, "strlen")
+
+	var a purego.Arena
+	dst := (*byte)(a.Alloc(16, 1))
+	if got := strcpy(&a, dst, "purego"); got != uintptr(unsafe.Pointer(dst)) {
+		t.Errorf("strcpy returned %#x, want %p", got, dst)
+	}
+	// a nil Arena uses one from the pool
+	if got := strlen("purego", nil); got != 6 {
+		t.Errorf("strlen(purego) = %d, want 6", got)
+	}
+	if got := strlen("", &a); got != 0 {
+		t.Errorf("strlen('') = %d, want 0", got)
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"unsafe"
+)
+
+// Keys of the auxiliary vector the kernel passes to every process.
+const (
+	atHWCap       = 16 // AT_HWCAP, the hardware capabilities
+	atSysinfoEHdr = 33 // AT_SYSINFO_EHDR, the address of the vDSO
+	atHWCap2      = 26 // AT_HWCAP2, more hardware capabilities
+)
+
+var auxv struct {
+	once sync.Once
+	err  error
+	m    map[uintptr]uintptr
+}
+
+// auxvValue returns the value of the entry key of the auxiliary vector read from /proc/self/auxv.
+func auxvValue(key uintptr) (uintptr, error) {
+	auxv.once.Do(func() {
+		data, err := os.ReadFile("/proc/self/auxv")
+		if err != nil {
+			auxv.err = errors.New("purego: reading the auxiliary vector: " + err.Error())
+			return
+		}
+		auxv.m = map[uintptr]uintptr{}
+		// every entry is a pair of words in the byte order of the machine
+		word := int(unsafe.Sizeof(uintptr(0)))
+		for i := 0; i+2*word <= len(data); i += 2 * word {
+			auxv.m[*(*uintptr)(unsafe.Pointer(&data[i]))] = *(*uintptr)(unsafe.Pointer(&data[i+word]))
+		}
+	})
+	if auxv.err != nil {
+		return 0, auxv.err
+	}
+	return auxv.m[key], nil
+}
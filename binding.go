@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Direction describes which way data flows through a parameter.
+type Direction uint8
+
+const (
+	// In parameters are only read by the C function. This is the default. A pointer to a struct
+	// that is copied to C because its C layout differs gets a copy of its own for the call, so
+	// what C writes to it isn't copied back into the argument.
+	In Direction = iota
+	// Out parameters point to memory the C function fills in. Before the call the value a pointer
+	// points to or the elements of a slice are set to zero.
+	Out
+	// InOut parameters point to memory the C function reads and then modifies.
+	InOut
+)
+
+func (d Direction) String() string {
+	switch d {
+	case In:
+		return "in"
+	case Out:
+		return "out"
+	case InOut:
+		return "inout"
+	default:
+		return fmt.Sprintf("Direction(%d)", uint8(d))
+	}
+}
+
+// Ownership describes who is responsible for the memory behind a parameter or return value.
+type Ownership uint8
+
+const (
+	// Borrowed memory is only used for the duration of the call. For a return value it means
+	// the C library keeps ownership and the memory must not be freed. This is the default.
+	Borrowed Ownership = iota
+	// Retained parameters are referenced by the C library after the call returns. Purego keeps
+	// the Go memory alive until Binding.Release is called and refuses arguments that would have to
+	// be copied into temporary memory, such as strings that are not null-terminated.
+	Retained
+	// Owned return values are transferred to the caller. Returned strings are copied into Go memory
+	// and the C memory is then released with ReturnSpec.Free.
+	Owned
+	// Aliased return values stay owned by the C library like Borrowed ones but returned strings
+	// refer to the C memory instead of being copied. It must not change or be freed while the string is used.
+	Aliased
+)
+
+func (o Ownership) String() string {
+	switch o {
+	case Borrowed:
+		return "borrowed"
+	case Retained:
+		return "retained"
+	case Owned:
+		return "owned"
+	case Aliased:
+		return "aliased"
+	default:
+		return fmt.Sprintf("Ownership(%d)", uint8(o))
+	}
+}
+
+// ParamSpec describes one parameter of a C function.
+type ParamSpec struct {
+	Name string    // Name is used in error messages.
+	Dir  Direction // Dir is the direction data flows through the parameter.
+	Own  Ownership // Own is who owns the memory passed in the parameter.
+}
+
+// name returns the name of the i-th parameter p for error messages.
+func (p ParamSpec) name(i int) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return fmt.Sprint("#", i)
+}
+
+// ReturnSpec describes the return value of a C function.
+type ReturnSpec struct {
+	Own Ownership // Own is who owns the memory returned.
+	// Free is the address of the C function used to release Owned memory such as the address of free from libc.
+	// It is called with the returned pointer once its contents have been copied.
+	Free uintptr
+}
+
+// FuncSpec is a declarative description of a C function. It turns the implicit conventions of
+// RegisterFunc into an explicit contract that Bind verifies against the Go function type
+// before building the function.
+//
+// For example, a binding of
+//
+//	char *strdup(const char *s);
+//
+// where the caller has to free the result can be described as
+//
+//	purego.FuncSpec{
+//		Symbol: "strdup",
+//		Params: []purego.ParamSpec{{Name: "s"}},
+//		Return: purego.ReturnSpec{Own: purego.Owned, Free: free},
+//	}
+type FuncSpec struct {
+	Symbol string      // Symbol is the name of the C function.
+	Params []ParamSpec // Params must describe every parameter of the Go function type.
+	Return ReturnSpec
+}
+
+// Binding is a C function bound with Bind.
+type Binding struct {
+	spec FuncSpec
+
+	mu       sync.Mutex
+	retained []interface{} // arguments of Retained parameters
+}
+
+// Bind verifies that the Go function pointed to by fptr agrees with spec, looks up spec.Symbol
+// using r and sets fptr to a function that calls it following the contract of spec.
+// Unlike RegisterFunc, an error is returned if the contract can't be satisfied.
+func Bind(fptr interface{}, r Resolver, spec FuncSpec) (_ *Binding, err error) {
+	defer recoverError(&err)
+	ptr := reflect.ValueOf(fptr)
+	if ptr.Kind() != reflect.Ptr || ptr.Elem().Kind() != reflect.Func {
+		return nil, errors.New("purego: fptr must be a function pointer")
+	}
+	ty := ptr.Elem().Type()
+	if err := spec.check(ty); err != nil {
+		return nil, err
+	}
+	cfn, err := r.Lookup(spec.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if cfn == 0 {
+		return nil, errors.New("purego: " + spec.Symbol + " resolved to nil")
+	}
+	spec.Params = append([]ParamSpec(nil), spec.Params...)
+	b := &Binding{spec: spec}
+
+	var opts []FuncOption
+	if ty.NumOut() == 1 && ty.Out(0).Kind() == reflect.String {
+		opts = append(opts, WithStringReturn(spec.Return.Own, spec.Return.Free))
+	}
+	raw := reflect.New(ty)
+	RegisterFuncWith(raw.Interface(), cfn, opts...)
+	raw = raw.Elem()
+
+	ptr.Elem().Set(reflect.MakeFunc(ty, func(args []reflect.Value) []reflect.Value {
+		b.retain(args)
+		b.direct(args)
+		if ty.IsVariadic() {
+			return raw.CallSlice(args)
+		}
+		return raw.Call(args)
+	}))
+	return b, nil
+}
+
+// retain keeps the arguments of Retained parameters alive until Release is called.
+func (b *Binding) retain(args []reflect.Value) {
+	for i, p := range b.spec.Params {
+		if p.Own != Retained {
+			continue
+		}
+		arg := args[i]
+		if arg.Kind() == reflect.String {
+			if s := arg.String(); len(s) == 0 || s[len(s)-1] != 0 {
+				panic("purego: argument " + p.name(i) + " of " + b.spec.Symbol + " is retained so it must be null-terminated")
+			}
+		}
+		b.mu.Lock()
+		b.retained = append(b.retained, arg.Interface())
+		b.mu.Unlock()
+	}
+}
+
+// direct prepares the arguments of the parameters as their directions require.
+func (b *Binding) direct(args []reflect.Value) {
+	for i, p := range b.spec.Params {
+		arg := args[i]
+		switch p.Dir {
+		case In:
+			// a retained argument must be the one C keeps
+			if p.Own == Retained || arg.Kind() != reflect.Ptr || arg.IsNil() || arg.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			if l, err := layoutOf(arg.Type().Elem()); err == nil && !l.sameAsGo {
+				cp := reflect.New(arg.Type().Elem())
+				cp.Elem().Set(arg.Elem())
+				args[i] = cp
+			}
+		case Out:
+			switch arg.Kind() {
+			case reflect.Ptr:
+				if !arg.IsNil() {
+					arg.Elem().Set(reflect.Zero(arg.Type().Elem()))
+				}
+			case reflect.Slice:
+				zero := reflect.Zero(arg.Type().Elem())
+				for j := 0; j < arg.Len(); j++ {
+					arg.Index(j).Set(zero)
+				}
+			}
+		}
+	}
+}
+
+// Release lets the garbage collector reclaim the arguments of Retained parameters
+// passed so far. It must only be called once the C library no longer references them.
+func (b *Binding) Release() {
+	b.mu.Lock()
+	b.retained = nil
+	b.mu.Unlock()
+}
+
+// Spec returns the FuncSpec b was bound with.
+func (b *Binding) Spec() FuncSpec {
+	return b.spec
+}
+
+// check reports whether the Go function type ty can satisfy spec.
+func (spec FuncSpec) check(ty reflect.Type) error {
+	fail := func(format string, args ...interface{}) error {
+		return fmt.Errorf("purego: %s: %s", spec.Symbol, fmt.Sprintf(format, args...))
+	}
+	if spec.Symbol == "" {
+		return errors.New("purego: FuncSpec.Symbol is empty")
+	}
+	if len(spec.Params) != ty.NumIn() {
+		return fail("spec has %d parameters but %s has %d", len(spec.Params), ty, ty.NumIn())
+	}
+	if ty.NumOut() > 1 {
+		return fail("function can only return zero or one values")
+	}
+	names := map[string]bool{}
+	for i, p := range spec.Params {
+		if p.Name != "" {
+			if names[p.Name] {
+				return fail("parameter %s is described twice", p.Name)
+			}
+			names[p.Name] = true
+		}
+		name := p.name(i)
+		in := ty.In(i)
+		switch p.Dir {
+		case In:
+		case Out, InOut:
+			if ty.IsVariadic() && i == ty.NumIn()-1 {
+				return fail("variadic parameter %s can only be in", name)
+			}
+			switch in.Kind() {
+			case reflect.Ptr, reflect.UnsafePointer, reflect.Slice:
+			default:
+				return fail("%s parameter %s must be a pointer or slice but is %s", p.Dir, name, in)
+			}
+		default:
+			return fail("parameter %s has invalid direction %s", name, p.Dir)
+		}
+		switch p.Own {
+		case Borrowed:
+		case Retained:
+			switch in.Kind() {
+			case reflect.Ptr, reflect.UnsafePointer, reflect.Slice, reflect.String:
+			default:
+				return fail("retained parameter %s must reference memory but is %s", name, in)
+			}
+		case Owned:
+			return fail("parameter %s can't be owned; only return values can transfer ownership", name)
+		case Aliased:
+			return fail("parameter %s can't be aliased; only string return values can", name)
+		default:
+			return fail("parameter %s has invalid ownership %s", name, p.Own)
+		}
+	}
+	switch spec.Return.Own {
+	case Borrowed:
+	case Owned:
+		if ty.NumOut() == 0 {
+			return fail("owned return value but the function returns nothing")
+		}
+		if ty.Out(0).Kind() != reflect.String {
+			// pointers and uintptrs are handed to the caller as is and it is up to them to free them
+			switch ty.Out(0).Kind() {
+			case reflect.Ptr, reflect.UnsafePointer, reflect.Uintptr:
+			default:
+				return fail("owned return value must be a string or pointer but is %s", ty.Out(0))
+			}
+		} else if spec.Return.Free == 0 {
+			return fail("owned string return value needs ReturnSpec.Free")
+		}
+	case Aliased:
+		if ty.NumOut() == 0 || ty.Out(0).Kind() != reflect.String {
+			return fail("aliased return value must be a string")
+		}
+	default:
+		return fail("return value has invalid ownership %s", spec.Return.Own)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestBindOwnedString(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := purego.Dlopen(library, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	free, err := purego.Dlsym(libc, "free")
+	if err != nil {
+		t.Fatalf("failed to dlsym: %s", err)
+	}
+	var strdup func(s string) string
+	_, err = purego.Bind(&strdup, purego.LibraryResolver(libc), purego.FuncSpec{
+		Symbol: "strdup",
+		Params: []purego.ParamSpec{{Name: "s"}},
+		Return: purego.ReturnSpec{Own: purego.Owned, Free: free},
+	})
+	if err != nil {
+		t.Fatalf("Bind failed: %v", err)
+	}
+	if got := strdup("purego"); got != "purego" {
+		t.Errorf("strdup got %q wanted %q", got, "purego")
+	}
+}
+
+func TestBindRetained(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := purego.Dlopen(library, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var strlen func(s string) int
+	b, err := purego.Bind(&strlen, purego.LibraryResolver(libc), purego.FuncSpec{
+		Symbol: "strlen",
+		Params: []purego.ParamSpec{{Name: "s", Own: purego.Retained}},
+	})
+	if err != nil {
+		t.Fatalf("Bind failed: %v", err)
+	}
+	defer b.Release()
+	if got := strlen("abc\x00"); got != 3 {
+		t.Errorf("strlen got %d wanted %d", got, 3)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("retained string without null terminator did not panic")
+		}
+	}()
+	strlen("abc")
+}
+
+func TestBindCheck(t *testing.T) {
+	r := purego.ResolverFunc(func(name string) (uintptr, error) {
+		t.Fatalf("Lookup must not be called for an invalid spec")
+		return 0, nil
+	})
+	tests := []struct {
+		name string
+		fptr interface{}
+		spec purego.FuncSpec
+	}{
+		{"param count", new(func(int)), purego.FuncSpec{Symbol: "f"}},
+		{"out not pointer", new(func(int)), purego.FuncSpec{Symbol: "f", Params: []purego.ParamSpec{{Dir: purego.Out}}}},
+		{"owned param", new(func(*int)), purego.FuncSpec{Symbol: "f", Params: []purego.ParamSpec{{Own: purego.Owned}}}},
+		{"retained int", new(func(int)), purego.FuncSpec{Symbol: "f", Params: []purego.ParamSpec{{Own: purego.Retained}}}},
+		{"owned string without free", new(func() string), purego.FuncSpec{Symbol: "f", Return: purego.ReturnSpec{Own: purego.Owned}}},
+		{"aliased int", new(func() int), purego.FuncSpec{Symbol: "f", Return: purego.ReturnSpec{Own: purego.Aliased}}},
+		{"duplicate names", new(func(a, b int)), purego.FuncSpec{Symbol: "f", Params: []purego.ParamSpec{{Name: "a"}, {Name: "a"}}}},
+		{"out variadic", new(func(...*int)), purego.FuncSpec{Symbol: "f", Params: []purego.ParamSpec{{Dir: purego.Out}}}},
+	}
+	for _, test := range tests {
+		if _, err := purego.Bind(test.fptr, r, test.spec); err == nil {
+			t.Errorf("%s: Bind succeeded but wanted an error", test.name)
+		}
+	}
+}
+
+func TestBindUnsupportedType(t *testing.T) {
+	r := purego.ResolverFunc(func(name string) (uintptr, error) {
+		return 1, nil
+	})
+	// spec.check accepts the channel but RegisterFunc can't pass it
+	var f func(c chan int)
+	_, err := purego.Bind(&f, r, purego.FuncSpec{Symbol: "f", Params: []purego.ParamSpec{{Name: "c"}}})
+	if err == nil {
+		t.Errorf("Bind of a function with a channel parameter succeeded but wanted an error")
+	}
+	if f != nil {
+		t.Errorf("Bind set the function although it failed")
+	}
+}
+
+func TestBindDirection(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := purego.Dlopen(library, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	r := purego.LibraryResolver(libc)
+
+	// the memory of an out parameter is zeroed before C fills it
+	var strlen func(buf []byte) int
+	if _, err := purego.Bind(&strlen, r, purego.FuncSpec{Symbol: "strlen", Params: []purego.ParamSpec{{Dir: purego.Out}}}); err != nil {
+		t.Fatalf("Bind failed: %v", err)
+	}
+	if got := strlen([]byte("abc\x00")); got != 0 {
+		t.Errorf("strlen of an out parameter got %d wanted 0", got)
+	}
+
+	// the struct is copied to C since its C layout differs and only copied back if C may change it
+	type wide struct {
+		A, B int64 `purego:"width(4)"`
+	}
+	for _, dir := range []purego.Direction{purego.In, purego.InOut} {
+		var memset func(p *wide, c int32, n uintptr) unsafe.Pointer
+		_, err := purego.Bind(&memset, r, purego.FuncSpec{Symbol: "memset", Params: []purego.ParamSpec{{Dir: dir}, {}, {}}})
+		if err != nil {
+			t.Fatalf("Bind failed: %v", err)
+		}
+		w := wide{A: 1, B: 2}
+		memset(&w, 0, purego.Sizeof(w))
+		if want := map[purego.Direction]wide{purego.In: {A: 1, B: 2}, purego.InOut: {}}[dir]; w != want {
+			t.Errorf("%s parameter got %+v after the call wanted %+v", dir, w, want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego_test
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+func buildSharedLib(compilerEnv, libFile string, sources ...string) error {
+	out, err := exec.Command("go", "env", compilerEnv).Output()
+	if err != nil {
+		return fmt.Errorf("go env %s error: %w", compilerEnv, err)
+	}
+
+	compiler := strings.TrimSpace(string(out))
+	if compiler == "" {
+		return errors.New("compiler not found")
+	}
+
+	var args []string
+	if runtime.GOOS == "freebsd" || runtime.GOOS == "linux" {
+		// position independent code is needed for libraries that access their own global variables
+		args = []string{"-shared", "-Wall", "-Werror", "-fPIC", "-o", libFile}
+	} else {
+		args = []string{"-shared", "-Wall", "-Werror", "-o", libFile}
+	}
+
+	// macOS arm64 can run amd64 tests through Rossetta.
+	// Build the shared library based on the GOARCH and not
+	// the default behavior of the compiler.
+	if runtime.GOOS == "darwin" {
+		var arch string
+		switch runtime.GOARCH {
+		case "arm64":
+			arch = "arm64"
+		case "amd64":
+			arch = "x86_64"
+		default:
+			return fmt.Errorf("unknown macOS architecture %s", runtime.GOARCH)
+		}
+		args = append(args, "-arch", arch)
+	}
+	cmd := exec.Command(compiler, append(args, sources...)...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("compile lib: %w\n%q\n%s", err, cmd, string(out))
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CallMetrics are the metrics of the calls of a C function registered with RegisterFunc.
+// They are only collected while EnableCallMetrics is on. The calls of every function registered
+// for the same symbol are counted together.
+type CallMetrics struct {
+	// Name is the name of the symbol or the address of the C function if it was registered by address.
+	Name string `json:"-"`
+
+	Calls uint64        `json:"calls"`    // Calls is the number of calls.
+	Total time.Duration `json:"total_ns"` // Total is the time spent in the C function.
+	Last  time.Time     `json:"last"`     // Last is when the function was last called.
+
+	// P50 and P99 are the median and 99th percentile duration of a call.
+	// They are estimated from a histogram and accurate to about 12%.
+	P50 time.Duration `json:"p50_ns"`
+	P99 time.Duration `json:"p99_ns"`
+}
+
+// callMetricsEnabled is non-zero while metrics are collected.
+var callMetricsEnabled int32
+
+// EnableCallMetrics turns the collection of call metrics on or off. It is off by default
+// because measuring every call adds a small overhead. Metrics already collected are kept.
+func EnableCallMetrics(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&callMetricsEnabled, v)
+}
+
+var callMetrics struct {
+	sync.Mutex
+	m map[string]*latencyStats
+}
+
+// callMetricsFor returns the histogram of the calls of the symbol name.
+func callMetricsFor(name string) *latencyStats {
+	callMetrics.Lock()
+	defer callMetrics.Unlock()
+	if callMetrics.m == nil {
+		callMetrics.m = map[string]*latencyStats{}
+	}
+	s, ok := callMetrics.m[name]
+	if !ok {
+		s = new(latencyStats)
+		callMetrics.m[name] = s
+	}
+	return s
+}
+
+// CallMetricsOf returns the metrics of the symbol name.
+// It returns false if no call of the symbol was measured.
+func CallMetricsOf(name string) (CallMetrics, bool) {
+	callMetrics.Lock()
+	s, ok := callMetrics.m[name]
+	callMetrics.Unlock()
+	if !ok {
+		return CallMetrics{}, false
+	}
+	return newCallMetrics(name, s), true
+}
+
+// AllCallMetrics returns the metrics of every C function called while metrics were collected
+// sorted by the total time spent in them so that the calls that dominate come first.
+func AllCallMetrics() []CallMetrics {
+	callMetrics.Lock()
+	all := make([]CallMetrics, 0, len(callMetrics.m))
+	for name, s := range callMetrics.m {
+		all = append(all, newCallMetrics(name, s))
+	}
+	callMetrics.Unlock()
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Total != all[j].Total {
+			return all[i].Total > all[j].Total
+		}
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
+
+func newCallMetrics(name string, s *latencyStats) CallMetrics {
+	st := s.snapshot()
+	return CallMetrics{Name: name, Calls: st.Calls, Total: st.Total, Last: st.Last, P50: st.P50, P99: st.P99}
+}
+
+// CallMetricsVar implements the expvar.Var interface. It reports AllCallMetrics as a JSON object
+// keyed by the name of the symbol and can be published with
+//
+//	expvar.Publish("purego", purego.CallMetricsVar{})
+type CallMetricsVar struct{}
+
+// String returns the call metrics as JSON.
+func (CallMetricsVar) String() string {
+	all := AllCallMetrics()
+	m := make(map[string]CallMetrics, len(all))
+	for _, c := range all {
+		m[c.Name] = c
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"math"
+	"runtime"
+	"unsafe"
+)
+
+// CallArgs are the arguments of a call of a C function without reflection. The arguments are added
+// in the order of the C parameters and placed in the registers and on the stack as the C calling
+// convention of the platform requires:
+//
+//	// double ldexp(double x, int exp);
+//	var args purego.CallArgs
+//	args.Float64(0.75)
+//	args.Word(uintptr(3))
+//	r := args.Call(ldexp)
+//	fmt.Println(r.Float64()) // 6
+//
+// It is the building block of the code generated by cmd/puregogen for functions whose calls must
+// not allocate or use reflection. Unlike RegisterFunc nothing is converted: strings must be passed
+// as NUL-terminated byte slices and Go memory passed with Pointer must be kept alive with
+// runtime.KeepAlive until Call returns. Structs and more arguments than there are registers and
+// stack words in syscall9X are not supported.
+type CallArgs struct {
+	ints                         [maxArgs]uintptr
+	floats                       [numOfFloats]uintptr
+	numInts, numFloats, numStack int
+}
+
+// Word adds an integer argument of at most the size of a pointer such as an int32 or uintptr.
+// Signed integers must be converted to uintptr with sign extension, which the Go conversion does.
+func (a *CallArgs) Word(x uintptr) {
+	a.addInt(x)
+}
+
+// Uint32 adds an unsigned 32-bit argument.
+func (a *CallArgs) Uint32(x uint32) {
+	if signExtendUint32 {
+		a.addInt(uintptr(int32(x)))
+	} else {
+		a.addInt(uintptr(x))
+	}
+}
+
+// Uint64 adds a 64-bit integer argument which takes two words on 32-bit platforms.
+func (a *CallArgs) Uint64(x uint64) {
+	a.addInt(uintptr(x))
+	if is32bit {
+		a.addInt(uintptr(x >> 32))
+	}
+}
+
+// Pointer adds a pointer argument. Go memory it points to must be kept alive until Call returns.
+func (a *CallArgs) Pointer(p unsafe.Pointer) {
+	escape(p)
+	a.addInt(uintptr(p))
+}
+
+// Float32 adds a float argument.
+func (a *CallArgs) Float32(f float32) {
+	a.addFloat(uintptr(math.Float32bits(f)))
+}
+
+// Float64 adds a double argument.
+func (a *CallArgs) Float64(f float64) {
+	bits := math.Float64bits(f)
+	a.addFloat(uintptr(bits))
+	if is32bit {
+		a.addFloat(uintptr(bits >> 32))
+	}
+}
+
+// sequentialArgs is true if the arguments are passed in the numbered registers and then on the stack
+// regardless of their type. See the setup of the arguments in registerFunc.
+const sequentialArgs = positionalArgs || runtime.GOOS == "windows" && runtime.GOARCH != "arm64"
+
+func (a *CallArgs) addStack(x uintptr) {
+	if sequentialArgs {
+		if a.numStack >= len(a.ints) {
+			panic("purego: too many arguments")
+		}
+		a.ints[a.numStack] = x
+		a.numStack++
+		return
+	}
+	n := numOfIntegerRegisters() + a.numStack
+	if n >= len(a.ints) {
+		panic("purego: too many arguments")
+	}
+	a.ints[n] = x
+	a.numStack++
+}
+
+func (a *CallArgs) addInt(x uintptr) {
+	if sequentialArgs || a.numInts >= numOfIntegerRegisters() {
+		a.addStack(x)
+		return
+	}
+	a.ints[a.numInts] = x
+	a.numInts++
+}
+
+func (a *CallArgs) addFloat(x uintptr) {
+	if sequentialArgs || is32bit || a.numFloats >= len(a.floats) {
+		a.addStack(x)
+		return
+	}
+	a.floats[a.numFloats] = x
+	a.numFloats++
+}
+
+// Call calls the C function fn with the arguments.
+func (a *CallArgs) Call(fn uintptr) CallResult {
+	if fn == 0 {
+		panic("purego: fn is nil")
+	}
+	syscall := syscall9Args{
+		fn: fn,
+		a1: a.ints[0], a2: a.ints[1], a3: a.ints[2], a4: a.ints[3], a5: a.ints[4], a6: a.ints[5], a7: a.ints[6], a8: a.ints[7], a9: a.ints[8],
+		f1: a.floats[0], f2: a.floats[1], f3: a.floats[2], f4: a.floats[3], f5: a.floats[4], f6: a.floats[5], f7: a.floats[6], f8: a.floats[7],
+	}
+	if translateExceptions() {
+		runtime_cgocall(syscall9XSEHABI0, unsafe.Pointer(&syscall))
+	} else if syscall9XABI0 != 0 {
+		runtime_cgocall(syscall9XABI0, unsafe.Pointer(&syscall))
+	} else {
+		syscall.r1, syscall.r2, _ = syscall_syscall9X(fn, a.ints[0], a.ints[1], a.ints[2], a.ints[3], a.ints[4], a.ints[5], a.ints[6], a.ints[7], a.ints[8])
+	}
+	raiseException(&syscall)
+	return CallResult{r1: syscall.r1, r2: syscall.r2, rf2: syscall.rf2, rf3: syscall.rf3}
+}
+
+// CallResult is the return value of a call made with CallArgs.
+type CallResult struct {
+	r1, r2, rf2, rf3 uintptr
+}
+
+// Word returns an integer result of at most the size of a pointer. C functions that return a
+// type smaller than a register may leave garbage in the upper bits so only convert the result
+// to the return type such as int32(r.Word()).
+func (r CallResult) Word() uintptr {
+	return r.r1
+}
+
+// Uint64 returns a 64-bit integer result whose upper half is in a second register on 32-bit platforms.
+func (r CallResult) Uint64() uint64 {
+	x := uint64(r.r1)
+	if is32bit {
+		x |= uint64(r.r2) << 32
+	}
+	return x
+}
+
+// Pointer returns a pointer result.
+func (r CallResult) Pointer() unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&r.r1))
+}
+
+// Float32 returns a float result.
+func (r CallResult) Float32() float32 {
+	if is32bit {
+		return float32(r.Float64())
+	}
+	return math.Float32frombits(uint32(r.r2))
+}
+
+// Float64 returns a double result.
+func (r CallResult) Float64() float64 {
+	if is32bit {
+		// syscall9X stores ST0 as a float64 in rf2 and rf3
+		return math.Float64frombits(uint64(r.rf2) | uint64(r.rf3)<<32)
+	}
+	return math.Float64frombits(uint64(r.r2))
+}
+
+// escapeSink makes the pointers passed to escape escape to the heap.
+var escapeSink struct {
+	b bool
+	p unsafe.Pointer
+}
+
+// escape moves the memory p points to to the heap, where it doesn't move, if it would
+// otherwise be on the stack since it is only passed to C as a uintptr.
+func escape(p unsafe.Pointer) {
+	if escapeSink.b {
+		escapeSink.p = p
+	}
+}
+
+// CallbackFrame are the arguments of a call of a callback created with NewFrameCallback which are read
+// in the order of the C parameters without reflection, as the code generated by cmd/puregogen does:
+//
+//	// int (*compar)(const void *, const void *)
+//	cb := purego.NewFrameCallback(func(f *purego.CallbackFrame) uintptr {
+//		a, b := (*int32)(f.Pointer()), (*int32)(f.Pointer())
+//		return uintptr(*a - *b)
+//	})
+//
+// Structs and va_list parameters are not supported.
+type CallbackFrame struct {
+	// frame points to the float registers followed by the integer registers and the stack
+	// as they are saved by callbackasm.
+	frame                        unsafe.Pointer
+	numInts, numFloats, numStack int
+}
+
+// word returns the address of the i-th word of the frame.
+func (f *CallbackFrame) word(i int) *uintptr {
+	return (*uintptr)(unsafe.Add(f.frame, uintptr(i)*unsafe.Sizeof(uintptr(0))))
+}
+
+func (f *CallbackFrame) stack() *uintptr {
+	w := f.word(numOfIntegerRegisters() + numOfFloats + f.numStack)
+	f.numStack++
+	return w
+}
+
+func (f *CallbackFrame) nextInt() *uintptr {
+	var w *uintptr
+	if f.numInts >= numOfIntegerRegisters() {
+		w = f.stack()
+	} else {
+		// the integers begin after the floats in the frame
+		w = f.word(numOfFloats + f.numInts)
+	}
+	f.numInts++
+	if positionalArgs {
+		f.numFloats = f.numInts
+	}
+	return w
+}
+
+func (f *CallbackFrame) nextFloat() *uintptr {
+	var w *uintptr
+	if f.numFloats >= numOfFloats {
+		w = f.stack()
+	} else {
+		w = f.word(f.numFloats)
+	}
+	f.numFloats++
+	if positionalArgs {
+		f.numInts = f.numFloats
+	}
+	return w
+}
+
+// Word returns the next integer parameter of at most the size of a pointer. Only the bits of the
+// parameter type are defined so convert it to the type such as int32(f.Word()).
+func (f *CallbackFrame) Word() uintptr {
+	return *f.nextInt()
+}
+
+// Uint64 returns the next 64-bit integer parameter.
+func (f *CallbackFrame) Uint64() uint64 {
+	x := uint64(*f.nextInt())
+	if is32bit {
+		x |= uint64(*f.nextInt()) << 32
+	}
+	return x
+}
+
+// Pointer returns the next pointer parameter.
+func (f *CallbackFrame) Pointer() unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(f.nextInt()))
+}
+
+// Float32 returns the next float parameter.
+func (f *CallbackFrame) Float32() float32 {
+	p := unsafe.Pointer(f.nextFloat())
+	if bigEndian {
+		p = unsafe.Add(p, unsafe.Sizeof(uintptr(0))-4)
+	}
+	return *(*float32)(p)
+}
+
+// Float64 returns the next double parameter.
+func (f *CallbackFrame) Float64() float64 {
+	return *(*float64)(unsafe.Pointer(f.nextFloat()))
+}
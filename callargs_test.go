@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
+
+package purego_test
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestCallArgs(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strlen, err := purego.Dlsym(libc, "strlen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := []byte("purego\x00")
+	var args purego.CallArgs
+	args.Pointer(unsafe.Pointer(&s[0]))
+	r := args.Call(strlen)
+	runtime.KeepAlive(s)
+	if got := r.Word(); got != 6 {
+		t.Errorf("strlen got %d wanted 6", got)
+	}
+
+	// more integers and floats than there are registers
+	type call struct {
+		a, b, c, d, e, f, g int32
+		x, y                float64
+		z                   float32
+		u                   uint64
+	}
+	var got call
+	cb := purego.NewCallback(func(a, b, c int32, x float64, d, e, f, g int32, y float64, z float32, u uint64) int32 {
+		got = call{a, b, c, d, e, f, g, x, y, z, u}
+		return -1
+	})
+	want := call{1, -2, 3, 4, 5, 6, -7, 1.5, -2.25, 3.5, 1 << 40}
+	args = purego.CallArgs{}
+	args.Word(uintptr(want.a))
+	args.Word(uintptr(want.b))
+	args.Word(uintptr(want.c))
+	args.Float64(want.x)
+	args.Word(uintptr(want.d))
+	args.Word(uintptr(want.e))
+	args.Word(uintptr(want.f))
+	args.Word(uintptr(want.g))
+	args.Float64(want.y)
+	args.Float32(want.z)
+	args.Uint64(want.u)
+	if r := int32(args.Call(cb).Word()); r != -1 {
+		t.Errorf("got result %d wanted -1", r)
+	}
+	if got != want {
+		t.Errorf("got %+v wanted %+v", got, want)
+	}
+}
+
+func TestNewFrameCallback(t *testing.T) {
+	type call struct {
+		a, b int32
+		x    float64
+		p    *int32
+		z    float32
+		c, d int64
+		e, f int32
+	}
+	var got call
+	cb := purego.NewFrameCallback(func(f *purego.CallbackFrame) uintptr {
+		got = call{
+			a: int32(f.Word()),
+			b: int32(f.Word()),
+			x: f.Float64(),
+			p: (*int32)(f.Pointer()),
+			z: f.Float32(),
+			c: int64(f.Uint64()),
+			d: int64(f.Uint64()),
+			e: int32(f.Word()),
+			f: int32(f.Word()),
+		}
+		return uintptr(got.a + got.b)
+	})
+	var fn func(a, b int32, x float64, p *int32, z float32, c, d int64, e, f int32) int32
+	purego.RegisterFunc(&fn, cb)
+	n := int32(42)
+	want := call{3, -5, 2.5, &n, -1.25, 1 << 40, -9, 7, 8}
+	if r := fn(want.a, want.b, want.x, want.p, want.z, want.c, want.d, want.e, want.f); r != -2 {
+		t.Errorf("got result %d wanted -2", r)
+	}
+	if got != want {
+		t.Errorf("got %+v wanted %+v", got, want)
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// ErrNoCallbacks is returned by ReserveCallbacks if fewer callbacks are free than were requested.
+var ErrNoCallbacks = errors.New("purego: not enough free callbacks")
+
+// CallbackCapacity returns the maximum number of callbacks made by NewCallback that can exist at the
+// same time or 0 if NewCallback isn't supported. On Windows it is the limit of the runtime which the
+// callbacks of syscall.NewCallback count against too and the callbacks with float parameters are
+// further limited to 512.
+func CallbackCapacity() int {
+	return callbackCapacity()
+}
+
+// FreeCallbacks returns the number of callbacks that can still be created before NewCallback panics
+// without the ones set aside by ReserveCallbacks. Released callbacks are free again. On Windows only
+// the callbacks created by purego are counted.
+func FreeCallbacks() int {
+	return freeCallbacks()
+}
+
+// CallbackReservation is a number of callbacks set aside by ReserveCallbacks which only its
+// NewCallback and NewCallbackWith create. Except on Windows, where the runtime picks the
+// addresses, they take the reserved callbacks in the order of their addresses.
+type CallbackReservation struct {
+	mu    sync.Mutex
+	slots []int
+}
+
+// ReserveCallbacks sets aside n callbacks so that a program which needs a known number of them
+// can fail when it starts instead of panicking in NewCallback once other code has used them up.
+// It returns an error wrapping ErrNoCallbacks if fewer than n are free.
+func ReserveCallbacks(n int) (*CallbackReservation, error) {
+	if n < 0 {
+		return nil, errors.New("purego: negative number of callbacks to reserve: " + strconv.Itoa(n))
+	}
+	slots, ok := reserveCallbacks(n)
+	if !ok {
+		return nil, &noCallbacksError{want: n, free: freeCallbacks()}
+	}
+	return &CallbackReservation{slots: slots}, nil
+}
+
+// noCallbacksError is the error of ReserveCallbacks if fewer than want callbacks are free.
+type noCallbacksError struct {
+	want, free int
+}
+
+func (e *noCallbacksError) Error() string {
+	return ErrNoCallbacks.Error() + ": wanted " + strconv.Itoa(e.want) + " but " + strconv.Itoa(e.free) + " are free"
+}
+
+func (e *noCallbacksError) Unwrap() error {
+	return ErrNoCallbacks
+}
+
+// Len returns the number of callbacks that are still reserved.
+func (r *CallbackReservation) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.slots)
+}
+
+// NewCallback is like the function NewCallback but takes one of the reserved callbacks.
+// It panics if none are left.
+func (r *CallbackReservation) NewCallback(fn interface{}) uintptr {
+	return r.NewCallbackWith(fn)
+}
+
+// NewCallbackWith is like the function NewCallbackWith but takes one of the reserved callbacks.
+// It panics if none are left.
+func (r *CallbackReservation) NewCallbackWith(fn interface{}, opts ...CallbackOption) uintptr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.slots) == 0 {
+		panic("purego: all reserved callbacks have been used")
+	}
+	// the slot stays reserved if fn can't be converted
+	cb := newReservedCallback(r.slots[0], fn, opts)
+	r.slots = r.slots[1:]
+	return cb
+}
+
+// Release returns the callbacks that are still reserved so that NewCallback can use them.
+// The callbacks already created from the reservation are not released.
+func (r *CallbackReservation) Release() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	unreserveCallbacks(r.slots)
+	r.slots = nil
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"math/bits"
+	"sync/atomic"
+	"time"
+)
+
+// CallbackStats are the statistics of a callback created with NewCallback.
+// They are only collected while EnableCallbackStats is on.
+type CallbackStats struct {
+	Callback uintptr // Callback is the C function pointer returned by NewCallback.
+	Name     string  // Name is the name of the Go function.
+
+	Calls uint64        // Calls is the number of invocations.
+	Total time.Duration // Total is the time spent in the Go function.
+	Last  time.Time     // Last is when the callback was last invoked.
+
+	// P50 and P99 are the median and 99th percentile duration of an invocation.
+	// They are estimated from a histogram and accurate to about 12%.
+	P50, P99 time.Duration
+}
+
+// callbackStatsEnabled is non-zero while statistics are collected.
+var callbackStatsEnabled int32
+
+// EnableCallbackStats turns the collection of callback statistics on or off. It is off by default
+// because measuring every invocation adds a small overhead. Statistics already collected are kept.
+//
+// Statistics are not collected on platforms where NewCallback is provided by the operating system
+// such as Windows.
+func EnableCallbackStats(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&callbackStatsEnabled, v)
+}
+
+// CallbackStatsOf returns the statistics of the callback cb returned by NewCallback.
+// It returns false if cb isn't a callback or it wasn't invoked while statistics were collected.
+func CallbackStatsOf(cb uintptr) (CallbackStats, bool) {
+	return callbackStatsOf(cb)
+}
+
+// AllCallbackStats returns the statistics of every callback invoked while statistics were collected.
+func AllCallbackStats() []CallbackStats {
+	return allCallbackStats()
+}
+
+// statsSubBuckets is the number of linear buckets every power of two is split into.
+const statsSubBuckets = 4
+
+// latencyStats is the histogram of the durations of the invocations of a callback or C function.
+// All fields are accessed atomically.
+type latencyStats struct {
+	calls   uint64
+	total   int64 // nanoseconds
+	last    int64 // Unix nanoseconds
+	buckets [64 * statsSubBuckets]uint64
+}
+
+func (s *latencyStats) record(start time.Time, d time.Duration) {
+	atomic.AddUint64(&s.calls, 1)
+	atomic.AddInt64(&s.total, int64(d))
+	atomic.StoreInt64(&s.last, start.UnixNano())
+	atomic.AddUint64(&s.buckets[statsBucket(d)], 1)
+}
+
+// snapshot returns the statistics collected so far with the identifying fields left empty.
+func (s *latencyStats) snapshot() CallbackStats {
+	var buckets [len(s.buckets)]uint64
+	var n uint64
+	for i := range buckets {
+		buckets[i] = atomic.LoadUint64(&s.buckets[i])
+		n += buckets[i]
+	}
+	quantile := func(q float64) time.Duration {
+		rank := uint64(q*float64(n) + 0.5)
+		if rank == 0 {
+			rank = 1
+		}
+		var seen uint64
+		for i, c := range buckets {
+			seen += c
+			if seen >= rank {
+				low, high := statsBucketBounds(i)
+				return (low + high) / 2
+			}
+		}
+		return 0
+	}
+	return CallbackStats{
+		Calls: atomic.LoadUint64(&s.calls),
+		Total: time.Duration(atomic.LoadInt64(&s.total)),
+		Last:  time.Unix(0, atomic.LoadInt64(&s.last)),
+		P50:   quantile(0.5),
+		P99:   quantile(0.99),
+	}
+}
+
+// statsBucket returns the histogram bucket of d. Durations below statsSubBuckets nanoseconds
+// have their own bucket and every larger power of two is split into statsSubBuckets buckets.
+func statsBucket(d time.Duration) int {
+	if d < statsSubBuckets {
+		if d < 0 {
+			return 0
+		}
+		return int(d)
+	}
+	n := uint64(d)
+	exp := bits.Len64(n) - 1 // at least 2
+	sub := int(n>>(exp-2)) & (statsSubBuckets - 1)
+	return (exp-1)*statsSubBuckets + sub
+}
+
+// statsBucketBounds returns the range [low, high) of durations in bucket i.
+func statsBucketBounds(i int) (low, high time.Duration) {
+	if i < statsSubBuckets {
+		return time.Duration(i), time.Duration(i + 1)
+	}
+	exp := i/statsSubBuckets + 1
+	sub := i % statsSubBuckets
+	low = time.Duration(statsSubBuckets+sub) << (exp - 2)
+	high = time.Duration(statsSubBuckets+sub+1) << (exp - 2)
+	return low, high
+}
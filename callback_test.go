@@ -1,14 +1,26 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2023 The Ebitengine Authors
 
-//go:build darwin || (linux && (!cgo || amd64 || arm64))
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
 
 package purego_test
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
+	"runtime"
+	"runtime/trace"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 	"unsafe"
 
 	"github.com/jwijenbergh/purego"
@@ -50,6 +62,9 @@ func TestCallGoFromSharedLib(t *testing.T) {
 }
 
 func TestNewCallbackFloat64(t *testing.T) {
+	if runtime.GOARCH == "mips64" || runtime.GOARCH == "mips64le" {
+		t.Skip("floats take the registers of integers on mips64")
+	}
 	// This tests the maximum number of arguments a function to NewCallback can take
 	const (
 		expectCbTotal    = -3
@@ -77,6 +92,9 @@ func TestNewCallbackFloat64(t *testing.T) {
 }
 
 func TestNewCallbackFloat32(t *testing.T) {
+	if runtime.GOARCH == "mips64" || runtime.GOARCH == "mips64le" {
+		t.Skip("floats take the registers of integers on mips64")
+	}
 	// This tests the maximum number of float32 arguments a function to NewCallback can take
 	const (
 		expectCbTotal    = 6
@@ -127,3 +145,759 @@ func TestNewCallbackFloat32AndFloat64(t *testing.T) {
 		t.Errorf("cbTotalF64 not correct got %f but wanted %f", cbTotalF64, expectedCbTotalF64)
 	}
 }
+
+func TestKeepAliveDuringCall(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var qsort func(data []int, nitms uintptr, size uintptr, compar func(a, b *int) int)
+	purego.RegisterLibFunc(&qsort, libc, "qsort")
+
+	// the only reference to data is the argument to qsort so
+	// purego must keep it alive while the GC runs inside the callback.
+	var collected int32
+	compare := func(a, b *int) int {
+		runtime.GC()
+		if atomic.LoadInt32(&collected) != 0 {
+			t.Errorf("argument was collected during the call")
+		}
+		return *a - *b
+	}
+	func() {
+		data := &[4]int{4, 3, 2, 1}
+		runtime.SetFinalizer(data, func(*[4]int) { atomic.StoreInt32(&collected, 1) })
+		qsort(data[:], 4, unsafe.Sizeof(int(0)), compare)
+	}()
+}
+
+func TestCallbackStats(t *testing.T) {
+	purego.EnableCallbackStats(true)
+	defer purego.EnableCallbackStats(false)
+
+	const sleep = time.Millisecond
+	imp := purego.NewCallback(func(n int) int {
+		time.Sleep(sleep)
+		return n
+	})
+	var fn func(n int) int
+	purego.RegisterFunc(&fn, imp)
+	before := time.Now()
+	const calls = 5
+	for i := 0; i < calls; i++ {
+		fn(i)
+	}
+
+	stats, ok := purego.CallbackStatsOf(imp)
+	if !ok {
+		t.Fatal("no statistics for callback")
+	}
+	if stats.Callback != imp {
+		t.Errorf("Callback got %#x wanted %#x", stats.Callback, imp)
+	}
+	if !strings.Contains(stats.Name, "TestCallbackStats") {
+		t.Errorf("Name got %q wanted the name of the test's function literal", stats.Name)
+	}
+	if stats.Calls != calls {
+		t.Errorf("Calls got %d wanted %d", stats.Calls, calls)
+	}
+	if stats.Total < calls*sleep {
+		t.Errorf("Total got %s wanted at least %s", stats.Total, calls*sleep)
+	}
+	if stats.Last.Before(before) {
+		t.Errorf("Last got %s wanted after %s", stats.Last, before)
+	}
+	// the percentiles are the middle of a bucket which can be up to 12.5% off
+	if min := sleep * 7 / 8; stats.P50 < min || stats.P99 < stats.P50 {
+		t.Errorf("P50 got %s and P99 %s wanted at least %s", stats.P50, stats.P99, min)
+	}
+
+	found := false
+	for _, s := range purego.AllCallbackStats() {
+		found = found || s.Callback == imp
+	}
+	if !found {
+		t.Errorf("AllCallbackStats doesn't include the callback")
+	}
+	if _, ok := purego.CallbackStatsOf(imp + 1); ok {
+		t.Errorf("CallbackStatsOf found statistics for an address that isn't a callback")
+	}
+}
+
+func TestCallbackCorrelation(t *testing.T) {
+	var buf bytes.Buffer
+	if err := trace.Start(&buf); err != nil {
+		t.Fatal(err)
+	}
+	defer trace.Stop()
+
+	var got []string
+	imp := purego.NewCallback(func(ctx context.Context, token uintptr) int {
+		id, _ := purego.CorrelationID(ctx)
+		got = append(got, id)
+		return 1
+	})
+	var fn func(ctx context.Context, token uintptr) int
+	purego.RegisterFunc(&fn, imp)
+
+	ctx := purego.WithCorrelationID(context.Background(), "request-1")
+	release := purego.Correlate(ctx, 42)
+	if fn(ctx, 42) != 1 {
+		t.Fatal("callback didn't return 1")
+	}
+	fn(ctx, 43)
+	release()
+	fn(ctx, 42)
+	if want := []string{"request-1", "", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("correlation IDs got %q wanted %q", got, want)
+	}
+}
+
+// sliceContext is a context whose dynamic type isn't comparable.
+type sliceContext struct {
+	context.Context
+	ids []string
+}
+
+func TestCorrelateUncomparableContext(t *testing.T) {
+	var got []string
+	imp := purego.NewCallback(func(ctx context.Context, token uintptr) int {
+		id, _ := purego.CorrelationID(ctx)
+		got = append(got, id)
+		return 1
+	})
+	var fn func(ctx context.Context, token uintptr) int
+	purego.RegisterFunc(&fn, imp)
+
+	first := sliceContext{purego.WithCorrelationID(context.Background(), "first"), []string{"a"}}
+	second := sliceContext{purego.WithCorrelationID(context.Background(), "second"), []string{"b"}}
+	releaseFirst := purego.Correlate(first, 7)
+	releaseSecond := purego.Correlate(second, 7)
+	// the first binding was replaced so releasing it keeps the second
+	releaseFirst()
+	fn(context.Background(), 7)
+	releaseSecond()
+	fn(context.Background(), 7)
+	if want := []string{"second", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("correlation IDs got %q wanted %q", got, want)
+	}
+}
+
+func TestCallbackVaList(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libcbtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	// enough arguments that some of them are passed on the stack
+	const format = "%d %s %d %lld %.1f %.1f %d %d"
+	const want = "1 two 3 4 5.5 6.5 7 8"
+	var got []string
+	cb := purego.NewCallback(func(level int32, fmt string, ap purego.VaList) {
+		if fmt != format {
+			t.Errorf("format got %q wanted %q", fmt, format)
+		}
+		a := ap.Args()
+		got = append(got, strconvItoa(a.Int32()), a.String(), strconvItoa(a.Int32()), strconvItoa(int32(a.Int64())),
+			strconv.FormatFloat(a.Float64(), 'f', 1, 64), strconv.FormatFloat(a.Float64(), 'f', 1, 64),
+			strconvItoa(a.Int32()), strconvItoa(a.Int32()))
+		if s := ap.Sprintf(fmt); s != want {
+			t.Errorf("Sprintf got %q wanted %q", s, want)
+		}
+	})
+	callLog, err := purego.Dlsym(lib, "callLog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fn func(cb uintptr, level int32, format string, args ...interface{})
+	purego.RegisterFunc(&fn, callLog)
+	fn(cb, 1, format, 1, "two", 3, int64(4), 5.5, 6.5, 7, 8)
+	if s := strings.Join(got, " "); s != want {
+		t.Errorf("Args got %q wanted %q", s, want)
+	}
+}
+
+func strconvItoa(i int32) string {
+	return strconv.Itoa(int(i))
+}
+
+func TestCallbackAliasedStrings(t *testing.T) {
+	arg := "purego\x00"
+	argData := (*reflect.StringHeader)(unsafe.Pointer(&arg)).Data
+
+	for _, test := range []struct {
+		own     purego.Ownership
+		aliased bool
+	}{
+		{purego.Borrowed, false},
+		{purego.Aliased, true},
+	} {
+		var got string
+		var gotData uintptr
+		cb := purego.NewCallbackWith(func(s string) {
+			got = string([]byte(s))
+			gotData = (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+		}, purego.WithStringArgs(test.own))
+		var call func(s string)
+		purego.RegisterFunc(&call, cb)
+		// the string is already null-terminated so C gets a pointer to arg itself
+		call(arg)
+		if got != "purego" {
+			t.Errorf("%s: callback got %q wanted %q", test.own, got, "purego")
+		}
+		if aliased := gotData == argData; aliased != test.aliased {
+			t.Errorf("%s: string refers to the C memory is %t wanted %t", test.own, aliased, test.aliased)
+		}
+	}
+}
+
+func TestCallbackInternedStrings(t *testing.T) {
+	var got []uintptr
+	cb := purego.NewCallbackWith(func(s string) {
+		got = append(got, (*reflect.StringHeader)(unsafe.Pointer(&s)).Data)
+	}, purego.WithInternedStrings(2))
+	var call func(s string)
+	purego.RegisterFunc(&call, cb)
+	call("alpha")
+	call("beta")
+	call("alpha")
+	if got[0] != got[2] {
+		t.Errorf("the second call with a got a new copy")
+	}
+	if got[0] == got[1] {
+		t.Errorf("alpha and beta got the same copy")
+	}
+	// the table is full so it is cleared
+	call("gamma")
+	call("alpha")
+	if got[0] == got[4] {
+		t.Errorf("alpha was still interned after the table was full")
+	}
+}
+
+func TestCallbackMaxConcurrency(t *testing.T) {
+	for _, n := range []int32{1, 2} {
+		var running, most int32
+		cb := purego.NewCallbackWith(func(x int) int {
+			r := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&most)
+				if r <= m || atomic.CompareAndSwapInt32(&most, m, r) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return x
+		}, purego.WithMaxConcurrency(int(n)))
+		var call func(x int) int
+		purego.RegisterFunc(&call, cb)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 10; j++ {
+					call(j)
+				}
+			}()
+		}
+		wg.Wait()
+		if most > n {
+			t.Errorf("WithMaxConcurrency(%d) ran %d calls at the same time", n, most)
+		}
+	}
+}
+
+func TestReentrantCallbacks(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libcbtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+	var enumerate func(cb uintptr, n int32, depth uintptr) int32
+	var enumerateFunc func(cb func(item int32, depth uintptr) int32, n int32, depth uintptr) int32
+	purego.RegisterLibFunc(&enumerate, lib, "enumerate")
+	purego.RegisterLibFuncWith(&enumerateFunc, lib, "enumerate", purego.WithFuncArgs(purego.CallbackPerCall))
+
+	// every item is enumerated again until maxDepth so a call at depth 0 counts 3^(maxDepth+1) leaves
+	const maxDepth = 3
+	const want = 81
+	// nested creates and releases a callback for every nested enumeration
+	var nested func(item int32, depth uintptr) int32
+	nested = func(item int32, depth uintptr) int32 {
+		if depth == maxDepth {
+			return 1
+		}
+		return enumerateFunc(func(item int32, depth uintptr) int32 { return nested(item, depth) }, 3, depth+1)
+	}
+	for _, opts := range [][]purego.CallbackOption{nil, {purego.WithMaxConcurrency(1)}, {purego.WithMaxConcurrency(2)}} {
+		var cb uintptr
+		cb = purego.NewCallbackWith(func(item int32, depth uintptr) int32 {
+			if depth == maxDepth {
+				return 1
+			}
+			if item%2 == 0 {
+				// C calls the callback again while it runs
+				return enumerate(cb, 3, depth+1)
+			}
+			return nested(item, depth)
+		}, opts...)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 20; j++ {
+						if got := enumerate(cb, 3, 0); got != want {
+							t.Errorf("enumerate got %d wanted %d", got, want)
+							return
+						}
+					}
+				}()
+			}
+			wg.Wait()
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Minute):
+			t.Fatalf("nested callbacks with %d options deadlocked", len(opts))
+		}
+	}
+}
+
+func TestFuncArgLifetimes(t *testing.T) {
+	name, err := getSystemLibrary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	libc, err := openLibrary(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type compar = func(a, b unsafe.Pointer) int32
+	var qsort, qsortManual func(base unsafe.Pointer, n, size uintptr, compar compar)
+	purego.RegisterLibFuncWith(&qsort, libc, "qsort", purego.WithFuncArgs(purego.CallbackPerCall))
+	purego.RegisterLibFuncWith(&qsortManual, libc, "qsort", purego.WithFuncArgs(purego.CallbackManual))
+
+	// more closures than callbacks can exist at the same time
+	for i := 0; i < 2500; i++ {
+		sign := int32(1 - 2*(i%2))
+		data := []int32{3, 1, 2}
+		qsort(unsafe.Pointer(&data[0]), 3, 4, func(a, b unsafe.Pointer) int32 {
+			return sign * (*(*int32)(a) - *(*int32)(b))
+		})
+		if want := [][]int32{{1, 2, 3}, {3, 2, 1}}[i%2]; !reflect.DeepEqual(data, want) {
+			t.Fatalf("%d: qsort got %v wanted %v", i, data, want)
+		}
+	}
+
+	var calls int
+	cmp := func(a, b unsafe.Pointer) int32 {
+		calls++
+		return *(*int32)(a) - *(*int32)(b)
+	}
+	data := []int32{2, 1}
+	qsortManual(unsafe.Pointer(&data[0]), 2, 4, cmp)
+	qsortManual(unsafe.Pointer(&data[0]), 2, 4, cmp)
+	if calls == 0 || data[0] != 1 {
+		t.Errorf("qsort with a manual callback got %v after %d calls", data, calls)
+	}
+	if !purego.ReleaseFuncCallback(cmp) {
+		t.Errorf("ReleaseFuncCallback of a manual callback failed")
+	}
+	if purego.ReleaseFuncCallback(cmp) {
+		t.Errorf("ReleaseFuncCallback released a callback twice")
+	}
+
+	cached := func(a, b unsafe.Pointer) int32 { return 0 }
+	purego.RegisterLibFunc(&qsort, libc, "qsort")
+	qsort(unsafe.Pointer(&data[0]), 2, 4, cached)
+	if purego.ReleaseFuncCallback(cached) {
+		t.Errorf("ReleaseFuncCallback released a cached callback")
+	}
+}
+
+func TestCallbackHandle(t *testing.T) {
+	var got []string
+	h := purego.NewHandle(&got)
+	defer h.Delete()
+	cb := purego.NewCallback(func(userdata uintptr, s string) {
+		p := purego.Handle(userdata).Value().(*[]string)
+		*p = append(*p, s)
+	})
+	var call func(userdata uintptr, s string)
+	purego.RegisterFunc(&call, cb)
+	call(uintptr(h), "a")
+	call(uintptr(h), "b")
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("callback appended %q wanted [a b]", got)
+	}
+}
+
+func TestNarrowReturns(t *testing.T) {
+	// The callback leaves garbage in the upper bits like a C function returning a small type may.
+	cb := purego.NewCallback(func(r uintptr) uintptr { return r })
+	var (
+		i8  func(uintptr) int8
+		i16 func(uintptr) int16
+		i32 func(uintptr) int32
+		u8  func(uintptr) uint8
+		u16 func(uintptr) uint16
+		u32 func(uintptr) uint32
+		b   func(uintptr) bool
+	)
+	purego.RegisterFunc(&i8, cb)
+	purego.RegisterFunc(&i16, cb)
+	purego.RegisterFunc(&i32, cb)
+	purego.RegisterFunc(&u8, cb)
+	purego.RegisterFunc(&u16, cb)
+	purego.RegisterFunc(&u32, cb)
+	purego.RegisterFunc(&b, cb)
+	const garbage = ^uintptr(0) &^ 0xFFFF_FFFF
+	if got := i8(garbage | 0x1234_5680); got != -128 {
+		t.Errorf("int8 got %d wanted -128", got)
+	}
+	if got := i8(0x17f); got != 127 {
+		t.Errorf("int8 got %d wanted 127", got)
+	}
+	if got := i16(garbage | 0x1234_8000); got != -32768 {
+		t.Errorf("int16 got %d wanted -32768", got)
+	}
+	if got := i32(0x8000_0000); got != -2147483648 {
+		t.Errorf("int32 got %d wanted -2147483648", got)
+	}
+	if got := u8(^uintptr(0)); got != 0xff {
+		t.Errorf("uint8 got %#x wanted 0xff", got)
+	}
+	if got := u16(^uintptr(0)); got != 0xffff {
+		t.Errorf("uint16 got %#x wanted 0xffff", got)
+	}
+	if got := u32(^uintptr(0)); got != 0xffff_ffff {
+		t.Errorf("uint32 got %#x wanted 0xffffffff", got)
+	}
+	if got := b(0x100); got {
+		t.Errorf("bool with only upper bits set got true wanted false")
+	}
+	if got := b(garbage | 1); !got {
+		t.Errorf("bool got false wanted true")
+	}
+}
+
+func TestNegativeErrno(t *testing.T) {
+	cb := purego.NewCallback(func(r int32) int32 { return r })
+	var withValue func(int32) (int32, error)
+	purego.RegisterFuncWith(&withValue, cb, purego.WithNegativeErrno())
+	if n, err := withValue(5); n != 5 || err != nil {
+		t.Errorf("got (%d, %v) wanted (5, <nil>)", n, err)
+	}
+	if n, err := withValue(-int32(syscall.EAGAIN)); n != 0 || err != syscall.EAGAIN {
+		t.Errorf("got (%d, %v) wanted (0, %v)", n, err, syscall.EAGAIN)
+	}
+	var onlyErr func(int32) error
+	purego.RegisterFuncWith(&onlyErr, cb, purego.WithNegativeErrno())
+	if err := onlyErr(0); err != nil {
+		t.Errorf("got %v wanted <nil>", err)
+	}
+	if err := onlyErr(-int32(syscall.ENOENT)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("got %v wanted %v", err, syscall.ENOENT)
+	}
+	var unsigned func(int32) (uint32, error)
+	if err := purego.RegisterFuncE(&unsigned, cb); err == nil {
+		t.Errorf("RegisterFuncE of a function returning an error without WithNegativeErrno returned no error")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithNegativeErrno with an unsigned return didn't panic")
+		}
+	}()
+	purego.RegisterFuncWith(&unsigned, cb, purego.WithNegativeErrno())
+}
+
+type sorter struct {
+	desc  bool
+	calls int
+}
+
+func (s *sorter) compare(a, b unsafe.Pointer) int32 {
+	s.calls++
+	d := *(*int32)(a) - *(*int32)(b)
+	if s.desc {
+		return -d
+	}
+	return d
+}
+
+// cBinding has a function registered into a field which its methods call.
+type cBinding struct {
+	strlen func(s string) uintptr
+}
+
+func (b *cBinding) len(s string) int {
+	return int(b.strlen(s))
+}
+
+func TestCallbackClosuresAndMethodValues(t *testing.T) {
+	name, err := getSystemLibrary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	libc, err := openLibrary(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// closures that share their code but not their state get distinct callbacks
+	var cbs []uintptr
+	for i := int32(0); i < 3; i++ {
+		i := i
+		cbs = append(cbs, purego.NewCallback(func(n int32) int32 { return n * i }))
+	}
+	for i, cb := range cbs {
+		for _, other := range cbs[:i] {
+			if cb == other {
+				t.Fatalf("closures share the callback %#x", cb)
+			}
+		}
+		var call func(n int32) int32
+		purego.RegisterFunc(&call, cb)
+		if got := call(10); got != int32(10*i) {
+			t.Errorf("closure %d got %d wanted %d", i, got, 10*i)
+		}
+	}
+
+	// method values bind their receiver
+	asc, desc := &sorter{}, &sorter{desc: true}
+	ascCB, descCB := purego.NewCallback(asc.compare), purego.NewCallback(desc.compare)
+	if ascCB == descCB {
+		t.Fatalf("method values share the callback %#x", ascCB)
+	}
+	var qsortPtr func(base unsafe.Pointer, n, size uintptr, compar uintptr)
+	purego.RegisterLibFunc(&qsortPtr, libc, "qsort")
+	data := []int32{2, 3, 1}
+	qsortPtr(unsafe.Pointer(&data[0]), 3, 4, descCB)
+	if !reflect.DeepEqual(data, []int32{3, 2, 1}) || desc.calls == 0 || asc.calls != 0 {
+		t.Errorf("qsort with desc.compare got %v after %d and %d calls", data, asc.calls, desc.calls)
+	}
+
+	// method values and closures passed as func arguments are told apart as well
+	var qsort func(base unsafe.Pointer, n, size uintptr, compar func(a, b unsafe.Pointer) int32)
+	purego.RegisterLibFunc(&qsort, libc, "qsort")
+	ascCompare, descCompare := asc.compare, desc.compare
+	for i := 0; i < 2; i++ {
+		qsort(unsafe.Pointer(&data[0]), 3, 4, ascCompare)
+		if !reflect.DeepEqual(data, []int32{1, 2, 3}) {
+			t.Errorf("qsort with asc.compare got %v", data)
+		}
+		qsort(unsafe.Pointer(&data[0]), 3, 4, descCompare)
+		if !reflect.DeepEqual(data, []int32{3, 2, 1}) {
+			t.Errorf("qsort with desc.compare got %v", data)
+		}
+	}
+
+	// functions can be registered into struct fields
+	b := &cBinding{}
+	purego.RegisterLibFunc(&b.strlen, libc, "strlen")
+	if got := b.len("purego"); got != 6 {
+		t.Errorf("strlen through a struct field got %d wanted 6", got)
+	}
+}
+
+func lookupTestCallback(a, b int32) int32 { return a + b }
+
+func TestLookupCallback(t *testing.T) {
+	cb := purego.NewCallback(lookupTestCallback)
+	for _, addr := range []uintptr{cb, cb + 1} {
+		info, ok := purego.LookupCallback(addr)
+		if !ok {
+			t.Fatalf("LookupCallback(%#x) failed", addr)
+		}
+		if info.Addr != cb || info.Name != "github.com/jwijenbergh/purego_test.lookupTestCallback" ||
+			info.Signature != "func(int32, int32) int32" || info.Released {
+			t.Errorf("LookupCallback(%#x) got %+v", addr, info)
+		}
+		if !strings.Contains(info.Stack, "purego_test.TestLookupCallback") {
+			t.Errorf("creation stack doesn't contain the test:\n%s", info.Stack)
+		}
+	}
+	if _, ok := purego.LookupCallback(0); ok {
+		t.Errorf("LookupCallback(0) succeeded")
+	}
+
+	// options wrap the function but the original one is reported
+	cb = purego.NewCallbackWith(func(s string) {}, purego.WithMaxConcurrency(1))
+	if info, _ := purego.LookupCallback(cb); !strings.HasPrefix(info.Name, "github.com/jwijenbergh/purego_test.TestLookupCallback.func") || info.Signature != "func(string)" {
+		t.Errorf("LookupCallback of a closure got %+v", info)
+	}
+
+	// the callback of a func argument is found and reported as released once it is
+	echo := purego.NewCallback(func(p uintptr) uintptr { return p })
+	var pass func(f func() int32) uintptr
+	purego.RegisterFuncWith(&pass, echo, purego.WithFuncArgs(purego.CallbackManual))
+	f := func() int32 { return 1 }
+	cb = pass(f)
+	if info, ok := purego.LookupCallback(cb); !ok || info.Signature != "func() int32" || info.Released {
+		t.Errorf("LookupCallback of a func argument got %+v, %v", info, ok)
+	}
+	purego.ReleaseFuncCallback(f)
+	if info, ok := purego.LookupCallback(cb); !ok || !info.Released {
+		t.Errorf("LookupCallback of a released callback got %+v, %v", info, ok)
+	}
+}
+
+type ioCallbacks struct {
+	Version int32
+	Read    func(user unsafe.Pointer, buf *byte, n int32) int32
+	Close   func(user unsafe.Pointer)
+	User    unsafe.Pointer
+}
+
+func TestCallbackTable(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libcbtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	var useIO func(io unsafe.Pointer) int32
+	purego.RegisterLibFunc(&useIO, lib, "useIO")
+
+	data := []byte{1, 2, 3}
+	var closed unsafe.Pointer
+	io := &ioCallbacks{
+		Version: 2,
+		Read: func(user unsafe.Pointer, buf *byte, n int32) int32 {
+			return int32(copy(unsafe.Slice(buf, n), (*[3]byte)(user)[:]))
+		},
+		Close: func(user unsafe.Pointer) { closed = user },
+		User:  unsafe.Pointer(&data[0]),
+	}
+	table, err := purego.NewCallbackTable(io)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer table.Release()
+	if got := useIO(table.Pointer()); got != 206 {
+		t.Errorf("useIO got %d wanted 206", got)
+	}
+	if closed != unsafe.Pointer(&data[0]) {
+		t.Errorf("close got user %p wanted %p", closed, &data[0])
+	}
+
+	// a nil func is NULL
+	closed = nil
+	io.Close = nil
+	table2, err := purego.NewCallbackTable(*io)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := useIO(table2.Pointer()); got != 206 || closed != nil {
+		t.Errorf("useIO without close got %d and closed %p", got, closed)
+	}
+	table2.Release()
+	table2.Release()
+
+	if _, err := purego.NewCallbackTable(42); err == nil {
+		t.Errorf("NewCallbackTable of an int succeeded")
+	}
+}
+
+func TestCallbackAllocationOrder(t *testing.T) {
+	if purego.CallbackCapacity() == 0 {
+		t.Skip("NewCallback isn't supported")
+	}
+	type fnTable struct {
+		Fn func() int32
+	}
+	newTable := func() (*purego.CallbackTable, uintptr) {
+		table, err := purego.NewCallbackTable(&fnTable{Fn: func() int32 { return 0 }})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return table, *(*uintptr)(table.Pointer())
+	}
+	var tables [4]*purego.CallbackTable
+	var addrs [4]uintptr
+	for i := range tables {
+		tables[i], addrs[i] = newTable()
+		if i > 0 && addrs[i] <= addrs[i-1] {
+			t.Errorf("callback %d got address %#x after %#x", i, addrs[i], addrs[i-1])
+		}
+	}
+	// the lowest released slot is reused first whatever the order of the releases
+	for _, i := range []int{2, 0, 3, 1} {
+		tables[i].Release()
+	}
+	for i := range tables {
+		var addr uintptr
+		tables[i], addr = newTable()
+		if addr != addrs[i] {
+			t.Errorf("callback %d after the releases got address %#x wanted %#x", i, addr, addrs[i])
+		}
+	}
+	for _, table := range tables {
+		table.Release()
+	}
+
+	r, err := purego.ReserveCallbacks(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+	first, second := r.NewCallback(func() {}), r.NewCallback(func() {})
+	if first != addrs[0] || second != addrs[1] {
+		t.Errorf("reserved callbacks got addresses %#x and %#x wanted %#x and %#x", first, second, addrs[0], addrs[1])
+	}
+}
+
+func TestReserveCallbacks(t *testing.T) {
+	if purego.CallbackCapacity() == 0 {
+		t.Skip("NewCallback isn't supported")
+	}
+	free := purego.FreeCallbacks()
+	r, err := purego.ReserveCallbacks(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+	if got := purego.FreeCallbacks(); got != free-3 {
+		t.Errorf("FreeCallbacks after reserving 3 got %d wanted %d", got, free-3)
+	}
+	cb := r.NewCallback(func(a, b int32) int32 { return a * b })
+	var mul func(a, b int32) int32
+	purego.RegisterFunc(&mul, cb)
+	if got := mul(6, 7); got != 42 {
+		t.Errorf("reserved callback returned %d wanted 42", got)
+	}
+	if r.Len() != 2 || purego.FreeCallbacks() != free-3 {
+		t.Errorf("a reserved callback changed the free callbacks: Len %d FreeCallbacks %d", r.Len(), purego.FreeCallbacks())
+	}
+	if _, err := purego.ReserveCallbacks(free); !errors.Is(err, purego.ErrNoCallbacks) {
+		t.Errorf("reserving more than are free got %v wanted ErrNoCallbacks", err)
+	}
+	r.Release()
+	if got := purego.FreeCallbacks(); got != free-1 {
+		t.Errorf("FreeCallbacks after Release got %d wanted %d", got, free-1)
+	}
+	if r.Len() != 0 {
+		t.Errorf("Len after Release got %d wanted 0", r.Len())
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import "reflect"
+
+// CallbackInfo describes the Go function behind a callback created with NewCallback.
+type CallbackInfo struct {
+	// Addr is the C function pointer of the callback.
+	Addr uintptr
+	// Name is the name of the Go function such as "main.onEvent" or "main.run.func1" for a closure.
+	Name string
+	// Signature is the type of the Go function such as "func(int32, unsafe.Pointer) int32".
+	Signature string
+	// Stack is the call stack that created the callback, one "function\n\tfile:line" per frame.
+	Stack string
+	// Released is true if the callback was released. A call of it panics until the slot is reused.
+	Released bool
+}
+
+// LookupCallback returns the callback the address addr belongs to, for example a function pointer
+// that C passed back or an address in the trampolines of purego seen in a crash report. It reports
+// false if addr is not the address of a callback. Callbacks created by RegisterFunc for func arguments
+// are found too. On Windows only callbacks created by this package are found, by their exact address,
+// and on platforms without callbacks it always reports false.
+func LookupCallback(addr uintptr) (CallbackInfo, bool) {
+	info, cb, ok := lookupCallback(addr)
+	if !ok {
+		return CallbackInfo{}, false
+	}
+	return info.export(cb), true
+}
+
+// callbackInfo is what is recorded about a callback when it is created.
+type callbackInfo struct {
+	name     string
+	typ      reflect.Type
+	pcs      []uintptr
+	released bool
+}
+
+// newCallbackInfo records the Go function fn as passed to NewCallback and the call stack creating it.
+func newCallbackInfo(fn reflect.Value) callbackInfo {
+	return callbackInfo{name: funcName(fn), typ: fn.Type(), pcs: callerPCs()}
+}
+
+func (c callbackInfo) export(addr uintptr) CallbackInfo {
+	info := CallbackInfo{Addr: addr, Name: c.name, Stack: formatStack(c.pcs), Released: c.released}
+	if c.typ != nil {
+		info.Signature = c.typ.String()
+	}
+	return info
+}
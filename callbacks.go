@@ -0,0 +1,393 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"math"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// TryNewCallback is like NewCallback but returns an error instead of panicking if fn can't be
+// converted or if the maximum number of callbacks has been reached.
+func TryNewCallback(fn interface{}) (cb uintptr, err error) {
+	defer recoverError(&err)
+	return NewCallback(fn), nil
+}
+
+// CallbackOption changes how a callback created with NewCallbackWith converts its arguments.
+type CallbackOption func(*callbackConfig)
+
+// callbackConfig holds the settings of a single callback.
+type callbackConfig struct {
+	// stringArgs is how the char* passed in string parameters is converted.
+	stringArgs Ownership
+	// interned are the Go copies of string parameters shared between calls.
+	interned *internTable
+	// orig is the function passed to NewCallbackWith before it is wrapped.
+	orig reflect.Value
+	// frame is true if the callback reads its parameters from a CallbackFrame.
+	frame bool
+	// maxConcurrency is the number of calls that may run at the same time or 0 if there is no limit.
+	maxConcurrency int
+	// slot is one more than the index of the slot set aside by ReserveCallbacks the callback takes
+	// or 0 if it takes any free slot.
+	slot int
+}
+
+// WithStringArgs sets how the char* passed to string parameters of a callback is converted:
+//
+//   - Borrowed: the string is copied into Go memory. This is the default.
+//   - Aliased: the string refers to the C memory without copying it which avoids an allocation
+//     per argument in callbacks that are called often such as loggers. The string is only valid
+//     until the callback returns and must be copied if it is kept.
+//
+// To get the char* itself declare the parameter as a pointer or uintptr instead of a string.
+// WithStringArgs panics for other ownerships.
+func WithStringArgs(own Ownership) CallbackOption {
+	switch own {
+	case Borrowed, Aliased:
+	default:
+		panic("purego: invalid ownership for string arguments: " + own.String())
+	}
+	return func(cfg *callbackConfig) {
+		cfg.stringArgs = own
+	}
+}
+
+// WithInternedStrings makes the string parameters of a callback share the Go copies of C strings
+// with the same contents instead of copying the char* into a new Go string on every call.
+// This avoids the allocations of callbacks that are called often with a few distinct strings,
+// such as the category of a logger or the token names of a parser. Up to n strings are kept
+// and the strings are forgotten once there are more. Unlike Aliased strings they can be kept
+// after the callback returns. WithInternedStrings panics if n is not positive.
+func WithInternedStrings(n int) CallbackOption {
+	if n <= 0 {
+		panic("purego: the number of interned strings must be positive")
+	}
+	return func(cfg *callbackConfig) {
+		cfg.interned = &internTable{max: n}
+	}
+}
+
+// internTable holds the Go copies of the C strings passed to a callback.
+type internTable struct {
+	sync.Mutex
+	max     int
+	strings map[string]string
+}
+
+// goString returns the Go copy of the char* c.
+func (t *internTable) goString(c uintptr) string {
+	// the lookup with the aliased string doesn't allocate
+	alias := strings.GoStringNoCopy(c)
+	t.Lock()
+	defer t.Unlock()
+	if s, ok := t.strings[alias]; ok {
+		return s
+	}
+	if t.strings == nil || len(t.strings) >= t.max {
+		t.strings = make(map[string]string, t.max)
+	}
+	s := string([]byte(alias))
+	t.strings[s] = s
+	return s
+}
+
+// WithMaxConcurrency limits the number of calls of a callback that run at the same time to n when
+// a C library calls it from multiple threads. Further calls wait until a running call returns, so
+// WithMaxConcurrency(1) serializes the calls and protects Go state that isn't safe for concurrent use
+// without a mutex of its own. A callback that is called again while it runs on the same thread, for
+// example through the C function it called, runs without waiting since the outer call can't return
+// before it. WithMaxConcurrency panics if n is not positive.
+func WithMaxConcurrency(n int) CallbackOption {
+	if n <= 0 {
+		panic("purego: the maximum number of concurrent calls must be positive")
+	}
+	return func(cfg *callbackConfig) {
+		cfg.maxConcurrency = n
+	}
+}
+
+// limit returns fn wrapped in a function that waits while maxConcurrency calls are running.
+// fn is returned as is if there is no limit or it is not a function.
+func (cfg *callbackConfig) limit(fn interface{}) interface{} {
+	v := reflect.ValueOf(fn)
+	if cfg.maxConcurrency == 0 || v.Kind() != reflect.Func || v.IsNil() {
+		return fn
+	}
+	sem := make(chan struct{}, cfg.maxConcurrency)
+	var mu sync.Mutex
+	// depth counts the running calls on each thread that holds a slot of sem. A call that C makes
+	// while the callback runs on the same thread is nested in a running call and must not wait for it.
+	depth := map[uintptr]int{}
+	return reflect.MakeFunc(v.Type(), func(args []reflect.Value) []reflect.Value {
+		tid := curThreadID()
+		mu.Lock()
+		nested := depth[tid] > 0
+		if nested {
+			depth[tid]++
+		}
+		mu.Unlock()
+		if !nested {
+			sem <- struct{}{}
+			mu.Lock()
+			depth[tid]++
+			mu.Unlock()
+		}
+		defer func() {
+			mu.Lock()
+			depth[tid]--
+			last := depth[tid] == 0
+			if last {
+				delete(depth, tid)
+			}
+			mu.Unlock()
+			if last {
+				<-sem
+			}
+		}()
+		return v.Call(args)
+	}).Interface()
+}
+
+// splitWideArgs returns fn or, on 32-bit platforms, a function that takes the int64, uint64 and
+// float64 parameters of fn as the two words C passes them in: the low word first, in a register pair
+// or in two stack slots. On arm a pair starts at an even word as the AAPCS requires, so a padding word
+// is inserted before it if needed. float32 parameters are taken as a word on 386 where they are
+// passed on the stack like integers.
+func splitWideArgs(fn interface{}) interface{} {
+	v := reflect.ValueOf(fn)
+	if !is32bit || v.Kind() != reflect.Func || v.IsNil() {
+		return fn
+	}
+	ty := v.Type()
+	var in []reflect.Type
+	// word is the index of the word in the split parameters where each parameter of fn begins
+	word := make([]int, ty.NumIn())
+	split := false
+	for i := 0; i < ty.NumIn(); i++ {
+		t := ty.In(i)
+		switch {
+		case t.Size() == 8 && isWideArg(t.Kind()):
+			if runtime.GOARCH == "arm" && len(in)%2 == 1 {
+				in = append(in, uintptrType)
+			}
+			word[i] = len(in)
+			in = append(in, uintptrType, uintptrType)
+			split = true
+		case t.Kind() == reflect.Float32 && runtime.GOARCH == "386":
+			word[i] = len(in)
+			in = append(in, uintptrType)
+			split = true
+		default:
+			word[i] = len(in)
+			in = append(in, t)
+		}
+	}
+	if !split {
+		return fn
+	}
+	out := make([]reflect.Type, ty.NumOut())
+	for i := range out {
+		out[i] = ty.Out(i)
+	}
+	return reflect.MakeFunc(reflect.FuncOf(in, out, false), func(words []reflect.Value) []reflect.Value {
+		args := make([]reflect.Value, ty.NumIn())
+		for i := range args {
+			t := ty.In(i)
+			w := words[word[i]]
+			switch {
+			case t.Size() == 8 && isWideArg(t.Kind()):
+				bits := uint64(w.Uint()) | uint64(words[word[i]+1].Uint())<<32
+				args[i] = reflect.New(t).Elem()
+				switch t.Kind() {
+				case reflect.Int64:
+					args[i].SetInt(int64(bits))
+				case reflect.Uint64:
+					args[i].SetUint(bits)
+				case reflect.Float64:
+					args[i].SetFloat(math.Float64frombits(bits))
+				}
+			case t.Kind() == reflect.Float32 && runtime.GOARCH == "386":
+				args[i] = reflect.New(t).Elem()
+				args[i].SetFloat(float64(math.Float32frombits(uint32(w.Uint()))))
+			default:
+				args[i] = w
+			}
+		}
+		return v.Call(args)
+	}).Interface()
+}
+
+// isWideArg reports whether a 64-bit parameter of kind k is split into two words by splitWideArgs.
+// float64 is only split on 386 since the port for arm passes floats in VFP registers.
+func isWideArg(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int64, reflect.Uint64:
+		return true
+	case reflect.Float64:
+		return runtime.GOARCH == "386"
+	}
+	return false
+}
+
+// newCallbackConfig returns the settings of a callback with opts applied.
+func newCallbackConfig(opts []CallbackOption) *callbackConfig {
+	cfg := &callbackConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// CallbackLifetime is how long the callback lives that a Go func passed to a C function is converted into.
+type CallbackLifetime int
+
+const (
+	// CallbackCached creates the callback the first time the func is passed and keeps it forever.
+	// Passing the same func value again reuses it. This is the default.
+	CallbackCached CallbackLifetime = iota
+	// CallbackPerCall creates the callback for the call and releases it once the C function returned,
+	// for example for the comparison function of qsort. C must not keep the function pointer.
+	CallbackPerCall
+	// CallbackManual is like CallbackCached but the callback is released with ReleaseFuncCallback once C
+	// doesn't call it anymore, for example after unsubscribing from an event.
+	CallbackManual
+)
+
+// WithFuncArgs sets the lifetime of the callbacks that the Go func parameters of a function are
+// converted into with NewCallback:
+//
+//	// void qsort(void *base, size_t nmemb, size_t size, int (*compar)(const void *, const void *));
+//	var qsort func(base unsafe.Pointer, n, size uintptr, compar func(a, b unsafe.Pointer) int32)
+//	purego.RegisterLibFuncWith(&qsort, libc, "qsort", purego.WithFuncArgs(purego.CallbackPerCall))
+//
+// Since only a limited number of callbacks can exist at the same time, closures that are created for
+// every call must not be cached. Callbacks can't be released on Windows so they are always cached there.
+// The lifetime applies to the func fields of struct arguments and of structs pointed to as well.
+func WithFuncArgs(lifetime CallbackLifetime) FuncOption {
+	switch lifetime {
+	case CallbackCached, CallbackPerCall, CallbackManual:
+	default:
+		panic("purego: invalid callback lifetime")
+	}
+	return func(cfg *funcConfig) {
+		cfg.funcArgs = lifetime
+	}
+}
+
+// funcCallbacks remembers the callbacks created for Go funcs passed to C as arguments or struct fields.
+// The same func value reuses its callback instead of using up another one every time it is passed.
+var funcCallbacks struct {
+	sync.Mutex
+	byFunc     map[unsafe.Pointer]cachedCallback // keyed by the func value
+	byCallback map[uintptr]reflect.Value
+}
+
+type cachedCallback struct {
+	cb uintptr
+	// manual is true if the callback is released with ReleaseFuncCallback.
+	manual bool
+}
+
+// funcKey returns the func value f which tells closures apart that share their code pointer.
+func funcKey(f reflect.Value) unsafe.Pointer {
+	// Value.Pointer only returns the code pointer which closures share so the func value is used instead
+	p := reflect.New(f.Type())
+	p.Elem().Set(f)
+	return *(*unsafe.Pointer)(p.UnsafePointer())
+}
+
+// funcCallback returns the C function pointer that calls the Go func f. A nil func is NULL.
+func funcCallback(f reflect.Value) uintptr {
+	cb, _ := funcArgCallback(f, CallbackCached)
+	return cb
+}
+
+// funcArgCallback returns the C function pointer that calls the Go func f passed with lifetime.
+// It reports whether the callback must be released with releaseCallback after the call.
+func funcArgCallback(f reflect.Value, lifetime CallbackLifetime) (cb uintptr, release bool) {
+	if f.IsNil() {
+		return 0, false
+	}
+	if lifetime == CallbackPerCall && runtime.GOOS == "windows" {
+		lifetime = CallbackCached
+	}
+	key := funcKey(f)
+	funcCallbacks.Lock()
+	defer funcCallbacks.Unlock()
+	if c, ok := funcCallbacks.byFunc[key]; ok {
+		if lifetime == CallbackCached && c.manual {
+			// the func is also passed where it is expected to stay valid
+			funcCallbacks.byFunc[key] = cachedCallback{cb: c.cb}
+		}
+		return c.cb, false
+	}
+	cb = NewCallback(f.Interface())
+	if funcCallbacks.byFunc == nil {
+		funcCallbacks.byFunc = map[unsafe.Pointer]cachedCallback{}
+		funcCallbacks.byCallback = map[uintptr]reflect.Value{}
+	}
+	// cFunc turns the callback back into f while it exists, for example when C
+	// leaves the func field of a struct it writes unchanged.
+	funcCallbacks.byCallback[cb] = f
+	if lifetime == CallbackPerCall {
+		return cb, true
+	}
+	funcCallbacks.byFunc[key] = cachedCallback{cb: cb, manual: lifetime == CallbackManual}
+	return cb, false
+}
+
+// releaseFuncCallback releases the callback cb that funcArgCallback returned to be released.
+func releaseFuncCallback(cb uintptr) {
+	funcCallbacks.Lock()
+	delete(funcCallbacks.byCallback, cb)
+	funcCallbacks.Unlock()
+	releaseCallback(cb)
+}
+
+// ReleaseFuncCallback releases the callback of the Go func fn that was passed to a function registered
+// with WithFuncArgs(CallbackManual) so that the callback can be reused. C must not call it anymore.
+// It reports whether fn had a callback to release which is not the case if fn was also passed with
+// CallbackCached or to a struct field, or on Windows where callbacks can't be released.
+func ReleaseFuncCallback(fn interface{}) bool {
+	f := reflect.ValueOf(fn)
+	if f.Kind() != reflect.Func || f.IsNil() {
+		return false
+	}
+	key := funcKey(f)
+	funcCallbacks.Lock()
+	defer funcCallbacks.Unlock()
+	c, ok := funcCallbacks.byFunc[key]
+	if !ok || !c.manual || !releaseCallback(c.cb) {
+		return false
+	}
+	delete(funcCallbacks.byFunc, key)
+	delete(funcCallbacks.byCallback, c.cb)
+	return true
+}
+
+// cFunc returns a Go func of type t that calls the C function pointer cfn.
+// If cfn was created by funcCallback the original Go func is returned.
+func cFunc(t reflect.Type, cfn uintptr) reflect.Value {
+	if cfn == 0 {
+		return reflect.Zero(t)
+	}
+	funcCallbacks.Lock()
+	f, ok := funcCallbacks.byCallback[cfn]
+	funcCallbacks.Unlock()
+	if ok && f.Type() == t {
+		return f
+	}
+	p := reflect.New(t)
+	registerFunc(p.Interface(), cfn, &funcConfig{unlisted: true})
+	return p.Elem()
+}
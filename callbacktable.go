@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// CallbackTable is a C struct of function pointers to Go callbacks made by NewCallbackTable.
+// It is the way C plugin APIs such as custom allocators, I/O streams and host interfaces take
+// functions of the host.
+type CallbackTable struct {
+	mem []uint64
+
+	mu  sync.Mutex
+	cbs []uintptr // the callbacks that weren't released yet
+}
+
+// NewCallbackTable returns the C struct of the Go struct v, or the one v points to, whose func fields
+// are converted to function pointers of callbacks created with NewCallbackWith and opts. A nil func
+// is NULL. The other fields are converted as for a struct argument of a function registered with
+// RegisterFunc which includes the struct tags described at Sizeof, so a table like
+//
+//	struct io_callbacks {
+//		int version;
+//		int (*read)(void *user, char *buf, int n);
+//		void (*close)(void *user);
+//		void *user;
+//	};
+//
+// is made from
+//
+//	type ioCallbacks struct {
+//		Version int32
+//		Read    func(user unsafe.Pointer, buf *byte, n int32) int32
+//		Close   func(user unsafe.Pointer)
+//		User    unsafe.Pointer
+//	}
+//
+// The callbacks live as long as the table so it must stay reachable, for example by storing it
+// next to the C object it is installed on, until C no longer uses it and it is released with Release.
+// Func fields of nested structs become callbacks created with NewCallback that are released with it.
+func NewCallbackTable(v interface{}, opts ...CallbackOption) (*CallbackTable, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("purego: NewCallbackTable needs a struct but got " + reflect.TypeOf(v).String())
+	}
+	l, err := layoutOf(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkActiveArg(rv); err != nil {
+		return nil, err
+	}
+	// the func fields are cleared before the struct is converted so that they aren't converted
+	// into cached callbacks and their callbacks are written to their slots instead
+	cp := reflect.New(rv.Type()).Elem()
+	cp.Set(rv)
+	for _, f := range l.fields {
+		if f.layout.funcType != nil {
+			cp.Field(f.index).Set(reflect.Zero(f.typ))
+		}
+	}
+	nested := fieldCallbacks{lifetime: CallbackPerCall}
+	t := &CallbackTable{mem: l.toC(cp, &nested), cbs: nested.owned}
+	base := unsafe.Pointer(&t.mem[0])
+	for _, f := range l.fields {
+		if fn := rv.Field(f.index); f.layout.funcType != nil && !fn.IsNil() {
+			cb := NewCallbackWith(fn.Interface(), opts...)
+			*(*uintptr)(unsafe.Add(base, f.offset)) = cb
+			t.cbs = append(t.cbs, cb)
+		}
+	}
+	return t, nil
+}
+
+// Pointer returns the address of the C struct. It is valid until the table is unreachable.
+func (t *CallbackTable) Pointer() unsafe.Pointer {
+	return unsafe.Pointer(&t.mem[0])
+}
+
+// Release releases the callbacks of the table so that their slots can be reused. C must not call
+// them afterwards. Callbacks can't be released on Windows so they are kept there.
+// Release does nothing if the table was already released.
+func (t *CallbackTable) Release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, cb := range t.cbs {
+		releaseFuncCallback(cb)
+	}
+	t.cbs = nil
+}
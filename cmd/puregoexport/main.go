@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Command puregoexport generates the glue needed for C code to call Go functions in the same process.
+//
+// It scans the Go package in the current directory for functions whose doc comment contains
+// the directive
+//
+//	//purego:export
+//
+// and writes two files:
+//
+//   - a Go file with a function that fills a purego.ExportTable with every marked function.
+//   - a C header declaring the table layout, a typedef for every function and a struct
+//     plus loader function that resolves all of them from the table at once.
+//
+// The Go program passes ExportTable.Pointer to the C library, typically through an initialization
+// function registered with purego, and the C code loads the functions with <pkg>_exports_load.
+//
+// Usage:
+//
+//	//go:generate go run github.com/jwijenbergh/purego/cmd/puregoexport
+//
+// The flags are:
+//
+//	-dir string
+//		directory of the package to scan (default ".")
+//	-go string
+//		name of the generated Go file (default "exports_gen.go")
+//	-header string
+//		name of the generated C header (default "<pkg>_exports.h")
+//	-func string
+//		name of the generated Go function (default "newExportTable")
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const directive = "//purego:export"
+
+// exportedFunc is a Go function marked with the export directive.
+type exportedFunc struct {
+	Name    string
+	Params  []param
+	Returns string // the C return type
+}
+
+type param struct {
+	Name  string
+	CType string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("puregoexport: ")
+	dir := flag.String("dir", ".", "directory of the package to scan")
+	goFile := flag.String("go", "exports_gen.go", "name of the generated Go file")
+	header := flag.String("header", "", "name of the generated C header (default \"<pkg>_exports.h\")")
+	funcName := flag.String("func", "newExportTable", "name of the generated Go function")
+	flag.Parse()
+
+	pkg, funcs, err := scan(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *header == "" {
+		*header = pkg + "_exports.h"
+	}
+	src, err := generateGo(pkg, *funcName, funcs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *goFile), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *header), generateHeader(pkg, funcs), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// scan parses the non-test Go files in dir and returns the package name and the marked functions.
+func scan(dir string) (string, []exportedFunc, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(pkgs) != 1 {
+		return "", nil, fmt.Errorf("expected one package in %s but found %d", dir, len(pkgs))
+	}
+	var pkgName string
+	var funcs []exportedFunc
+	for name, pkg := range pkgs {
+		pkgName = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || fd.Recv != nil || !hasDirective(fd.Doc) {
+					continue
+				}
+				f, err := convert(fd)
+				if err != nil {
+					return "", nil, fmt.Errorf("%s: %w", fset.Position(fd.Pos()), err)
+				}
+				funcs = append(funcs, f)
+			}
+		}
+	}
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].Name < funcs[j].Name })
+	return pkgName, funcs, nil
+}
+
+func hasDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.TrimSpace(c.Text) == directive {
+			return true
+		}
+	}
+	return false
+}
+
+func convert(fd *ast.FuncDecl) (exportedFunc, error) {
+	f := exportedFunc{Name: fd.Name.Name, Returns: "void"}
+	for _, field := range fd.Type.Params.List {
+		ctype, err := cType(field.Type)
+		if err != nil {
+			return f, err
+		}
+		if len(field.Names) == 0 {
+			f.Params = append(f.Params, param{Name: fmt.Sprintf("p%d", len(f.Params)), CType: ctype})
+		}
+		for _, n := range field.Names {
+			f.Params = append(f.Params, param{Name: n.Name, CType: ctype})
+		}
+	}
+	if res := fd.Type.Results; res != nil {
+		if res.NumFields() > 1 {
+			return f, fmt.Errorf("%s: exported functions can only have one return", f.Name)
+		}
+		ctype, err := cType(res.List[0].Type)
+		if err != nil {
+			return f, err
+		}
+		f.Returns = ctype
+	}
+	return f, nil
+}
+
+// cType returns the C type that purego converts the Go type expr to. It must agree with cTypeName in package purego.
+func cType(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return "void *", nil
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok && x.Name == "unsafe" && t.Sel.Name == "Pointer" {
+			return "void *", nil
+		}
+	case *ast.Ident:
+		switch t.Name {
+		case "bool":
+			return "_Bool", nil
+		case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+			return t.Name + "_t", nil
+		case "byte":
+			return "uint8_t", nil
+		case "int":
+			return "intptr_t", nil
+		case "uint", "uintptr":
+			return "uintptr_t", nil
+		case "float32":
+			return "float", nil
+		case "float64":
+			return "double", nil
+		case "string":
+			return "const char *", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %s", exprString(expr))
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func generateGo(pkg, funcName string, funcs []exportedFunc) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by puregoexport. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/jwijenbergh/purego\"\n\n")
+	fmt.Fprintf(&b, "// %s returns a purego.ExportTable holding every function marked %s.\n", funcName, directive)
+	fmt.Fprintf(&b, "func %s() *purego.ExportTable {\n", funcName)
+	fmt.Fprintf(&b, "t := purego.NewExportTable()\n")
+	for _, f := range funcs {
+		fmt.Fprintf(&b, "t.Add(%q, %s)\n", f.Name, f.Name)
+	}
+	fmt.Fprintf(&b, "return t\n}\n")
+	return format.Source(b.Bytes())
+}
+
+func generateHeader(pkg string, funcs []exportedFunc) []byte {
+	var b bytes.Buffer
+	guard := "PUREGO_EXPORTS_" + strings.ToUpper(pkg) + "_H"
+	fmt.Fprintf(&b, "// Code generated by puregoexport. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "#ifndef %s\n#define %s\n\n", guard, guard)
+	b.WriteString(headerPrelude)
+	for _, f := range funcs {
+		params := make([]string, len(f.Params))
+		for i, p := range f.Params {
+			params[i] = p.CType + " " + p.Name
+			params[i] = strings.Replace(params[i], "* ", "*", 1)
+		}
+		if len(params) == 0 {
+			params = []string{"void"}
+		}
+		fmt.Fprintf(&b, "typedef %s (*%s_%s_fn)(%s);\n", f.Returns, pkg, f.Name, strings.Join(params, ", "))
+	}
+	fmt.Fprintf(&b, "\ntypedef struct %s_exports {\n", pkg)
+	for _, f := range funcs {
+		fmt.Fprintf(&b, "\t%s_%s_fn %s;\n", pkg, f.Name, f.Name)
+	}
+	fmt.Fprintf(&b, "} %s_exports;\n\n", pkg)
+	fmt.Fprintf(&b, "// %s_exports_load resolves every function from exports into out.\n", pkg)
+	fmt.Fprintf(&b, "// It returns 0 on success and -1 if the table is incompatible or a function is missing.\n")
+	fmt.Fprintf(&b, "static inline int %s_exports_load(const purego_exports *exports, %s_exports *out) {\n", pkg, pkg)
+	for _, f := range funcs {
+		fmt.Fprintf(&b, "\tif ((out->%s = (%s_%s_fn)purego_lookup(exports, %q)) == NULL) return -1;\n", f.Name, pkg, f.Name, f.Name)
+	}
+	fmt.Fprintf(&b, "\treturn 0;\n}\n\n#endif // %s\n", guard)
+	return b.Bytes()
+}
+
+// headerPrelude declares the layout of purego.ExportTable. It is guarded separately
+// so that headers generated for several packages can be included together.
+const headerPrelude = `#include <stddef.h>
+#include <stdint.h>
+#include <string.h>
+
+#ifndef PUREGO_EXPORTS_VERSION
+#define PUREGO_EXPORTS_VERSION 1
+
+typedef struct purego_export {
+	const char *name;
+	void *fn;
+	const char *signature;
+} purego_export;
+
+typedef struct purego_exports {
+	uint32_t version;
+	uint32_t count;
+	const purego_export *entries;
+} purego_exports;
+
+// purego_lookup returns the function exported as name or NULL if there is none.
+static inline void *purego_lookup(const purego_exports *exports, const char *name) {
+	if (exports == NULL || exports->version != PUREGO_EXPORTS_VERSION) return NULL;
+	for (uint32_t i = 0; i < exports->count; i++) {
+		if (strcmp(exports->entries[i].name, name) == 0) return exports->entries[i].fn;
+	}
+	return NULL;
+}
+#endif // PUREGO_EXPORTS_VERSION
+
+`
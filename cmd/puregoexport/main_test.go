@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSource = `package plugin
+
+import "unsafe"
+
+//purego:export
+func Add(a, b int64) int64 { return a + b }
+
+// Log is not exported to C.
+func Log(msg string) {}
+
+// Notify tells the host about p.
+//
+//purego:export
+func Notify(msg string, p unsafe.Pointer) bool { return true }
+`
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "plugin.go"), []byte(testSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg, funcs, err := scan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg != "plugin" || len(funcs) != 2 {
+		t.Fatalf("got package %q with %d functions", pkg, len(funcs))
+	}
+	src, err := generateGo(pkg, "newExportTable", funcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`t.Add("Add", Add)`, `t.Add("Notify", Notify)`} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("Go output is missing %s:\n%s", want, src)
+		}
+	}
+	header := generateHeader(pkg, funcs)
+	for _, want := range []string{
+		"typedef int64_t (*plugin_Add_fn)(int64_t a, int64_t b);",
+		"typedef _Bool (*plugin_Notify_fn)(const char *msg, void *p);",
+	} {
+		if !strings.Contains(string(header), want) {
+			t.Errorf("header is missing %s:\n%s", want, header)
+		}
+	}
+
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+	hfile := filepath.Join(dir, "plugin_exports.h")
+	if err := os.WriteFile(hfile, header, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfile := filepath.Join(dir, "main.c")
+	if err := os.WriteFile(cfile, []byte("#include \"plugin_exports.h\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command(cc, "-fsyntax-only", "-Wall", "-Werror", cfile).CombinedOutput(); err != nil {
+		t.Errorf("header doesn't compile: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,431 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Command puregogen generates code that calls C functions and implements callbacks without reflection.
+//
+// RegisterFunc and NewCallback convert the arguments with reflection which allocates and makes the
+// latency of a call depend on the garbage collector. For functions in a hot path puregogen writes
+// the conversions out for every signature instead, using purego.CallArgs and purego.NewFrameCallback.
+//
+// It scans the Go package in the current directory for package-level function variables whose doc
+// comment contains the directive
+//
+//	//purego:call [symbol]
+//
+// and function types whose doc comment contains the directive
+//
+//	//purego:callback
+//
+// For example
+//
+//	//purego:call
+//	var ldexp func(x float64, exp int32) float64
+//
+//	//purego:callback
+//	type compareFunc func(a, b unsafe.Pointer) int32
+//
+// The variables are set by a generated function that looks up the symbols, named after the variable
+// unless the directive names the symbol:
+//
+//	func bindCalls(r purego.Resolver) error
+//
+// and for every callback type a function converts a Go function into a callback:
+//
+//	func newCompareFuncCallback(fn compareFunc) uintptr
+//
+// Parameters and results can be bool, integers, floats, pointers and unsafe.Pointer. Calls can also
+// take strings, which are copied, but callbacks can't. Callbacks can't return floats. The generated
+// callbacks are only supported where purego.NewFrameCallback is.
+//
+// Usage:
+//
+//	//go:generate go run github.com/jwijenbergh/purego/cmd/puregogen
+//
+// The flags are:
+//
+//	-dir string
+//		directory of the package to scan (default ".")
+//	-o string
+//		name of the generated Go file (default "purego_gen.go")
+//	-func string
+//		name of the generated function that binds the calls (default "bindCalls")
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	callDirective     = "//purego:call"
+	callbackDirective = "//purego:callback"
+)
+
+// signature is a function variable or type marked with a directive.
+type signature struct {
+	Name   string
+	Symbol string // the C symbol of a call
+	Params []goType
+	Result *goType
+}
+
+// goType is a Go type and how it is passed to C.
+type goType struct {
+	Expr string
+	Kind kind
+}
+
+type kind int
+
+const (
+	kindInt    kind = iota // a signed integer of at most the size of a pointer
+	kindUint               // an unsigned integer of at most the size of a pointer other than uint32
+	kindUint32             // uint32 which is sign extended on mips64
+	kindInt64
+	kindUint64
+	kindBool
+	kindFloat32
+	kindFloat64
+	kindUnsafePointer
+	kindPointer
+	kindString
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("puregogen: ")
+	dir := flag.String("dir", ".", "directory of the package to scan")
+	out := flag.String("o", "purego_gen.go", "name of the generated Go file")
+	funcName := flag.String("func", "bindCalls", "name of the generated function that binds the calls")
+	flag.Parse()
+
+	pkg, calls, callbacks, err := scan(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, *funcName, calls, callbacks)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *out), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// scan parses the non-test Go files in dir and returns the package name, the marked variables and the marked types.
+func scan(dir string) (string, []signature, []signature, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if len(pkgs) != 1 {
+		return "", nil, nil, fmt.Errorf("expected one package in %s but found %d", dir, len(pkgs))
+	}
+	var pkgName string
+	var calls, callbacks []signature
+	for name, pkg := range pkgs {
+		pkgName = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					switch spec := spec.(type) {
+					case *ast.ValueSpec:
+						args, ok := directive(callDirective, spec.Doc, gd)
+						if !ok {
+							continue
+						}
+						ft, ok := spec.Type.(*ast.FuncType)
+						if !ok || len(spec.Names) != 1 {
+							return "", nil, nil, fmt.Errorf("%s: %s needs a single variable of a function type", fset.Position(spec.Pos()), callDirective)
+						}
+						sig, err := convert(spec.Names[0].Name, ft, true)
+						if err != nil {
+							return "", nil, nil, fmt.Errorf("%s: %w", fset.Position(spec.Pos()), err)
+						}
+						sig.Symbol = sig.Name
+						if args != "" {
+							sig.Symbol = args
+						}
+						calls = append(calls, sig)
+					case *ast.TypeSpec:
+						if _, ok := directive(callbackDirective, spec.Doc, gd); !ok {
+							continue
+						}
+						ft, ok := spec.Type.(*ast.FuncType)
+						if !ok {
+							return "", nil, nil, fmt.Errorf("%s: %s needs a function type", fset.Position(spec.Pos()), callbackDirective)
+						}
+						sig, err := convert(spec.Name.Name, ft, false)
+						if err != nil {
+							return "", nil, nil, fmt.Errorf("%s: %w", fset.Position(spec.Pos()), err)
+						}
+						callbacks = append(callbacks, sig)
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(calls, func(i, j int) bool { return calls[i].Name < calls[j].Name })
+	sort.Slice(callbacks, func(i, j int) bool { return callbacks[i].Name < callbacks[j].Name })
+	return pkgName, calls, callbacks, nil
+}
+
+// directive returns the arguments of the directive dir in the doc comment of a spec or,
+// if the declaration has a single spec, of the declaration.
+func directive(dir string, doc *ast.CommentGroup, gd *ast.GenDecl) (string, bool) {
+	if doc == nil && len(gd.Specs) == 1 {
+		doc = gd.Doc
+	}
+	if doc == nil {
+		return "", false
+	}
+	for _, c := range doc.List {
+		text := strings.TrimSpace(c.Text)
+		if text == dir {
+			return "", true
+		}
+		if strings.HasPrefix(text, dir+" ") {
+			return strings.TrimSpace(text[len(dir):]), true
+		}
+	}
+	return "", false
+}
+
+// convert returns the signature of the function type ft.
+func convert(name string, ft *ast.FuncType, call bool) (signature, error) {
+	sig := signature{Name: name}
+	for _, field := range ft.Params.List {
+		if _, ok := field.Type.(*ast.Ellipsis); ok {
+			return sig, fmt.Errorf("%s: variadic functions are not supported", name)
+		}
+		t, err := typeOf(field.Type)
+		if err != nil {
+			return sig, err
+		}
+		if t.Kind == kindString && !call {
+			return sig, fmt.Errorf("%s: callbacks can't have string parameters", name)
+		}
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			sig.Params = append(sig.Params, t)
+		}
+	}
+	if res := ft.Results; res != nil {
+		if res.NumFields() > 1 {
+			return sig, fmt.Errorf("%s: functions can only have one result", name)
+		}
+		t, err := typeOf(res.List[0].Type)
+		if err != nil {
+			return sig, err
+		}
+		switch {
+		case t.Kind == kindString:
+			return sig, fmt.Errorf("%s: string results are not supported", name)
+		case !call && (t.Kind == kindFloat32 || t.Kind == kindFloat64):
+			return sig, fmt.Errorf("%s: callbacks can't return floats", name)
+		}
+		sig.Result = &t
+	}
+	return sig, nil
+}
+
+// typeOf returns how the type expr is passed to C.
+func typeOf(expr ast.Expr) (goType, error) {
+	t := goType{Expr: exprString(expr)}
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		t.Kind = kindPointer
+		return t, nil
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "unsafe" && e.Sel.Name == "Pointer" {
+			t.Kind = kindUnsafePointer
+			return t, nil
+		}
+	case *ast.Ident:
+		switch e.Name {
+		case "int", "int8", "int16", "int32":
+			t.Kind = kindInt
+			return t, nil
+		case "uint", "uint8", "byte", "uint16", "uintptr":
+			t.Kind = kindUint
+			return t, nil
+		case "uint32":
+			t.Kind = kindUint32
+			return t, nil
+		case "int64":
+			t.Kind = kindInt64
+			return t, nil
+		case "uint64":
+			t.Kind = kindUint64
+			return t, nil
+		case "bool":
+			t.Kind = kindBool
+			return t, nil
+		case "float32":
+			t.Kind = kindFloat32
+			return t, nil
+		case "float64":
+			t.Kind = kindFloat64
+			return t, nil
+		case "string":
+			t.Kind = kindString
+			return t, nil
+		}
+	}
+	return t, fmt.Errorf("unsupported type %s", t.Expr)
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// funcType returns the Go function type of sig with the parameters named p0, p1 and so on.
+func (sig signature) funcType() string {
+	params := make([]string, len(sig.Params))
+	for i, p := range sig.Params {
+		params[i] = fmt.Sprintf("p%d %s", i, p.Expr)
+	}
+	s := "func(" + strings.Join(params, ", ") + ")"
+	if sig.Result != nil {
+		s += " " + sig.Result.Expr
+	}
+	return s
+}
+
+func generate(pkg, funcName string, calls, callbacks []signature) ([]byte, error) {
+	var body bytes.Buffer
+	if len(calls) > 0 {
+		fmt.Fprintf(&body, "// %s looks up the C functions of the variables marked %s with r and sets the variables.\n", funcName, callDirective)
+		fmt.Fprintf(&body, "func %s(r purego.Resolver) error {\n", funcName)
+		for _, c := range calls {
+			fmt.Fprintf(&body, "{\nsym, err := r.Lookup(%q)\nif err != nil {\nreturn err\n}\n", c.Symbol)
+			fmt.Fprintf(&body, "%s = %s {\n", c.Name, c.funcType())
+			fmt.Fprintf(&body, "var args purego.CallArgs\n")
+			var keep []string
+			for i, p := range c.Params {
+				name := fmt.Sprintf("p%d", i)
+				switch p.Kind {
+				case kindInt, kindUint:
+					fmt.Fprintf(&body, "args.Word(uintptr(%s))\n", name)
+				case kindUint32:
+					fmt.Fprintf(&body, "args.Uint32(%s)\n", name)
+				case kindInt64, kindUint64:
+					fmt.Fprintf(&body, "args.Uint64(uint64(%s))\n", name)
+				case kindBool:
+					fmt.Fprintf(&body, "if %s {\nargs.Word(1)\n} else {\nargs.Word(0)\n}\n", name)
+				case kindFloat32:
+					fmt.Fprintf(&body, "args.Float32(%s)\n", name)
+				case kindFloat64:
+					fmt.Fprintf(&body, "args.Float64(%s)\n", name)
+				case kindUnsafePointer:
+					fmt.Fprintf(&body, "args.Pointer(%s)\n", name)
+					keep = append(keep, name)
+				case kindPointer:
+					fmt.Fprintf(&body, "args.Pointer(unsafe.Pointer(%s))\n", name)
+					keep = append(keep, name)
+				case kindString:
+					// the C string is a copy with a terminating NUL
+					fmt.Fprintf(&body, "s%d := append([]byte(%s), 0)\n", i, name)
+					fmt.Fprintf(&body, "args.Pointer(unsafe.Pointer(&s%d[0]))\n", i)
+					keep = append(keep, fmt.Sprintf("s%d", i))
+				}
+			}
+			if c.Result != nil {
+				fmt.Fprintf(&body, "r := args.Call(sym)\n")
+			} else {
+				fmt.Fprintf(&body, "args.Call(sym)\n")
+			}
+			for _, k := range keep {
+				fmt.Fprintf(&body, "runtime.KeepAlive(%s)\n", k)
+			}
+			if c.Result != nil {
+				fmt.Fprintf(&body, "return %s\n", result(*c.Result, "r"))
+			}
+			fmt.Fprintf(&body, "}\n}\n")
+		}
+		fmt.Fprintf(&body, "return nil\n}\n\n")
+	}
+	for _, cb := range callbacks {
+		newName := "new" + strings.ToUpper(cb.Name[:1]) + cb.Name[1:] + "Callback"
+		fmt.Fprintf(&body, "// %s converts fn into a C function pointer that doesn't use reflection.\n", newName)
+		fmt.Fprintf(&body, "func %s(fn %s) uintptr {\n", newName, cb.Name)
+		fmt.Fprintf(&body, "return purego.NewFrameCallback(func(f *purego.CallbackFrame) uintptr {\n")
+		args := make([]string, len(cb.Params))
+		for i, p := range cb.Params {
+			args[i] = result(p, "f")
+		}
+		call := "fn(" + strings.Join(args, ", ") + ")"
+		if cb.Result == nil {
+			fmt.Fprintf(&body, "%s\nreturn 0\n", call)
+		} else {
+			switch cb.Result.Kind {
+			case kindBool:
+				fmt.Fprintf(&body, "if %s {\nreturn 1\n}\nreturn 0\n", call)
+			case kindUnsafePointer:
+				fmt.Fprintf(&body, "return uintptr(%s)\n", call)
+			case kindPointer:
+				fmt.Fprintf(&body, "return uintptr(unsafe.Pointer(%s))\n", call)
+			default:
+				fmt.Fprintf(&body, "return uintptr(%s)\n", call)
+			}
+		}
+		fmt.Fprintf(&body, "})\n}\n\n")
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by puregogen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n")
+	if bytes.Contains(body.Bytes(), []byte("runtime.")) {
+		fmt.Fprintf(&b, "\"runtime\"\n")
+	}
+	if bytes.Contains(body.Bytes(), []byte("unsafe.")) {
+		fmt.Fprintf(&b, "\"unsafe\"\n")
+	}
+	fmt.Fprintf(&b, "\n\"github.com/jwijenbergh/purego\"\n)\n\n")
+	b.Write(body.Bytes())
+	return format.Source(b.Bytes())
+}
+
+// result returns the expression that converts the value returned by the CallResult or read
+// from the CallbackFrame v into the type t.
+func result(t goType, v string) string {
+	switch t.Kind {
+	case kindInt64, kindUint64:
+		return fmt.Sprintf("%s(%s.Uint64())", t.Expr, v)
+	case kindBool:
+		// a _Bool is in the lowest byte
+		return fmt.Sprintf("uint8(%s.Word()) != 0", v)
+	case kindFloat32:
+		return v + ".Float32()"
+	case kindFloat64:
+		return v + ".Float64()"
+	case kindUnsafePointer:
+		return v + ".Pointer()"
+	case kindPointer:
+		return fmt.Sprintf("(%s)(%s.Pointer())", t.Expr, v)
+	}
+	return fmt.Sprintf("%s(%s.Word())", t.Expr, v)
+}
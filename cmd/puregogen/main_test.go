@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSource = `package binding
+
+import "unsafe"
+
+//purego:call
+var ldexp func(x float64, exp int32) float64
+
+//purego:call strlen
+var cStrlen func(s string) uintptr
+
+// open is registered with reflection.
+var open func(path string) int32
+
+//purego:callback
+type compareFunc func(a, b unsafe.Pointer) int32
+
+//purego:callback
+type visitFunc func(n *int64, last bool) bool
+`
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "binding.go"), []byte(testSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg, calls, callbacks, err := scan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg != "binding" || len(calls) != 2 || len(callbacks) != 2 {
+		t.Fatalf("got package %q with %d calls and %d callbacks", pkg, len(calls), len(callbacks))
+	}
+	src, err := generate(pkg, "bindCalls", calls, callbacks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func bindCalls(r purego.Resolver) error {",
+		`sym, err := r.Lookup("strlen")`,
+		"cStrlen = func(p0 string) uintptr {",
+		"args.Float64(p0)",
+		"args.Word(uintptr(p1))",
+		"return r.Float64()",
+		"runtime.KeepAlive(s0)",
+		"func newCompareFuncCallback(fn compareFunc) uintptr {",
+		"return uintptr(fn(f.Pointer(), f.Pointer()))",
+		"if fn((*int64)(f.Pointer()), uint8(f.Word()) != 0) {",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("output is missing %s:\n%s", want, src)
+		}
+	}
+	if strings.Contains(string(src), "open") {
+		t.Errorf("output contains the unmarked variable open:\n%s", src)
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	for _, src := range []string{
+		"//purego:call\nvar f func(args ...int32)",
+		"//purego:call\nvar f func() string",
+		"//purego:call\nvar f func() (int32, error)",
+		"//purego:callback\ntype f func(s string)",
+		"//purego:callback\ntype f func() float64",
+		"//purego:callback\ntype f func(m map[int]int)",
+	} {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "binding.go"), []byte("package binding\n\n"+src+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, _, err := scan(dir); err == nil {
+			t.Errorf("scan succeeded for %q", src)
+		}
+	}
+}
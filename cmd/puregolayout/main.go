@@ -0,0 +1,500 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Command puregolayout checks that the C layout purego computes for Go structs matches the layout
+// the C compiler gives the C types they are marshaled to.
+//
+// The Go struct types of a package are marked with a directive that names their C type:
+//
+//	//purego:layout struct event
+//	type Event struct {
+//		Type uint32
+//		Time uint64 `purego:"name(timestamp)"`
+//	}
+//
+// puregolayout writes a C program that includes the headers declaring the C types and prints their
+// sizeof, _Alignof and the offsetof of every field, compiles it with the C compiler, runs it and
+// compares the values with purego.Sizeof, purego.Alignof and purego.Offsetof. The C name of a field
+// is its name tag or its Go name. Fields of embedded structs are looked up in the outer C type like
+// the members of anonymous structs and unions. It prints every difference and exits with status 1
+// if there is one.
+//
+// Usage:
+//
+//	puregolayout [flags] [dir]
+//
+// For example
+//
+//	go run github.com/jwijenbergh/purego/cmd/puregolayout -include SDL2/SDL_events.h -I /usr/include .
+//
+// The flags are:
+//
+//	-include string
+//		header to include, may be repeated
+//	-I string
+//		directory to search for headers, may be repeated
+//	-cc string
+//		C compiler to run (default $CC or "cc")
+//	-v
+//		print the values that match too
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+const layoutDirective = "//purego:layout"
+
+// listFlag is a flag that may be repeated.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("puregolayout: ")
+	var includes, dirs listFlag
+	flag.Var(&includes, "include", "header to include, may be repeated")
+	flag.Var(&dirs, "I", "directory to search for headers, may be repeated")
+	cc := flag.String("cc", "", "C compiler to run (default $CC or \"cc\")")
+	verbose := flag.Bool("v", false, "print the values that match too")
+	flag.Parse()
+	if flag.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "usage: puregolayout [flags] [dir]")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	if *cc == "" {
+		*cc = os.Getenv("CC")
+	}
+	if *cc == "" {
+		*cc = "cc"
+	}
+
+	types, err := scan(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(types) == 0 {
+		log.Fatalf("no type in %s has a %s directive", dir, layoutDirective)
+	}
+	var checks []check
+	for _, t := range types {
+		checks = append(checks, t.checks()...)
+	}
+	got, err := measure(*cc, includes, dirs, checks)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mismatches := 0
+	for i, c := range checks {
+		if got[i] != c.want {
+			mismatches++
+			fmt.Printf("%s: C %d purego %d\n", c.name, got[i], c.want)
+		} else if *verbose {
+			fmt.Printf("%s: %d\n", c.name, got[i])
+		}
+	}
+	if mismatches > 0 {
+		os.Exit(1)
+	}
+}
+
+// layoutType is a Go struct type marked with the directive.
+type layoutType struct {
+	goName string
+	cType  string
+	typ    reflect.Type
+	// fields are the fields of the C type with their path in the Go struct for purego.Offsetof.
+	fields []layoutField
+}
+
+type layoutField struct {
+	cName  string
+	goPath string
+}
+
+// check is a value that the C program prints and the value purego computed for it.
+type check struct {
+	name string // description like "sizeof(struct event)"
+	expr string // C expression
+	want uintptr
+}
+
+// checks returns the values to compare for t.
+func (t layoutType) checks() []check {
+	v := reflect.New(t.typ).Elem().Interface()
+	checks := []check{
+		{name: t.goName + ": sizeof(" + t.cType + ")", expr: "sizeof(" + t.cType + ")", want: purego.Sizeof(v)},
+		{name: t.goName + ": _Alignof(" + t.cType + ")", expr: "_Alignof(" + t.cType + ")", want: purego.Alignof(v)},
+	}
+	for _, f := range t.fields {
+		checks = append(checks, check{
+			name: t.goName + "." + f.goPath + ": offsetof(" + t.cType + ", " + f.cName + ")",
+			expr: "offsetof(" + t.cType + ", " + f.cName + ")",
+			want: purego.Offsetof(v, f.goPath),
+		})
+	}
+	return checks
+}
+
+// measure compiles and runs a C program that prints the value of every check.
+func measure(cc string, includes, dirs []string, checks []check) ([]uintptr, error) {
+	tmp, err := os.MkdirTemp("", "puregolayout")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+	src := filepath.Join(tmp, "layout.c")
+	exe := filepath.Join(tmp, "layout")
+	if err := os.WriteFile(src, cProgram(includes, checks), 0o644); err != nil {
+		return nil, err
+	}
+	args := []string{"-o", exe}
+	for _, d := range dirs {
+		args = append(args, "-I", d)
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(cc, append(args, src)...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %v\n%s", cc, err, stderr.Bytes())
+	}
+	out, err := exec.Command(exe).Output()
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) != len(checks) {
+		return nil, fmt.Errorf("the C program printed %d values for %d checks", len(fields), len(checks))
+	}
+	values := make([]uintptr, len(fields))
+	for i, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = uintptr(n)
+	}
+	return values, nil
+}
+
+// cProgram returns a C program that prints the values of checks on a line each.
+func cProgram(includes []string, checks []check) []byte {
+	var b bytes.Buffer
+	b.WriteString("#include <stddef.h>\n#include <stdio.h>\n")
+	for _, h := range includes {
+		if strings.HasPrefix(h, "<") || strings.HasPrefix(h, "\"") {
+			fmt.Fprintf(&b, "#include %s\n", h)
+		} else {
+			fmt.Fprintf(&b, "#include <%s>\n", h)
+		}
+	}
+	b.WriteString("\nint main(void) {\n")
+	for _, c := range checks {
+		fmt.Fprintf(&b, "    printf(\"%%zu\\n\", (size_t)%s);\n", c.expr)
+	}
+	b.WriteString("    return 0;\n}\n")
+	return b.Bytes()
+}
+
+// scan parses the non-test Go files in dir and returns the types marked with the directive.
+func scan(dir string) ([]layoutType, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package in %s but found %d", dir, len(pkgs))
+	}
+	c := &converter{specs: map[string]*ast.TypeSpec{}, types: map[string]reflect.Type{}}
+	type marked struct {
+		spec  *ast.TypeSpec
+		cType string
+	}
+	var marks []marked
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					spec := spec.(*ast.TypeSpec)
+					c.specs[spec.Name.Name] = spec
+					args, ok := directive(layoutDirective, spec.Doc, gd)
+					if !ok {
+						continue
+					}
+					if args == "" {
+						return nil, fmt.Errorf("%s: %s needs the C type", fset.Position(spec.Pos()), layoutDirective)
+					}
+					marks = append(marks, marked{spec, args})
+				}
+			}
+		}
+	}
+	var types []layoutType
+	for _, m := range marks {
+		st, ok := m.spec.Type.(*ast.StructType)
+		if !ok {
+			return nil, fmt.Errorf("%s: %s needs a struct type", fset.Position(m.spec.Pos()), layoutDirective)
+		}
+		typ, err := c.typeOf(m.spec.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fset.Position(m.spec.Pos()), err)
+		}
+		if err := purego.CheckLayout(typ); err != nil {
+			return nil, fmt.Errorf("%s: %w", fset.Position(m.spec.Pos()), err)
+		}
+		t := layoutType{goName: m.spec.Name.Name, cType: m.cType, typ: typ}
+		if t.fields, err = c.fields(st, ""); err != nil {
+			return nil, fmt.Errorf("%s: %w", fset.Position(m.spec.Pos()), err)
+		}
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].goName < types[j].goName })
+	return types, nil
+}
+
+// directive returns the arguments of the directive dir in the doc comment of a spec or,
+// if the declaration has a single spec, of the declaration.
+func directive(dir string, doc *ast.CommentGroup, gd *ast.GenDecl) (string, bool) {
+	if doc == nil && len(gd.Specs) == 1 {
+		doc = gd.Doc
+	}
+	if doc == nil {
+		return "", false
+	}
+	for _, c := range doc.List {
+		text := strings.TrimSpace(c.Text)
+		if text == dir {
+			return "", true
+		}
+		if strings.HasPrefix(text, dir+" ") {
+			return strings.TrimSpace(text[len(dir):]), true
+		}
+	}
+	return "", false
+}
+
+// converter builds reflect types with the same layout as the types declared in the package.
+type converter struct {
+	specs map[string]*ast.TypeSpec
+	types map[string]reflect.Type
+}
+
+var basicTypes = map[string]reflect.Type{
+	"bool":    reflect.TypeOf(false),
+	"int":     reflect.TypeOf(int(0)),
+	"int8":    reflect.TypeOf(int8(0)),
+	"int16":   reflect.TypeOf(int16(0)),
+	"int32":   reflect.TypeOf(int32(0)),
+	"rune":    reflect.TypeOf(int32(0)),
+	"int64":   reflect.TypeOf(int64(0)),
+	"uint":    reflect.TypeOf(uint(0)),
+	"uint8":   reflect.TypeOf(uint8(0)),
+	"byte":    reflect.TypeOf(uint8(0)),
+	"uint16":  reflect.TypeOf(uint16(0)),
+	"uint32":  reflect.TypeOf(uint32(0)),
+	"uint64":  reflect.TypeOf(uint64(0)),
+	"uintptr": reflect.TypeOf(uintptr(0)),
+	"float32": reflect.TypeOf(float32(0)),
+	"float64": reflect.TypeOf(float64(0)),
+}
+
+// typeOf returns a reflect type with the layout of the type expression e.
+func (c *converter) typeOf(e ast.Expr) (reflect.Type, error) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if t, ok := c.types[e.Name]; ok {
+			return t, nil
+		}
+		if spec, ok := c.specs[e.Name]; ok {
+			t, err := c.typeOf(spec.Type)
+			if err != nil {
+				return nil, err
+			}
+			c.types[e.Name] = t
+			return t, nil
+		}
+		if t, ok := basicTypes[e.Name]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unknown type %s", e.Name)
+	case *ast.ParenExpr:
+		return c.typeOf(e.X)
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "unsafe" && e.Sel.Name == "Pointer" {
+			return reflect.TypeOf(unsafe.Pointer(nil)), nil
+		}
+		return nil, fmt.Errorf("the type %s of another package is not supported", exprString(e))
+	case *ast.StarExpr:
+		// the type pointed to doesn't change the layout and may refer to the struct itself
+		return reflect.TypeOf((*byte)(nil)), nil
+	case *ast.FuncType:
+		return reflect.TypeOf(func() {}), nil
+	case *ast.ArrayType:
+		if e.Len == nil {
+			return reflect.TypeOf([]byte(nil)), nil
+		}
+		lit, ok := e.Len.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return nil, fmt.Errorf("the length of %s must be a number", exprString(e))
+		}
+		n, err := strconv.ParseInt(lit.Value, 0, 64)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := c.typeOf(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ArrayOf(int(n), elem), nil
+	case *ast.StructType:
+		var fields []reflect.StructField
+		for _, f := range e.Fields.List {
+			var tag reflect.StructTag
+			if f.Tag != nil {
+				s, err := strconv.Unquote(f.Tag.Value)
+				if err != nil {
+					return nil, err
+				}
+				tag = reflect.StructTag(s)
+			}
+			// a skipped field only exists in Go so its type doesn't matter
+			t := reflect.TypeOf(struct{}{})
+			if tag.Get("purego") != "-" {
+				var err error
+				if t, err = c.typeOf(f.Type); err != nil {
+					return nil, err
+				}
+			}
+			for _, name := range fieldNames(f) {
+				sf := reflect.StructField{Name: name, Type: t, Tag: tag}
+				if !ast.IsExported(name) {
+					sf.PkgPath = "main"
+				}
+				fields = append(fields, sf)
+			}
+		}
+		return reflect.StructOf(fields), nil
+	}
+	return nil, fmt.Errorf("the type %s is not supported", exprString(e))
+}
+
+// fields returns the fields of the C struct for the Go struct st whose path starts with prefix.
+// The fields of embedded structs are promoted.
+func (c *converter) fields(st *ast.StructType, prefix string) ([]layoutField, error) {
+	var fields []layoutField
+	for _, f := range st.Fields.List {
+		tag := ""
+		if f.Tag != nil {
+			s, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(s).Get("purego")
+		}
+		if tag == "-" {
+			continue
+		}
+		for _, name := range fieldNames(f) {
+			if name == "_" {
+				continue
+			}
+			if f.Names == nil {
+				if inner := c.structOf(f.Type); inner != nil {
+					promoted, err := c.fields(inner, prefix+name+".")
+					if err != nil {
+						return nil, err
+					}
+					fields = append(fields, promoted...)
+					continue
+				}
+			}
+			cName := name
+			for _, opt := range strings.Split(tag, ",") {
+				opt = strings.TrimSpace(opt)
+				if strings.HasPrefix(opt, "name(") && strings.HasSuffix(opt, ")") {
+					cName = opt[len("name(") : len(opt)-1]
+				}
+			}
+			fields = append(fields, layoutField{cName: cName, goPath: prefix + name})
+		}
+	}
+	return fields, nil
+}
+
+// structOf returns the struct type that the type expression e names or nil.
+func (c *converter) structOf(e ast.Expr) *ast.StructType {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if spec, ok := c.specs[e.Name]; ok {
+			return c.structOf(spec.Type)
+		}
+	case *ast.StructType:
+		return e
+	}
+	return nil
+}
+
+// fieldNames returns the names of the field f, which is the name of its type if it is embedded.
+func fieldNames(f *ast.Field) []string {
+	if f.Names == nil {
+		t := f.Type
+		if star, ok := t.(*ast.StarExpr); ok {
+			t = star.X
+		}
+		if id, ok := t.(*ast.Ident); ok {
+			return []string{id.Name}
+		}
+		return []string{"_"}
+	}
+	names := make([]string, len(f.Names))
+	for i, n := range f.Names {
+		names[i] = n.Name
+	}
+	return names
+}
+
+// exprString formats the type expression e.
+func exprString(e ast.Expr) string {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, token.NewFileSet(), e); err != nil {
+		return fmt.Sprintf("%T", e)
+	}
+	return b.String()
+}
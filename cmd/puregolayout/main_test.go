@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testHeader = `#include <stdint.h>
+
+struct key { uint16_t code; uint8_t down; };
+struct motion { int32_t x, y; };
+
+struct event {
+    uint8_t type;
+    union { struct key key; struct motion motion; };
+    uint64_t timestamp;
+};
+
+struct __attribute__((packed)) header { uint8_t kind; uint32_t length; };
+
+struct wrong { uint8_t a; uint32_t b; };
+`
+
+const testSource = `package events
+
+type key struct {
+	Code uint16
+	Down bool
+}
+
+type motion struct{ X, Y int32 }
+
+type eventData struct {
+	_      struct{} ` + "`purego:\"union\"`" + `
+	Key    key    ` + "`purego:\"name(key)\"`" + `
+	Motion motion ` + "`purego:\"name(motion)\"`" + `
+}
+
+//purego:layout struct event
+type Event struct {
+	Type  uint8 ` + "`purego:\"name(type)\"`" + `
+	eventData
+	Time  uint64 ` + "`purego:\"name(timestamp)\"`" + `
+	cache map[string]int ` + "`purego:\"-\"`" + `
+}
+
+//purego:layout struct header
+type header struct {
+	_      struct{} ` + "`purego:\"packed\"`" + `
+	kind   uint8
+	length uint32
+}
+
+// wrong forgets that b is aligned.
+//
+//purego:layout struct wrong
+type wrong struct {
+	_ struct{} ` + "`purego:\"packed\"`" + `
+	a uint8
+	b uint32
+}
+`
+
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "events.go"), []byte(testSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	types, err := scan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, typ := range types {
+		names = append(names, typ.goName+"="+typ.cType)
+	}
+	if want := []string{"Event=struct event", "header=struct header", "wrong=struct wrong"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("types got %q wanted %q", names, want)
+	}
+	want := []layoutField{{"type", "Type"}, {"key", "eventData.Key"}, {"motion", "eventData.Motion"}, {"timestamp", "Time"}}
+	if !reflect.DeepEqual(types[0].fields, want) {
+		t.Errorf("fields of Event got %+v wanted %+v", types[0].fields, want)
+	}
+
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler")
+	}
+	if err := os.WriteFile(filepath.Join(dir, "events.h"), []byte(testHeader), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var checks []check
+	for _, typ := range types {
+		checks = append(checks, typ.checks()...)
+	}
+	got, err := measure(cc, []string{"events.h"}, []string{dir}, checks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mismatches []string
+	for i, c := range checks {
+		if got[i] != c.want {
+			mismatches = append(mismatches, c.name)
+		}
+	}
+	if want := []string{"wrong: sizeof(struct wrong)", "wrong: _Alignof(struct wrong)", "wrong.b: offsetof(struct wrong, b)"}; !reflect.DeepEqual(mismatches, want) {
+		t.Errorf("mismatches got %s wanted %s", strings.Join(mismatches, "; "), strings.Join(want, "; "))
+	}
+}
@@ -0,0 +1,296 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Command puregopkgconfig bootstraps a binding of a C library from its pkg-config metadata.
+//
+// It asks pkg-config for the version, libraries and directories of a package and writes a Go file with:
+//
+//   - the list of library files to try in order, starting with the versioned soname found in the
+//     library directory so that the binding doesn't depend on the development symlink being installed.
+//   - a skeleton struct whose function fields are registered by an Open function.
+//
+// The generated file is meant to be edited: add a field for every C function that is needed.
+// The field name is the name of the C symbol unless the field has a `symbol:"name"` tag.
+//
+// Usage:
+//
+//	puregopkgconfig [flags] package
+//
+// For example
+//
+//	go run github.com/jwijenbergh/purego/cmd/puregopkgconfig -o zlib.go zlib
+//
+// The flags are:
+//
+//	-o string
+//		name of the generated Go file (default "<package>_binding.go")
+//	-package string
+//		name of the Go package (default derived from the pkg-config package)
+//	-type string
+//		name of the generated struct (default "Library")
+//	-pkg-config string
+//		pkg-config command to run (default $PKG_CONFIG or "pkg-config")
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// pkgInfo is the metadata pkg-config reports for a package.
+type pkgInfo struct {
+	Name        string
+	Version     string
+	Libs        []string // names passed with -l
+	LibDirs     []string // directories passed with -L followed by the libdir variable
+	IncludeDirs []string // directories passed with -I
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("puregopkgconfig: ")
+	out := flag.String("o", "", "name of the generated Go file (default \"<package>_binding.go\")")
+	goPkg := flag.String("package", "", "name of the Go package (default derived from the pkg-config package)")
+	typeName := flag.String("type", "Library", "name of the generated struct")
+	pkgConfig := flag.String("pkg-config", "", "pkg-config command to run (default $PKG_CONFIG or \"pkg-config\")")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: puregopkgconfig [flags] package")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	if *pkgConfig == "" {
+		*pkgConfig = os.Getenv("PKG_CONFIG")
+	}
+	if *pkgConfig == "" {
+		*pkgConfig = "pkg-config"
+	}
+	name := flag.Arg(0)
+	if *goPkg == "" {
+		*goPkg = goPackageName(name)
+	}
+	if *out == "" {
+		*out = *goPkg + "_binding.go"
+	}
+
+	info, err := query(*pkgConfig, name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(info.Libs) == 0 {
+		log.Fatalf("%s doesn't link any library", name)
+	}
+	src, err := generate(info, *goPkg, *typeName, candidates(info.Libs[0], info.LibDirs, runtime.GOOS))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// query runs pkgConfig to get the metadata of the package name.
+func query(pkgConfig, name string) (pkgInfo, error) {
+	info := pkgInfo{Name: name}
+	run := func(args ...string) ([]string, error) {
+		cmd := exec.Command(pkgConfig, append(args, name)...)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("%s %s %s: %v\n%s", pkgConfig, strings.Join(args, " "), name, err, stderr.Bytes())
+		}
+		return strings.Fields(string(out)), nil
+	}
+	version, err := run("--modversion")
+	if err != nil {
+		return info, err
+	}
+	info.Version = strings.Join(version, " ")
+	libs, err := run("--libs-only-l")
+	if err != nil {
+		return info, err
+	}
+	info.Libs = trimFlags(libs, "-l")
+	dirs, err := run("--libs-only-L")
+	if err != nil {
+		return info, err
+	}
+	info.LibDirs = trimFlags(dirs, "-L")
+	libdir, err := run("--variable=libdir")
+	if err != nil {
+		return info, err
+	}
+	info.LibDirs = append(info.LibDirs, libdir...)
+	includes, err := run("--cflags-only-I")
+	if err != nil {
+		return info, err
+	}
+	info.IncludeDirs = trimFlags(includes, "-I")
+	return info, nil
+}
+
+// trimFlags returns the values of the fields that start with prefix in order without duplicates.
+func trimFlags(fields []string, prefix string) []string {
+	var values []string
+	seen := map[string]bool{}
+	for _, f := range fields {
+		if !strings.HasPrefix(f, prefix) {
+			continue
+		}
+		v := strings.TrimPrefix(f, prefix)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		values = append(values, v)
+	}
+	return values
+}
+
+// candidates returns the files to try in order to load the library linked with -l lib on goos.
+// A library found in one of dirs comes first followed by the names the dynamic linker searches for.
+func candidates(lib string, dirs []string, goos string) []string {
+	var names []string
+	add := func(name string) {
+		for _, n := range names {
+			if n == name {
+				return
+			}
+		}
+		names = append(names, name)
+	}
+	switch goos {
+	case "darwin", "ios":
+		file := "lib" + lib + ".dylib"
+		for _, dir := range dirs {
+			path := filepath.Join(dir, file)
+			if _, err := os.Stat(path); err == nil {
+				add(path)
+			}
+		}
+		add(file)
+	case "windows":
+		add(lib + ".dll")
+		add("lib" + lib + ".dll")
+	default:
+		file := "lib" + lib + ".so"
+		var sonames []string
+		for _, dir := range dirs {
+			soname, err := readSoname(filepath.Join(dir, file))
+			if err != nil || soname == "" {
+				continue
+			}
+			add(filepath.Join(dir, soname))
+			sonames = append(sonames, soname)
+		}
+		for _, soname := range sonames {
+			add(soname)
+		}
+		add(file)
+	}
+	return names
+}
+
+// readSoname returns the DT_SONAME of the ELF shared library at path.
+func readSoname(path string) (string, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	sonames, err := f.DynString(elf.DT_SONAME)
+	if err != nil || len(sonames) == 0 {
+		return "", err
+	}
+	return sonames[0], nil
+}
+
+// goPackageName turns the name of a pkg-config package such as "gtk+-3.0" or "libcrypto" into a Go package name.
+func goPackageName(name string) string {
+	name = strings.TrimPrefix(name, "lib")
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		}
+	}
+	s := b.String()
+	if s == "" || unicode.IsDigit(rune(s[0])) {
+		s = "lib" + s
+	}
+	return s
+}
+
+func generate(info pkgInfo, goPkg, typeName string, names []string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by puregopkgconfig from %s %s. Add the functions of the library to %s.\n\n", info.Name, info.Version, typeName)
+	fmt.Fprintf(&b, "package %s\n\n", goPkg)
+	fmt.Fprintf(&b, "import (\n\"reflect\"\n\n\"github.com/jwijenbergh/purego\"\n)\n\n")
+	fmt.Fprintf(&b, "// libraryNames are the files tried in order to load the library linked with -l%s.\n", info.Libs[0])
+	fmt.Fprintf(&b, "var libraryNames = []string{\n")
+	for _, n := range names {
+		fmt.Fprintf(&b, "%q,\n", n)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+	fmt.Fprintf(&b, "// %s holds the functions of %s %s.\n", typeName, info.Name, info.Version)
+	if len(info.IncludeDirs) > 0 {
+		fmt.Fprintf(&b, "//\n// The headers declaring them are in\n//\n")
+		for _, dir := range info.IncludeDirs {
+			fmt.Fprintf(&b, "//\t%s\n", dir)
+		}
+	}
+	if len(info.Libs) > 1 {
+		fmt.Fprintf(&b, "//\n// The package also links %s which are not loaded.\n", strings.Join(info.Libs[1:], ", "))
+	}
+	fmt.Fprintf(&b, "type %s struct {\n", typeName)
+	fmt.Fprintf(&b, "lib *purego.Library\n\n")
+	fmt.Fprintf(&b, "// Add a field for every C function that is needed, for example\n//\n")
+	fmt.Fprintf(&b, "//\tVersion func() string `symbol:\"%s_version\"`\n", goPkg)
+	fmt.Fprintf(&b, "}\n\n")
+	fmt.Fprintf(&b, "// Open loads the library from the first of libraryNames that can be opened\n")
+	fmt.Fprintf(&b, "// and registers every exported function field of %s.\n", typeName)
+	fmt.Fprintf(&b, "func Open() (*%s, error) {\n", typeName)
+	fmt.Fprintf(&b, `var lib *purego.Library
+	var err error
+	for _, name := range libraryNames {
+		if lib, err = purego.OpenLibrary(name); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	l := &%s{lib: lib}
+	v := reflect.ValueOf(l).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if !f.IsExported() || f.Type.Kind() != reflect.Func {
+			continue
+		}
+		symbol := f.Tag.Get("symbol")
+		if symbol == "" {
+			symbol = f.Name
+		}
+		if err := lib.RegisterFuncE(v.Field(i).Addr().Interface(), symbol); err != nil {
+			lib.Close()
+			return nil, err
+		}
+	}
+	return l, nil
+}
+`, typeName)
+	fmt.Fprintf(&b, "\n// Close releases the library.\n")
+	fmt.Fprintf(&b, "func (l *%s) Close() error {\nreturn l.lib.Close()\n}\n", typeName)
+	return format.Source(b.Bytes())
+}
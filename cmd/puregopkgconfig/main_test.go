@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// fakePkgConfig answers the queries of query for the package "foo".
+const fakePkgConfig = `#!/bin/sh
+case "$1" in
+--modversion) echo 1.2.3 ;;
+--libs-only-l) echo -lfoo -lbar -lfoo ;;
+--libs-only-L) echo -L$LIBDIR ;;
+--variable=libdir) echo $LIBDIR ;;
+--cflags-only-I) echo -I/usr/include/foo -I/usr/include/foo ;;
+esac
+`
+
+func TestQuery(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake pkg-config is a shell script")
+	}
+	dir := t.TempDir()
+	script := filepath.Join(dir, "pkg-config")
+	if err := os.WriteFile(script, []byte(fakePkgConfig), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LIBDIR", "/opt/foo/lib")
+	info, err := query(script, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := pkgInfo{
+		Name:        "foo",
+		Version:     "1.2.3",
+		Libs:        []string{"foo", "bar"},
+		LibDirs:     []string{"/opt/foo/lib", "/opt/foo/lib"},
+		IncludeDirs: []string{"/usr/include/foo"},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("got %+v wanted %+v", info, want)
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	if got, want := candidates("foo", nil, "windows"), []string{"foo.dll", "libfoo.dll"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("windows got %q wanted %q", got, want)
+	}
+	if got, want := candidates("foo", nil, "darwin"), []string{"libfoo.dylib"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("darwin got %q wanted %q", got, want)
+	}
+	if runtime.GOOS != "linux" && runtime.GOOS != "freebsd" {
+		t.Skip("the soname is only read from ELF libraries")
+	}
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "foo.c")
+	if err := os.WriteFile(src, []byte("int foo(void) { return 1; }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lib := filepath.Join(dir, "libfoo.so")
+	if out, err := exec.Command(cc, "-shared", "-fPIC", "-Wl,-soname,libfoo.so.2", "-o", lib, src).CombinedOutput(); err != nil {
+		t.Fatalf("failed to build the library: %v\n%s", err, out)
+	}
+	got := candidates("foo", []string{filepath.Join(dir, "missing"), dir, dir}, runtime.GOOS)
+	want := []string{filepath.Join(dir, "libfoo.so.2"), "libfoo.so.2", "libfoo.so"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q wanted %q", got, want)
+	}
+}
+
+func TestGoPackageName(t *testing.T) {
+	for name, want := range map[string]string{
+		"libcrypto": "crypto",
+		"gtk+-3.0":  "gtk30",
+		"SDL2":      "sdl2",
+		"lib":       "lib",
+		"2geom":     "lib2geom",
+	} {
+		if got := goPackageName(name); got != want {
+			t.Errorf("goPackageName(%q) got %q wanted %q", name, got, want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	info := pkgInfo{
+		Name:        "foo",
+		Version:     "1.2.3",
+		Libs:        []string{"foo", "bar"},
+		IncludeDirs: []string{"/usr/include/foo"},
+	}
+	src, err := generate(info, "foo", "Foo", []string{"/usr/lib/libfoo.so.2", "libfoo.so.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"package foo",
+		"\"/usr/lib/libfoo.so.2\",\n\t\"libfoo.so.2\",\n}",
+		"// Foo holds the functions of foo 1.2.3.",
+		"//\t/usr/include/foo",
+		"also links bar",
+		"type Foo struct {",
+		"func Open() (*Foo, error) {",
+		"func (l *Foo) Close() error {",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("output is missing %q:\n%s", want, src)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+import (
+	"sync"
+	"unsafe"
+)
+
+var fnCalloc struct {
+	once   sync.Once
+	calloc func(n, size uintptr) unsafe.Pointer
+	free   func(p unsafe.Pointer)
+}
+
+// cAlloc returns size bytes of zeroed memory of the C library that the garbage collector doesn't
+// manage, for memory C keeps referencing after a call. It must be released with cFree.
+func cAlloc(size uintptr) unsafe.Pointer {
+	fnCalloc.once.Do(func() {
+		calloc, free := fnDlsym(RTLD_DEFAULT, "calloc"), fnDlsym(RTLD_DEFAULT, "free")
+		if calloc == 0 || free == 0 {
+			panic("purego: calloc not found")
+		}
+		RegisterFunc(&fnCalloc.calloc, calloc)
+		RegisterFunc(&fnCalloc.free, free)
+	})
+	p := fnCalloc.calloc(1, size)
+	if p == nil {
+		panic("purego: out of C memory")
+	}
+	return p
+}
+
+// cFree releases the memory p returned by cAlloc.
+func cFree(p unsafe.Pointer) {
+	fnCalloc.free(p)
+}
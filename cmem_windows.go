@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// cAlloc returns size bytes of zeroed memory that the garbage collector doesn't manage, for memory
+// C keeps referencing after a call. It must be released with cFree.
+func cAlloc(size uintptr) unsafe.Pointer {
+	p, err := windows.LocalAlloc(windows.LPTR, uint32(size))
+	if p == 0 {
+		panic("purego: LocalAlloc failed: " + err.Error())
+	}
+	return *(*unsafe.Pointer)(unsafe.Pointer(&p))
+}
+
+// cFree releases the memory p returned by cAlloc.
+func cFree(p unsafe.Pointer) {
+	windows.LocalFree(windows.Handle(uintptr(p)))
+}
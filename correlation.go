@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"context"
+	"reflect"
+	"runtime/trace"
+	"sync"
+)
+
+// contextType is the type of a leading context.Context parameter of a function registered with
+// RegisterFunc or passed to NewCallback. It isn't passed to or received from C.
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// correlationKey is the context key of the correlation ID.
+type correlationKey struct{}
+
+// correlationCategory is the category of the runtime/trace log events with the correlation ID.
+const correlationCategory = "purego.correlation"
+
+// WithCorrelationID returns a copy of ctx carrying the correlation ID id.
+//
+// A function registered with RegisterFunc whose first parameter is a context.Context doesn't pass the
+// context to C. Instead the call is recorded as a runtime/trace region in the context's task and the
+// correlation ID is logged in the category "purego.correlation" so that tracing spans can be joined
+// with the foreign call. A callback whose first parameter is a context.Context receives the context
+// given to Correlate for the native operation it fires for and is traced the same way.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, id)
+}
+
+// CorrelationID returns the correlation ID carried by ctx.
+func CorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationKey{}).(string)
+	return id, ok
+}
+
+var correlations struct {
+	sync.Mutex
+	m map[uintptr]*correlation
+}
+
+// correlation is the context bound to a token. Its address tells the bindings of the same token
+// apart since contexts can't be compared.
+type correlation struct {
+	ctx context.Context
+}
+
+// Correlate associates the native operation identified by token with ctx until release is called.
+// The token is usually the user data pointer or request handle that a C library passes back to
+// the callbacks of an asynchronous operation. A callback whose first parameter is a context.Context
+// receives ctx when one of its pointer or uintptr arguments is token, which lets a completion that
+// fires on another thread be traced in the span that started the operation.
+// Callbacks with a context.Context parameter are not supported on Windows.
+func Correlate(ctx context.Context, token uintptr) (release func()) {
+	correlations.Lock()
+	defer correlations.Unlock()
+	if correlations.m == nil {
+		correlations.m = map[uintptr]*correlation{}
+	}
+	c := &correlation{ctx: ctx}
+	correlations.m[token] = c
+	return func() {
+		correlations.Lock()
+		defer correlations.Unlock()
+		if correlations.m[token] == c {
+			delete(correlations.m, token)
+		}
+	}
+}
+
+// correlatedContext returns the context of the first of args bound with Correlate.
+// It returns context.Background if there is none.
+func correlatedContext(args []reflect.Value) context.Context {
+	correlations.Lock()
+	defer correlations.Unlock()
+	if len(correlations.m) == 0 {
+		return context.Background()
+	}
+	for _, a := range args {
+		switch a.Kind() {
+		case reflect.Uintptr, reflect.UnsafePointer, reflect.Ptr:
+			var token uintptr
+			if a.Kind() == reflect.Uintptr {
+				token = uintptr(a.Uint())
+			} else {
+				token = a.Pointer()
+			}
+			if c, ok := correlations.m[token]; ok {
+				return c.ctx
+			}
+		}
+	}
+	return context.Background()
+}
+
+// startTrace starts the runtime/trace region named name for a foreign call or callback in ctx
+// and logs its correlation ID. The returned function ends the region.
+func startTrace(ctx context.Context, name string) (end func()) {
+	if !trace.IsEnabled() {
+		return func() {}
+	}
+	r := trace.StartRegion(ctx, name)
+	if id, ok := CorrelationID(ctx); ok {
+		trace.Log(ctx, correlationCategory, id)
+	}
+	return r.End
+}
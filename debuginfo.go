@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"debug/dwarf"
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// WithDebugInfoCheck compares the Go function with the DWARF description of the C function when the
+// library ships debug info, either in the library itself or in a separate file: in
+// /usr/lib/debug/.build-id on Linux and FreeBSD or in a .dSYM bundle next to the library on macOS.
+// The number of parameters and the class of each parameter and of the return value must match:
+// integers, pointers, floats of the same size, structs of the same size and vectors. A mismatch such
+// as a missing parameter, which would otherwise corrupt the registers and stack of the call, makes the
+// registration panic:
+//
+//	// int add(int a, int b);
+//	var add func(a int32) int32
+//	// panics: the C function has 2 parameters but the Go function has 1
+//	purego.RegisterLibFuncWith(&add, lib, "add", purego.WithDebugInfoCheck())
+//
+// Since reading the debug info is slow the check is meant for tests and debug builds. Functions
+// registered by address and functions of libraries without debug info are not checked.
+func WithDebugInfoCheck() FuncOption {
+	return func(cfg *funcConfig) {
+		cfg.checkDebugInfo = true
+	}
+}
+
+// checkDebugInfo panics if the function type ty doesn't match the debug info of the C function of cfg.
+func checkDebugInfo(cfg *funcConfig, ty reflect.Type) {
+	if cfg.name == "" {
+		return
+	}
+	var path string
+	if cfg.library != nil {
+		path = handlePath(cfg.library.Handle())
+	} else if cfg.handle != 0 {
+		path = handlePath(cfg.handle)
+	} else {
+		return
+	}
+	if path == "" {
+		// the main program
+		exe, err := os.Executable()
+		if err != nil {
+			return
+		}
+		path = exe
+	}
+	info := loadDebugInfo(path)
+	fn, ok := info.funcs[cfg.name]
+	if !ok {
+		return
+	}
+	if msg := fn.mismatch(info.data, ty); msg != "" {
+		panic(errors.New("purego: " + ty.String() + " doesn't match the debug info of " + cfg.name + " in " + path + ": " + msg))
+	}
+}
+
+// debugInfo are the functions described by the DWARF of a library.
+type debugInfo struct {
+	data  *dwarf.Data
+	funcs map[string]debugFunc
+}
+
+// debugFunc is a DWARF subprogram whose types are looked up when it is checked.
+type debugFunc struct {
+	params   []dwarf.Offset
+	ret      dwarf.Offset
+	void     bool
+	variadic bool
+}
+
+var debugInfos struct {
+	sync.Mutex
+	m map[string]*debugInfo
+}
+
+// loadDebugInfo returns the debug info of the library at path which is read once.
+func loadDebugInfo(path string) *debugInfo {
+	debugInfos.Lock()
+	defer debugInfos.Unlock()
+	if info, ok := debugInfos.m[path]; ok {
+		return info
+	}
+	info := &debugInfo{funcs: map[string]debugFunc{}}
+	if d, err := openDWARF(path); err == nil {
+		info.data = d
+		readDebugFuncs(d, info.funcs)
+	}
+	if debugInfos.m == nil {
+		debugInfos.m = map[string]*debugInfo{}
+	}
+	debugInfos.m[path] = info
+	return info
+}
+
+// readDebugFuncs adds the subprograms of d to funcs by name and linkage name.
+// Definitions replace declarations of the same function.
+func readDebugFuncs(d *dwarf.Data, funcs map[string]debugFunc) {
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil || e == nil {
+			return
+		}
+		if e.Tag != dwarf.TagSubprogram {
+			continue
+		}
+		var fn debugFunc
+		ret, ok := e.Val(dwarf.AttrType).(dwarf.Offset)
+		fn.ret, fn.void = ret, !ok
+		if e.Children {
+			for {
+				c, err := r.Next()
+				if err != nil || c == nil {
+					return
+				}
+				if c.Tag == 0 {
+					break
+				}
+				switch c.Tag {
+				case dwarf.TagFormalParameter:
+					off, _ := c.Val(dwarf.AttrType).(dwarf.Offset)
+					fn.params = append(fn.params, off)
+				case dwarf.TagUnspecifiedParameters:
+					fn.variadic = true
+				}
+				if c.Children {
+					r.SkipChildren()
+				}
+			}
+		}
+		declaration, _ := e.Val(dwarf.AttrDeclaration).(bool)
+		for _, attr := range []dwarf.Attr{dwarf.AttrName, dwarf.AttrLinkageName} {
+			name, _ := e.Val(attr).(string)
+			if name == "" {
+				continue
+			}
+			if _, ok := funcs[name]; !ok || !declaration {
+				funcs[name] = fn
+			}
+		}
+	}
+}
+
+// typeClass is how a type is passed to C as far as the check of debug info is concerned.
+type typeClass int
+
+const (
+	classUnknown typeClass = iota
+	classVoid
+	classInt
+	classPointer
+	classFloat
+	classStruct
+	classVector
+)
+
+// cClass returns the class and size of the C type at off.
+func cClass(d *dwarf.Data, off dwarf.Offset) (typeClass, int64, string) {
+	t, err := d.Type(off)
+	if err != nil {
+		return classUnknown, 0, ""
+	}
+	name := t.String()
+	for {
+		switch tt := t.(type) {
+		case *dwarf.TypedefType:
+			t = tt.Type
+			continue
+		case *dwarf.QualType:
+			t = tt.Type
+			continue
+		}
+		break
+	}
+	switch t.(type) {
+	case *dwarf.IntType, *dwarf.UintType, *dwarf.CharType, *dwarf.UcharType, *dwarf.BoolType, *dwarf.EnumType:
+		return classInt, t.Size(), name
+	case *dwarf.PtrType, *dwarf.FuncType, *dwarf.UnspecifiedType:
+		return classPointer, t.Size(), name
+	case *dwarf.FloatType:
+		return classFloat, t.Size(), name
+	case *dwarf.StructType:
+		return classStruct, t.Size(), name
+	case *dwarf.ArrayType:
+		// arrays are adjusted to pointers in parameters so an array type is a vector such as __m128
+		return classVector, t.Size(), name
+	case *dwarf.VoidType:
+		return classVoid, 0, name
+	}
+	return classUnknown, 0, name
+}
+
+// goClass returns the class of the Go type t as it is passed to C.
+func goClass(t reflect.Type) typeClass {
+	if isCMarshaler(t) || isCUnmarshaler(t) {
+		// the C type depends on the marshaling
+		return classUnknown
+	}
+	if t == vec128Type {
+		return classVector
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return classInt
+	case reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer, reflect.String, reflect.Slice, reflect.Func:
+		return classPointer
+	case reflect.Float32, reflect.Float64:
+		return classFloat
+	case reflect.Struct:
+		return classStruct
+	}
+	return classUnknown
+}
+
+// matches reports whether the Go type t is passed like the C type of class c and size.
+func matches(t reflect.Type, c typeClass, size int64) bool {
+	g := goClass(t)
+	switch {
+	case g == classUnknown || c == classUnknown:
+		return true
+	case t.Kind() == reflect.Uintptr:
+		// a uintptr often holds an integer such as size_t or a handle
+		return c == classInt || c == classPointer
+	case g != c:
+		return false
+	case g == classFloat || g == classStruct || g == classVector:
+		return size <= 0 || int64(t.Size()) == size
+	}
+	return true
+}
+
+// cArgTypes returns the types of the arguments the Go function type ty passes to C
+// leaving out the variadic arguments.
+func cArgTypes(ty reflect.Type) []reflect.Type {
+	n := ty.NumIn()
+	if ty.IsVariadic() {
+		n--
+	}
+	var args []reflect.Type
+	for i := 0; i < n; i++ {
+		arg := ty.In(i)
+		switch {
+		case i == 0 && arg == contextType:
+		case arg == swiftSelfType || arg == swiftErrorPtrType || arg == arenaType:
+		case arg == sizedBytesType:
+			args = append(args, arg, reflect.TypeOf(uintptr(0)))
+		default:
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// mismatch describes how the function type ty differs from fn or returns "" if it matches.
+func (fn debugFunc) mismatch(d *dwarf.Data, ty reflect.Type) string {
+	var msgs []string
+	args := cArgTypes(ty)
+	if len(args) < len(fn.params) || len(args) > len(fn.params) && !fn.variadic && !ty.IsVariadic() {
+		msgs = append(msgs, "the C function has "+parameters(len(fn.params))+" but the Go function has "+strconv.Itoa(len(args)))
+	}
+	for i := 0; i < len(args) && i < len(fn.params); i++ {
+		class, size, name := cClass(d, fn.params[i])
+		if !matches(args[i], class, size) {
+			msgs = append(msgs, "parameter "+strconv.Itoa(i+1)+" is "+args[i].String()+" but the C function takes "+name)
+		}
+	}
+	if ty.NumOut() == 1 {
+		out := ty.Out(0)
+		if fn.void {
+			msgs = append(msgs, "the Go function returns "+out.String()+" but the C function returns void")
+		} else if class, size, name := cClass(d, fn.ret); !matches(out, class, size) {
+			msgs = append(msgs, "the Go function returns "+out.String()+" but the C function returns "+name)
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// parameters returns "1 parameter" or "n parameters".
+func parameters(n int) string {
+	if n == 1 {
+		return "1 parameter"
+	}
+	return strconv.Itoa(n) + " parameters"
+}
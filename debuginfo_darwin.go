@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"debug/dwarf"
+	"debug/macho"
+	"path/filepath"
+)
+
+// openDWARF returns the DWARF of the dSYM bundle next to the Mach-O file at path or of the file itself.
+// The libraries of the system are in the shared cache and can't be read.
+func openDWARF(path string) (*dwarf.Data, error) {
+	dsym := filepath.Join(path+".dSYM", "Contents", "Resources", "DWARF", filepath.Base(path))
+	f, err := macho.Open(dsym)
+	if err != nil {
+		if f, err = macho.Open(path); err != nil {
+			return nil, err
+		}
+	}
+	defer f.Close()
+	return f.DWARF()
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+)
+
+// debugDir is where distributions install the separate debug info of libraries by build ID.
+const debugDir = "/usr/lib/debug/.build-id"
+
+// openDWARF returns the DWARF of the ELF file at path or of its separate debug file.
+func openDWARF(path string) (*dwarf.Data, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if f.Section(".debug_info") != nil {
+		return f.DWARF()
+	}
+	id := buildID(f)
+	if len(id) < 2 {
+		return nil, errors.New("purego: no debug info in " + path)
+	}
+	s := hex.EncodeToString(id)
+	debug, err := elf.Open(filepath.Join(debugDir, s[:2], s[2:]+".debug"))
+	if err != nil {
+		return nil, err
+	}
+	defer debug.Close()
+	return debug.DWARF()
+}
+
+// buildID returns the GNU build ID of f or nil if it has none.
+func buildID(f *elf.File) []byte {
+	sec := f.Section(".note.gnu.build-id")
+	if sec == nil {
+		return nil
+	}
+	note, err := sec.Data()
+	// the note is namesz, descsz and type followed by the name "GNU\x00" and the ID
+	if err != nil || len(note) < 16 {
+		return nil
+	}
+	size := f.ByteOrder.Uint32(note[4:])
+	if uint64(len(note)) < 16+uint64(size) {
+		return nil
+	}
+	return note[16 : 16+size]
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestDebugInfoCheck(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libdebuginfotest.so")
+	if err := buildSharedLib("CC", libFileName, "-g", filepath.Join("libdebuginfotest", "debuginfo.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type point struct{ x, y int32 }
+	tests := []struct {
+		name string
+		fptr interface{}
+		err  string
+	}{
+		{"add", new(func(a, b int32) int32), ""},
+		{"add", new(func(a int32) int32), "the C function has 2 parameters but the Go function has 1"},
+		{"add", new(func(a, b, c int32) int32), "the C function has 2 parameters but the Go function has 3"},
+		{"add", new(func(a int32, b float64) int32), "parameter 2 is float64 but the C function takes const int"},
+		{"add", new(func(a, b int32) float64), "the Go function returns float64 but the C function returns count_t"},
+		{"scale", new(func(v float64, s float32) float64), ""},
+		{"scale", new(func(v, s float64) float64), "parameter 2 is float64 but the C function takes float"},
+		{"make_point", new(func(x, y int32) point), ""},
+		{"make_point", new(func(x, y int32) *point), "the Go function returns *purego_test.point but the C function returns struct point"},
+		{"sum", new(func(n int32, a, b, c int32) int32), ""},
+		{"sum", new(func(n int32, args ...interface{}) int32), ""},
+		{"sum", new(func() int32), "the C function has 1 parameter but the Go function has 0"},
+		{"length", new(func(s string) uintptr), ""},
+		{"length", new(func(s unsafe.Pointer) uint64), ""},
+		{"length", new(func(s purego.SizedBytes) uintptr), "the C function has 1 parameter but the Go function has 2"},
+		{"nothing", new(func()), ""},
+		{"nothing", new(func() int32), "the Go function returns int32 but the C function returns void"},
+	}
+	for _, test := range tests {
+		err := registerChecked(test.fptr, lib, test.name)
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s as %T: %v", test.name, test.fptr, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%s as %T: got error %v wanted %q", test.name, test.fptr, err, test.err)
+		}
+	}
+
+	// without the option the signature isn't checked
+	var add func(a int32) int32
+	purego.RegisterLibFunc(&add, lib, "add")
+}
+
+// registerChecked registers the function name with the check of its debug info and returns its panic.
+func registerChecked(fptr interface{}, lib uintptr, name string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(error)
+		}
+	}()
+	purego.RegisterLibFuncWith(fptr, lib, name, purego.WithDebugInfoCheck())
+	return nil
+}
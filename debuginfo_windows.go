@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"debug/dwarf"
+	"debug/pe"
+)
+
+// openDWARF returns the DWARF of the PE file at path as MinGW and Clang write it.
+// The PDB files of MSVC are not supported.
+func openDWARF(path string) (*dwarf.Data, error) {
+	f, err := pe.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.DWARF()
+}
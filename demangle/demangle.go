@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package demangle turns the mangled symbol names of C++ and Rust into the names they have in source.
+//
+// Names of the Itanium C++ ABI used by GCC and Clang start with _Z, names of the Rust v0 scheme
+// start with _R and names of the legacy Rust scheme are Itanium names ending in a hash component.
+// The extra leading underscore of Mach-O symbols is accepted. The output follows c++filt and
+// rustc-demangle in their default formats except that Rust crate disambiguators are left out.
+//
+//	s, err := demangle.Demangle("_ZN3foo3barEi")
+//	// s is "foo::bar(int)"
+package demangle
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ErrNotMangled is returned by Demangle for a name that doesn't use a supported mangling scheme.
+var ErrNotMangled = errors.New("demangle: not a mangled name")
+
+// Error is returned by Demangle for a mangled name that can't be parsed.
+type Error struct {
+	Name string // the mangled name
+	Pos  int    // the byte offset in Name where parsing failed
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return "demangle: " + e.Msg + " at offset " + strconv.Itoa(e.Pos) + " in " + strconv.Quote(e.Name)
+}
+
+// Demangle returns the demangled form of name.
+// It returns ErrNotMangled if name is not mangled and an *Error if it is malformed.
+func Demangle(name string) (string, error) {
+	mangled := name
+	if strings.HasPrefix(mangled, "__Z") || strings.HasPrefix(mangled, "__R") {
+		mangled = mangled[1:]
+	}
+	var s string
+	var err error
+	switch {
+	case strings.HasPrefix(mangled, "_Z"):
+		s, err = demangleItanium(mangled)
+		if err == nil && isLegacyRust(mangled) {
+			s = legacyRust(s)
+		}
+	case strings.HasPrefix(mangled, "_R"):
+		s, err = demangleRust(mangled)
+	default:
+		return "", ErrNotMangled
+	}
+	if err != nil {
+		if e, ok := err.(*Error); ok && len(mangled) != len(name) {
+			e.Name = name
+			e.Pos++
+		}
+		return "", err
+	}
+	return s, nil
+}
+
+// recoverError turns a panic with an *Error raised by a parser into err.
+func recoverError(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	switch r := r.(type) {
+	case runtime.Error:
+		panic(r)
+	case *Error:
+		*err = r
+	default:
+		panic(r)
+	}
+}
+
+// isLegacyRust reports whether name is a nested name whose last component is the
+// hash h followed by 16 hex digits that rustc appends to legacy symbol names.
+func isLegacyRust(name string) bool {
+	i := strings.LastIndex(name, "17h")
+	if !strings.HasPrefix(name, "_ZN") || i < 0 || i+20 > len(name) || name[i+19] != 'E' {
+		return false
+	}
+	// a vendor suffix such as .llvm.123 may follow
+	if i+20 < len(name) && name[i+20] != '.' {
+		return false
+	}
+	for _, c := range name[i+3 : i+19] {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// legacyEscapes are the escapes of characters that are not allowed in Itanium source names.
+var legacyEscapes = strings.NewReplacer(
+	"$SP$", "@", "$BP$", "*", "$RF$", "&", "$LT$", "<", "$GT$", ">", "$LP$", "(", "$RP$", ")", "$C$", ",",
+	"$u7e$", "~", "$u20$", " ", "$u27$", "'", "$u3d$", "=", "$u5b$", "[", "$u5d$", "]",
+	"$u7b$", "{", "$u7d$", "}", "$u3b$", ";", "$u2b$", "+", "$u22$", "\"",
+)
+
+// legacyRust cleans up the Itanium demangling s of a legacy Rust name by removing the hash
+// and replacing the escapes in its components.
+func legacyRust(s string) string {
+	i := strings.LastIndex(s, "::h")
+	if i < 0 {
+		return s
+	}
+	suffix := ""
+	if j := strings.Index(s[i:], " [clone "); j >= 0 {
+		suffix = s[i+j:]
+	}
+	parts := strings.Split(s[:i], "::")
+	for i, p := range parts {
+		// a leading _ is added to components that would otherwise start with $
+		if strings.HasPrefix(p, "_$") {
+			p = p[1:]
+		}
+		p = strings.ReplaceAll(p, "..", "::")
+		parts[i] = legacyEscapes.Replace(p)
+	}
+	return strings.Join(parts, "::") + suffix
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package demangle_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jwijenbergh/purego/demangle"
+)
+
+func TestDemangle(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		// Itanium C++ names as printed by c++filt
+		{"_Z3foov", "foo()"},
+		{"__ZN3foo3barEi", "foo::bar(int)"},
+		{"_ZNK3foo3barEv", "foo::bar() const"},
+		{"_ZN3FooC1ERKS_", "Foo::Foo(Foo const&)"},
+		{"_ZN3FooD0Ev", "Foo::~Foo()"},
+		{"_ZNSt6vectorIiSaIiEE9push_backERKi", "std::vector<int, std::allocator<int> >::push_back(int const&)"},
+		{"_Z3maxIiET_S0_S0_", "int max<int>(int, int)"},
+		{"_ZN3fooplERKS_", "foo::operator+(foo const&)"},
+		{"_Z1fPFviEPA10_i", "f(void (*)(int), int (*) [10])"},
+		{"_ZNSirsEPFRSiS_E", "std::basic_istream<char, std::char_traits<char> >::operator>>(std::basic_istream<char, std::char_traits<char> >& (*)(std::basic_istream<char, std::char_traits<char> >&))"},
+		{"_ZTV3Foo", "vtable for Foo"},
+		{"_ZTI3Foo", "typeinfo for Foo"},
+		{"_ZZ4mainE5count", "main::count"},
+		{"_ZN12_GLOBAL__N_13fooEv", "(anonymous namespace)::foo()"},
+		{"_Z3foov.cold", "foo() [clone .cold]"},
+		{"_ZGTtNKSt9exception4whatEv", "transaction clone for std::exception::what() const"},
+		{"_ZN4llvm21appendLoopsToWorklistIRNS_8ArrayRefIPNS_4LoopEEEEEvOT_RNS_21SmallPriorityWorklistIS3_Lj4EEE", "void llvm::appendLoopsToWorklist<llvm::ArrayRef<llvm::Loop*>&>(llvm::ArrayRef<llvm::Loop*>&, llvm::SmallPriorityWorklist<llvm::Loop*, 4u>&)"},
+		// legacy Rust names without the hash
+		{"_ZN4core3fmt5write17h0123456789abcdefE", "core::fmt::write"},
+		{"_ZN100_$LT$alloc..ffi..c_str..CString$u20$as$u20$core..convert..From$LT$$RF$core..ffi..c_str..CStr$GT$$GT$4from17he7147a2db3567bc8E", "<alloc::ffi::c_str::CString as core::convert::From<&core::ffi::c_str::CStr>>::from"},
+		// Rust v0 names without the crate disambiguators
+		{"_RNvXs0_Cs1F5znQO6TJb_2ccNtB5_5ErrorNtNtCs5GmCzIpY9Qj_4core3fmt7Display3fmt", "<cc::Error as core::fmt::Display>::fmt"},
+		{"_RINvMNtCscrePIp1uoPc_15rustc_serialize6opaqueNtB3_11FileEncoder19panic_invalid_writeKja_EB5_", "<rustc_serialize::opaque::FileEncoder>::panic_invalid_write::<10>"},
+		{"_RINvMs6_NtCsgyaJDGyq3nG_9hashbrown3rawINtB6_8RawTableTNtCs1F5znQO6TJb_2cc12CompilerFlagbEE14reserve_rehashNCINvNtB8_3map11make_hasherBQ_bNtNtNtCscKkwsb9kWaL_3std4hash6random11RandomStateE0EBS_", "<hashbrown::raw::RawTable<(cc::CompilerFlag, bool)>>::reserve_rehash::<hashbrown::map::make_hasher<cc::CompilerFlag, bool, std::hash::random::RandomState>::{closure#0}>"},
+		{"_RNvXs0_NtNtCsg4aKxXSSNMP_18tracing_subscriber3fmt4timeFG0_QL1_INtNtB7_6format6WriterL0_EEINtNtCs5GmCzIpY9Qj_4core6result6ResultuNtNtB1u_3fmt5ErrorENtB5_10FormatTime11format_time", "<for<'a, 'b> fn(&'a mut tracing_subscriber::fmt::format::Writer<'b>) -> core::result::Result<(), core::fmt::Error> as tracing_subscriber::fmt::time::FormatTime>::format_time"},
+		{"_RNvCsd31AUCFlsec_3libu9gemse_mva", "lib::gemüse"},
+	}
+	for _, test := range tests {
+		got, err := demangle.Demangle(test.name)
+		if err != nil {
+			t.Errorf("Demangle(%q) failed: %v", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Demangle(%q)\n got %s\nwant %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDemangleErrors(t *testing.T) {
+	for _, name := range []string{"", "malloc", "_foo", "Z3foov"} {
+		if _, err := demangle.Demangle(name); err != demangle.ErrNotMangled {
+			t.Errorf("Demangle(%q) got error %v wanted ErrNotMangled", name, err)
+		}
+	}
+	for _, name := range []string{"_Z", "_Z3fo", "_ZN3foo3bar", "_Z3fooS5_", "_R", "_RNvC3foo", "_RNvCs_3foo3barB99_"} {
+		_, err := demangle.Demangle(name)
+		var e *demangle.Error
+		if !errors.As(err, &e) || e.Name != name {
+			t.Errorf("Demangle(%q) got error %v wanted an *Error", name, err)
+		}
+	}
+}
@@ -0,0 +1,956 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package demangle
+
+import (
+	"strconv"
+	"strings"
+)
+
+// typeKind is the kind of a typ.
+type typeKind uint8
+
+const (
+	kindName      typeKind = iota // a builtin or named type
+	kindQualified                 // elem with cv-qualifiers
+	kindPointer                   // pointer to elem
+	kindLRef                      // lvalue reference to elem
+	kindRRef                      // rvalue reference to elem
+	kindFunction                  // function returning elem
+	kindArray                     // array of elem
+	kindMember                    // pointer to a member of class of type elem
+	kindPack                      // pack expansion of elem
+	kindArgPack                   // template argument pack of params
+)
+
+// typ is a C++ type. Declarators such as pointers to functions are printed inside out.
+type typ struct {
+	kind   typeKind
+	name   string // the name of kindName, the qualifiers of kindQualified and kindFunction and the dimension of kindArray
+	elem   *typ
+	params []*typ // the parameters of kindFunction and the arguments of kindArgPack
+	class  *typ   // the class of kindMember
+	// size estimates the length of the printed type. Substitutions share types,
+	// which can make it exponential in the length of the mangled name.
+	size int
+}
+
+// maxLength bounds the length of a demangled name.
+const maxLength = 1 << 20
+
+// length returns the size of t.
+func (t *typ) length() int {
+	if t == nil {
+		return 0
+	}
+	if t.size == 0 {
+		t.size = len(t.name) + t.elem.length() + t.class.length() + 4
+		n := 0
+		for _, p := range t.params {
+			n += p.length() + 2
+		}
+		if t.kind == kindPack {
+			if pack := findPack(t.elem); pack != nil {
+				t.size *= len(pack.params)
+			}
+		}
+		t.size += n + 1
+		if t.size > maxLength {
+			t.size = maxLength + 1
+		}
+	}
+	return t.size
+}
+
+func (t *typ) String() string {
+	return t.declare("")
+}
+
+// declare prints t around the declarator inner.
+func (t *typ) declare(inner string) string {
+	switch t.kind {
+	case kindName:
+		if inner != "" && inner[0] != '*' && inner[0] != '&' && inner[0] != ' ' {
+			return t.name + " " + inner
+		}
+		return t.name + inner
+	case kindQualified:
+		if strings.HasPrefix(inner, "(") {
+			return t.elem.declare(t.name + " " + inner)
+		}
+		return t.elem.declare(t.name + inner)
+	case kindPointer, kindLRef, kindRRef:
+		op := map[typeKind]string{kindPointer: "*", kindLRef: "&", kindRRef: "&&"}[t.kind]
+		if k := t.elem.kind; k == kindFunction || k == kindArray {
+			return t.elem.declare("(" + op + inner + ")")
+		}
+		return t.elem.declare(op + inner)
+	case kindFunction:
+		return t.elem.declare(" " + inner + "(" + joinTypes(t.params) + ")" + t.name)
+	case kindArray:
+		if inner != "" && !strings.HasSuffix(inner, "]") {
+			inner += " "
+		}
+		return t.elem.declare(inner + "[" + t.name + "]")
+	case kindMember:
+		if t.elem.kind == kindFunction {
+			return t.elem.declare("(" + t.class.String() + "::*" + inner + ")")
+		}
+		return t.elem.declare(t.class.String() + "::*" + inner)
+	case kindPack:
+		pack := findPack(t.elem)
+		if pack == nil {
+			return t.elem.declare(inner) + "..."
+		}
+		// the pattern is expanded for each argument of the pack
+		s := make([]string, len(pack.params))
+		for i, arg := range pack.params {
+			s[i] = replacePack(t.elem, pack, arg).declare(inner)
+		}
+		return strings.Join(s, ", ")
+	case kindArgPack:
+		return joinTypes(t.params) + inner
+	}
+	return inner
+}
+
+// joinTypes prints a parameter or template argument list. A single void parameter is an empty list.
+func joinTypes(ts []*typ) string {
+	if len(ts) == 1 && ts[0].kind == kindName && ts[0].name == "void" {
+		return ""
+	}
+	var s []string
+	for _, t := range ts {
+		// an empty pack is left out
+		if t := t.String(); t != "" {
+			s = append(s, t)
+		}
+	}
+	return strings.Join(s, ", ")
+}
+
+// findPack returns the argument pack in the pattern t of a pack expansion or nil.
+func findPack(t *typ) *typ {
+	for ; t != nil; t = t.elem {
+		if t.kind == kindArgPack {
+			return t
+		}
+		if t.kind == kindFunction {
+			for _, p := range t.params {
+				if pack := findPack(p); pack != nil {
+					return pack
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// replacePack returns a copy of t where pack is replaced by arg.
+func replacePack(t, pack, arg *typ) *typ {
+	if t == pack {
+		return arg
+	}
+	if t == nil || t.kind == kindName {
+		return t
+	}
+	c := *t
+	c.elem = replacePack(t.elem, pack, arg)
+	if t.kind == kindFunction {
+		c.params = make([]*typ, len(t.params))
+		for i, p := range t.params {
+			c.params[i] = replacePack(p, pack, arg)
+		}
+	}
+	return collapse(&c)
+}
+
+// collapse applies the reference collapsing rules to a reference to a reference.
+func collapse(t *typ) *typ {
+	if t.kind != kindLRef && t.kind != kindRRef {
+		return t
+	}
+	switch t.elem.kind {
+	case kindLRef:
+		return t.elem
+	case kindRRef:
+		if t.kind == kindRRef {
+			return t.elem
+		}
+		return &typ{kind: kindLRef, elem: t.elem.elem}
+	}
+	return t
+}
+
+func named(name string) *typ {
+	return &typ{kind: kindName, name: name}
+}
+
+// nameInfo describes a parsed name.
+type nameInfo struct {
+	// last is the last unqualified name without template arguments, which names constructors and destructors.
+	last string
+	// args are the last template arguments of the name.
+	args []*typ
+	// template reports whether the name ends with template arguments.
+	template bool
+	// noReturn reports whether the encoding of the name has no return type even if it is a template
+	// as is the case for constructors, destructors and conversion operators.
+	noReturn bool
+	// quals are the cv- and ref-qualifiers of a member function.
+	quals string
+}
+
+type itanium struct {
+	s    string
+	pos  int
+	subs []*typ // substitution candidates
+	tmpl []*typ // the template arguments T_ refers to
+	// local reports whether the encoding being parsed is the function of a local name,
+	// whose return type is not printed.
+	local bool
+}
+
+// demangleItanium demangles a name of the Itanium C++ ABI that starts with _Z.
+func demangleItanium(name string) (s string, err error) {
+	d := &itanium{s: name, pos: 2}
+	defer recoverError(&err)
+	s = d.encoding()
+	if d.pos < len(d.s) {
+		if d.s[d.pos] != '.' {
+			d.fail("unexpected trailing characters")
+		}
+		// vendor suffixes such as .cold or .constprop.0 are kept as they are printed by c++filt
+		s += " [clone " + d.s[d.pos:] + "]"
+	}
+	return s, nil
+}
+
+func (d *itanium) fail(msg string) {
+	panic(&Error{Name: d.s, Pos: d.pos, Msg: msg})
+}
+
+func (d *itanium) peek() byte {
+	if d.pos < len(d.s) {
+		return d.s[d.pos]
+	}
+	return 0
+}
+
+func (d *itanium) peekN(n int) string {
+	if d.pos+n <= len(d.s) {
+		return d.s[d.pos : d.pos+n]
+	}
+	return ""
+}
+
+func (d *itanium) consume(prefix string) bool {
+	if strings.HasPrefix(d.s[d.pos:], prefix) {
+		d.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (d *itanium) expect(c byte) {
+	if d.peek() != c {
+		d.fail("expected " + string(c))
+	}
+	d.pos++
+}
+
+// number parses [n] <decimal>.
+func (d *itanium) number() int {
+	neg := d.consume("n")
+	start := d.pos
+	for d.pos < len(d.s) && d.s[d.pos] >= '0' && d.s[d.pos] <= '9' {
+		d.pos++
+	}
+	if start == d.pos {
+		d.fail("expected a number")
+	}
+	n, err := strconv.Atoi(d.s[start:d.pos])
+	if err != nil {
+		d.fail("invalid number")
+	}
+	if neg {
+		return -n
+	}
+	return n
+}
+
+// seqID parses the base-36 <seq-id> of substitutions and template parameters up to _.
+// It returns 0 for a missing seq-id and seq-id + 1 otherwise.
+func (d *itanium) seqID() int {
+	if d.consume("_") {
+		return 0
+	}
+	n := 0
+	for {
+		c := d.peek()
+		switch {
+		case c >= '0' && c <= '9':
+			n = n*36 + int(c-'0')
+		case c >= 'A' && c <= 'Z':
+			n = n*36 + int(c-'A') + 10
+		case c == '_':
+			d.pos++
+			return n + 1
+		default:
+			d.fail("invalid sequence id")
+		}
+		if n > len(d.s) {
+			d.fail("sequence id out of range")
+		}
+		d.pos++
+	}
+}
+
+// encoding parses <encoding>: a function, a data object or a special name.
+func (d *itanium) encoding() string {
+	switch {
+	case d.consume("TV"):
+		return "vtable for " + d.typ().String()
+	case d.consume("TT"):
+		return "VTT for " + d.typ().String()
+	case d.consume("TI"):
+		return "typeinfo for " + d.typ().String()
+	case d.consume("TS"):
+		return "typeinfo name for " + d.typ().String()
+	case d.consume("Th"):
+		d.number()
+		d.expect('_')
+		return "non-virtual thunk to " + d.encoding()
+	case d.consume("Tv"):
+		d.number()
+		d.expect('_')
+		d.number()
+		d.expect('_')
+		return "virtual thunk to " + d.encoding()
+	case d.consume("TH"):
+		name, _ := d.name()
+		return "TLS init function for " + name
+	case d.consume("TW"):
+		name, _ := d.name()
+		return "TLS wrapper function for " + name
+	case d.consume("GV"):
+		name, _ := d.name()
+		return "guard variable for " + name
+	case d.consume("GTt"):
+		return "transaction clone for " + d.encoding()
+	}
+	local := d.local
+	d.local = false
+	name, info := d.name()
+	if info.args != nil {
+		d.tmpl = info.args
+	}
+	if c := d.peek(); c == 0 || c == 'E' || c == '.' {
+		return name
+	}
+	var ret *typ
+	if info.template && !info.noReturn {
+		ret = d.typ()
+	}
+	var params []*typ
+	for c := d.peek(); c != 0 && c != 'E' && c != '.'; c = d.peek() {
+		params = append(params, d.typ())
+	}
+	s := name + "(" + joinTypes(params) + ")" + info.quals
+	if ret != nil && !local {
+		s = ret.String() + " " + s
+	}
+	return s
+}
+
+// baseName returns the last component of the qualified name s without its template arguments.
+func baseName(s string) string {
+	depth := 0
+	end := len(s)
+	for i := len(s) - 1; i >= 0; i-- {
+		switch s[i] {
+		case '>':
+			depth++
+		case '<':
+			depth--
+			if depth == 0 && end == len(s) {
+				end = i
+			}
+		case ':':
+			if depth == 0 && i > 0 && s[i-1] == ':' {
+				return s[i+1 : end]
+			}
+		}
+	}
+	return s[:end]
+}
+
+// name parses <name>.
+func (d *itanium) name() (string, nameInfo) {
+	switch d.peek() {
+	case 'N':
+		return d.nestedName()
+	case 'Z':
+		return d.localName()
+	}
+	var s string
+	var info nameInfo
+	fromSub := false
+	if d.peekN(2) == "St" {
+		d.pos += 2
+		s, info = d.unqualifiedName()
+		s = "std::" + s
+	} else if d.peek() == 'S' {
+		t := d.substitution()
+		if d.peek() != 'I' {
+			d.fail("expected template arguments after a substitution")
+		}
+		s = t.String()
+		info.last = baseName(s)
+		fromSub = true
+	} else {
+		s, info = d.unqualifiedName()
+	}
+	if d.peek() == 'I' {
+		// the unscoped template name is a substitution candidate but a substitution isn't added again
+		if !fromSub {
+			d.subs = append(d.subs, named(s))
+		}
+		args := d.templateArgs()
+		s = addTemplateArgs(s, args)
+		info.args, info.template = args, true
+	}
+	return s, info
+}
+
+// addTemplateArgs appends the template argument list args to the name s.
+func addTemplateArgs(s string, args []*typ) string {
+	var list []string
+	for _, a := range args {
+		if t := a.String(); t != "" {
+			list = append(list, t)
+		}
+	}
+	// an empty pack is printed as nothing, which c++filt tracks when separating the closing brackets
+	emptyLast := len(args) > 0 && args[len(args)-1].String() == ""
+	if strings.HasSuffix(s, "<") {
+		s += " "
+	}
+	a := strings.Join(list, ", ")
+	if strings.HasSuffix(a, ">") && !emptyLast {
+		a += " "
+	}
+	return s + "<" + a + ">"
+}
+
+// nestedName parses N [<CV-qualifiers>] [<ref-qualifier>] <prefix> <unqualified-name> E.
+func (d *itanium) nestedName() (string, nameInfo) {
+	d.expect('N')
+	var info nameInfo
+	info.quals = d.cvQualifiers()
+	switch {
+	case d.consume("R"):
+		info.quals += " &"
+	case d.consume("O"):
+		info.quals += " &&"
+	}
+	var s string
+	// fromSub reports whether s is a substitution which isn't added to the candidates again
+	fromSub := false
+	for d.peek() != 'E' {
+		if s != "" && !fromSub {
+			d.subs = append(d.subs, named(s))
+		}
+		fromSub = false
+		switch c := d.peek(); {
+		case c == 0:
+			d.fail("unterminated nested name")
+		case c == 'S' && d.peekN(2) != "St":
+			s = d.substitution().String()
+			info.last = baseName(s)
+			fromSub = true
+		case c == 'S':
+			d.pos += 2
+			s = "std"
+			fromSub = true
+		case c == 'T':
+			s = d.templateParam().String()
+			info.last = baseName(s)
+		case c == 'I':
+			if s == "" {
+				d.fail("template arguments without a template")
+			}
+			args := d.templateArgs()
+			s = addTemplateArgs(s, args)
+			info.args, info.template = args, true
+			continue
+		default:
+			name, ni := d.unqualifiedNameIn(info.last)
+			if s != "" {
+				s += "::"
+			}
+			s += name
+			info.last, info.noReturn = ni.last, ni.noReturn
+		}
+		info.template = false
+	}
+	d.pos++
+	return s, info
+}
+
+// localName parses Z <function encoding> E <entity name> [<discriminator>].
+func (d *itanium) localName() (string, nameInfo) {
+	d.expect('Z')
+	d.local = true
+	fn := d.encoding()
+	d.expect('E')
+	if d.consume("s") {
+		d.discriminator()
+		return fn + "::string literal", nameInfo{}
+	}
+	name, info := d.name()
+	d.discriminator()
+	return fn + "::" + name, info
+}
+
+func (d *itanium) discriminator() {
+	if !d.consume("_") {
+		return
+	}
+	if d.consume("_") {
+		d.number()
+		d.expect('_')
+		return
+	}
+	d.number()
+}
+
+func (d *itanium) unqualifiedName() (string, nameInfo) {
+	return d.unqualifiedNameIn("")
+}
+
+// unqualifiedNameIn parses <unqualified-name> in the scope whose last name is class.
+func (d *itanium) unqualifiedNameIn(class string) (string, nameInfo) {
+	var s string
+	var info nameInfo
+	switch c := d.peek(); {
+	case c >= '0' && c <= '9':
+		s = d.sourceName()
+		info.last = s
+	case c == 'C' && d.peekN(2) != "Cv":
+		d.pos++
+		d.consume("I")
+		if d.pos >= len(d.s) || !strings.ContainsRune("12345", rune(d.s[d.pos])) {
+			d.fail("invalid constructor")
+		}
+		d.pos++
+		s, info.last, info.noReturn = class, class, true
+	case c == 'D' && d.pos+1 < len(d.s) && strings.ContainsRune("0125", rune(d.s[d.pos+1])):
+		d.pos += 2
+		s, info.last, info.noReturn = "~"+class, "~"+class, true
+	case c == 'U':
+		s = d.unnamedType()
+		info.last = s
+	default:
+		s, info.noReturn = d.operatorName()
+		info.last = s
+	}
+	for d.consume("B") {
+		s += "[abi:" + d.sourceName() + "]"
+	}
+	return s, info
+}
+
+// sourceName parses <length> <identifier>.
+func (d *itanium) sourceName() string {
+	n := d.number()
+	if n <= 0 || n > len(d.s)-d.pos {
+		d.fail("invalid source name")
+	}
+	s := d.s[d.pos : d.pos+n]
+	d.pos += n
+	if strings.HasPrefix(s, "_GLOBAL_") && len(s) > 9 && strings.ContainsRune("._$", rune(s[8])) && s[9] == 'N' {
+		return "(anonymous namespace)"
+	}
+	return s
+}
+
+// unnamedType parses the names of unnamed types and closures.
+func (d *itanium) unnamedType() string {
+	switch {
+	case d.consume("Ut"):
+		n := d.seqIDNumber()
+		return "{unnamed type#" + strconv.Itoa(n) + "}"
+	case d.consume("Ul"):
+		var params []*typ
+		for d.peek() != 'E' {
+			if d.peek() == 0 {
+				d.fail("unterminated lambda")
+			}
+			params = append(params, d.typ())
+		}
+		d.pos++
+		n := d.seqIDNumber()
+		return "{lambda(" + joinTypes(params) + ")#" + strconv.Itoa(n) + "}"
+	}
+	d.fail("invalid unnamed type")
+	return ""
+}
+
+// seqIDNumber parses the [<number>] _ of unnamed types which counts from 1.
+func (d *itanium) seqIDNumber() int {
+	if d.consume("_") {
+		return 1
+	}
+	n := d.number()
+	d.expect('_')
+	return n + 2
+}
+
+// operators are the two letter codes of <operator-name> and their arity in expressions.
+var operators = map[string]struct {
+	name  string
+	arity int
+}{
+	"nw": {"new", 3}, "na": {"new[]", 3}, "dl": {"delete", 1}, "da": {"delete[]", 1},
+	"ps": {"+", 1}, "ng": {"-", 1}, "ad": {"&", 1}, "de": {"*", 1}, "co": {"~", 1},
+	"pl": {"+", 2}, "mi": {"-", 2}, "ml": {"*", 2}, "dv": {"/", 2}, "rm": {"%", 2},
+	"an": {"&", 2}, "or": {"|", 2}, "eo": {"^", 2}, "aS": {"=", 2}, "pL": {"+=", 2},
+	"mI": {"-=", 2}, "mL": {"*=", 2}, "dV": {"/=", 2}, "rM": {"%=", 2}, "aN": {"&=", 2},
+	"oR": {"|=", 2}, "eO": {"^=", 2}, "ls": {"<<", 2}, "rs": {">>", 2}, "lS": {"<<=", 2},
+	"rS": {">>=", 2}, "eq": {"==", 2}, "ne": {"!=", 2}, "lt": {"<", 2}, "gt": {">", 2},
+	"le": {"<=", 2}, "ge": {">=", 2}, "ss": {"<=>", 2}, "nt": {"!", 1}, "aa": {"&&", 2},
+	"oo": {"||", 2}, "pp": {"++", 1}, "mm": {"--", 1}, "cm": {",", 2}, "pm": {"->*", 2},
+	"pt": {"->", 2}, "cl": {"()", 2}, "ix": {"[]", 2}, "qu": {"?", 3},
+}
+
+// operatorName parses <operator-name>. It reports whether it is a conversion operator.
+func (d *itanium) operatorName() (string, bool) {
+	code := d.peekN(2)
+	if op, ok := operators[code]; ok {
+		d.pos += 2
+		if op.name[0] >= 'a' && op.name[0] <= 'z' {
+			return "operator " + op.name, false
+		}
+		return "operator" + op.name, false
+	}
+	switch {
+	case d.consume("cv"):
+		return "operator " + d.typ().String(), true
+	case d.consume("li"):
+		return "operator\"\" " + d.sourceName(), false
+	case code != "" && code[0] == 'v' && code[1] >= '0' && code[1] <= '9':
+		d.pos += 2
+		return "operator " + d.sourceName(), false
+	}
+	d.fail("invalid name")
+	return "", false
+}
+
+func (d *itanium) cvQualifiers() string {
+	var q string
+	if d.consume("r") {
+		q += " restrict"
+	}
+	if d.consume("V") {
+		q += " volatile"
+	}
+	if d.consume("K") {
+		q += " const"
+	}
+	return q
+}
+
+// builtins are the one letter codes of builtin types.
+var builtins = map[byte]string{
+	'v': "void", 'w': "wchar_t", 'b': "bool", 'c': "char", 'a': "signed char", 'h': "unsigned char",
+	's': "short", 't': "unsigned short", 'i': "int", 'j': "unsigned int", 'l': "long", 'm': "unsigned long",
+	'x': "long long", 'y': "unsigned long long", 'n': "__int128", 'o': "unsigned __int128",
+	'f': "float", 'd': "double", 'e': "long double", 'g': "__float128", 'z': "...",
+}
+
+// builtinsD are the builtin types whose code starts with D.
+var builtinsD = map[byte]string{
+	'd': "decimal64", 'e': "decimal128", 'f': "decimal32", 'h': "half", 'i': "char32_t",
+	's': "char16_t", 'u': "char8_t", 'a': "auto", 'c': "decltype(auto)", 'n': "decltype(nullptr)",
+}
+
+// typ parses <type>.
+func (d *itanium) typ() *typ {
+	c := d.peek()
+	if name, ok := builtins[c]; ok {
+		d.pos++
+		return named(name)
+	}
+	var t *typ
+	switch c {
+	case 'D':
+		if d.pos+1 < len(d.s) {
+			if name, ok := builtinsD[d.s[d.pos+1]]; ok {
+				d.pos += 2
+				return named(name)
+			}
+		}
+		switch {
+		case d.consume("Dp"):
+			t = &typ{kind: kindPack, elem: d.typ()}
+		default:
+			d.fail("unsupported type")
+		}
+	case 'u':
+		d.pos++
+		return named(d.sourceName())
+	case 'r', 'V', 'K':
+		q := d.cvQualifiers()
+		elem := d.typ()
+		if elem.kind == kindFunction {
+			// cv-qualifiers of a function type qualify the member function
+			f := *elem
+			f.name = q + f.name
+			t = &f
+		} else {
+			t = &typ{kind: kindQualified, name: q, elem: elem}
+		}
+	case 'P':
+		d.pos++
+		t = &typ{kind: kindPointer, elem: d.typ()}
+	case 'R':
+		d.pos++
+		t = collapse(&typ{kind: kindLRef, elem: d.typ()})
+	case 'O':
+		d.pos++
+		t = collapse(&typ{kind: kindRRef, elem: d.typ()})
+	case 'F':
+		d.pos++
+		d.consume("Y")
+		ret := d.typ()
+		var params []*typ
+		for d.peek() != 'E' && d.peekN(2) != "RE" && d.peekN(2) != "OE" {
+			if d.peek() == 0 {
+				d.fail("unterminated function type")
+			}
+			params = append(params, d.typ())
+		}
+		var quals string
+		switch {
+		case d.consume("RE"):
+			quals = " &"
+		case d.consume("OE"):
+			quals = " &&"
+		default:
+			d.pos++
+		}
+		t = &typ{kind: kindFunction, name: quals, elem: ret, params: params}
+	case 'A':
+		d.pos++
+		var dim string
+		if c := d.peek(); c >= '0' && c <= '9' {
+			dim = strconv.Itoa(d.number())
+		} else if c != '_' {
+			dim = d.expression()
+		}
+		d.expect('_')
+		t = &typ{kind: kindArray, name: dim, elem: d.typ()}
+	case 'M':
+		d.pos++
+		class := d.typ()
+		t = &typ{kind: kindMember, class: class, elem: d.typ()}
+	case 'T':
+		t = d.templateParam()
+		if d.peek() == 'I' {
+			d.subs = append(d.subs, t)
+			t = named(addTemplateArgs(t.String(), d.templateArgs()))
+		}
+	case 'S':
+		if d.peekN(2) != "St" {
+			t = d.substitution()
+			if d.peek() != 'I' {
+				return t
+			}
+			t = named(addTemplateArgs(t.String(), d.templateArgs()))
+			break
+		}
+		fallthrough
+	default:
+		name, _ := d.name()
+		t = named(name)
+	}
+	if t.length() > maxLength {
+		d.fail("demangled name too long")
+	}
+	d.subs = append(d.subs, t)
+	return t
+}
+
+// templateParam parses T [<number>] _.
+func (d *itanium) templateParam() *typ {
+	d.expect('T')
+	n := d.seqID()
+	if n >= len(d.tmpl) {
+		d.fail("template parameter out of range")
+	}
+	return d.tmpl[n]
+}
+
+// standardSubs are the abbreviations of the std namespace.
+var standardSubs = map[string]string{
+	"Sa": "std::allocator", "Sb": "std::basic_string", "Ss": "std::basic_string<char, std::char_traits<char>, std::allocator<char> >",
+	"Si": "std::basic_istream<char, std::char_traits<char> >", "So": "std::basic_ostream<char, std::char_traits<char> >",
+	"Sd": "std::basic_iostream<char, std::char_traits<char> >",
+}
+
+// substitution parses S [<seq-id>] _ and the standard abbreviations.
+func (d *itanium) substitution() *typ {
+	if s, ok := standardSubs[d.peekN(2)]; ok {
+		d.pos += 2
+		return named(s)
+	}
+	d.expect('S')
+	n := d.seqID()
+	if n >= len(d.subs) {
+		d.fail("substitution out of range")
+	}
+	return d.subs[n]
+}
+
+// templateArgs parses I <template-arg>+ E.
+func (d *itanium) templateArgs() []*typ {
+	d.expect('I')
+	var args []*typ
+	for !d.consume("E") {
+		if d.peek() == 0 {
+			d.fail("unterminated template arguments")
+		}
+		args = append(args, d.templateArg())
+	}
+	return args
+}
+
+func (d *itanium) templateArg() *typ {
+	switch d.peek() {
+	case 'L':
+		return named(d.exprPrimary())
+	case 'X':
+		d.pos++
+		e := d.expression()
+		d.expect('E')
+		return named(e)
+	case 'J':
+		d.pos++
+		var args []*typ
+		for !d.consume("E") {
+			if d.peek() == 0 {
+				d.fail("unterminated argument pack")
+			}
+			args = append(args, d.templateArg())
+		}
+		return &typ{kind: kindArgPack, params: args}
+	}
+	return d.typ()
+}
+
+// literalSuffixes are the suffixes of integer literals of the builtin types.
+var literalSuffixes = map[byte]string{'i': "", 'j': "u", 'l': "l", 'm': "ul", 'x': "ll", 'y': "ull"}
+
+// exprPrimary parses L <type> <value> E and L <mangled-name> E.
+func (d *itanium) exprPrimary() string {
+	d.expect('L')
+	if d.consume("_Z") {
+		s := d.encoding()
+		d.expect('E')
+		return s
+	}
+	c := d.peek()
+	t := d.typ()
+	start := d.pos
+	for d.peek() != 'E' {
+		if d.peek() == 0 {
+			d.fail("unterminated literal")
+		}
+		d.pos++
+	}
+	v := d.s[start:d.pos]
+	d.pos++
+	if strings.HasPrefix(v, "n") {
+		v = "-" + v[1:]
+	}
+	if c == 'b' && (v == "0" || v == "1") {
+		return map[string]string{"0": "false", "1": "true"}[v]
+	}
+	if suffix, ok := literalSuffixes[c]; ok {
+		return v + suffix
+	}
+	return "(" + t.String() + ")" + v
+}
+
+// unresolvedName parses the <unresolved-name> following sr.
+func (d *itanium) unresolvedName() string {
+	var s string
+	switch c := d.peek(); {
+	case c == 'N':
+		d.pos++
+		s = d.typ().String()
+		if d.peek() == 'I' {
+			s = addTemplateArgs(s, d.templateArgs())
+		}
+		s += d.qualifierLevels()
+	case c == 'T' || c == 'D' || c == 'S':
+		s = d.typ().String()
+	default:
+		s = d.qualifierLevels()[2:]
+	}
+	name, _ := d.unqualifiedName()
+	if d.peek() == 'I' {
+		name = addTemplateArgs(name, d.templateArgs())
+	}
+	return s + "::" + name
+}
+
+// qualifierLevels parses <unresolved-qualifier-level>+ E.
+func (d *itanium) qualifierLevels() string {
+	var s string
+	for !d.consume("E") {
+		if d.peek() == 0 {
+			d.fail("unterminated qualifier")
+		}
+		s += "::" + d.sourceName()
+		if d.peek() == 'I' {
+			s = addTemplateArgs(s, d.templateArgs())
+		}
+	}
+	return s
+}
+
+// expression parses the subset of <expression> made of operators, template parameters and literals.
+func (d *itanium) expression() string {
+	switch c := d.peek(); {
+	case c == 'L':
+		return d.exprPrimary()
+	case c == 'T':
+		return d.templateParam().String()
+	case d.consume("sr"):
+		return d.unresolvedName()
+	case d.consume("st"):
+		return "sizeof (" + d.typ().String() + ")"
+	case d.consume("sz"):
+		return "sizeof (" + d.expression() + ")"
+	}
+	code := d.peekN(2)
+	op, ok := operators[code]
+	if !ok {
+		d.fail("unsupported expression")
+	}
+	d.pos += 2
+	switch op.arity {
+	case 1:
+		return op.name + "(" + d.expression() + ")"
+	case 2:
+		l := d.expression()
+		return "(" + l + ")" + op.name + "(" + d.expression() + ")"
+	default:
+		a := d.expression()
+		b := d.expression()
+		return "(" + a + ")" + op.name + "(" + b + ") : (" + d.expression() + ")"
+	}
+}
@@ -0,0 +1,562 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package demangle
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type rust struct {
+	s   string // the name without _R
+	pos int
+	out strings.Builder
+	// depth bounds the recursion of backrefs in malformed names.
+	depth int
+	// bound is the number of lifetimes bound by the enclosing binders.
+	bound uint64
+}
+
+// demangleRust demangles a name of the Rust v0 mangling scheme that starts with _R.
+// Crate disambiguators are left out as in the alternate format of rustc-demangle.
+func demangleRust(name string) (s string, err error) {
+	d := &rust{s: name[2:]}
+	defer recoverError(&err)
+	// an optional encoding version
+	if c := d.peek(); c >= '0' && c <= '9' {
+		d.fail("unsupported encoding version")
+	}
+	d.path(true)
+	// the instantiating crate is not printed
+	if d.pos < len(d.s) && d.s[d.pos] >= 'A' && d.s[d.pos] <= 'Z' {
+		p := &rust{s: d.s, pos: d.pos}
+		p.path(false)
+		d.pos = p.pos
+	}
+	if d.pos < len(d.s) && d.s[d.pos] != '.' {
+		d.fail("unexpected trailing characters")
+	}
+	return d.out.String(), nil
+}
+
+func (d *rust) fail(msg string) {
+	panic(&Error{Name: "_R" + d.s, Pos: d.pos + 2, Msg: msg})
+}
+
+func (d *rust) peek() byte {
+	if d.pos < len(d.s) {
+		return d.s[d.pos]
+	}
+	return 0
+}
+
+func (d *rust) next() byte {
+	c := d.peek()
+	if c == 0 {
+		d.fail("unexpected end")
+	}
+	d.pos++
+	return c
+}
+
+func (d *rust) consume(c byte) bool {
+	if d.peek() == c {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+func (d *rust) print(s string) {
+	// backrefs can make the output exponential in the length of the name
+	if d.out.Len()+len(s) > maxLength {
+		d.fail("demangled name too long")
+	}
+	d.out.WriteString(s)
+}
+
+// base62 parses <base-62-number> which is 0 for _ and the number plus one otherwise.
+func (d *rust) base62() uint64 {
+	if d.consume('_') {
+		return 0
+	}
+	var n uint64
+	for {
+		c := d.next()
+		switch {
+		case c == '_':
+			return n + 1
+		case c >= '0' && c <= '9':
+			n = n*62 + uint64(c-'0')
+		case c >= 'a' && c <= 'z':
+			n = n*62 + uint64(c-'a') + 10
+		case c >= 'A' && c <= 'Z':
+			n = n*62 + uint64(c-'A') + 36
+		default:
+			d.fail("invalid base-62 number")
+		}
+	}
+}
+
+// optBase62 parses [<tag> <base-62-number>] and returns 0 if it is missing and the number plus one otherwise.
+func (d *rust) optBase62(tag byte) uint64 {
+	if !d.consume(tag) {
+		return 0
+	}
+	return d.base62() + 1
+}
+
+func (d *rust) decimal() int {
+	start := d.pos
+	for c := d.peek(); c >= '0' && c <= '9'; c = d.peek() {
+		d.pos++
+	}
+	if start == d.pos {
+		d.fail("invalid decimal number")
+	}
+	if d.s[start] == '0' {
+		// a number doesn't have leading zeros so the digits that follow are part of the identifier
+		d.pos = start + 1
+		return 0
+	}
+	n, err := strconv.Atoi(d.s[start:d.pos])
+	if err != nil {
+		d.fail("invalid decimal number")
+	}
+	return n
+}
+
+// ident parses [<disambiguator>] <undisambiguated-identifier> and returns the identifier and the disambiguator.
+func (d *rust) ident() (string, uint64) {
+	dis := d.optBase62('s')
+	return d.undisambiguatedIdent(), dis
+}
+
+func (d *rust) undisambiguatedIdent() string {
+	puny := d.consume('u')
+	n := d.decimal()
+	d.consume('_')
+	if n > len(d.s)-d.pos {
+		d.fail("identifier out of range")
+	}
+	s := d.s[d.pos : d.pos+n]
+	d.pos += n
+	if puny {
+		return d.punycode(s)
+	}
+	return s
+}
+
+// backref runs f at the position of the backref B <base-62-number> and then continues after it.
+func (d *rust) backref(f func()) {
+	d.pos++ // B
+	start := d.pos - 1
+	target := d.base62()
+	if target >= uint64(start) {
+		d.fail("invalid backref")
+	}
+	if d.depth++; d.depth > 100 {
+		d.fail("too many backrefs")
+	}
+	saved := d.pos
+	d.pos = int(target)
+	f()
+	d.pos = saved
+	d.depth--
+}
+
+// path parses <path>. Generic arguments are printed with ::<> in values and <> in types.
+func (d *rust) path(value bool) {
+	switch c := d.next(); c {
+	case 'C':
+		name, _ := d.ident()
+		d.print(name)
+	case 'M':
+		d.implPath()
+		d.print("<")
+		d.typ()
+		d.print(">")
+	case 'X':
+		d.implPath()
+		d.print("<")
+		d.typ()
+		d.print(" as ")
+		d.path(false)
+		d.print(">")
+	case 'Y':
+		d.print("<")
+		d.typ()
+		d.print(" as ")
+		d.path(false)
+		d.print(">")
+	case 'N':
+		ns := d.next()
+		d.path(value)
+		name, dis := d.ident()
+		switch {
+		case ns >= 'A' && ns <= 'Z':
+			d.print("::{")
+			switch ns {
+			case 'C':
+				d.print("closure")
+			case 'S':
+				d.print("shim")
+			default:
+				d.print(string(ns))
+			}
+			if name != "" {
+				d.print(":" + name)
+			}
+			d.print("#" + strconv.FormatUint(dis, 10) + "}")
+		case name != "":
+			d.print("::" + name)
+		}
+	case 'I':
+		d.path(value)
+		if value {
+			d.print("::")
+		}
+		d.print("<")
+		for i := 0; !d.consume('E'); i++ {
+			if i > 0 {
+				d.print(", ")
+			}
+			d.genericArg()
+		}
+		d.print(">")
+	case 'B':
+		d.pos--
+		d.backref(func() { d.path(value) })
+	default:
+		d.fail("invalid path")
+	}
+}
+
+// implPath parses [<disambiguator>] <path> of an impl which isn't printed.
+func (d *rust) implPath() {
+	d.optBase62('s')
+	p := d.sub()
+	p.path(false)
+	d.pos = p.pos
+}
+
+// sub returns a parser at the same position whose output is separate.
+func (d *rust) sub() *rust {
+	return &rust{s: d.s, pos: d.pos, depth: d.depth, bound: d.bound}
+}
+
+// lifetime returns the name of the lifetime with the de Bruijn index i.
+// Bound lifetimes are named 'a, 'b and so on from the outermost binder.
+func (d *rust) lifetime(i uint64) string {
+	if i == 0 {
+		return "'_"
+	}
+	if i > d.bound {
+		d.fail("lifetime out of range")
+	}
+	depth := d.bound - i
+	if depth < 26 {
+		return "'" + string(rune('a'+depth))
+	}
+	return "'_" + strconv.FormatUint(depth, 10)
+}
+
+// binder parses [<binder>], prints the lifetimes it binds and returns the previous number of bound lifetimes.
+func (d *rust) binder() uint64 {
+	outer := d.bound
+	n := d.optBase62('G')
+	if n == 0 {
+		return outer
+	}
+	d.print("for<")
+	for i := uint64(0); i < n; i++ {
+		if i > 0 {
+			d.print(", ")
+		}
+		d.bound++
+		d.print(d.lifetime(1))
+	}
+	d.print("> ")
+	return outer
+}
+
+func (d *rust) genericArg() {
+	switch d.peek() {
+	case 'L':
+		d.pos++
+		d.print(d.lifetime(d.base62()))
+	case 'K':
+		d.pos++
+		d.constant()
+	default:
+		d.typ()
+	}
+}
+
+// basicTypes are the one letter codes of the builtin types.
+var basicTypes = map[byte]string{
+	'a': "i8", 'b': "bool", 'c': "char", 'd': "f64", 'e': "str", 'f': "f32", 'h': "u8", 'i': "isize",
+	'j': "usize", 'l': "i32", 'm': "u32", 'n': "i128", 'o': "u128", 's': "i16", 't': "u16", 'u': "()",
+	'v': "...", 'x': "i64", 'y': "u64", 'z': "!", 'p': "_",
+}
+
+// typ parses <type>.
+func (d *rust) typ() {
+	c := d.peek()
+	if name, ok := basicTypes[c]; ok {
+		d.pos++
+		d.print(name)
+		return
+	}
+	switch c {
+	case 'R', 'Q':
+		d.pos++
+		d.print("&")
+		if d.consume('L') {
+			if lt := d.base62(); lt != 0 {
+				d.print(d.lifetime(lt) + " ")
+			}
+		}
+		if c == 'Q' {
+			d.print("mut ")
+		}
+		d.typ()
+	case 'P':
+		d.pos++
+		d.print("*const ")
+		d.typ()
+	case 'O':
+		d.pos++
+		d.print("*mut ")
+		d.typ()
+	case 'A':
+		d.pos++
+		d.print("[")
+		d.typ()
+		d.print("; ")
+		d.constant()
+		d.print("]")
+	case 'S':
+		d.pos++
+		d.print("[")
+		d.typ()
+		d.print("]")
+	case 'T':
+		d.pos++
+		d.print("(")
+		n := 0
+		for ; !d.consume('E'); n++ {
+			if n > 0 {
+				d.print(", ")
+			}
+			d.typ()
+		}
+		if n == 1 {
+			d.print(",")
+		}
+		d.print(")")
+	case 'F':
+		d.pos++
+		d.fnSig()
+	case 'D':
+		d.pos++
+		d.print("dyn ")
+		outer := d.binder()
+		for i := 0; !d.consume('E'); i++ {
+			if i > 0 {
+				d.print(" + ")
+			}
+			d.dynTrait()
+		}
+		d.bound = outer
+		if !d.consume('L') {
+			d.fail("expected the lifetime of a trait object")
+		}
+		if lt := d.base62(); lt != 0 {
+			d.print(" + " + d.lifetime(lt))
+		}
+	case 'B':
+		d.backref(d.typ)
+	default:
+		d.path(false)
+	}
+}
+
+// fnSig parses [<binder>] [U] [K <abi>] {<type>} E <type>.
+func (d *rust) fnSig() {
+	outer := d.binder()
+	defer func() { d.bound = outer }()
+	if d.consume('U') {
+		d.print("unsafe ")
+	}
+	if d.consume('K') {
+		abi := "C"
+		if !d.consume('C') {
+			abi = strings.ReplaceAll(d.undisambiguatedIdent(), "_", "-")
+		}
+		d.print("extern \"" + abi + "\" ")
+	}
+	d.print("fn(")
+	for i := 0; !d.consume('E'); i++ {
+		if i > 0 {
+			d.print(", ")
+		}
+		d.typ()
+	}
+	d.print(")")
+	if d.peek() == 'u' {
+		d.pos++
+		return
+	}
+	d.print(" -> ")
+	d.typ()
+}
+
+// dynTrait parses <path> {<dyn-trait-assoc-binding>}.
+func (d *rust) dynTrait() {
+	// The associated type bindings are printed inside the generic arguments of the trait.
+	trait := d.sub()
+	trait.path(false)
+	d.pos = trait.pos
+	s := trait.out.String()
+	open := strings.HasSuffix(s, ">")
+	if open {
+		s = s[:len(s)-1]
+	}
+	for i := 0; d.consume('p'); i++ {
+		if open || i > 0 {
+			s += ", "
+		} else {
+			s += "<"
+		}
+		s += d.undisambiguatedIdent() + " = "
+		t := d.sub()
+		t.typ()
+		d.pos = t.pos
+		s += t.out.String()
+		open = true
+	}
+	if open {
+		s += ">"
+	}
+	d.print(s)
+}
+
+// constant parses <const>.
+func (d *rust) constant() {
+	switch c := d.peek(); {
+	case c == 'B':
+		d.backref(d.constant)
+		return
+	case c == 'p':
+		d.pos++
+		d.print("_")
+		return
+	}
+	t := d.next()
+	neg := false
+	if t != 'b' && t != 'c' && d.consume('n') {
+		neg = true
+	}
+	start := d.pos
+	for d.peek() != '_' {
+		d.next()
+	}
+	hex := d.s[start:d.pos]
+	d.pos++
+	v, err := strconv.ParseUint("0"+hex, 16, 64)
+	if err != nil {
+		d.fail("invalid constant")
+	}
+	switch t {
+	case 'b':
+		d.print(strconv.FormatBool(v != 0))
+	case 'c':
+		d.print(strconv.QuoteRune(rune(v)))
+	case 'h', 't', 'm', 'y', 'o', 'j', 'a', 's', 'l', 'x', 'n', 'i':
+		if neg {
+			d.print("-")
+		}
+		d.print(strconv.FormatUint(v, 10))
+	default:
+		d.fail("unsupported constant type")
+	}
+}
+
+// punycode decodes an identifier encoded with RFC 3492 where _ separates the basic code points.
+func (d *rust) punycode(s string) string {
+	const (
+		base        = 36
+		tmin        = 1
+		tmax        = 26
+		skew        = 38
+		damp        = 700
+		initialBias = 72
+		initialN    = 128
+	)
+	var out []rune
+	if i := strings.LastIndexByte(s, '_'); i >= 0 {
+		out = []rune(s[:i])
+		s = s[i+1:]
+	}
+	n, bias, i := initialN, initialBias, 0
+	adapt := func(delta, points int, first bool) int {
+		if first {
+			delta /= damp
+		} else {
+			delta /= 2
+		}
+		delta += delta / points
+		k := 0
+		for delta > ((base-tmin)*tmax)/2 {
+			delta /= base - tmin
+			k += base
+		}
+		return k + (base-tmin+1)*delta/(delta+skew)
+	}
+	for p := 0; p < len(s); {
+		old, w := i, 1
+		for k := base; ; k += base {
+			if p >= len(s) {
+				d.fail("invalid punycode")
+			}
+			c := s[p]
+			p++
+			var digit int
+			switch {
+			case c >= 'a' && c <= 'z':
+				digit = int(c - 'a')
+			case c >= '0' && c <= '9':
+				digit = int(c-'0') + 26
+			default:
+				d.fail("invalid punycode")
+			}
+			if i += digit * w; i > utf8.MaxRune*(len(out)+1) {
+				d.fail("invalid punycode")
+			}
+			t := k - bias
+			if t < tmin {
+				t = tmin
+			} else if t > tmax {
+				t = tmax
+			}
+			if digit < t {
+				break
+			}
+			if w *= base - t; w > utf8.MaxRune {
+				d.fail("invalid punycode")
+			}
+		}
+		bias = adapt(i-old, len(out)+1, old == 0)
+		n += i / (len(out) + 1)
+		i %= len(out) + 1
+		if n > utf8.MaxRune {
+			d.fail("invalid punycode")
+		}
+		out = append(out[:i], append([]rune{rune(n)}, out[i:]...)...)
+		i++
+	}
+	return string(out)
+}
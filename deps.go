@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import "strings"
+
+// MissingDependencyError is returned when loading a library failed because libraries it depends
+// on, directly or through other dependencies, could not be found. It wraps the error of the
+// dynamic loader which often only names the first missing library without saying who needs it.
+//
+// It is only returned on Linux, FreeBSD and Windows. The error of dyld on macOS already names the
+// missing library and the library that references it.
+type MissingDependencyError struct {
+	// Library is the library that was loaded.
+	Library string
+	// Missing are the dependencies that could not be found in the order they were found missing.
+	Missing []MissingDependency
+	// Err is the error of the dynamic loader.
+	Err error
+}
+
+// MissingDependency is a library that could not be found.
+type MissingDependency struct {
+	// Name is the name the library is referenced by, for example libssl.so.3.
+	Name string
+	// NeededBy is the path of the library that depends on it.
+	NeededBy string
+}
+
+func (e *MissingDependencyError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Err.Error())
+	b.WriteString(" (missing dependencies:")
+	for i, m := range e.Missing {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(" " + m.Name + " needed by " + m.NeededBy)
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func (e *MissingDependencyError) Unwrap() error {
+	return e.Err
+}
+
+// maxDependencies bounds how many libraries are inspected so that diagnosing a library with a
+// huge dependency tree doesn't take long.
+const maxDependencies = 256
+
+// diagnoseDependencies returns a MissingDependencyError wrapping err if some dependencies of the
+// library name can't be found. Otherwise it returns err unchanged. It walks the dependency tree
+// with findLibrary, dependencies and findDependency which implement the search of the dynamic
+// loader of the platform.
+func diagnoseDependencies(name string, err error) error {
+	path, ok := findLibrary(name)
+	if !ok {
+		// the library itself is missing which the error of the loader says already
+		return err
+	}
+	var missing []MissingDependency
+	seen := map[string]bool{path: true}
+	queue := []string{path}
+	for len(queue) > 0 && len(seen) < maxDependencies {
+		lib := queue[0]
+		queue = queue[1:]
+		deps, err := dependencies(lib)
+		if err != nil {
+			continue
+		}
+		for _, d := range deps.names {
+			p, found := findDependency(d, deps)
+			if !found {
+				missing = append(missing, MissingDependency{Name: d, NeededBy: lib})
+				continue
+			}
+			// a library that is already loaded was found by the loader before so its
+			// dependencies were found too
+			if p == "" || seen[p] {
+				continue
+			}
+			seen[p] = true
+			queue = append(queue, p)
+		}
+	}
+	if len(missing) == 0 {
+		return err
+	}
+	return &MissingDependencyError{Library: name, Missing: missing, Err: err}
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+// dyld already reports the missing library and the library that references it
+// so the dependencies aren't diagnosed on macOS.
+
+type libraryDeps struct {
+	names []string
+}
+
+func findLibrary(string) (string, bool) {
+	return "", false
+}
+
+func dependencies(string) (libraryDeps, error) {
+	return libraryDeps{}, nil
+}
+
+func findDependency(string, libraryDeps) (string, bool) {
+	return "", true
+}
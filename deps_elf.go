@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import (
+	"bufio"
+	"debug/elf"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// libraryDeps are the dependencies of an ELF library and what is needed to search for them.
+type libraryDeps struct {
+	names []string
+	// rpath are the directories of DT_RPATH and runpath those of DT_RUNPATH with $ORIGIN expanded.
+	rpath, runpath []string
+	class          elf.Class
+	machine        elf.Machine
+}
+
+// defaultLibraryDirs are searched after the directories of the loader configuration.
+var defaultLibraryDirs = []string{"/lib", "/usr/lib", "/lib64", "/usr/lib64"}
+
+// findLibrary returns the path of the library name passed to Dlopen.
+func findLibrary(name string) (string, bool) {
+	if strings.Contains(name, "/") {
+		_, err := os.Stat(name)
+		return name, err == nil
+	}
+	for _, dir := range searchDirs(libraryDeps{}) {
+		p := filepath.Join(dir, name)
+		if _, err := os.Stat(p); err == nil {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// dependencies returns the DT_NEEDED entries of the ELF file path.
+func dependencies(path string) (libraryDeps, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return libraryDeps{}, err
+	}
+	defer f.Close()
+	names, err := f.ImportedLibraries()
+	if err != nil {
+		return libraryDeps{}, err
+	}
+	deps := libraryDeps{names: names, class: f.Class, machine: f.Machine}
+	origin := filepath.Dir(path)
+	for _, t := range []struct {
+		tag  elf.DynTag
+		dirs *[]string
+	}{
+		{elf.DT_RPATH, &deps.rpath},
+		{elf.DT_RUNPATH, &deps.runpath},
+	} {
+		values, _ := f.DynString(t.tag)
+		for _, v := range values {
+			for _, dir := range filepath.SplitList(v) {
+				dir = strings.NewReplacer("$ORIGIN", origin, "${ORIGIN}", origin).Replace(dir)
+				*t.dirs = append(*t.dirs, dir)
+			}
+		}
+	}
+	return deps, nil
+}
+
+// findDependency returns the path of the dependency name of a library with the dependencies deps.
+// The path is empty if the library is already loaded.
+func findDependency(name string, deps libraryDeps) (string, bool) {
+	if h := fnDlopen(name, RTLD_LAZY|rtldNoload); h != 0 {
+		fnDlclose(h)
+		return "", true
+	}
+	if strings.Contains(name, "/") {
+		return name, compatibleLibrary(name, deps)
+	}
+	for _, dir := range searchDirs(deps) {
+		p := filepath.Join(dir, name)
+		if compatibleLibrary(p, deps) {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// compatibleLibrary reports whether path is an ELF file the loader would load for a library with
+// the dependencies deps. Libraries of another architecture are skipped by the loader.
+func compatibleLibrary(path string, deps libraryDeps) bool {
+	f, err := elf.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	return deps.class == elf.ELFCLASSNONE || (f.Class == deps.class && f.Machine == deps.machine)
+}
+
+// searchDirs returns the directories the loader searches for a dependency of a library with the
+// dependencies deps in order. DT_RPATH is ignored if there is a DT_RUNPATH.
+func searchDirs(deps libraryDeps) []string {
+	var dirs []string
+	if len(deps.runpath) == 0 {
+		dirs = append(dirs, deps.rpath...)
+	}
+	dirs = append(dirs, filepath.SplitList(os.Getenv("LD_LIBRARY_PATH"))...)
+	dirs = append(dirs, deps.runpath...)
+	dirs = append(dirs, loaderConfigDirs()...)
+	return append(dirs, defaultLibraryDirs...)
+}
+
+// loaderConfigDirs returns the directories listed in the configuration of the loader, which is
+// what ldconfig builds the cache of the loader from.
+func loaderConfigDirs() []string {
+	if runtime.GOOS == "freebsd" {
+		// ldconfig_paths of rc.conf defaults to /usr/local/lib and the directories
+		// that packages list in /usr/local/libdata/ldconfig
+		dirs := []string{"/usr/local/lib"}
+		files, _ := filepath.Glob("/usr/local/libdata/ldconfig/*")
+		for _, f := range files {
+			dirs = append(dirs, readLoaderConfig(f, 0)...)
+		}
+		return dirs
+	}
+	return readLoaderConfig("/etc/ld.so.conf", 0)
+}
+
+func readLoaderConfig(path string, depth int) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	var dirs []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "include "):
+			if depth > 8 {
+				continue
+			}
+			pattern := strings.TrimSpace(strings.TrimPrefix(line, "include "))
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(filepath.Dir(path), pattern)
+			}
+			matches, _ := filepath.Glob(pattern)
+			for _, m := range matches {
+				dirs = append(dirs, readLoaderConfig(m, depth+1)...)
+			}
+		case strings.HasPrefix(line, "/"):
+			dirs = append(dirs, line)
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"debug/pe"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// libraryDeps are the DLLs a PE file imports.
+type libraryDeps struct {
+	names   []string
+	machine uint16
+}
+
+// findLibrary returns the path of the DLL name passed to LoadLibrary.
+func findLibrary(name string) (string, bool) {
+	if filepath.Ext(name) == "" {
+		// LoadLibrary appends .dll to names without an extension
+		name += ".dll"
+	}
+	if strings.ContainsAny(name, `\/`) {
+		_, err := os.Stat(name)
+		return name, err == nil
+	}
+	for _, dir := range dllSearchDirs() {
+		p := filepath.Join(dir, name)
+		if _, err := os.Stat(p); err == nil {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// dependencies returns the DLLs imported by the PE file path.
+func dependencies(path string) (libraryDeps, error) {
+	f, err := pe.Open(path)
+	if err != nil {
+		return libraryDeps{}, err
+	}
+	defer f.Close()
+	// pe.File.ImportedLibraries is not implemented but every imported symbol is
+	// returned as "symbol:dll"
+	syms, err := f.ImportedSymbols()
+	if err != nil {
+		return libraryDeps{}, err
+	}
+	deps := libraryDeps{machine: f.Machine}
+	seen := map[string]bool{}
+	for _, s := range syms {
+		i := strings.LastIndexByte(s, ':')
+		if i < 0 {
+			continue
+		}
+		dll := s[i+1:]
+		if key := strings.ToLower(dll); !seen[key] {
+			seen[key] = true
+			deps.names = append(deps.names, dll)
+		}
+	}
+	return deps, nil
+}
+
+// findDependency returns the path of the DLL name imported by a DLL with the dependencies deps.
+// The path is empty if the DLL is already loaded or is provided by Windows itself.
+func findDependency(name string, deps libraryDeps) (string, bool) {
+	lower := strings.ToLower(name)
+	if strings.HasPrefix(lower, "api-ms-win-") || strings.HasPrefix(lower, "ext-ms-") {
+		// API sets are resolved by the loader to the DLLs that implement them
+		return "", true
+	}
+	if p, err := windows.UTF16PtrFromString(name); err == nil {
+		var h windows.Handle
+		if windows.GetModuleHandleEx(windows.GET_MODULE_HANDLE_EX_FLAG_UNCHANGED_REFCOUNT, p, &h) == nil {
+			return "", true
+		}
+	}
+	for _, dir := range dllSearchDirs() {
+		p := filepath.Join(dir, name)
+		if f, err := pe.Open(p); err == nil {
+			// the loader skips DLLs of another architecture
+			ok := f.Machine == deps.machine
+			f.Close()
+			if ok {
+				return p, true
+			}
+		}
+	}
+	return "", false
+}
+
+// dllSearchDirs returns the directories of the standard search order of LoadLibrary. The directory
+// of a DLL is not searched for its dependencies unless it is the directory of the application.
+func dllSearchDirs() []string {
+	var dirs []string
+	if exe, err := os.Executable(); err == nil {
+		dirs = append(dirs, filepath.Dir(exe))
+	}
+	if dir, err := windows.GetSystemDirectory(); err == nil {
+		dirs = append(dirs, dir)
+	}
+	if dir, err := windows.GetWindowsDirectory(); err == nil {
+		dirs = append(dirs, dir)
+	}
+	if dir, err := os.Getwd(); err == nil {
+		dirs = append(dirs, dir)
+	}
+	return append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+}
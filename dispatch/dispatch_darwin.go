@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package dispatch runs Go functions on the main thread or on Grand Central Dispatch queues.
+// AppKit, UIKit and parts of CoreAudio crash when they are called from any thread other than the
+// main thread, which goroutines don't run on unless the main goroutine locks it.
+//
+// Functions only run on the main queue while the main thread serves it. Either call RunMain from
+// the main goroutine or run the event loop of the toolkit, such as [NSApp run], on it:
+//
+//	func init() {
+//		// keep the main goroutine on the main thread
+//		runtime.LockOSThread()
+//	}
+//
+//	func main() {
+//		go program()
+//		dispatch.RunMain()
+//	}
+package dispatch
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+var (
+	dispatch_async_f            func(queue uintptr, context uintptr, work uintptr)
+	dispatch_sync_f             func(queue uintptr, context uintptr, work uintptr)
+	dispatch_queue_create       func(label string, attr uintptr) uintptr
+	dispatch_queue_set_specific func(queue uintptr, key uintptr, context uintptr, destructor uintptr)
+	dispatch_get_specific       func(key uintptr) uintptr
+	dispatch_release            func(object uintptr)
+	dispatch_main               func()
+	pthread_main_np             func() int32
+
+	mainQueue *Queue
+	// work is the C function that GCD calls with the ID of a function in funcs as its context.
+	work uintptr
+)
+
+func init() {
+	lib, err := purego.Dlopen("/usr/lib/libSystem.B.dylib", purego.RTLD_GLOBAL)
+	if err != nil {
+		panic(fmt.Errorf("dispatch: %w", err))
+	}
+	// dispatch_get_main_queue is a macro returning the address of _dispatch_main_q
+	q, err := purego.Dlsym(lib, "_dispatch_main_q")
+	if err != nil {
+		panic(fmt.Errorf("dispatch: %w", err))
+	}
+	mainQueue = &Queue{q: q, main: true}
+	purego.RegisterLibFunc(&dispatch_async_f, lib, "dispatch_async_f")
+	purego.RegisterLibFunc(&dispatch_sync_f, lib, "dispatch_sync_f")
+	purego.RegisterLibFunc(&dispatch_queue_create, lib, "dispatch_queue_create")
+	purego.RegisterLibFunc(&dispatch_queue_set_specific, lib, "dispatch_queue_set_specific")
+	purego.RegisterLibFunc(&dispatch_get_specific, lib, "dispatch_get_specific")
+	purego.RegisterLibFunc(&dispatch_release, lib, "dispatch_release")
+	purego.RegisterLibFunc(&dispatch_main, lib, "dispatch_main")
+	purego.RegisterLibFunc(&pthread_main_np, lib, "pthread_main_np")
+	work = purego.NewCallback(func(id uintptr) {
+		funcs.take(id)()
+	})
+}
+
+// funcTable holds the Go functions submitted to GCD until they run.
+type funcTable struct {
+	sync.Mutex
+	next uintptr
+	m    map[uintptr]func()
+}
+
+var funcs funcTable
+
+// add returns the ID of fn which is passed to work as its context.
+func (f *funcTable) add(fn func()) uintptr {
+	f.Lock()
+	defer f.Unlock()
+	if f.m == nil {
+		f.m = map[uintptr]func(){}
+	}
+	f.next++
+	f.m[f.next] = fn
+	return f.next
+}
+
+func (f *funcTable) take(id uintptr) func() {
+	f.Lock()
+	defer f.Unlock()
+	fn := f.m[id]
+	delete(f.m, id)
+	return fn
+}
+
+// queueKey is the key of the queue specific value which is set to the queue itself so that
+// a queue can tell if it is the current queue. Its address is the key.
+var queueKey byte
+
+// Queue is a serial dispatch queue. A function submitted to a queue runs after the functions
+// submitted before it finished. Queues other than Main don't run every function on the same
+// thread. Use purego.Executor for libraries that require that.
+type Queue struct {
+	q    uintptr
+	main bool
+}
+
+// Main returns the queue of the main thread.
+func Main() *Queue {
+	return mainQueue
+}
+
+// NewQueue returns a new serial queue with the label label which is shown by debuggers
+// and crash reports. It must be released with Release.
+func NewQueue(label string) *Queue {
+	q := &Queue{q: dispatch_queue_create(label, 0)}
+	dispatch_queue_set_specific(q.q, uintptr(unsafe.Pointer(&queueKey)), q.q, 0)
+	return q
+}
+
+// Release releases a queue returned by NewQueue after the functions submitted to it have run.
+func (q *Queue) Release() {
+	if q.main {
+		panic("dispatch: the main queue can't be released")
+	}
+	dispatch_release(q.q)
+}
+
+// Current reports whether the caller runs on q.
+func (q *Queue) Current() bool {
+	if q.main {
+		return pthread_main_np() == 1
+	}
+	return dispatch_get_specific(uintptr(unsafe.Pointer(&queueKey))) == q.q
+}
+
+// Go submits fn to run on q and returns without waiting like dispatch_async.
+// A panic in fn crashes the program like a panic in a goroutine.
+func (q *Queue) Go(fn func()) {
+	dispatch_async_f(q.q, funcs.add(fn), work)
+}
+
+// Call runs fn on q and waits for it to return like dispatch_sync. A panic in fn is propagated
+// to the caller. Calls made on q itself run directly instead of deadlocking.
+func (q *Queue) Call(fn func()) {
+	if q.Current() {
+		fn()
+		return
+	}
+	var p interface{}
+	panicked := true
+	dispatch_sync_f(q.q, funcs.add(func() {
+		defer func() {
+			if panicked {
+				p = recover()
+			}
+		}()
+		fn()
+		panicked = false
+	}), work)
+	if panicked {
+		panic(p)
+	}
+}
+
+// Route replaces the function fptr points to, which is usually one registered with
+// purego.RegisterFunc, with one that calls it on q with Call.
+func (q *Queue) Route(fptr interface{}) {
+	fn := reflect.ValueOf(fptr)
+	if fn.Kind() != reflect.Ptr || fn.Elem().Kind() != reflect.Func {
+		panic("dispatch: fptr must be a function pointer")
+	}
+	fn = fn.Elem()
+	if fn.IsNil() {
+		panic("dispatch: fptr must point to a registered function")
+	}
+	impl := reflect.ValueOf(fn.Interface())
+	ty := fn.Type()
+	fn.Set(reflect.MakeFunc(ty, func(args []reflect.Value) (results []reflect.Value) {
+		q.Call(func() {
+			if ty.IsVariadic() {
+				results = impl.CallSlice(args)
+			} else {
+				results = impl.Call(args)
+			}
+		})
+		return results
+	}))
+}
+
+// RunMain serves the main queue on the main thread and never returns. It must be called from the
+// main goroutine which locked the main thread with runtime.LockOSThread in an init function.
+func RunMain() {
+	if pthread_main_np() != 1 {
+		panic("dispatch: RunMain must be called on the main thread")
+	}
+	dispatch_main()
+}
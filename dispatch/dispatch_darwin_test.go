@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package dispatch_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/jwijenbergh/purego/dispatch"
+)
+
+func TestQueue(t *testing.T) {
+	q := dispatch.NewQueue("purego.test")
+	defer q.Release()
+
+	if q.Current() {
+		t.Errorf("Current is true outside of the queue")
+	}
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(1)
+		q.Go(func() {
+			defer wg.Done()
+			order = append(order, i)
+		})
+	}
+	wg.Wait()
+	for i, v := range order {
+		if i != v {
+			t.Fatalf("functions ran in order %v", order)
+		}
+	}
+
+	var current, nested bool
+	q.Call(func() {
+		current = q.Current()
+		q.Call(func() { nested = true })
+	})
+	if !current || !nested {
+		t.Errorf("Call got Current %t and nested call %t wanted both true", current, nested)
+	}
+
+	onQueue := q.Current
+	q.Route(&onQueue)
+	if !onQueue() {
+		t.Errorf("routed function didn't run on the queue")
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v wanted boom", r)
+			}
+		}()
+		q.Call(func() { panic("boom") })
+	}()
+}
+
+func TestMainQueue(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("releasing the main queue didn't panic")
+		}
+	}()
+	dispatch.Main().Release()
+}
@@ -7,6 +7,8 @@ package purego
 
 import (
 	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/fake"
 )
 
 // Unix Specification for dlfcn.h: https://pubs.opengroup.org/onlinepubs/7908799/xsh/dlfcn.h.html
@@ -33,19 +35,44 @@ func init() {
 // A second call to Dlopen with the same path will return the same handle, but the internal
 // reference count for the handle will be incremented. Therefore, all
 // Dlopen calls should be balanced with a Dlclose call.
+//
+// If the system doesn't permit loading libraries as CanLoadLibraries reports, for example because
+// of a seccomp filter, the returned error matches ErrRestricted. If libraries the library depends on
+// can't be found the error is a *MissingDependencyError that lists them. If the library was built for
+// another C library than the process runs against, such as glibc on Alpine Linux, it is a *LibCError.
 func Dlopen(path string, mode int) (uintptr, error) {
+	if h, ok := fake.Open(path); ok {
+		return h, nil
+	}
 	u := fnDlopen(path, mode)
 	if u == 0 {
-		return 0, Dlerror{fnDlerror()}
+		err := loadError(Dlerror{fnDlerror()})
+		return 0, diagnoseLibC(path, diagnoseDependencies(path, err))
 	}
+	trackResource("library", u, path)
 	return u, nil
 }
 
+// rtldNext is the pseudo-handle RTLD_NEXT for dlsym which is -1 on Linux, macOS and FreeBSD.
+const rtldNext = ^uintptr(0)
+
 // Dlsym takes a "handle" of a dynamic library returned by Dlopen and the symbol name.
 // It returns the address where that symbol is loaded into memory. If the symbol is not found,
 // in the specified library or any of the libraries that were automatically loaded by Dlopen
 // when that library was loaded, Dlsym returns zero.
 func Dlsym(handle uintptr, name string) (uintptr, error) {
+	if u, ok := fake.Symbol(handle, name); ok {
+		return u, nil
+	}
+	if fake.IsHandle(handle) {
+		return 0, Dlerror{"purego: undefined symbol: " + name}
+	}
+	if handle == RTLD_DEFAULT || handle == rtldNext {
+		// the pseudo-handles search every library, fake ones included
+		if u, ok := fake.GlobalSymbol(name); ok {
+			return u, nil
+		}
+	}
 	u := fnDlsym(handle, name)
 	if u == 0 {
 		return 0, Dlerror{fnDlerror()}
@@ -57,9 +84,13 @@ func Dlsym(handle uintptr, name string) (uintptr, error) {
 // If the reference count drops to zero and no other loaded libraries
 // use symbols in it, then the dynamic library is unloaded.
 func Dlclose(handle uintptr) error {
+	if fake.IsHandle(handle) {
+		return nil
+	}
 	if fnDlclose(handle) {
 		return Dlerror{fnDlerror()}
 	}
+	untrackResource("library", handle)
 	return nil
 }
 
@@ -72,6 +103,10 @@ func loadSymbol(handle uintptr, name string) (uintptr, error) {
 	return Dlsym(handle, name)
 }
 
+func closeLibrary(handle uintptr) error {
+	return Dlclose(handle)
+}
+
 // these functions exist in dlfcn_stubs.s and are calling C functions linked to in dlfcn_GOOS.go
 // the indirection is necessary because a function is actually a pointer to the pointer to the code.
 // sadly, I do not know of anyway to remove the assembly stubs entirely because //go:linkname doesn't
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && linux && (mips64 || mips64le)
+
+package purego
+
+// mips64 binaries that use Cgo are always linked externally so the dl functions come
+// from internal/cgo like on the architectures that call C through Cgo instead of from
+// the cgo_import_dynamic directives in dlfcn_nocgo_linux.go.
+import _ "github.com/jwijenbergh/purego/internal/cgo"
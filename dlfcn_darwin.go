@@ -13,7 +13,11 @@ const (
 	RTLD_GLOBAL  = 0x8             // All symbols are available for relocation processing of other modules.
 )
 
+// rtldNoload makes Dlopen only return a handle if the library is already loaded.
+const rtldNoload = 0x10
+
 //go:cgo_import_dynamic purego_dlopen dlopen "/usr/lib/libSystem.B.dylib"
 //go:cgo_import_dynamic purego_dlsym dlsym "/usr/lib/libSystem.B.dylib"
 //go:cgo_import_dynamic purego_dlerror dlerror "/usr/lib/libSystem.B.dylib"
 //go:cgo_import_dynamic purego_dlclose dlclose "/usr/lib/libSystem.B.dylib"
+//go:cgo_import_dynamic purego_dladdr dladdr "/usr/lib/libSystem.B.dylib"
@@ -12,7 +12,5 @@ const (
 	RTLD_GLOBAL  = 0x00100         // All symbols are available for relocation processing of other modules.
 )
 
-//go:cgo_import_dynamic purego_dlopen dlopen "libc.so.7"
-//go:cgo_import_dynamic purego_dlsym dlsym "libc.so.7"
-//go:cgo_import_dynamic purego_dlerror dlerror "libc.so.7"
-//go:cgo_import_dynamic purego_dlclose dlclose "libc.so.7"
+// rtldNoload makes Dlopen only return a handle if the library is already loaded.
+const rtldNoload = 0x02000
@@ -12,3 +12,6 @@ const (
 	RTLD_LOCAL   = 0x00000 // All symbols are not made available for relocation processing by other modules.
 	RTLD_GLOBAL  = 0x00100 // All symbols are available for relocation processing of other modules.
 )
+
+// rtldNoload makes Dlopen only return a handle if the library is already loaded.
+const rtldNoload = 0x00004
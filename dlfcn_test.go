@@ -9,10 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
-	"strings"
 	"testing"
 
 	"github.com/jwijenbergh/purego"
@@ -41,43 +39,61 @@ func TestNestedDlopenCall(t *testing.T) {
 	purego.Dlclose(lib)
 }
 
-func buildSharedLib(compilerEnv, libFile string, sources ...string) error {
-	out, err := exec.Command("go", "env", compilerEnv).Output()
-	if err != nil {
-		return fmt.Errorf("go env %s error: %w", compilerEnv, err)
-	}
-
-	compiler := strings.TrimSpace(string(out))
-	if compiler == "" {
-		return errors.New("compiler not found")
+func TestDlopenNotRestricted(t *testing.T) {
+	if !purego.CanLoadLibraries() {
+		t.Skip("loading libraries is not permitted")
 	}
-
-	var args []string
-	if runtime.GOOS == "freebsd" {
-		args = []string{"-shared", "-Wall", "-Werror", "-fPIC", "-o", libFile}
-	} else {
-		args = []string{"-shared", "-Wall", "-Werror", "-o", libFile}
+	// the message of the loader includes the path so it may contain any text
+	for _, name := range []string{"libdoesnotexist.so", "/nonexistent/Operation not permitted.so"} {
+		_, err := purego.Dlopen(name, purego.RTLD_NOW)
+		if err == nil {
+			t.Fatalf("Dlopen of missing library %q succeeded", name)
+		}
+		if errors.Is(err, purego.ErrRestricted) {
+			t.Errorf("missing library reported as restricted: %v", err)
+		}
+		if !purego.CanLoadLibraries() {
+			t.Errorf("missing library %q made CanLoadLibraries return false", name)
+		}
 	}
+}
 
-	// macOS arm64 can run amd64 tests through Rossetta.
-	// Build the shared library based on the GOARCH and not
-	// the default behavior of the compiler.
+func TestDlopenMissingDependency(t *testing.T) {
 	if runtime.GOOS == "darwin" {
-		var arch string
-		switch runtime.GOARCH {
-		case "arm64":
-			arch = "arm64"
-		case "amd64":
-			arch = "x86_64"
-		default:
-			return fmt.Errorf("unknown macOS architecture %s", runtime.GOARCH)
+		t.Skip("dyld reports missing dependencies itself")
+	}
+	// libdepsa.so needs libdepsb.so next to it which needs the missing libdepsc.so
+	dir := t.TempDir()
+	rpath := "-Wl,-rpath,$ORIGIN"
+	for _, lib := range []struct {
+		name string
+		args []string
+	}{
+		{"c", nil},
+		{"b", []string{"-L" + dir, "-ldepsc", rpath}},
+		{"a", []string{"-L" + dir, "-ldepsb", rpath}},
+	} {
+		src := filepath.Join("libdeps", lib.name+".c")
+		if err := buildSharedLib("CC", filepath.Join(dir, "libdeps"+lib.name+".so"), append([]string{src}, lib.args...)...); err != nil {
+			t.Fatal(err)
 		}
-		args = append(args, "-arch", arch)
 	}
-	cmd := exec.Command(compiler, append(args, sources...)...)
-	if out, err := cmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("compile lib: %w\n%q\n%s", err, cmd, string(out))
+	if err := os.Remove(filepath.Join(dir, "libdepsc.so")); err != nil {
+		t.Fatal(err)
 	}
 
-	return nil
+	libA := filepath.Join(dir, "libdepsa.so")
+	_, err := purego.Dlopen(libA, purego.RTLD_NOW)
+	var missing *purego.MissingDependencyError
+	if !errors.As(err, &missing) {
+		t.Fatalf("Dlopen returned %v, want a *MissingDependencyError", err)
+	}
+	want := []purego.MissingDependency{{Name: "libdepsc.so", NeededBy: filepath.Join(dir, "libdepsb.so")}}
+	if fmt.Sprint(missing.Missing) != fmt.Sprint(want) {
+		t.Errorf("Missing = %v, want %v", missing.Missing, want)
+	}
+	var dlerr purego.Dlerror
+	if !errors.As(err, &dlerr) {
+		t.Errorf("%v doesn't wrap the Dlerror", err)
+	}
 }
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+// LibraryInfo describes a library as the dynamic loader mapped it, which tells
+// exactly which file a program bound against when several versions are installed.
+type LibraryInfo struct {
+	// Path is the path of the file that was loaded.
+	// It is empty for the main program on Linux and FreeBSD.
+	Path string
+	// Base is the address the library is loaded at: the load bias of l_addr in the link map on Linux,
+	// the base address on FreeBSD, the address of the Mach-O header on macOS and the module handle on Windows.
+	Base uintptr
+	// Dynamic is the address of the dynamic section of an ELF library. It is 0 on macOS and Windows.
+	Dynamic uintptr
+}
+
+// Info returns where the library was loaded from. See DlInfo.
+func (l *Library) Info() (LibraryInfo, error) {
+	return DlInfo(l.Handle())
+}
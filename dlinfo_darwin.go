@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"errors"
+	"sync"
+)
+
+var dyldFuncs struct {
+	once           sync.Once
+	err            error
+	imageCount     func() uint32
+	getImageName   func(i uint32) string
+	getImageHeader func(i uint32) uintptr
+}
+
+// DlInfo returns the path and the address of the library handle returned by Dlopen.
+// dyld doesn't map a handle to its image so the images are searched for the one
+// that Dlopen returns the same handle for.
+func DlInfo(handle uintptr) (LibraryInfo, error) {
+	dyldFuncs.once.Do(func() {
+		for _, f := range []struct {
+			fptr interface{}
+			name string
+		}{
+			{&dyldFuncs.imageCount, "_dyld_image_count"},
+			{&dyldFuncs.getImageName, "_dyld_get_image_name"},
+			{&dyldFuncs.getImageHeader, "_dyld_get_image_header"},
+		} {
+			sym, err := Dlsym(RTLD_DEFAULT, f.name)
+			if err != nil {
+				dyldFuncs.err = err
+				return
+			}
+			RegisterFunc(f.fptr, sym)
+		}
+	})
+	if dyldFuncs.err != nil {
+		return LibraryInfo{}, dyldFuncs.err
+	}
+	for i := uint32(0); i < dyldFuncs.imageCount(); i++ {
+		name := dyldFuncs.getImageName(i)
+		h := fnDlopen(name, RTLD_LAZY|rtldNoload)
+		if h == 0 {
+			continue
+		}
+		fnDlclose(h)
+		if h == handle {
+			return LibraryInfo{Path: name, Base: dyldFuncs.getImageHeader(i)}, nil
+		}
+	}
+	return LibraryInfo{}, errors.New("purego: no image is loaded for the handle")
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// RTLD_DI_LINKMAP is the request of dlinfo that returns the link map of a handle.
+const rtldDiLinkmap = 2
+
+// linkMap is the beginning of struct link_map which is the same in glibc, musl and FreeBSD.
+type linkMap struct {
+	addr       uintptr
+	name       *byte
+	ld         uintptr
+	next, prev *linkMap
+}
+
+var dlinfoFuncs struct {
+	once   sync.Once
+	err    error
+	dlinfo func(handle uintptr, request int, info **linkMap) int32
+}
+
+// DlInfo returns the path and the addresses of the library handle returned by Dlopen
+// taken from its entry in the link map of the dynamic loader.
+func DlInfo(handle uintptr) (LibraryInfo, error) {
+	dlinfoFuncs.once.Do(func() {
+		sym, err := Dlsym(RTLD_DEFAULT, "dlinfo")
+		if err != nil {
+			dlinfoFuncs.err = err
+			return
+		}
+		RegisterFunc(&dlinfoFuncs.dlinfo, sym)
+	})
+	if dlinfoFuncs.err != nil {
+		return LibraryInfo{}, dlinfoFuncs.err
+	}
+	var lm *linkMap
+	if dlinfoFuncs.dlinfo(handle, rtldDiLinkmap, &lm) != 0 || lm == nil {
+		return LibraryInfo{}, Dlerror{fnDlerror()}
+	}
+	return LibraryInfo{
+		Path:    strings.GoString(uintptr(unsafe.Pointer(lm.name))),
+		Base:    lm.addr,
+		Dynamic: lm.ld,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestLibraryInfo(t *testing.T) {
+	name, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	lib, err := purego.OpenLibrary(name)
+	if err != nil {
+		t.Fatalf("failed to open %s: %s", name, err)
+	}
+	defer lib.Close()
+	info, err := lib.Info()
+	if err != nil {
+		t.Fatalf("Info failed: %s", err)
+	}
+	want := strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+	if !strings.HasPrefix(strings.ToLower(filepath.Base(info.Path)), strings.ToLower(want)) {
+		t.Errorf("Path got %q wanted the file of %s", info.Path, name)
+	}
+	if info.Base == 0 {
+		t.Errorf("Base is 0")
+	}
+	sym, err := lib.Lookup("strlen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sym <= info.Base {
+		t.Errorf("strlen at %#x is not after the base %#x", sym, info.Base)
+	}
+}
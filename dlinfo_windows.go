@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "golang.org/x/sys/windows"
+
+// DlInfo returns the path and the address of the module handle returned by LoadLibrary.
+func DlInfo(handle uintptr) (LibraryInfo, error) {
+	buf := make([]uint16, windows.MAX_LONG_PATH)
+	n, err := windows.GetModuleFileName(windows.Handle(handle), &buf[0], uint32(len(buf)))
+	if err != nil {
+		return LibraryInfo{}, err
+	}
+	return LibraryInfo{Path: windows.UTF16ToString(buf[:n]), Base: handle}, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "sync"
+
+// Lmid identifies a link-map namespace of the dynamic loader. Every namespace has its own
+// copy of the libraries loaded into it so their global state and symbols are independent.
+type Lmid int
+
+const (
+	LM_ID_BASE  Lmid = 0  // The namespace of the program and the libraries loaded with Dlopen.
+	LM_ID_NEWLM Lmid = -1 // Create a new namespace for the library.
+)
+
+// RTLD_DI_LMID is the request of dlinfo that returns the namespace of a handle.
+const rtldDiLmid = 1
+
+var dlmopenFuncs struct {
+	once    sync.Once
+	err     error
+	dlmopen func(lmid Lmid, path string, mode int) uintptr
+	dlinfo  func(handle uintptr, request int, info *Lmid) int32
+}
+
+// loadDlmopen looks up dlmopen and dlinfo which are only available in glibc.
+func loadDlmopen() error {
+	dlmopenFuncs.once.Do(func() {
+		for _, f := range []struct {
+			fptr interface{}
+			name string
+		}{
+			{&dlmopenFuncs.dlmopen, "dlmopen"},
+			{&dlmopenFuncs.dlinfo, "dlinfo"},
+		} {
+			sym, err := Dlsym(RTLD_DEFAULT, f.name)
+			if err != nil {
+				dlmopenFuncs.err = err
+				return
+			}
+			RegisterFunc(f.fptr, sym)
+		}
+	})
+	return dlmopenFuncs.err
+}
+
+// Dlmopen is like Dlopen but loads the library into the namespace lmid. With LM_ID_NEWLM the
+// library and its dependencies are loaded into a new namespace, which makes it possible to load
+// two versions of a library whose symbols conflict, for example two OpenSSL versions, and register
+// functions against each handle independently with RegisterLibFunc. Further libraries are loaded
+// into the same namespace by passing the Lmid returned by DlNamespace for the handle.
+//
+// RTLD_GLOBAL can't be used with LM_ID_NEWLM. Dlmopen is only supported by glibc and the number of
+// namespaces is limited to 16 by default.
+func Dlmopen(lmid Lmid, path string, mode int) (uintptr, error) {
+	if err := loadDlmopen(); err != nil {
+		return 0, err
+	}
+	u := dlmopenFuncs.dlmopen(lmid, path, mode)
+	if u == 0 {
+		return 0, diagnoseDependencies(path, loadError(Dlerror{fnDlerror()}))
+	}
+	trackResource("library", u, path)
+	return u, nil
+}
+
+// DlNamespace returns the namespace the library handle was loaded into.
+func DlNamespace(handle uintptr) (Lmid, error) {
+	if err := loadDlmopen(); err != nil {
+		return 0, err
+	}
+	var lmid Lmid
+	if dlmopenFuncs.dlinfo(handle, rtldDiLmid, &lmid) != 0 {
+		return 0, Dlerror{fnDlerror()}
+	}
+	return lmid, nil
+}
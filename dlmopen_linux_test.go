@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestDlmopen(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libdlmopen.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libdlmopen", "counter.c")); err != nil {
+		t.Fatal(err)
+	}
+
+	base, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_LOCAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer purego.Dlclose(base)
+	isolated, err := purego.Dlmopen(purego.LM_ID_NEWLM, libFileName, purego.RTLD_NOW|purego.RTLD_LOCAL)
+	if err != nil {
+		t.Skipf("dlmopen isn't supported: %v", err)
+	}
+	defer purego.Dlclose(isolated)
+
+	if lmid, err := purego.DlNamespace(base); err != nil || lmid != purego.LM_ID_BASE {
+		t.Errorf("DlNamespace of the Dlopen handle = %d, %v; want LM_ID_BASE", lmid, err)
+	}
+	lmid, err := purego.DlNamespace(isolated)
+	if err != nil || lmid == purego.LM_ID_BASE {
+		t.Fatalf("DlNamespace of the Dlmopen handle = %d, %v; want a new namespace", lmid, err)
+	}
+	// loading the library again into that namespace returns the same copy
+	again, err := purego.Dlmopen(lmid, libFileName, purego.RTLD_NOW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer purego.Dlclose(again)
+	if again != isolated {
+		t.Errorf("Dlmopen into namespace %d returned a new handle", lmid)
+	}
+
+	var incBase, incIsolated func() int32
+	purego.RegisterLibFunc(&incBase, base, "increment")
+	purego.RegisterLibFunc(&incIsolated, isolated, "increment")
+	incBase()
+	incBase()
+	if got := incIsolated(); got != 1 {
+		t.Errorf("increment in the new namespace returned %d, want 1 as its counter is separate", got)
+	}
+	if got := incBase(); got != 3 {
+		t.Errorf("increment in the base namespace returned %d, want 3", got)
+	}
+}
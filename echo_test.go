@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux) && (amd64 || arm64)
+
+package purego_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/puregotest"
+)
+
+func TestEcho(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libechotest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libechotest", "echo.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type mixed struct {
+		A int8
+		B float64
+		C uint16
+	}
+	type floats struct{ X, Y, Z float32 }
+	type ints struct{ V [3]int32 }
+	for _, v := range []interface{}{
+		int8(0), int16(0), int32(0), int64(0), uint8(0), uint16(0), uint32(0), uint64(0),
+		float32(0), float64(0), false, mixed{}, floats{}, ints{},
+	} {
+		ty := reflect.TypeOf(v)
+		name := map[reflect.Kind]string{reflect.Float32: "float", reflect.Float64: "double"}[ty.Kind()]
+		if name == "" {
+			name = ty.Name()
+		}
+		t.Run(name, func(t *testing.T) {
+			echo := reflect.New(reflect.FuncOf([]reflect.Type{ty}, []reflect.Type{ty}, false))
+			purego.RegisterLibFunc(echo.Interface(), lib, "echo_"+name)
+			puregotest.CheckEcho(t, echo.Elem().Interface(), nil)
+
+			i64, f64 := reflect.TypeOf(int64(0)), reflect.TypeOf(float64(0))
+			in := []reflect.Type{i64, i64, i64, i64, i64, i64, i64, i64, f64, f64, f64, f64, f64, f64, f64, f64, ty}
+			echo = reflect.New(reflect.FuncOf(in, []reflect.Type{ty}, false))
+			purego.RegisterLibFunc(echo.Interface(), lib, "echo_"+name+"_stack")
+			puregotest.CheckEcho(t, echo.Elem().Interface(), nil)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package elfload is a dynamic loader for ELF shared libraries written in Go.
+//
+// It maps a library and the libraries it depends on, applies their relocations and runs their
+// initializers without the dynamic linker of the system, which doesn't exist in statically linked
+// binaries such as those built against musl or as static-pie. The addresses returned by
+// Library.Lookup can be passed to purego.RegisterFunc and a Library is a purego.Resolver:
+//
+//	lib, err := elfload.Open("/opt/app/libfoo.so")
+//	if err != nil {
+//		return err
+//	}
+//	var foo func(int32) int32
+//	purego.RegisterResolverFunc(&foo, lib, "foo")
+//
+// Only what is needed to load self-contained libraries is supported:
+//
+//   - linux/amd64 and linux/arm64.
+//   - all relocations are resolved when the library is opened. Libraries that use thread-local
+//     storage or copy relocations can't be loaded.
+//   - the C library of the system relies on its own dynamic linker and can't be loaded. Symbols
+//     it provides can be supplied with WithExternal, for example from Go callbacks.
+//
+// Symbols are resolved in the order the libraries were loaded starting with the opened library,
+// like the dynamic linker does for a library opened with RTLD_LOCAL. Every call of Open loads
+// its own copy of the library and its dependencies.
+package elfload
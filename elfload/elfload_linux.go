@@ -0,0 +1,552 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build amd64 || arm64
+
+package elfload
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+// kind is how a relocation is computed.
+type kind uint8
+
+const (
+	relocUnsupported kind = iota
+	relocNone             // nothing is done
+	relocRelative         // the load address plus the addend
+	relocSymbol           // the address of the symbol plus the addend
+	relocIndirect         // the return value of the resolver function at the load address plus the addend
+)
+
+// defaultPaths are searched for dependencies after the run path of the library and LD_LIBRARY_PATH.
+var defaultPaths = []string{
+	"/lib/" + multiarch, "/usr/lib/" + multiarch,
+	"/lib64", "/usr/lib64", "/lib", "/usr/lib", "/usr/local/lib",
+}
+
+// Library is a shared library loaded by Open.
+type Library struct {
+	name string
+	mem  []byte  // the mapping of the library
+	bias uintptr // the address of virtual address 0 of the library
+	syms map[string]uintptr
+
+	mu     sync.Mutex
+	closed bool
+	fini   []uintptr // finalizers in the order they are called
+	deps   []*Library
+}
+
+// Option configures Open.
+type Option func(*loader)
+
+// WithSearchPath adds dirs to the directories searched for dependencies. They are searched
+// after the run path of the library and LD_LIBRARY_PATH but before the default directories.
+func WithSearchPath(dirs ...string) Option {
+	return func(ld *loader) {
+		ld.search = append(ld.search, dirs...)
+	}
+}
+
+// WithExternal makes r resolve the symbols that none of the loaded libraries define.
+// The dependencies named in libs, such as "libc.so.6", aren't loaded and their symbols
+// must be provided by r.
+func WithExternal(r purego.Resolver, libs ...string) Option {
+	return func(ld *loader) {
+		ld.external = r
+		for _, lib := range libs {
+			ld.skip[lib] = true
+		}
+	}
+}
+
+// loader holds the state of a single call of Open.
+type loader struct {
+	search   []string
+	external purego.Resolver
+	skip     map[string]bool
+	images   []*image // the loaded libraries in the order symbols are looked up
+	byPath   map[string]*image
+	late     []lateReloc // relocations that call the resolver of an indirect function
+}
+
+// lateReloc is a relocation that is applied once every library is relocated since it calls the
+// resolver of an indirect function which may use the relocated data of its library.
+type lateReloc struct {
+	img      *image
+	off      uint64
+	resolver uintptr // the resolver of an R_*_IRELATIVE relocation or 0 for a symbol
+	name     string  // the indirect function the relocation refers to if resolver is 0
+	addend   uintptr
+}
+
+// image is a library that is being loaded.
+type image struct {
+	lib     *Library
+	file    *elf.File
+	path    string
+	dynsyms []elf.Symbol
+	dyn     map[elf.DynTag][]uint64
+	ifuncs  map[string]bool // the symbols of type STT_GNU_IFUNC whose syms entry is the resolver
+}
+
+// Open loads the shared library at path and the libraries it depends on.
+func Open(path string, opts ...Option) (*Library, error) {
+	ld := &loader{skip: map[string]bool{}, byPath: map[string]*image{}}
+	for _, opt := range opts {
+		opt(ld)
+	}
+	root, err := ld.load(path)
+	if err == nil {
+		err = ld.link()
+	}
+	if err != nil {
+		for _, img := range ld.images {
+			img.lib.unmap()
+			img.file.Close()
+		}
+		return nil, fmt.Errorf("elfload: %s: %w", path, err)
+	}
+	for _, img := range ld.images {
+		img.file.Close()
+	}
+	// the dependencies are initialized before the libraries that need them
+	for i := len(ld.images) - 1; i >= 0; i-- {
+		ld.images[i].initialize()
+	}
+	return root.lib, nil
+}
+
+// load maps the library at path and then the libraries it needs.
+func (ld *loader) load(path string) (*image, error) {
+	if img, ok := ld.byPath[path]; ok {
+		return img, nil
+	}
+	img, err := mapImage(path)
+	if err != nil {
+		return nil, err
+	}
+	ld.byPath[path] = img
+	ld.images = append(ld.images, img)
+	needed, err := img.file.ImportedLibraries()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range needed {
+		if ld.skip[name] {
+			continue
+		}
+		dep, err := ld.find(img, name)
+		if err != nil {
+			return nil, err
+		}
+		depImg, err := ld.load(dep)
+		if err != nil {
+			return nil, err
+		}
+		img.lib.deps = append(img.lib.deps, depImg.lib)
+	}
+	return img, nil
+}
+
+// find returns the path of the library name that img needs.
+func (ld *loader) find(img *image, name string) (string, error) {
+	if strings.Contains(name, "/") {
+		return name, nil
+	}
+	var dirs []string
+	runpath, _ := img.file.DynString(elf.DT_RUNPATH)
+	if len(runpath) == 0 {
+		runpath, _ = img.file.DynString(elf.DT_RPATH)
+	}
+	origin := filepath.Dir(img.path)
+	for _, p := range runpath {
+		for _, dir := range filepath.SplitList(p) {
+			dir = strings.ReplaceAll(dir, "${ORIGIN}", origin)
+			dirs = append(dirs, strings.ReplaceAll(dir, "$ORIGIN", origin))
+		}
+	}
+	dirs = append(dirs, filepath.SplitList(os.Getenv("LD_LIBRARY_PATH"))...)
+	dirs = append(dirs, ld.search...)
+	dirs = append(dirs, defaultPaths...)
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("library %s not found", name)
+}
+
+// mapImage maps the loadable segments of the library at path into memory that stays writable until link is done.
+func mapImage(path string) (*image, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	img := &image{file: f, path: path}
+	if err := img.mapSegments(); err != nil {
+		img.lib.unmap()
+		f.Close()
+		return nil, err
+	}
+	return img, nil
+}
+
+func (img *image) mapSegments() error {
+	f := img.file
+	if f.Class != elf.ELFCLASS64 || f.Data != elf.ELFDATA2LSB || f.Machine != machine {
+		return fmt.Errorf("%s is not a library for this architecture", img.path)
+	}
+	if f.Type != elf.ET_DYN {
+		return fmt.Errorf("%s is not a shared library", img.path)
+	}
+	page := uint64(os.Getpagesize())
+	low, high := ^uint64(0), uint64(0)
+	for _, p := range f.Progs {
+		switch p.Type {
+		case elf.PT_LOAD:
+			if p.Vaddr < low {
+				low = p.Vaddr
+			}
+			if end := p.Vaddr + p.Memsz; end > high {
+				high = end
+			}
+		case elf.PT_TLS:
+			return errors.New("thread-local storage is not supported")
+		}
+	}
+	if high == 0 {
+		return errors.New("no loadable segments")
+	}
+	low &^= page - 1
+	high = (high + page - 1) &^ (page - 1)
+	mem, err := syscall.Mmap(-1, 0, int(high-low), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return err
+	}
+	img.lib = &Library{name: img.path, mem: mem, bias: uintptr(unsafe.Pointer(&mem[0])) - uintptr(low)}
+	for _, p := range f.Progs {
+		if p.Type != elf.PT_LOAD || p.Filesz == 0 {
+			continue
+		}
+		if _, err := p.ReadAt(img.slice(p.Vaddr, p.Filesz), 0); err != nil {
+			return err
+		}
+	}
+	img.dyn, err = dynamic(f)
+	if err != nil {
+		return err
+	}
+	img.dynsyms, err = f.DynamicSymbols()
+	if err != nil && !errors.Is(err, elf.ErrNoSymbols) {
+		return err
+	}
+	img.lib.syms = map[string]uintptr{}
+	img.ifuncs = map[string]bool{}
+	for _, s := range img.dynsyms {
+		if s.Section == elf.SHN_UNDEF {
+			continue
+		}
+		switch elf.ST_BIND(s.Info) {
+		case elf.STB_GLOBAL, elf.STB_WEAK, elf.STB_LOOS: // STB_LOOS is STB_GNU_UNIQUE
+		default:
+			continue
+		}
+		if elf.ST_TYPE(s.Info) == elf.STT_TLS {
+			continue
+		}
+		name := s.Name
+		if i := strings.IndexByte(name, '@'); i >= 0 {
+			name = name[:i]
+		}
+		if _, ok := img.lib.syms[name]; !ok {
+			img.lib.syms[name] = img.lib.bias + uintptr(s.Value)
+			if elf.ST_TYPE(s.Info) == sttGNUIFunc {
+				img.ifuncs[name] = true
+			}
+		}
+	}
+	return nil
+}
+
+// dynamic returns the entries of the dynamic section of f by their tag.
+func dynamic(f *elf.File) (map[elf.DynTag][]uint64, error) {
+	dyn := map[elf.DynTag][]uint64{}
+	for _, p := range f.Progs {
+		if p.Type != elf.PT_DYNAMIC {
+			continue
+		}
+		data := make([]byte, p.Filesz)
+		if _, err := p.ReadAt(data, 0); err != nil {
+			return nil, err
+		}
+		for ; len(data) >= 16; data = data[16:] {
+			tag := elf.DynTag(binary.LittleEndian.Uint64(data))
+			if tag == elf.DT_NULL {
+				break
+			}
+			dyn[tag] = append(dyn[tag], binary.LittleEndian.Uint64(data[8:]))
+		}
+	}
+	return dyn, nil
+}
+
+// slice returns the n bytes of the mapping at the virtual address vaddr of the library.
+func (img *image) slice(vaddr, n uint64) []byte {
+	off := uintptr(vaddr) + img.lib.bias - uintptr(unsafe.Pointer(&img.lib.mem[0]))
+	return img.lib.mem[off : off+uintptr(n)]
+}
+
+// sttGNUIFunc is STT_GNU_IFUNC which older versions of debug/elf don't define.
+const sttGNUIFunc = elf.SymType(10)
+
+// link applies the relocations of every loaded library and then protects their segments.
+// Indirect functions are resolved last like the dynamic loader does so that Lookup and the
+// relocations that refer to them return the implementation instead of the resolver.
+func (ld *loader) link() error {
+	for _, img := range ld.images {
+		if err := ld.relocate(img); err != nil {
+			return err
+		}
+	}
+	for _, img := range ld.images {
+		if err := img.protect(); err != nil {
+			return err
+		}
+	}
+	// the resolvers can only run once the code is executable and the relocations they
+	// apply are to the global offset table which stays writable
+	for _, img := range ld.images {
+		for name := range img.ifuncs {
+			img.lib.syms[name] = purego.ResolveIFunc(img.lib.syms[name])
+		}
+	}
+	for _, r := range ld.late {
+		value := r.resolver
+		if value != 0 {
+			value = purego.ResolveIFunc(value)
+		} else {
+			value, _ = ld.resolve(r.name)
+		}
+		binary.LittleEndian.PutUint64(r.img.slice(r.off, 8), uint64(value+r.addend))
+	}
+	return nil
+}
+
+// resolve returns the address of the symbol name looking it up in the loaded libraries in order and then in the external resolver.
+func (ld *loader) resolve(name string) (uintptr, bool) {
+	for _, img := range ld.images {
+		if addr, ok := img.lib.syms[name]; ok {
+			return addr, true
+		}
+	}
+	if ld.external != nil {
+		if addr, err := ld.external.Lookup(name); err == nil && addr != 0 {
+			return addr, true
+		}
+	}
+	return 0, false
+}
+
+// isIFunc reports whether the symbol name that resolve returns is an indirect function
+// defined by one of the loaded libraries.
+func (ld *loader) isIFunc(name string) bool {
+	for _, img := range ld.images {
+		if _, ok := img.lib.syms[name]; ok {
+			return img.ifuncs[name]
+		}
+	}
+	return false
+}
+
+func (ld *loader) relocate(img *image) error {
+	for _, s := range img.file.Sections {
+		if s.Type != elf.SHT_RELA || s.Flags&elf.SHF_ALLOC == 0 {
+			continue
+		}
+		data, err := s.Data()
+		if err != nil {
+			return err
+		}
+		for ; len(data) >= 24; data = data[24:] {
+			off := binary.LittleEndian.Uint64(data)
+			info := binary.LittleEndian.Uint64(data[8:])
+			addend := binary.LittleEndian.Uint64(data[16:])
+			typ, symIdx := uint32(info), info>>32
+			var value uintptr
+			switch relocKind(typ) {
+			case relocNone:
+				continue
+			case relocRelative:
+				value = img.lib.bias + uintptr(addend)
+			case relocSymbol:
+				// The symbol table of debug/elf doesn't include the null symbol at index 0.
+				if symIdx == 0 || symIdx > uint64(len(img.dynsyms)) {
+					return fmt.Errorf("relocation at %#x has an invalid symbol", off)
+				}
+				sym := img.dynsyms[symIdx-1]
+				name := sym.Name
+				if i := strings.IndexByte(name, '@'); i >= 0 {
+					name = name[:i]
+				}
+				if ld.isIFunc(name) {
+					ld.late = append(ld.late, lateReloc{img: img, off: off, name: name, addend: uintptr(addend)})
+					continue
+				}
+				addr, ok := ld.resolve(name)
+				if !ok {
+					if elf.ST_BIND(sym.Info) != elf.STB_WEAK {
+						return fmt.Errorf("undefined symbol %s", name)
+					}
+					// an undefined weak symbol is zero
+					addend = 0
+				}
+				value = addr + uintptr(addend)
+			case relocIndirect:
+				ld.late = append(ld.late, lateReloc{img: img, off: off, resolver: img.lib.bias + uintptr(addend)})
+				continue
+			default:
+				return fmt.Errorf("unsupported relocation type %d", typ)
+			}
+			binary.LittleEndian.PutUint64(img.slice(off, 8), uint64(value))
+		}
+	}
+	return nil
+}
+
+// protect sets the protection of the pages of the library to that of the segments in them.
+func (img *image) protect() error {
+	page := uint64(os.Getpagesize())
+	base := uint64(uintptr(unsafe.Pointer(&img.lib.mem[0])))
+	prot := make([]int, len(img.lib.mem)/int(page))
+	for _, p := range img.file.Progs {
+		if p.Type != elf.PT_LOAD {
+			continue
+		}
+		var flags int
+		if p.Flags&elf.PF_R != 0 {
+			flags |= syscall.PROT_READ
+		}
+		if p.Flags&elf.PF_W != 0 {
+			flags |= syscall.PROT_WRITE
+		}
+		if p.Flags&elf.PF_X != 0 {
+			flags |= syscall.PROT_EXEC
+		}
+		start := uint64(img.lib.bias) + p.Vaddr - base
+		for i := start / page; i < (start+p.Memsz+page-1)/page; i++ {
+			prot[i] |= flags
+		}
+	}
+	for i := 0; i < len(prot); {
+		j := i + 1
+		for j < len(prot) && prot[j] == prot[i] {
+			j++
+		}
+		if err := syscall.Mprotect(img.lib.mem[uint64(i)*page:uint64(j)*page], prot[i]); err != nil {
+			return err
+		}
+		i = j
+	}
+	return nil
+}
+
+// initialize runs the initializers of the library and records its finalizers.
+func (img *image) initialize() {
+	lib := img.lib
+	for _, fn := range img.dyn[elf.DT_INIT] {
+		purego.SyscallN(lib.bias + uintptr(fn))
+	}
+	for _, fn := range img.array(elf.DT_INIT_ARRAY, elf.DT_INIT_ARRAYSZ) {
+		purego.SyscallN(fn)
+	}
+	fini := img.array(elf.DT_FINI_ARRAY, elf.DT_FINI_ARRAYSZ)
+	for i := len(fini) - 1; i >= 0; i-- {
+		lib.fini = append(lib.fini, fini[i])
+	}
+	for _, fn := range img.dyn[elf.DT_FINI] {
+		lib.fini = append(lib.fini, lib.bias+uintptr(fn))
+	}
+}
+
+// array returns the relocated function pointers of the array whose address and size are the dynamic entries addr and size.
+func (img *image) array(addr, size elf.DynTag) []uintptr {
+	if len(img.dyn[addr]) == 0 || len(img.dyn[size]) == 0 {
+		return nil
+	}
+	data := img.slice(img.dyn[addr][0], img.dyn[size][0])
+	var fns []uintptr
+	for ; len(data) >= 8; data = data[8:] {
+		// entries of 0 and -1 are placeholders and not functions
+		if fn := uintptr(binary.LittleEndian.Uint64(data)); fn != 0 && fn != ^uintptr(0) {
+			fns = append(fns, fn)
+		}
+	}
+	return fns
+}
+
+// Name returns the path the library was loaded from.
+func (l *Library) Name() string {
+	return l.name
+}
+
+// Lookup returns the address of the symbol name defined by the library.
+func (l *Library) Lookup(name string) (uintptr, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return 0, fmt.Errorf("elfload: %s is closed", l.name)
+	}
+	if addr, ok := l.syms[name]; ok {
+		return addr, nil
+	}
+	return 0, fmt.Errorf("elfload: %s: undefined symbol %s", l.name, name)
+}
+
+// Close runs the finalizers of the library and unmaps it and then closes its dependencies.
+// Functions of the library must not be called afterwards.
+func (l *Library) Close() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.closed = true
+	l.mu.Unlock()
+	for _, fn := range l.fini {
+		purego.SyscallN(fn)
+	}
+	err := l.unmap()
+	for _, dep := range l.deps {
+		if e := dep.Close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (l *Library) unmap() error {
+	if l == nil || l.mem == nil {
+		return nil
+	}
+	err := syscall.Munmap(l.mem)
+	l.mem = nil
+	return err
+}
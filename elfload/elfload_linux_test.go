@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build amd64 || arm64
+
+package elfload_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/elfload"
+)
+
+// The libraries don't link the C library since it can't be loaded by elfload.
+const depSource = `
+int dep_value = 40;
+int dep_add(int a) { return a + dep_value; }
+`
+
+const mainSource = `
+extern int dep_add(int a);
+extern int host(int a);
+extern int missing(void) __attribute__((weak));
+
+static int counter;
+static int *counter_ptr = &counter;
+int (*add_ptr)(int) = dep_add;
+
+__attribute__((constructor)) static void init(void) { counter = 2; }
+
+int get_counter(void) { return *counter_ptr; }
+int call_dep(int a) { return dep_add(a); }
+int call_ptr(int a) { return add_ptr(a); }
+int call_host(int a) { return host(a) + 1; }
+int has_missing(void) { return missing != 0; }
+
+static int ifunc_impl(int a) { return a * 3; }
+static void *resolve_ifunc_mul(void) { return ifunc_impl; }
+int ifunc_mul(int a) __attribute__((ifunc("resolve_ifunc_mul")));
+int call_ifunc(int a) { return ifunc_mul(a) + 1; }
+`
+
+// buildLibraries builds libmain.so which needs libdep.so found through its run path.
+func buildLibraries(t *testing.T) string {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+	dir := t.TempDir()
+	build := func(name, src string, args ...string) {
+		t.Helper()
+		c := filepath.Join(dir, name+".c")
+		if err := os.WriteFile(c, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		args = append([]string{"-shared", "-fPIC", "-nostdlib", "-O1", "-o", filepath.Join(dir, "lib"+name+".so"), c}, args...)
+		if out, err := exec.Command(cc, args...).CombinedOutput(); err != nil {
+			t.Fatalf("failed to build lib%s.so: %v\n%s", name, err, out)
+		}
+	}
+	build("dep", depSource)
+	build("main", mainSource, "-L"+dir, "-ldep", "-Wl,-rpath,$ORIGIN", "-Wl,--allow-shlib-undefined")
+	return filepath.Join(dir, "libmain.so")
+}
+
+func TestOpen(t *testing.T) {
+	path := buildLibraries(t)
+	host := purego.NewCallback(func(a int32) int32 { return a * 2 })
+	lib, err := elfload.Open(path, elfload.WithExternal(purego.ResolverFunc(func(name string) (uintptr, error) {
+		if name == "host" {
+			return host, nil
+		}
+		return purego.Dlsym(purego.RTLD_DEFAULT, name)
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lib.Close()
+
+	var getCounter, hasMissing func() int32
+	var callDep, callPtr, callHost, ifuncMul, callIFunc func(int32) int32
+	purego.RegisterResolverFunc(&getCounter, lib, "get_counter")
+	purego.RegisterResolverFunc(&hasMissing, lib, "has_missing")
+	purego.RegisterResolverFunc(&callDep, lib, "call_dep")
+	purego.RegisterResolverFunc(&callPtr, lib, "call_ptr")
+	purego.RegisterResolverFunc(&callHost, lib, "call_host")
+	purego.RegisterResolverFunc(&ifuncMul, lib, "ifunc_mul")
+	purego.RegisterResolverFunc(&callIFunc, lib, "call_ifunc")
+	if got := getCounter(); got != 2 {
+		t.Errorf("get_counter got %d wanted 2 set by the constructor", got)
+	}
+	if got := callDep(2); got != 42 {
+		t.Errorf("call_dep(2) got %d wanted 42", got)
+	}
+	if got := callPtr(3); got != 43 {
+		t.Errorf("call_ptr(3) got %d wanted 43", got)
+	}
+	if got := callHost(5); got != 11 {
+		t.Errorf("call_host(5) got %d wanted 11", got)
+	}
+	if got := hasMissing(); got != 0 {
+		t.Errorf("has_missing got %d wanted 0", got)
+	}
+	// the indirect function is resolved to its implementation instead of the resolver
+	if got := ifuncMul(4); got != 12 {
+		t.Errorf("ifunc_mul(4) got %d wanted 12", got)
+	}
+	if got := callIFunc(4); got != 13 {
+		t.Errorf("call_ifunc(4) got %d wanted 13", got)
+	}
+	if _, err := lib.Lookup("dep_add"); err == nil {
+		t.Errorf("Lookup found dep_add which is defined by the dependency")
+	}
+}
+
+func TestOpenUndefined(t *testing.T) {
+	path := buildLibraries(t)
+	_, err := elfload.Open(path)
+	if err == nil || !strings.Contains(err.Error(), "undefined symbol host") {
+		t.Errorf("got error %v wanted undefined symbol host", err)
+	}
+	_, err = elfload.Open(filepath.Join(filepath.Dir(path), "main.c"))
+	if err == nil {
+		t.Errorf("Open of a C file returned no error")
+	}
+}
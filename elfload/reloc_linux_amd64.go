@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package elfload
+
+import "debug/elf"
+
+const (
+	machine   = elf.EM_X86_64
+	multiarch = "x86_64-linux-gnu"
+)
+
+func relocKind(typ uint32) kind {
+	switch elf.R_X86_64(typ) {
+	case elf.R_X86_64_NONE:
+		return relocNone
+	case elf.R_X86_64_RELATIVE:
+		return relocRelative
+	case elf.R_X86_64_64, elf.R_X86_64_GLOB_DAT, elf.R_X86_64_JMP_SLOT:
+		return relocSymbol
+	case elf.R_X86_64_IRELATIVE:
+		return relocIndirect
+	default:
+		return relocUnsupported
+	}
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package elfload
+
+import "debug/elf"
+
+const (
+	machine   = elf.EM_AARCH64
+	multiarch = "aarch64-linux-gnu"
+)
+
+func relocKind(typ uint32) kind {
+	switch elf.R_AARCH64(typ) {
+	case elf.R_AARCH64_NONE:
+		return relocNone
+	case elf.R_AARCH64_RELATIVE:
+		return relocRelative
+	case elf.R_AARCH64_ABS64, elf.R_AARCH64_GLOB_DAT, elf.R_AARCH64_JUMP_SLOT:
+		return relocSymbol
+	case elf.R_AARCH64_IRELATIVE:
+		return relocIndirect
+	default:
+		return relocUnsupported
+	}
+}
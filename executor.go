@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Executor runs functions on a single OS thread that it owns. Many C libraries such as OpenGL and
+// some audio and UI toolkits must only be called from one thread, while Go moves goroutines
+// between threads freely. Functions submitted to an Executor run one at a time in the order they
+// were submitted.
+//
+// The thread is not the main thread of the process. Libraries that require the main thread must
+// be called from the main goroutine after calling runtime.LockOSThread in an init function.
+type Executor struct {
+	calls     chan func()
+	done      chan struct{}
+	closeOnce sync.Once
+	// goid is the ID of the goroutine locked to the thread.
+	goid uint64
+	// busy is non-zero while a function runs on the thread.
+	busy int32
+	// pending are the functions submitted with Go from the thread itself.
+	// It is only accessed on the thread.
+	pending []func()
+}
+
+// NewExecutor starts an Executor with a new locked OS thread.
+func NewExecutor() *Executor {
+	e := &Executor{
+		calls: make(chan func(), 64),
+		done:  make(chan struct{}),
+	}
+	started := make(chan struct{})
+	go func() {
+		// the thread is never unlocked so that it exits with the goroutine
+		// instead of being reused with whatever state the C library left on it.
+		runtime.LockOSThread()
+		e.goid = curGoroutineID()
+		close(started)
+		defer close(e.done)
+		for fn := range e.calls {
+			atomic.StoreInt32(&e.busy, 1)
+			fn()
+			for len(e.pending) > 0 {
+				fn, e.pending = e.pending[0], e.pending[1:]
+				fn()
+			}
+			atomic.StoreInt32(&e.busy, 0)
+		}
+	}()
+	<-started
+	return e
+}
+
+// onThread reports whether the caller is running on the thread of e, which is the case when a C
+// function called on the thread calls back into Go.
+func (e *Executor) onThread() bool {
+	return atomic.LoadInt32(&e.busy) != 0 && curGoroutineID() == e.goid
+}
+
+// Call runs fn on the thread of e and waits for it to return. A panic in fn is propagated to the
+// caller. Calls made from the thread itself, for example from a callback, run directly so that
+// they don't wait for themselves. Call panics if e was closed.
+func (e *Executor) Call(fn func()) {
+	if e.onThread() {
+		fn()
+		return
+	}
+	var p interface{}
+	panicked := true
+	done := make(chan struct{})
+	e.calls <- func() {
+		defer close(done)
+		defer func() {
+			if panicked {
+				p = recover()
+			}
+		}()
+		fn()
+		panicked = false
+	}
+	<-done
+	if panicked {
+		panic(p)
+	}
+}
+
+// Go submits fn to run on the thread of e and returns without waiting. Functions submitted with Go
+// and Call run in the order they were submitted, except that functions submitted from the thread
+// itself run right after the function that submitted them. A panic in fn crashes the program like
+// a panic in a goroutine. Go panics if e was closed.
+func (e *Executor) Go(fn func()) {
+	if e.onThread() {
+		e.pending = append(e.pending, fn)
+		return
+	}
+	e.calls <- fn
+}
+
+// Route replaces the function fptr points to, which is usually one registered with RegisterFunc,
+// with one that calls it on the thread of e with Call.
+func (e *Executor) Route(fptr interface{}) {
+	fn := reflect.ValueOf(fptr)
+	if fn.Kind() != reflect.Ptr || fn.Elem().Kind() != reflect.Func {
+		panic("purego: fptr must be a function pointer")
+	}
+	fn = fn.Elem()
+	if fn.IsNil() {
+		panic("purego: fptr must point to a registered function")
+	}
+	impl := reflect.ValueOf(fn.Interface())
+	ty := fn.Type()
+	fn.Set(reflect.MakeFunc(ty, func(args []reflect.Value) (results []reflect.Value) {
+		e.Call(func() {
+			if ty.IsVariadic() {
+				results = impl.CallSlice(args)
+			} else {
+				results = impl.Call(args)
+			}
+		})
+		return results
+	}))
+}
+
+// Close stops e after the functions already submitted have run and waits for them.
+// The thread exits afterwards.
+func (e *Executor) Close() {
+	e.closeOnce.Do(func() {
+		close(e.calls)
+	})
+	if !e.onThread() {
+		<-e.done
+	}
+}
+
+// curGoroutineID returns the ID of the current goroutine from its stack trace.
+// It is only used to detect reentrant calls so its cost only matters while the thread is busy.
+func curGoroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
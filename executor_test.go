@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build linux
+
+package purego_test
+
+import (
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestExecutor(t *testing.T) {
+	e := purego.NewExecutor()
+	defer e.Close()
+
+	var tid int
+	e.Call(func() { tid = syscall.Gettid() })
+	if tid == syscall.Gettid() {
+		t.Errorf("executor runs on the thread of the caller")
+	}
+
+	// functions run on the same thread in the order they were submitted
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		i := i
+		wg.Add(1)
+		e.Go(func() {
+			defer wg.Done()
+			if got := syscall.Gettid(); got != tid {
+				t.Errorf("function %d ran on thread %d wanted %d", i, got, tid)
+			}
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	for i, v := range order {
+		if i != v {
+			t.Fatalf("functions ran in order %v", order)
+		}
+	}
+
+	// a function running on the thread can call and submit to the executor
+	var nested []string
+	e.Call(func() {
+		e.Go(func() { nested = append(nested, "go") })
+		e.Call(func() { nested = append(nested, "call") })
+	})
+	e.Call(func() {})
+	if len(nested) != 2 || nested[0] != "call" || nested[1] != "go" {
+		t.Errorf("nested calls ran as %q", nested)
+	}
+
+	// panics are propagated to the caller
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v wanted boom", r)
+			}
+		}()
+		e.Call(func() { panic("boom") })
+	}()
+}
+
+func TestExecutorRoute(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var gettid func() int32
+	purego.RegisterLibFunc(&gettid, libc, "gettid")
+
+	e := purego.NewExecutor()
+	defer e.Close()
+	var want int
+	e.Call(func() { want = syscall.Gettid() })
+
+	e.Route(&gettid)
+	for i := 0; i < 10; i++ {
+		if got := gettid(); int(got) != want {
+			t.Fatalf("routed gettid got thread %d wanted %d", got, want)
+		}
+	}
+}
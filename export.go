@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// ExportVersion is the version of the layout of ExportTable. It is stored in the table
+// so that C code can check it against PUREGO_EXPORTS_VERSION from the header generated
+// by cmd/puregoexport.
+const ExportVersion = 1
+
+// exportEntry matches purego_export in the generated header.
+type exportEntry struct {
+	name      *byte
+	fn        uintptr
+	signature *byte
+}
+
+// exportHeader matches purego_exports in the generated header.
+type exportHeader struct {
+	version uint32
+	count   uint32
+	entries *exportEntry
+}
+
+// ExportTable is a C-compatible table of Go functions that C code can look up by name.
+// Each function is converted into a C function pointer with NewCallback and described by
+// its name and C prototype. The table is handed to C code with Pointer, typically by
+// calling an initialization function of the C library that stores it.
+//
+// The table, its names and signatures are allocated outside of the Go heap and are never freed,
+// like the callbacks, so C code may keep referencing them even after the ExportTable is unreachable.
+// This includes the entries of the table before the last Add, which stay valid but don't hold
+// the functions added later.
+// Use cmd/puregoexport to generate the C header and the code that fills the table.
+type ExportTable struct {
+	mu     sync.Mutex
+	header *exportHeader // in C memory
+	names  []string      // names[i] is the name of the entry i
+}
+
+// NewExportTable returns an empty ExportTable.
+func NewExportTable() *ExportTable {
+	t := &ExportTable{header: (*exportHeader)(cAlloc(unsafe.Sizeof(exportHeader{})))}
+	t.header.version = ExportVersion
+	return t
+}
+
+// Add converts fn to a C function pointer with NewCallback and adds it to the table as name.
+// It panics if fn can't be used with NewCallback, if it takes or returns a struct or an array
+// by value or if name is already in the table. Functions must not be added after the table has
+// been given to C code.
+func (t *ExportTable) Add(name string, fn interface{}) {
+	ty := reflect.TypeOf(fn)
+	if ty == nil || ty.Kind() != reflect.Func {
+		panic("purego: exported value must be a function")
+	}
+	sig := cPrototype(ty)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, n := range t.names {
+		if n == name {
+			panic("purego: function " + name + " is already exported")
+		}
+	}
+	cb := NewCallback(fn)
+	// the entries are copied to a larger array since C only gets the table once it is filled
+	n := len(t.names)
+	entries := unsafe.Slice((*exportEntry)(cAlloc(uintptr(n+1)*unsafe.Sizeof(exportEntry{}))), n+1)
+	copy(entries, t.entries())
+	entries[n] = exportEntry{name: cstring(name), fn: cb, signature: cstring(sig)}
+	t.header.entries, t.header.count = &entries[0], uint32(n+1)
+	t.names = append(t.names, name)
+}
+
+// entries returns the entries of the table. t.mu must be held.
+func (t *ExportTable) entries() []exportEntry {
+	if t.header.entries == nil {
+		return nil
+	}
+	return unsafe.Slice(t.header.entries, t.header.count)
+}
+
+// Lookup returns the C function pointer of the function exported as name.
+// It implements Resolver so that Go code can use the same table as the C code.
+func (t *ExportTable) Lookup(name string) (uintptr, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, n := range t.names {
+		if n == name {
+			return t.entries()[i].fn, nil
+		}
+	}
+	return 0, errors.New("purego: function " + name + " is not exported")
+}
+
+// Pointer returns the address of the C struct purego_exports describing the table.
+func (t *ExportTable) Pointer() uintptr {
+	return uintptr(unsafe.Pointer(t.header))
+}
+
+// cstring copies s into null-terminated C memory that is never freed.
+func cstring(s string) *byte {
+	p := (*byte)(cAlloc(uintptr(len(s)) + 1))
+	copy(unsafe.Slice(p, len(s)), s)
+	return p
+}
+
+// cPrototype returns the C prototype of a function pointer with the Go type ty. For example,
+// func(int32, float64) bool becomes "_Bool (*)(int32_t, double)". It panics if ty takes or returns
+// a struct or an array since the prototype can't name their C type.
+func cPrototype(ty reflect.Type) string {
+	ret := "void"
+	if ty.NumOut() == 1 {
+		ret = cTypeName(ty.Out(0))
+	}
+	params := make([]string, ty.NumIn())
+	for i := range params {
+		params[i] = cTypeName(ty.In(i))
+	}
+	if len(params) == 0 {
+		params = append(params, "void")
+	}
+	return ret + " (*)(" + strings.Join(params, ", ") + ")"
+}
+
+// cTypeName returns the name of the C type that purego converts the Go type ty to.
+func cTypeName(ty reflect.Type) string {
+	switch ty.Kind() {
+	case reflect.Bool:
+		return "_Bool"
+	case reflect.Int8:
+		return "int8_t"
+	case reflect.Int16:
+		return "int16_t"
+	case reflect.Int32:
+		return "int32_t"
+	case reflect.Int64:
+		return "int64_t"
+	case reflect.Int:
+		return "intptr_t"
+	case reflect.Uint8:
+		return "uint8_t"
+	case reflect.Uint16:
+		return "uint16_t"
+	case reflect.Uint32:
+		return "uint32_t"
+	case reflect.Uint64:
+		return "uint64_t"
+	case reflect.Uint, reflect.Uintptr:
+		return "uintptr_t"
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	case reflect.String:
+		return "const char *"
+	case reflect.Struct, reflect.Array:
+		panic("purego: can't export a function that passes " + ty.String() + " by value")
+	default:
+		return "void *"
+	}
+}
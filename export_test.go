@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
+
+package purego_test
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestExportTable(t *testing.T) {
+	table := purego.NewExportTable()
+	table.Add("mul", func(a, b int32) int32 { return a * b })
+	table.Add("neg", func(i int64) int64 { return -i })
+
+	// read the table the same way purego_lookup in the generated header does
+	type entry struct {
+		name      *byte
+		fn        uintptr
+		signature *byte
+	}
+	type header struct {
+		version, count uint32
+		entries        *entry
+	}
+	ptr := table.Pointer()
+	h := *(**header)(unsafe.Pointer(&ptr))
+	if h.version != purego.ExportVersion || h.count != 2 {
+		t.Fatalf("got version %d count %d", h.version, h.count)
+	}
+	entries := unsafe.Slice(h.entries, h.count)
+	var mul func(a, b int32) int32
+	purego.RegisterFunc(&mul, entries[0].fn)
+	if got := mul(6, 7); got != 42 {
+		t.Errorf("mul got %d wanted %d", got, 42)
+	}
+	sig := unsafe.Slice(entries[0].signature, len("int32_t (*)(int32_t, int32_t)"))
+	if string(sig) != "int32_t (*)(int32_t, int32_t)" {
+		t.Errorf("signature got %q", sig)
+	}
+
+	var neg func(int64) int64
+	purego.RegisterResolverFunc(&neg, table, "neg")
+	if got := neg(5); got != -5 {
+		t.Errorf("neg got %d wanted %d", got, -5)
+	}
+}
+
+func TestExportTableOutlivesGo(t *testing.T) {
+	var collected int32
+	ptr := func() uintptr {
+		table := purego.NewExportTable()
+		for _, name := range []string{"a", "b", "c", "d"} {
+			table.Add(name, func(i int32) int32 { return i + 1 })
+		}
+		runtime.SetFinalizer(table, func(*purego.ExportTable) { atomic.StoreInt32(&collected, 1) })
+		return table.Pointer()
+	}()
+	for i := 0; i < 10 && atomic.LoadInt32(&collected) == 0; i++ {
+		runtime.GC()
+	}
+	if atomic.LoadInt32(&collected) == 0 {
+		t.Skip("the ExportTable wasn't collected")
+	}
+	// C code keeps the pointer it was given so the table must not be in the Go heap
+	type entry struct {
+		name      *byte
+		fn        uintptr
+		signature *byte
+	}
+	type header struct {
+		version, count uint32
+		entries        *entry
+	}
+	h := *(**header)(unsafe.Pointer(&ptr))
+	if h.version != purego.ExportVersion || h.count != 4 {
+		t.Fatalf("got version %d count %d", h.version, h.count)
+	}
+	for i, e := range unsafe.Slice(h.entries, h.count) {
+		if name := string(unsafe.Slice(e.name, 2)); name != string(rune('a'+i))+"\x00" {
+			t.Errorf("entry %d has name %q", i, name)
+		}
+		var fn func(int32) int32
+		purego.RegisterFunc(&fn, e.fn)
+		if got := fn(int32(i)); got != int32(i)+1 {
+			t.Errorf("entry %d returned %d wanted %d", i, got, i+1)
+		}
+	}
+}
+
+func TestExportTableAddPanics(t *testing.T) {
+	table := purego.NewExportTable()
+	table.Add("f", func() {})
+	free := purego.FreeCallbacks()
+	for name, fn := range map[string]interface{}{
+		"f": func() {},
+		"g": func(struct{ a, b int64 }) {},
+		"h": func() [2]int32 { return [2]int32{} },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Add(%q) didn't panic", name)
+				}
+			}()
+			table.Add(name, fn)
+		}()
+	}
+	if got := purego.FreeCallbacks(); got != free {
+		t.Errorf("Add took callbacks when it panicked: FreeCallbacks %d wanted %d", got, free)
+	}
+}
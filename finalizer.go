@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// collected holds the functions that run once their owner is collected by the garbage collector.
+// An owner has a single finalizer which runs all of its functions.
+var collected struct {
+	sync.Mutex
+	m map[uintptr][]func() // keyed by the address of the owner
+}
+
+// onCollect runs release once owner is collected. owner must be a pointer to a Go object
+// without a finalizer set with runtime.SetFinalizer.
+func onCollect(owner interface{}, release func()) {
+	v := reflect.ValueOf(owner)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic("purego: owner must be a non-nil pointer")
+	}
+	collected.Lock()
+	defer collected.Unlock()
+	if collected.m == nil {
+		collected.m = map[uintptr][]func(){}
+	}
+	addr := v.Pointer()
+	if _, ok := collected.m[addr]; !ok {
+		runtime.SetFinalizer(owner, runCollected)
+	}
+	collected.m[addr] = append(collected.m[addr], release)
+}
+
+// runCollected is the finalizer of an owner passed to onCollect.
+func runCollected(owner interface{}) {
+	addr := reflect.ValueOf(owner).Pointer()
+	collected.Lock()
+	fns := collected.m[addr]
+	delete(collected.m, addr)
+	collected.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// NewOwnedCallback is like NewCallbackWith but releases the callback once owner is collected by the
+// garbage collector, for example the Go value that wraps the C object the callback is installed on:
+//
+//	w := &Watcher{}
+//	cb := purego.NewOwnedCallback(w, func(event int32) { ... })
+//	watcherSetHandler(w.handle, cb)
+//
+// C must not call the callback after owner is unreachable. fn must not refer to owner since owner
+// would stay reachable through it and never be collected. owner must be a pointer to a Go object
+// which has no finalizer of its own. Callbacks can't be released on Windows so they are kept there.
+func NewOwnedCallback(owner interface{}, fn interface{}, opts ...CallbackOption) uintptr {
+	cb := NewCallbackWith(fn, opts...)
+	onCollect(owner, func() {
+		releaseCallback(cb)
+	})
+	return cb
+}
+
+// CloseOnCollect calls Close once owner is collected by the garbage collector so that the reference
+// taken by OpenLibrary is released without threading a call to Close through the application:
+//
+//	type Client struct{ lib *purego.Library }
+//	lib, err := purego.OpenLibrary("libcurl.so.4")
+//	c := &Client{lib: lib}
+//	lib.CloseOnCollect(c)
+//
+// Functions registered with l must not be called after owner is unreachable. owner must be a pointer
+// to a Go object which has no finalizer of its own. An error returned by Close is ignored.
+func (l *Library) CloseOnCollect(owner interface{}) {
+	onCollect(owner, func() {
+		_ = l.Close()
+	})
+}
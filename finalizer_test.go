@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
+
+package purego_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestOnCollect(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libvartest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libvartest", "var.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// big enough and with a pointer so that it isn't combined with other objects by the allocator
+	type owner struct {
+		p   *int
+		buf [64]byte
+	}
+	o := &owner{}
+	cb := purego.NewOwnedCallback(o, func(x int) int { return x + 1 })
+	lib.CloseOnCollect(o)
+	var call func(x int) int
+	purego.RegisterFunc(&call, cb)
+	if got := call(1); got != 2 {
+		t.Fatalf("owned callback got %d wanted 2", got)
+	}
+	o = nil
+
+	// the library is closed after the callback is released since they have the same owner
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		runtime.GC()
+		reopened, err := purego.OpenLibrary(libFileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reopened.Close()
+		if reopened != lib {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the library wasn't closed after its owner was collected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// the released callback is reused first
+	if got := purego.NewCallback(func() {}); got != cb {
+		t.Errorf("the callback of the collected owner wasn't released")
+	}
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"math"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// maxFloatCB is the number of trampolines for callbacks with float parameters or results on windows/amd64
+// and for callbacks with more parameters than the runtime accepts.
+const maxFloatCB = 512
+
+// maxRuntimeCallbackArgs is the number of word-sized parameters of the largest argument frame
+// syscall.NewCallback accepts on amd64. Callbacks with more take a trampoline which passes
+// the parameters after the fourth as a pointer to the stack of the caller.
+const maxRuntimeCallbackArgs = 64
+
+// floatCallback is a trampoline of floatcallbackasm. It moves the float parameters among the first four
+// from X0 to X3 into the integer registers of their position, calls fn, the callback of the runtime,
+// and copies the result from AX to X0.
+type floatCallback struct {
+	fn     uintptr
+	floats uintptr // bit i is set if parameter i is a float
+	nstack uintptr // number of parameters after the fourth which are copied to the stack of fn
+	frame  uintptr // if not zero the fifth parameter of fn is a pointer to the stack parameters instead
+}
+
+var (
+	// floatCallbackABI0 is the address of floatcallbackasm. It is set by the assembly on amd64.
+	floatCallbackABI0 uintptr
+	// floatCallbacks is read by floatcallbackasm1 to find the trampoline that was called.
+	floatCallbacks [maxFloatCB]floatCallback
+
+	floatCallbacksMu  sync.Mutex
+	numFloatCallbacks int
+)
+
+// hasFloats reports whether a parameter or the result of the function type ty is a float.
+func hasFloats(ty reflect.Type) bool {
+	if ty == nil || ty.Kind() != reflect.Func {
+		return false
+	}
+	for i := 0; i < ty.NumIn(); i++ {
+		if isFloat(ty.In(i)) {
+			return true
+		}
+	}
+	return ty.NumOut() == 1 && isFloat(ty.Out(0))
+}
+
+func isFloat(t reflect.Type) bool {
+	return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+// floatCallbackFunc returns a function that takes and returns the bits of the float parameters and
+// result of fn as uintptr which the runtime accepts and the mask of the float parameters.
+func floatCallbackFunc(fn interface{}) (interface{}, uintptr) {
+	v := reflect.ValueOf(fn)
+	ty := v.Type()
+	in := make([]reflect.Type, ty.NumIn())
+	var floats uintptr
+	for i := range in {
+		in[i] = ty.In(i)
+		if isFloat(in[i]) {
+			in[i] = uintptrType
+			if i < 4 {
+				floats |= 1 << i
+			}
+		}
+	}
+	out := make([]reflect.Type, ty.NumOut())
+	for i := range out {
+		out[i] = ty.Out(i)
+		if isFloat(out[i]) {
+			out[i] = uintptrType
+		}
+	}
+	return reflect.MakeFunc(reflect.FuncOf(in, out, false), func(words []reflect.Value) []reflect.Value {
+		args := make([]reflect.Value, len(words))
+		for i, w := range words {
+			args[i] = w
+			switch t := ty.In(i); t.Kind() {
+			case reflect.Float32:
+				args[i] = reflect.New(t).Elem()
+				args[i].SetFloat(float64(math.Float32frombits(uint32(w.Uint()))))
+			case reflect.Float64:
+				args[i] = reflect.New(t).Elem()
+				args[i].SetFloat(math.Float64frombits(w.Uint()))
+			}
+		}
+		results := v.Call(args)
+		for i, r := range results {
+			switch r.Kind() {
+			case reflect.Float32:
+				results[i] = reflect.ValueOf(uintptr(math.Float32bits(float32(r.Float()))))
+			case reflect.Float64:
+				results[i] = reflect.ValueOf(uintptr(math.Float64bits(r.Float())))
+			}
+		}
+		return results
+	}).Interface(), floats
+}
+
+// frameCallbackFunc returns a function which the runtime accepts however many parameters fn has.
+// It takes the first four parameters of fn as uintptr and a pointer to the rest on the stack of the
+// caller, which is what the trampoline of a floatCallback with frame set passes.
+func frameCallbackFunc(fn interface{}) interface{} {
+	v := reflect.ValueOf(fn)
+	ty := v.Type()
+	in := []reflect.Type{uintptrType, uintptrType, uintptrType, uintptrType, reflect.TypeOf(unsafe.Pointer(nil))}
+	out := make([]reflect.Type, ty.NumOut())
+	for i := range out {
+		out[i] = ty.Out(i)
+	}
+	return reflect.MakeFunc(reflect.FuncOf(in, out, false), func(words []reflect.Value) []reflect.Value {
+		stack := words[4].UnsafePointer()
+		args := make([]reflect.Value, ty.NumIn())
+		for i := range args {
+			var w uintptr
+			if i < 4 {
+				w = uintptr(words[i].Uint())
+			} else {
+				w = *(*uintptr)(unsafe.Add(stack, (i-4)*int(unsafe.Sizeof(w))))
+			}
+			// every parameter is at most a word and the low bytes come first
+			args[i] = reflect.NewAt(ty.In(i), unsafe.Pointer(&w)).Elem()
+		}
+		return v.Call(args)
+	}).Interface()
+}
+
+// newFloatCallback returns the trampoline that calls cb, the runtime callback of a function created
+// by floatCallbackFunc or frameCallbackFunc with numIn parameters of which floats are passed in XMM
+// registers. If frame is true fn was created by frameCallbackFunc.
+func newFloatCallback(cb uintptr, floats uintptr, numIn int, frame bool) uintptr {
+	floatCallbacksMu.Lock()
+	defer floatCallbacksMu.Unlock()
+	if numFloatCallbacks >= maxFloatCB {
+		panic("purego: the maximum number of callbacks with float parameters or many parameters has been reached")
+	}
+	i := numFloatCallbacks
+	floatCallbacks[i] = floatCallback{fn: cb, floats: floats}
+	if frame {
+		floatCallbacks[i].nstack = 1
+		floatCallbacks[i].frame = 1
+	} else if numIn > 4 {
+		floatCallbacks[i].nstack = uintptr(numIn - 4)
+	}
+	numFloatCallbacks++
+	// each trampoline is a 5 byte CALL instruction
+	return floatCallbackABI0 + uintptr(i)*5
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import "reflect"
+
+var (
+	logicalPtrType   = reflect.TypeOf((*int32)(nil))
+	characterType    = reflect.TypeOf((*byte)(nil))
+	characterLenType = reflect.TypeOf(uintptr(0))
+)
+
+// WithFortran makes a function follow the calling convention of Fortran compilers such as gfortran
+// which libraries like BLAS and LAPACK are written in. Integer, float and bool arguments are passed
+// by reference to a copy of the value and a bool is passed as a 4-byte LOGICAL. A string is passed
+// as a CHARACTER argument without a terminating NUL and its length is appended after all the
+// other arguments as a hidden size_t argument. Slices, pointers and uintptr are passed as they are.
+// Since the arguments are copies, values the function writes to them are lost; use pointers or
+// slices for output arguments. The function may return an integer, a float or a bool, but not a
+// CHARACTER or COMPLEX value since these are returned through hidden arguments.
+//
+// Fortran compilers usually append an underscore to the name of the symbol:
+//
+//	// SUBROUTINE DSCAL(N, DA, DX, INCX)
+//	var dscal func(n int32, da float64, dx []float64, incx int32)
+//	purego.RegisterLibFuncWith(&dscal, libblas, "dscal_", purego.WithFortran())
+//
+//	// LOGICAL FUNCTION LSAME(CA, CB)
+//	var lsame func(ca, cb string) bool
+//	purego.RegisterLibFuncWith(&lsame, libblas, "lsame_", purego.WithFortran())
+func WithFortran() FuncOption {
+	return func(cfg *funcConfig) {
+		cfg.fortran = true
+	}
+}
+
+// registerFortran sets fn to a function that passes its arguments to cfn by reference with the lengths
+// of its string arguments appended. cfn is registered as a function that takes the pointers and lengths.
+func registerFortran(fn reflect.Value, cfn uintptr, cfg *funcConfig) {
+	ty := fn.Type()
+	if ty.IsVariadic() {
+		panic("purego: WithFortran doesn't support variadic functions")
+	}
+	if ty.NumOut() > 1 {
+		panic("purego: function can only return zero or one values")
+	}
+	if ty.NumOut() == 1 {
+		switch ty.Out(0).Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64, reflect.Bool:
+		default:
+			panic("purego: WithFortran doesn't support returning " + ty.Out(0).String())
+		}
+	}
+	var in []reflect.Type
+	var strs int
+	for i := 0; i < ty.NumIn(); i++ {
+		arg := ty.In(i)
+		switch arg.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			in = append(in, reflect.PtrTo(arg))
+		case reflect.Bool:
+			in = append(in, logicalPtrType)
+		case reflect.String:
+			if arg == wstringType {
+				panic("purego: WithFortran doesn't support arguments of type " + arg.String())
+			}
+			in = append(in, characterType)
+			strs++
+		case reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer, reflect.Slice:
+			in = append(in, arg)
+		default:
+			panic("purego: WithFortran doesn't support arguments of type " + arg.String())
+		}
+	}
+	for i := 0; i < strs; i++ {
+		in = append(in, characterLenType)
+	}
+	var out []reflect.Type
+	if ty.NumOut() == 1 {
+		out = append(out, ty.Out(0))
+	}
+	call := reflect.New(reflect.FuncOf(in, out, false))
+	cfg.fortran = false
+	// the debug info describes the Fortran arguments rather than the pointers passed to C
+	cfg.checkDebugInfo = false
+	registerFunc(call.Interface(), cfn, cfg)
+	call = call.Elem()
+	fn.Set(reflect.MakeFunc(ty, func(args []reflect.Value) []reflect.Value {
+		cargs := make([]reflect.Value, 0, len(in))
+		var lens []reflect.Value
+		for _, v := range args {
+			switch v.Kind() {
+			case reflect.Bool:
+				var logical int32
+				if v.Bool() {
+					logical = 1
+				}
+				cargs = append(cargs, reflect.ValueOf(&logical))
+			case reflect.String:
+				// a CHARACTER argument always needs an address even if it is empty
+				b := append([]byte(v.String()), 0)
+				cargs = append(cargs, reflect.ValueOf(&b[0]))
+				lens = append(lens, reflect.ValueOf(uintptr(v.Len())))
+			case reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer, reflect.Slice:
+				cargs = append(cargs, v)
+			default:
+				p := reflect.New(v.Type())
+				p.Elem().Set(v)
+				cargs = append(cargs, p)
+			}
+		}
+		return call.Call(append(cargs, lens...))
+	}))
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestWithFortran(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libfortrantest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libfortrantest", "fortran.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("failed to dlopen %s: %s", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var dscal func(n int32, da float64, dx []float64, incx int32)
+	purego.RegisterLibFuncWith(&dscal, lib, "dscal_", purego.WithFortran())
+	dx := []float64{1, 2, 3, 4}
+	dscal(2, 21, dx, 2)
+	if want := []float64{21, 2, 63, 4}; dx[0] != want[0] || dx[1] != want[1] || dx[2] != want[2] || dx[3] != want[3] {
+		t.Errorf("dscal = %v, want %v", dx, want)
+	}
+
+	var lsame func(ca, cb string) bool
+	purego.RegisterLibFuncWith(&lsame, lib, "lsame_", purego.WithFortran())
+	if !lsame("N", "n") {
+		t.Errorf("lsame(N, n) = false, want true")
+	}
+	if lsame("N", "T") {
+		t.Errorf("lsame(N, T) = true, want false")
+	}
+
+	var count func(s string, skip bool) int32
+	purego.RegisterLibFuncWith(&count, lib, "count_", purego.WithFortran())
+	if got := count("a b c", false); got != 3 {
+		t.Errorf("count(a b c, false) = %d, want 3", got)
+	}
+	if got := count("a b c", true); got != 0 {
+		t.Errorf("count(a b c, true) = %d, want 0", got)
+	}
+	if got := count("", false); got != 0 {
+		t.Errorf("count('', false) = %d, want 0", got)
+	}
+}
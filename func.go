@@ -6,11 +6,19 @@
 package purego
 
 import (
+	"context"
+	"errors"
 	"math"
 	"reflect"
 	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 	"unsafe"
 
+	"github.com/jwijenbergh/purego/internal/fake"
 	"github.com/jwijenbergh/purego/internal/strings"
 )
 
@@ -21,7 +29,25 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 	if err != nil {
 		panic(err)
 	}
-	RegisterFunc(fptr, sym)
+	registerFunc(fptr, sym, &funcConfig{name: name, handle: handle})
+}
+
+// RegisterLibFuncE is like RegisterLibFunc but returns an error instead of panicking.
+func RegisterLibFuncE(fptr interface{}, handle uintptr, name string) (err error) {
+	defer recoverError(&err)
+	RegisterLibFunc(fptr, handle, name)
+	return nil
+}
+
+// RegisterLibFuncWith is like RegisterLibFunc but applies opts to the function.
+func RegisterLibFuncWith(fptr interface{}, handle uintptr, name string, opts ...FuncOption) {
+	sym, err := loadSymbol(handle, name)
+	if err != nil {
+		panic(err)
+	}
+	cfg := newFuncConfig(name, opts)
+	cfg.handle = handle
+	registerFunc(fptr, sym, cfg)
 }
 
 // RegisterFunc takes a pointer to a Go function representing the calling convention of the C function.
@@ -29,6 +55,8 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 // parameters passed in the correct registers and stack.
 //
 // A panic is produced if the type is not a function pointer or if the function returns more than 1 value.
+// fptr can point to any func variable including a field of a struct, such as a binding whose methods
+// call the C functions registered into its fields.
 //
 // These conversions describe how a Go type in the fptr will be used to call
 // the C function. It is important to note that there is no way to verify that fptr
@@ -38,6 +66,7 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 // # Type Conversions (Go <=> C)
 //
 //	string <=> char*
+//	WString <=> wchar_t*
 //	bool <=> _Bool
 //	uintptr <=> uintptr_t
 //	uint <=> uint32_t or uint64_t
@@ -52,15 +81,21 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 //	int64 <=> int64_t
 //	float32 <=> float (WIP)
 //	float64 <=> double (WIP)
-//	struct <=> struct (WIP)
+//	struct <=> struct
 //	func <=> C function
 //	unsafe.Pointer, *T <=> void*
 //	[]T => void*
+//	[]string, []*T <=> NULL-terminated char**, T** (see WithNullTerminatedArrays)
+//	SizedBytes => void*, size_t
+//	CMarshaler => the integer or pointer MarshalC returns
+//	CUnmarshaler <= the integer or pointer passed to UnmarshalC
 //
 // There is a special case when the last argument of fptr is a variadic interface (or []interface}
 // it will be expanded into a call to the C function as if it had the arguments in that slice.
 // This means that using arg ...interface{} is like a cast to the function with the arguments inside arg.
-// This is not the same as C variadic.
+// This is not the same as C variadic unless the function is registered with WithVariadic.
+//
+// A first parameter of type context.Context isn't passed to C. It traces the call, see WithCorrelationID.
 //
 // # Memory
 //
@@ -68,6 +103,12 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 // calling functions using RegisterFunc. For arguments to a C function it is important that the C function doesn't
 // hold onto a reference to Go memory. This is the same as the [Cgo rules].
 //
+// Every Go pointer, slice and string passed as an argument, along with any Go memory reachable from it
+// such as the fields of a struct it points to, is kept alive until the C function returns. So are the
+// pointers and slices in the fields of a struct passed by value even though C gets a copy. There is no
+// need to call runtime.KeepAlive on arguments after the call. This does not apply to a Go pointer that
+// was converted to a uintptr before the call since purego can't know that the uintptr is a pointer.
+//
 // However, there are some special cases. When passing a string as an argument if the string does not end in a null
 // terminated byte (\x00) then the string will be copied into memory maintained by purego. The memory is only valid for
 // that specific call. Therefore, if the C code keeps a reference to that string it may become invalid at some
@@ -76,10 +117,52 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 // This can be done using runtime.KeepAlive or allocating the string in C memory using malloc. When a C function
 // returns a null-terminated pointer to char a Go string can be used. Purego will allocate a new string in Go memory
 // and copy the data over. This string will be garbage collected whenever Go decides it's no longer referenced.
-// This C created string will not be freed by purego. If the pointer to char is not null-terminated or must continue
-// to point to C memory (because it's a buffer for example) then use a pointer to byte and then convert that to a slice
-// using unsafe.Slice. Doing this means that it becomes the responsibility of the caller to care about the lifetime
-// of the pointer
+// This C created string will not be freed by purego unless the function is registered with WithStringReturn
+// and Owned, which frees it with the given free function once it has been copied. If the pointer to char is not
+// null-terminated or must continue to point to C memory (because it's a buffer for example) then use a pointer to
+// byte and then convert that to a slice using unsafe.Slice. Doing this means that it becomes the responsibility of
+// the caller to care about the lifetime of the pointer
+//
+// A struct is passed by value like C does, so a large struct such as a struct of options can be declared
+// as it is in C instead of taking its address. Structs that don't fit in registers are copied to a temporary
+// which is passed by address on arm64 and Windows and copied onto the stack on amd64 System V platforms.
+// Either way C gets a copy that it may change without affecting the Go value.
+//
+// A pointer to a struct whose C layout differs from its Go layout, such as a struct with `purego:"packed"`
+// or `purego:"align(N)"` tags (see Sizeof), is passed as a pointer to a copy in the C layout. The copy is
+// written back to the Go struct when the call returns so C must not keep a reference to it.
+// Use NewStructHandle for a struct that C keeps a pointer to.
+//
+// This makes a pointer to a struct usable as an out-parameter of a C function that fills in a struct:
+//
+//	// int stat(const char *path, struct stat *buf);
+//	var stat func(path string, buf *Stat) int32
+//
+// The struct must have a C representation, otherwise RegisterFunc panics. A struct with a field of a type
+// such as a string, slice, map or interface can't be passed by pointer; use unsafe.Pointer to pass such a
+// struct to C as an opaque pointer.
+//
+// A pointer to a pointer such as **T or *unsafe.Pointer is an out-parameter for the common pattern of
+// a C function that allocates an object and returns it through its last argument:
+//
+//	// int widget_create(int id, widget **out);
+//	var widgetCreate func(id int32, out **Widget) int32
+//	var w *Widget
+//	widgetCreate(1, &w)
+//
+// C gets the address of a temporary that holds the current value of the pointer, and the pointer C stores
+// in it is written to the Go pointer when the call returns. The Go pointer is never written by C so the
+// garbage collector stays consistent even if the Go pointer is in the heap. C must not keep the address of
+// the out-parameter. T must have the same layout in C and Go since it's read from C memory.
+// A typed handle such as a *uintptr works as an out-parameter as is.
+//
+// A func argument or struct field is passed as a callback created with NewCallback. Passing the same
+// func value again reuses its callback. Closures and method values such as obj.Method are func values
+// too: closures that capture different variables and method values of different receivers get
+// distinct callbacks even though they share their code. Every evaluation of a func literal or method
+// value creates a new func value though, so passing obj.Method on every call creates a callback per call
+// which stays alive with its receiver. Store it in a variable once or use WithFuncArgs to release the
+// callbacks. A C function pointer copied back into a func field becomes a Go func that calls it.
 //
 // # Example
 //
@@ -99,17 +182,276 @@ func RegisterLibFunc(fptr interface{}, handle uintptr, name string) {
 //
 // [Cgo rules]: https://pkg.go.dev/cmd/cgo#hdr-Go_references_to_C
 func RegisterFunc(fptr interface{}, cfn uintptr) {
+	registerFunc(fptr, cfn, &funcConfig{})
+}
+
+// RegisterFuncE is like RegisterFunc but returns an error instead of panicking if fptr
+// can't be registered, which lets programs that load plugins report a bad binding
+// instead of crashing.
+func RegisterFuncE(fptr interface{}, cfn uintptr) (err error) {
+	defer recoverError(&err)
+	RegisterFunc(fptr, cfn)
+	return nil
+}
+
+// recoverError turns the panic of a function of purego that was called with invalid arguments into an error.
+// It must be deferred. Runtime errors are bugs and keep panicking.
+func recoverError(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if _, ok := r.(runtime.Error); ok {
+		panic(r)
+	}
+	switch r := r.(type) {
+	case error:
+		*err = r
+	case string:
+		*err = errors.New(r)
+	default:
+		panic(r)
+	}
+}
+
+// RegisterFuncWith is like RegisterFunc but applies opts to the function.
+func RegisterFuncWith(fptr interface{}, cfn uintptr, opts ...FuncOption) {
+	registerFunc(fptr, cfn, newFuncConfig("", opts))
+}
+
+// SizedBytes is a byte slice that is passed to C as two arguments: a pointer to its first
+// element and its length as a size_t. It matches the many C functions that take a buffer
+// and its size, for example
+//
+//	// ssize_t write(int fd, const void *buf, size_t count);
+//	var write func(fd int32, buf purego.SizedBytes) int
+//	write(1, purego.SizedBytes("hello\n"))
+//
+// The pointer is nil for a nil slice. Like other slices the memory must not be used by C after the call returns.
+type SizedBytes []byte
+
+var sizedBytesType = reflect.TypeOf(SizedBytes(nil))
+
+var interfaceSliceType = reflect.TypeOf([]interface{}(nil))
+
+// FuncOption changes how a function registered with RegisterFuncWith,
+// RegisterLibFuncWith or Library.RegisterFuncWith converts its arguments and return value.
+type FuncOption func(*funcConfig)
+
+// WithStringReturn sets who owns the char* returned by a function with a string return value:
+//
+//   - Borrowed: the C library keeps ownership. The string is copied into Go memory. This is the default.
+//   - Owned: the caller takes ownership. The string is copied into Go memory and the char* is then
+//     released by calling free with it, for example the address of free from libc.
+//   - Aliased: the C library keeps ownership and the string refers to the C memory without copying it.
+//     The memory must not change or be freed while the string is used.
+//
+// To get the char* itself declare the return value as a pointer or uintptr instead of a string.
+// WithStringReturn panics if own is Owned and free is 0 or if own is Retained.
+func WithStringReturn(own Ownership, free uintptr) FuncOption {
+	switch own {
+	case Borrowed, Aliased:
+	case Owned:
+		if free == 0 {
+			panic("purego: owned string return needs a free function")
+		}
+	default:
+		panic("purego: invalid ownership for a string return: " + own.String())
+	}
+	return func(cfg *funcConfig) {
+		cfg.stringReturn = own
+		cfg.stringFree = free
+	}
+}
+
+// WithNegativeErrno makes a function follow the convention of the Linux kernel and of libraries
+// such as liburing where a negative return value is the negated errno of the failure.
+// The function must return a signed integer and an error, or only an error if the C function
+// returns an int. A negative return value is converted into a syscall.Errno error and the
+// integer is returned as zero:
+//
+//	// int io_uring_submit(struct io_uring *ring);
+//	var submit func(ring unsafe.Pointer) (int32, error)
+//	purego.RegisterLibFuncWith(&submit, liburing, "io_uring_submit", purego.WithNegativeErrno())
+func WithNegativeErrno() FuncOption {
+	return func(cfg *funcConfig) {
+		cfg.negativeErrno = true
+	}
+}
+
+// WithVariadic makes the ...interface{} parameter of a function the variadic arguments of a C function
+// such as printf. Without it the arguments are passed as if the C function had been declared with their
+// types which only differs on darwin/arm64 where Apple's ABI passes variadic arguments on the stack
+// even if there are registers left. Like in C, float32 arguments are promoted to double.
+//
+//	// int snprintf(char *str, size_t size, const char *format, ...);
+//	var snprintf func(str []byte, size uintptr, format string, args ...interface{}) int32
+//	purego.RegisterLibFuncWith(&snprintf, libc, "snprintf", purego.WithVariadic())
+func WithVariadic() FuncOption {
+	return func(cfg *funcConfig) {
+		cfg.variadic = true
+	}
+}
+
+// newFuncConfig returns the settings of the function called name with opts applied.
+func newFuncConfig(name string, opts []FuncOption) *funcConfig {
+	cfg := &funcConfig{name: name}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// funcConfig holds the settings of a single function registered with registerFunc.
+type funcConfig struct {
+	// library is the Library the function was registered through, if any.
+	// Its init functions are run before every call that finds them pending.
+	library *Library
+	// name is the name of the C function used in trace events and call metrics.
+	name string
+	// sym is the address of the C function. If rebindable it is read on every call and accessed
+	// atomically since Library.Rebind and ProcTable.Resolve change it while the function may be called.
+	sym        uintptr
+	rebindable bool
+	// typ is the Go type of the function.
+	typ reflect.Type
+	// handle is the library handle the symbol of a function registered with RegisterLibFunc was looked up in.
+	handle uintptr
+	// entry is where the calls are counted and the function is listed in RegisteredFuncs.
+	// It is nil if unlisted, which is the case for the functions purego registers for the
+	// C function pointers it converts since there is a new one for every pointer.
+	entry    *registryEntry
+	unlisted bool
+
+	// stringReturn is who owns a returned char* and stringFree releases it if it is Owned.
+	stringReturn Ownership
+	stringFree   uintptr
+
+	// negativeErrno converts a negative return value into a syscall.Errno error.
+	negativeErrno bool
+
+	// gErrorFree is g_error_free if a GError ** is appended to the arguments and converted into an error.
+	gErrorFree uintptr
+
+	// fortran passes the arguments by reference and appends the lengths of strings.
+	fortran bool
+
+	// checkDebugInfo compares the function with the DWARF of the C function.
+	checkDebugInfo bool
+
+	// nullTerminated passes []*T arguments as NULL-terminated arrays.
+	nullTerminated bool
+
+	// variadic passes the arguments of the ...interface{} parameter as C variadic arguments.
+	variadic bool
+
+	// funcArgs is the lifetime of the callbacks Go func arguments are converted into.
+	funcArgs CallbackLifetime
+
+	// blockedSignals are the signals blocked on the thread during a call or nil.
+	blockedSignals *signalSet
+
+	// guarded calls the function after setjmp with the *JmpBuf argument, see JmpBuf.
+	guarded bool
+
+	// stats are the call metrics which are looked up the first time a call is measured.
+	statsOnce sync.Once
+	stats     *latencyStats
+}
+
+// callMetrics returns the histogram to record a call of cfn in or nil if metrics aren't collected.
+func (cfg *funcConfig) callMetrics(cfn uintptr) *latencyStats {
+	if atomic.LoadInt32(&callMetricsEnabled) == 0 {
+		return nil
+	}
+	cfg.statsOnce.Do(func() {
+		name := cfg.name
+		if name == "" {
+			name = "0x" + strconv.FormatUint(uint64(cfn), 16)
+		}
+		cfg.stats = callMetricsFor(name)
+	})
+	return cfg.stats
+}
+
+func registerFunc(fptr interface{}, cfn uintptr, cfg *funcConfig) {
+	if cfg.entry != nil || cfg.unlisted {
+		makeFunc(fptr, cfn, cfg)
+		return
+	}
+	// the function is listed with the type of fptr once it passed every check, so the functions
+	// that the wrappers like registerGuarded register for it aren't listed themselves
+	cfg.unlisted = true
+	makeFunc(fptr, cfn, cfg)
+	cfg.unlisted = false
+	cfg.entry = register(cfg, reflect.TypeOf(fptr).Elem(), cfn)
+}
+
+// makeFunc sets the function fptr points to to a function that calls cfn as configured by cfg.
+func makeFunc(fptr interface{}, cfn uintptr, cfg *funcConfig) {
 	fn := reflect.ValueOf(fptr).Elem()
 	ty := fn.Type()
 	if ty.Kind() != reflect.Func {
 		panic("purego: fptr must be a function pointer")
 	}
+	if cfg.gErrorFree != 0 {
+		registerGError(fn, cfn, cfg)
+		return
+	}
+	if cfg.fortran {
+		registerFortran(fn, cfn, cfg)
+		return
+	}
+	if cfg.negativeErrno {
+		registerNegativeErrno(fn, cfn, cfg)
+		return
+	}
+	if !cfg.guarded && jmpBufParam(ty) >= 0 {
+		registerGuarded(fn, cfn, cfg)
+		return
+	}
 	if ty.NumOut() > 1 {
 		panic("purego: function can only return zero or one values")
 	}
+	if cfg.checkDebugInfo {
+		checkDebugInfo(cfg, ty)
+	}
+	if cfg.variadic && (!ty.IsVariadic() || ty.In(ty.NumIn()-1) != interfaceSliceType) {
+		panic("purego: WithVariadic needs a function whose last parameter is ...interface{}")
+	}
+	if cfg.stringReturn != Borrowed && (ty.NumOut() == 0 || ty.Out(0).Kind() != reflect.String) {
+		panic("purego: WithStringReturn needs a function that returns a string")
+	}
 	if cfn == 0 {
 		panic("purego: cfn is nil")
 	}
+	cfg.sym = cfn
+	cfg.typ = ty
+	swift := swiftArgs(ty)
+	vector := vectorArgs(ty)
+	if f, ok := fake.Func(cfn); ok {
+		// cfn is a fake symbol from package puregotest so call the Go function directly
+		if f.Type() != ty {
+			panic("purego: fake symbol has type " + f.Type().String() + " but fptr has type " + ty.String())
+		}
+		if cfg.rebindable {
+			f = reflect.MakeFunc(ty, func(args []reflect.Value) []reflect.Value {
+				if cfg.library != nil {
+					cfg.library.runInits()
+				}
+				impl, ok := fake.Func(atomic.LoadUintptr(&cfg.sym))
+				if !ok {
+					panic("purego: " + cfg.name + " is not resolved")
+				}
+				if ty.IsVariadic() {
+					return impl.CallSlice(args)
+				}
+				return impl.Call(args)
+			})
+		}
+		fn.Set(f)
+		return
+	}
 	{
 		// this code checks how many registers and stack this function will use
 		// to avoid crashing with too many arguments
@@ -118,6 +460,48 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 		var stack int
 		for i := 0; i < ty.NumIn(); i++ {
 			arg := ty.In(i)
+			if i == 0 && arg == contextType {
+				continue
+			}
+			if arg == swiftSelfType || arg == swiftErrorPtrType || arg == arenaType || arg == jmpBufPtrType {
+				// passed in registers of their own
+				continue
+			}
+			if arg == vec128Type {
+				if floats >= numOfFloats {
+					panic("purego: too many vector and float arguments for the float registers: " + ty.String())
+				}
+				floats++
+				continue
+			}
+			if isCMarshaler(arg) {
+				// passed as the integer MarshalC returns
+				if ints < numOfIntegerRegisters() {
+					ints++
+				} else {
+					stack++
+				}
+				continue
+			}
+			// 64-bit values take two stack slots on 32-bit platforms
+			slots := 1
+			if is32bit && arg.Size() == 8 {
+				slots = 2
+			}
+			if arg.Kind() == reflect.Ptr && arg.Elem().Kind() == reflect.Struct {
+				checkStructPointer(arg)
+			}
+			if arg.Kind() == reflect.Ptr && arg.Elem().Kind() == reflect.Ptr {
+				checkOutParam(arg)
+			}
+			if arg == sizedBytesType {
+				// the length is an extra argument after the pointer
+				if ints < numOfIntegerRegisters() {
+					ints++
+				} else {
+					stack++
+				}
+			}
 			switch arg.Kind() {
 			case reflect.String, reflect.Uintptr, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
 				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Ptr, reflect.UnsafePointer, reflect.Slice,
@@ -125,24 +509,81 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 				if ints < numOfIntegerRegisters() {
 					ints++
 				} else {
-					stack++
+					stack += slots
 				}
 			case reflect.Float32, reflect.Float64:
-				if floats < numOfFloats {
+				if positionalArgs {
+					// floats take the slot of an integer register
+					if ints < numOfIntegerRegisters() {
+						ints++
+					} else {
+						stack += slots
+					}
+				} else if floats < numOfFloats && !is32bit {
 					floats++
 				} else {
-					stack++
+					stack += slots
 				}
+			case reflect.Struct:
+				checkStruct(arg)
 			default:
 				panic("purego: unsupported kind " + arg.Kind().String())
 			}
 		}
+		if ty.NumOut() == 1 && !isCUnmarshaler(ty.Out(0)) {
+			switch ty.Out(0).Kind() {
+			case reflect.Struct:
+				checkStruct(ty.Out(0))
+			case reflect.Slice:
+				if !isNullTerminatedSlice(ty.Out(0)) {
+					panic("purego: only NULL-terminated arrays of pointers or strings can be returned as slices: " + ty.String())
+				}
+			case reflect.Float32, reflect.Float64:
+				if is32bit && (runtime.GOOS != "windows" || runtime.GOARCH != "386") {
+					// the result is in the x87 register ST0 which is only saved by syscall9X on windows/386
+					panic("purego: float returns are not supported on " + runtime.GOOS + "/" + runtime.GOARCH)
+				}
+			}
+		}
 		sizeOfStack := maxArgs - numOfIntegerRegisters()
 		if stack > sizeOfStack {
-			return
+			panic("purego: too many arguments to pass on the stack: " + ty.String())
 		}
 	}
+	// the unions whose active field names no member are rejected before anything is passed to C
+	active := activeArgs(ty)
 	v := reflect.MakeFunc(ty, func(args []reflect.Value) (results []reflect.Value) {
+		for _, i := range active {
+			if err := checkActiveArg(args[i]); err != nil {
+				panic(err)
+			}
+		}
+		if cfg.entry != nil {
+			atomic.AddUint64(&cfg.entry.calls, 1)
+		}
+		cfn := cfn
+		if cfg.library != nil {
+			cfg.library.runInits()
+		}
+		if cfg.rebindable {
+			if cfn = atomic.LoadUintptr(&cfg.sym); cfn == 0 {
+				panic("purego: " + cfg.name + " is not resolved")
+			}
+		}
+		if ty.NumIn() > 0 && ty.In(0) == contextType {
+			name := cfg.name
+			if name == "" {
+				name = "C function"
+			}
+			ctx, _ := args[0].Interface().(context.Context)
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			defer startTrace(ctx, "purego: "+name)()
+			args = args[1:]
+		}
+		// fixed is the number of arguments before the C variadic ones if cfg.variadic
+		fixed := len(args) - 1
 		if len(args) > 0 {
 			if variadic, ok := args[len(args)-1].Interface().([]interface{}); ok {
 				// subtract one from args bc the last argument in args is []interface{}
@@ -161,10 +602,19 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 		var numInts int
 		var numFloats int
 		var numStack int
+		var extraStack []uintptr
 		var addStack, addInt, addFloat func(x uintptr)
-		if runtime.GOARCH == "arm64" || runtime.GOOS != "windows" {
+		if !positionalArgs && (runtime.GOARCH == "arm64" || runtime.GOOS != "windows") {
 			// Windows arm64 uses the same calling convention as macOS and Linux
 			addStack = func(x uintptr) {
+				if numStack >= len(stack) {
+					if !extraStackArgs {
+						panic("purego: too many arguments")
+					}
+					// structs passed in memory may need more stack than a7 to a9
+					extraStack = append(extraStack, x)
+					return
+				}
 				stack[numStack] = x
 				numStack++
 			}
@@ -185,45 +635,156 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 				}
 			}
 		} else {
-			// On Windows amd64 the arguments are passed in the numbered registered.
+			// On Windows amd64 and MIPS64 the arguments are passed in the numbered registered.
 			// So the first int is in the first integer register and the first float
 			// is in the second floating register if there is already a first int.
 			// This is in contrast to how macOS and Linux pass arguments which
 			// tries to use as many registers as possible in the calling convention.
 			addStack = func(x uintptr) {
+				if numStack >= len(sysargs) {
+					panic("purego: too many arguments")
+				}
 				sysargs[numStack] = x
 				numStack++
 			}
 			addInt = addStack
 			addFloat = addStack
 		}
+		if is32bit {
+			// 32-bit calling conventions pass every argument on the stack
+			addFloat = addStack
+		}
+		// add64 adds a 64-bit value which takes two words on 32-bit platforms
+		add64 := func(add func(uintptr), x uint64) {
+			add(uintptr(x))
+			if is32bit {
+				add(uintptr(x >> 32))
+			}
+		}
+
+		// a struct returned in memory is written where the hidden first argument points to
+		var structRet []uint64
+		var r8 uintptr
+		unmarshal := ty.NumOut() == 1 && isCUnmarshaler(ty.Out(0))
+		if ty.NumOut() == 1 && ty.Out(0).Kind() == reflect.Struct && !unmarshal {
+			structRet, r8 = prepareStructReturn(ty.Out(0), addInt)
+		}
 
+		var extra callExtra
+		var swiftSelf uintptr
+		var swiftError *SwiftError
+		var jmpBuf *JmpBuf
 		var keepAlive []interface{}
+		var copies []*cCopy
+		var outs []outParam
+		var perCall []uintptr
+		fields := fieldCallbacks{lifetime: cfg.funcArgs}
+		arena := argArena(args)
 		defer func() {
+			for _, cb := range perCall {
+				releaseFuncCallback(cb)
+			}
+			fields.release()
+			runtime.KeepAlive(copies)
 			runtime.KeepAlive(keepAlive)
 			runtime.KeepAlive(args)
+			if arena.pooled {
+				putArena(arena.Arena)
+			} else {
+				arena.Reset()
+			}
 		}()
-		for _, v := range args {
+		variadic := false
+		for i, v := range args {
+			if cfg.variadic && i == fixed {
+				variadic = true
+				if variadicOnStack {
+					addInt, addFloat = addStack, addStack
+				}
+			}
+			if v.Type() == arenaType {
+				continue
+			}
+			if cfg.guarded && v.Type() == jmpBufPtrType {
+				jmpBuf = v.Interface().(*JmpBuf)
+				continue
+			}
+			if vector && v.Type() == vec128Type {
+				u := v.Interface().(Vec128).Uint64x2()
+				if numFloats >= numOfFloats {
+					panic("purego: too many vector and float arguments for the float registers")
+				}
+				extra.hi[numFloats] = uintptr(u[1])
+				addFloat(uintptr(u[0]))
+				continue
+			}
+			if swift {
+				switch v.Type() {
+				case swiftSelfType:
+					swiftSelf = uintptr(v.Uint())
+					continue
+				case swiftErrorPtrType:
+					swiftError = v.Interface().(*SwiftError)
+					continue
+				}
+			}
+			if v.IsValid() && isCMarshaler(v.Type()) {
+				addInt(v.Interface().(CMarshaler).MarshalC())
+				continue
+			}
 			switch v.Kind() {
 			case reflect.String:
-				ptr := strings.CString(v.String())
-				keepAlive = append(keepAlive, ptr)
-				addInt(uintptr(unsafe.Pointer(ptr)))
-			case reflect.Uintptr, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				if v.Type() == wstringType {
+					addInt(uintptr(arena.WCString(v.String())))
+				} else {
+					addInt(uintptr(unsafe.Pointer(arena.CString(v.String()))))
+				}
+			case reflect.Uint64:
+				add64(addInt, v.Uint())
+			case reflect.Int64:
+				add64(addInt, uint64(v.Int()))
+			case reflect.Uint32:
+				if signExtendUint32 {
+					addInt(uintptr(int32(v.Uint())))
+				} else {
+					addInt(uintptr(v.Uint()))
+				}
+			case reflect.Uintptr, reflect.Uint, reflect.Uint8, reflect.Uint16:
 				addInt(uintptr(v.Uint()))
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
 				addInt(uintptr(v.Int()))
 			case reflect.Ptr, reflect.UnsafePointer, reflect.Slice:
-				if g, ok := v.Interface().([]string); ok {
-					res := strings.ByteSlice(g)
-					keepAlive = append(keepAlive, res)
-					addInt(uintptr(unsafe.Pointer(res)))
+				if v.Type() == sizedBytesType {
+					keepAlive = append(keepAlive, v.Interface())
+					addInt(v.Pointer())
+					addInt(uintptr(v.Len()))
+				} else if cfg.nullTerminated && isPointerSlice(v.Type()) {
+					keepAlive = append(keepAlive, v.Interface())
+					addInt(uintptr(arena.nullTerminated(v)))
+				} else if g, ok := v.Interface().([]string); ok {
+					addInt(uintptr(unsafe.Pointer(arena.byteSlice(g))))
+				} else if o, ok := newOutParam(v, arena.Arena); ok {
+					keepAlive = append(keepAlive, v.Interface())
+					outs = append(outs, o)
+					addInt(uintptr(unsafe.Pointer(o.slot)))
+				} else if c, ok := newCCopy(v, arena.Arena, &fields); ok {
+					// the struct is laid out differently in C so C gets a copy
+					// which is copied back after the call as C may modify it.
+					keepAlive = goPointers(v.Elem(), append(keepAlive, v.Interface()))
+					copies = append(copies, c)
+					addInt(uintptr(c.pointer()))
 				} else {
-					keepAlive = append(keepAlive, v.Pointer())
+					// keep the memory itself alive and not just its address
+					// so that the GC can't collect it while C is using it.
+					keepAlive = append(keepAlive, v.Interface())
 					addInt(v.Pointer())
 				}
 			case reflect.Func:
-				addInt(NewCallback(v.Interface()))
+				cb, release := funcArgCallback(v, cfg.funcArgs)
+				if release {
+					perCall = append(perCall, cb)
+				}
+				addInt(cb)
 			case reflect.Bool:
 				if v.Bool() {
 					addInt(1)
@@ -231,41 +792,111 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 					addInt(0)
 				}
 			case reflect.Float32:
-				addFloat(uintptr(math.Float32bits(float32(v.Float()))))
+				if variadic {
+					// C promotes a float passed as a variadic argument to double
+					add64(addFloat, math.Float64bits(v.Float()))
+				} else {
+					addFloat(uintptr(math.Float32bits(float32(v.Float()))))
+				}
 			case reflect.Float64:
-				addFloat(uintptr(math.Float64bits(v.Float())))
+				add64(addFloat, math.Float64bits(v.Float()))
+			case reflect.Struct:
+				keepAlive = goPointers(v, addStruct(v, &numInts, &numFloats, addStack, addInt, addFloat, &fields, keepAlive))
 			default:
 				panic("purego: unsupported kind: " + v.Kind().String())
 			}
 		}
-		// TODO: support structs
-		var r1, r2 uintptr
-		if runtime.GOARCH == "arm64" || runtime.GOOS != "windows" {
-			// Use the normal arm64 calling convention even on Windows
-			syscall := syscall9Args{
-				cfn,
-				sysargs[0], sysargs[1], sysargs[2], sysargs[3], sysargs[4], sysargs[5], sysargs[6], sysargs[7], sysargs[8],
-				floats[0], floats[1], floats[2], floats[3], floats[4], floats[5], floats[6], floats[7],
-				0, 0, 0,
+		syscall := syscall9Args{
+			fn: cfn,
+			a1: sysargs[0], a2: sysargs[1], a3: sysargs[2], a4: sysargs[3], a5: sysargs[4], a6: sysargs[5], a7: sysargs[6], a8: sysargs[7], a9: sysargs[8],
+			f1: floats[0], f2: floats[1], f3: floats[2], f4: floats[3], f5: floats[4], f6: floats[5], f7: floats[6], f8: floats[7],
+			arm64_r8: r8,
+		}
+		if len(extraStack) > 0 {
+			extra.stack, extra.nstack = unsafe.Pointer(&extraStack[0]), uintptr(len(extraStack))
+		}
+		if vector || extra.nstack > 0 {
+			syscall.extra = &extra
+		}
+		var start time.Time
+		stats := cfg.callMetrics(cfn)
+		if stats != nil {
+			start = time.Now()
+		}
+		mask := cfg.blockedSignals.block()
+		if swift {
+			if err := swiftcall(&syscall, swiftSelf); swiftError != nil {
+				*swiftError = SwiftError(err)
 			}
+		} else if jmpBuf != nil {
+			jmpBuf.guardedCall(&syscall)
+		} else if translateExceptions() {
+			runtime_cgocall(syscall9XSEHABI0, unsafe.Pointer(&syscall))
+		} else if syscall9XABI0 != 0 {
+			// Use the normal arm64 calling convention even on Windows.
+			// On windows/386 syscall9X handles both stdcall and cdecl functions and float results
+			// and on windows/amd64 it passes floats in the XMM registers of their position.
 			runtime_cgocall(syscall9XABI0, unsafe.Pointer(&syscall))
-			r1, r2 = syscall.r1, syscall.r2
 		} else {
-			// This is a fallback for amd64, 386, and arm. Note this may not support floats
-			r1, r2, _ = syscall_syscall9X(cfn, sysargs[0], sysargs[1], sysargs[2], sysargs[3], sysargs[4], sysargs[5], sysargs[6], sysargs[7], sysargs[8])
+			// This is a fallback for windows/arm. Note this doesn't support floats
+			syscall.r1, syscall.r2, _ = syscall_syscall9X(cfn, sysargs[0], sysargs[1], sysargs[2], sysargs[3], sysargs[4], sysargs[5], sysargs[6], sysargs[7], sysargs[8])
+		}
+		mask.unblock()
+		if stats != nil {
+			stats.record(start, time.Since(start))
+		}
+		raiseException(&syscall)
+		r1, r2 := syscall.r1, syscall.r2
+		for _, c := range copies {
+			c.copyBack()
+		}
+		for _, o := range outs {
+			o.copyBack()
 		}
 		if ty.NumOut() == 0 {
 			return nil
 		}
 		outType := ty.Out(0)
+		if outType == vec128Type {
+			return []reflect.Value{reflect.ValueOf(Uint64x2(uint64(r2), uint64(extra.rhi)))}
+		}
+		if unmarshal {
+			return []reflect.Value{unmarshalC(outType, r1)}
+		}
 		v := reflect.New(outType).Elem()
 		switch outType.Kind() {
-		case reflect.Uintptr, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		case reflect.Uint64, reflect.Int64:
+			// on 32-bit platforms the upper half is returned in r2
+			x := uint64(r1)
+			if is32bit {
+				x |= uint64(r2) << 32
+			}
+			if outType.Kind() == reflect.Int64 {
+				v.SetInt(int64(x))
+			} else {
+				v.SetUint(x)
+			}
+		case reflect.Uintptr, reflect.Uint:
 			v.SetUint(uint64(r1))
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		case reflect.Int:
 			v.SetInt(int64(r1))
+		// C functions that return a type smaller than a register may leave garbage in the upper bits
+		// so only the bits of the return type are used and then sign or zero extended.
+		case reflect.Uint8:
+			v.SetUint(uint64(uint8(r1)))
+		case reflect.Uint16:
+			v.SetUint(uint64(uint16(r1)))
+		case reflect.Uint32:
+			v.SetUint(uint64(uint32(r1)))
+		case reflect.Int8:
+			v.SetInt(int64(int8(r1)))
+		case reflect.Int16:
+			v.SetInt(int64(int16(r1)))
+		case reflect.Int32:
+			v.SetInt(int64(int32(r1)))
 		case reflect.Bool:
-			v.SetBool(r1 != 0)
+			// a _Bool is returned in the lowest byte
+			v.SetBool(uint8(r1) != 0)
 		case reflect.UnsafePointer:
 			// We take the address and then dereference it to trick go vet from creating a possible miss-use of unsafe.Pointer
 			v.SetPointer(*(*unsafe.Pointer)(unsafe.Pointer(&r1)))
@@ -274,14 +905,42 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 			v = reflect.NewAt(outType, runtime_noescape(unsafe.Pointer(&r1))).Elem()
 		case reflect.Func:
 			// wrap this C function in a nicely typed Go function
-			v = reflect.New(outType)
-			RegisterFunc(v.Interface(), r1)
+			v = cFunc(outType, r1)
 		case reflect.String:
-			v.SetString(strings.GoString(r1))
+			switch {
+			case outType == wstringType:
+				// a wchar_t* can't be aliased since it is converted to UTF-8
+				v.SetString(GoWString(*(*unsafe.Pointer)(unsafe.Pointer(&r1))))
+				if cfg.stringReturn == Owned && r1 != 0 {
+					SyscallN(cfg.stringFree, r1)
+				}
+			case cfg.stringReturn == Aliased:
+				v.SetString(strings.GoStringNoCopy(r1))
+			case cfg.stringReturn == Owned:
+				v.SetString(strings.GoString(r1))
+				if r1 != 0 {
+					SyscallN(cfg.stringFree, r1)
+				}
+			default:
+				v.SetString(strings.GoString(r1))
+			}
 		case reflect.Float32, reflect.Float64:
 			// NOTE: r2 is only the floating return value on 64bit platforms.
-			// On 32bit platforms r2 is the upper part of a 64bit return.
-			v.SetFloat(math.Float64frombits(uint64(r2)))
+			// On 32bit platforms r2 is the upper part of a 64bit return
+			// and syscall9X stores ST0 as a float64 in rf2 and rf3.
+			// On 64bit platforms a float is returned in the lower 32 bits of the register.
+			switch {
+			case is32bit:
+				v.SetFloat(math.Float64frombits(uint64(syscall.rf2) | uint64(syscall.rf3)<<32))
+			case outType.Kind() == reflect.Float32:
+				v.SetFloat(float64(math.Float32frombits(uint32(r2))))
+			default:
+				v.SetFloat(math.Float64frombits(uint64(r2)))
+			}
+		case reflect.Struct:
+			v = getStruct(outType, structRet, &syscall)
+		case reflect.Slice:
+			v = fromNullTerminated(outType, r1)
 		default:
 			panic("purego: unsupported return kind: " + outType.Kind().String())
 		}
@@ -290,17 +949,98 @@ func RegisterFunc(fptr interface{}, cfn uintptr) {
 	fn.Set(v)
 }
 
+// errorType is the type of the error returned by a function registered with WithNegativeErrno.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// registerNegativeErrno sets fn to a function that calls cfn and converts a negative return value into an error.
+// cfn is registered as a function with the same arguments that only returns the integer.
+func registerNegativeErrno(fn reflect.Value, cfn uintptr, cfg *funcConfig) {
+	ty := fn.Type()
+	ret := reflect.TypeOf(int32(0))
+	switch {
+	case ty.NumOut() == 1 && ty.Out(0) == errorType:
+	case ty.NumOut() == 2 && ty.Out(1) == errorType:
+		ret = ty.Out(0)
+		switch ret.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		default:
+			panic("purego: WithNegativeErrno needs a signed integer return but got " + ret.String())
+		}
+	default:
+		panic("purego: WithNegativeErrno needs a function that returns an error or a signed integer and an error")
+	}
+	in := make([]reflect.Type, ty.NumIn())
+	for i := range in {
+		in[i] = ty.In(i)
+	}
+	call := reflect.New(reflect.FuncOf(in, []reflect.Type{ret}, ty.IsVariadic()))
+	cfg.negativeErrno = false
+	registerFunc(call.Interface(), cfn, cfg)
+	call = call.Elem()
+	fn.Set(reflect.MakeFunc(ty, func(args []reflect.Value) []reflect.Value {
+		var r reflect.Value
+		if ty.IsVariadic() {
+			r = call.CallSlice(args)[0]
+		} else {
+			r = call.Call(args)[0]
+		}
+		err := reflect.New(errorType).Elem()
+		if n := r.Int(); n < 0 {
+			err.Set(reflect.ValueOf(syscall.Errno(-n)))
+			r = reflect.Zero(ret)
+		}
+		if ty.NumOut() == 1 {
+			return []reflect.Value{err}
+		}
+		return []reflect.Value{r, err}
+	}))
+}
+
+// checkStruct panics if values of the struct type t can't be passed to or returned from C.
+func checkStruct(t reflect.Type) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		panic("purego: struct arguments and returns are not supported on " + runtime.GOARCH)
+	}
+	if _, err := layoutOf(t); err != nil {
+		panic(err)
+	}
+}
+
+// checkStructPointer panics if the struct the pointer type t points to can't be read or filled in by C.
+func checkStructPointer(t reflect.Type) {
+	if _, err := layoutOf(t.Elem()); err != nil {
+		panic(err)
+	}
+}
+
+// checkOutParam panics if the pointer type t points to a pointer to a struct that C can't return
+// since the struct is laid out differently in C and can't be read through the Go type in C memory.
+func checkOutParam(t reflect.Type) {
+	elem := t.Elem().Elem()
+	if elem.Kind() != reflect.Struct {
+		return
+	}
+	l, err := layoutOf(elem)
+	if err != nil {
+		panic(err)
+	}
+	if !l.sameAsGo {
+		panic("purego: the C layout of " + elem.String() + " differs from Go so " + t.String() + " can't return a pointer to C memory, use *unsafe.Pointer instead")
+	}
+}
+
+// is32bit is true on platforms where uintptr is 32 bits wide.
+const is32bit = unsafe.Sizeof(uintptr(0)) == 4
+
 func numOfIntegerRegisters() int {
 	switch runtime.GOARCH {
-	case "arm64":
+	case "arm64", "mips64", "mips64le":
 		return 8
 	case "amd64":
 		return 6
-	// TODO: figure out why 386 tests are not working
-	/*case "386":
+	case "386":
+		// every argument is passed on the stack
 		return 0
-	case "arm":
-		return 4*/
 	default:
 		panic("purego: unknown GOARCH (" + runtime.GOARCH + ")")
 	}
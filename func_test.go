@@ -4,7 +4,9 @@
 package purego_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"runtime"
 	"testing"
 	"unsafe"
@@ -86,3 +88,238 @@ func Test_qsort(t *testing.T) {
 		}
 	}
 }
+
+func TestCallMetrics(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var strlen func(s string) uintptr
+	purego.RegisterLibFunc(&strlen, libc, "strlen")
+	strlen("not measured")
+
+	purego.EnableCallMetrics(true)
+	defer purego.EnableCallMetrics(false)
+	const calls = 10
+	for i := 0; i < calls; i++ {
+		strlen("measured")
+	}
+
+	m, ok := purego.CallMetricsOf("strlen")
+	if !ok {
+		t.Fatal("no metrics for strlen")
+	}
+	if m.Name != "strlen" || m.Calls != calls {
+		t.Errorf("got %q with %d calls wanted %q with %d calls", m.Name, m.Calls, "strlen", calls)
+	}
+	if m.Total <= 0 || m.P99 < m.P50 {
+		t.Errorf("Total got %s, P50 %s and P99 %s", m.Total, m.P50, m.P99)
+	}
+
+	var vars map[string]struct {
+		Calls uint64 `json:"calls"`
+	}
+	if err := json.Unmarshal([]byte(purego.CallMetricsVar{}.String()), &vars); err != nil {
+		t.Fatal(err)
+	}
+	if vars["strlen"].Calls != calls {
+		t.Errorf("CallMetricsVar reports %d calls of strlen wanted %d", vars["strlen"].Calls, calls)
+	}
+}
+
+func TestStringReturnOwnership(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := purego.OpenLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to open library: %s", err)
+	}
+	defer libc.Close()
+	strdupName := "strdup"
+	if runtime.GOOS == "windows" {
+		strdupName = "_strdup"
+	}
+	free, err := libc.Lookup("free")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var strdupOwned func(s string) string
+	libc.RegisterFuncWith(&strdupOwned, strdupName, purego.WithStringReturn(purego.Owned, free))
+	if got := strdupOwned("purego"); got != "purego" {
+		t.Errorf("owned strdup got %q wanted %q", got, "purego")
+	}
+
+	var strdup func(s string) uintptr
+	libc.RegisterFunc(&strdup, strdupName)
+	var freePtr func(p uintptr)
+	purego.RegisterFunc(&freePtr, free)
+	p := strdup("purego")
+	defer freePtr(p)
+
+	var strchrBorrowed func(s uintptr, c int) string
+	libc.RegisterFuncWith(&strchrBorrowed, "strchr", purego.WithStringReturn(purego.Borrowed, 0))
+	var strchrAliased func(s uintptr, c int) string
+	libc.RegisterFuncWith(&strchrAliased, "strchr", purego.WithStringReturn(purego.Aliased, 0))
+
+	borrowed := strchrBorrowed(p, 'r')
+	aliased := strchrAliased(p, 'r')
+	if borrowed != "rego" || aliased != "rego" {
+		t.Errorf("strchr got %q borrowed and %q aliased wanted %q", borrowed, aliased, "rego")
+	}
+	if data := (*reflect.StringHeader)(unsafe.Pointer(&aliased)).Data; data != p+2 {
+		t.Errorf("aliased string points to %#x wanted %#x", data, p+2)
+	}
+	if data := (*reflect.StringHeader)(unsafe.Pointer(&borrowed)).Data; data == p+2 {
+		t.Error("borrowed string points to C memory")
+	}
+}
+
+func TestSizedBytes(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var strnlen func(s purego.SizedBytes) uintptr
+	purego.RegisterLibFunc(&strnlen, libc, "strnlen")
+	if got := strnlen(purego.SizedBytes("abc\x00def")); got != 3 {
+		t.Errorf("strnlen of a terminated string got %d wanted 3", got)
+	}
+	if got := strnlen(purego.SizedBytes("abcdef")[:4]); got != 4 {
+		t.Errorf("strnlen of a truncated slice got %d wanted 4", got)
+	}
+	if got := strnlen(nil); got != 0 {
+		t.Errorf("strnlen of nil got %d wanted 0", got)
+	}
+}
+
+func TestVariadic(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("snprintf is only defined in the headers of the C runtime on Windows")
+	}
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var snprintf func(buf []byte, size uintptr, format string, args ...interface{}) int32
+	purego.RegisterLibFuncWith(&snprintf, libc, "snprintf", purego.WithVariadic())
+	sprintf := func(format string, args ...interface{}) string {
+		buf := make([]byte, 128)
+		n := snprintf(buf, uintptr(len(buf)), format, args...)
+		return string(buf[:n])
+	}
+	if got := sprintf("%d %s %.2f", int32(-1), "go", 2.5); got != "-1 go 2.50" {
+		t.Errorf("got %q wanted %q", got, "-1 go 2.50")
+	}
+	// a float is promoted to double
+	if got := sprintf("%.3f %lld", float32(0.125), int64(1)<<40); got != "0.125 1099511627776" {
+		t.Errorf("got %q wanted %q", got, "0.125 1099511627776")
+	}
+	// more arguments than there are registers
+	got := sprintf("%d %d %d %d %d %d %d %d %d %d %.1f %.1f %.1f %.1f %.1f %.1f %.1f %.1f %.1f",
+		int32(1), int32(2), int32(3), int32(4), int32(5), int32(6), int32(7), int32(8), int32(9), int32(10),
+		0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9)
+	if want := "1 2 3 4 5 6 7 8 9 10 0.1 0.2 0.3 0.4 0.5 0.6 0.7 0.8 0.9"; got != want {
+		t.Errorf("got %q wanted %q", got, want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithVariadic of a function without ...interface{} didn't panic")
+		}
+	}()
+	var strlen func(s string) uintptr
+	purego.RegisterLibFuncWith(&strlen, libc, "strlen", purego.WithVariadic())
+}
+
+func TestFloat32Return(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("floating point returns are tested in syscall_windows_test.go")
+	}
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	switch runtime.GOOS {
+	case "linux":
+		library = "libm.so.6"
+	case "freebsd":
+		library = "libm.so.5"
+	}
+	libm, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var floorf func(float32) float32
+	purego.RegisterLibFunc(&floorf, libm, "floorf")
+	if got := floorf(-2.5); got != -3 {
+		t.Errorf("floorf(-2.5) got %v wanted -3", got)
+	}
+	if got := floorf(1.75); got != 1 {
+		t.Errorf("floorf(1.75) got %v wanted 1", got)
+	}
+}
+
+func TestRegisterFuncE(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var strlen func(string) int
+	if err := purego.RegisterLibFuncE(&strlen, libc, "strlen"); err != nil {
+		t.Fatalf("RegisterLibFuncE failed: %s", err)
+	}
+	if got := strlen("purego"); got != 6 {
+		t.Errorf("strlen got %d wanted 6", got)
+	}
+	if err := purego.RegisterLibFuncE(&strlen, libc, "purego_does_not_exist"); err == nil {
+		t.Errorf("RegisterLibFuncE of a missing symbol returned no error")
+	}
+	if err := purego.RegisterFuncE(strlen, 1); err == nil {
+		t.Errorf("RegisterFuncE of a non-pointer returned no error")
+	}
+	var notFunc int
+	if err := purego.RegisterFuncE(&notFunc, 1); err == nil {
+		t.Errorf("RegisterFuncE of a pointer to a non-function returned no error")
+	}
+	var tooMany func(a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11, a12, a13, a14, a15, a16, a17, a18, a19, a20 int)
+	if err := purego.RegisterFuncE(&tooMany, 1); err == nil {
+		t.Errorf("RegisterFuncE with too many arguments returned no error")
+	}
+	if tooMany != nil {
+		t.Errorf("RegisterFuncE set the function after failing")
+	}
+}
+
+func TestTryNewCallback(t *testing.T) {
+	if _, err := purego.TryNewCallback(42); err == nil {
+		t.Errorf("TryNewCallback of a non-function returned no error")
+	}
+	cb, err := purego.TryNewCallback(func(a int) int { return a + 1 })
+	if err != nil {
+		t.Fatalf("TryNewCallback failed: %s", err)
+	}
+	var fn func(int) int
+	purego.RegisterFunc(&fn, cb)
+	if got := fn(1); got != 2 {
+		t.Errorf("callback got %d wanted 2", got)
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// GError is the error a GLib function reported through its GError ** parameter.
+// See WithGError.
+type GError struct {
+	// Domain is the GQuark of the error domain such as G_IO_ERROR.
+	Domain uint32
+	// Code is the error code within the domain.
+	Code int32
+	// Message is the human readable message.
+	Message string
+}
+
+func (e *GError) Error() string {
+	return e.Message
+}
+
+// gerror is the beginning of struct GError of GLib.
+type gerror struct {
+	domain  uint32
+	code    int32
+	message *byte
+}
+
+// WithGError makes a function follow the convention of GLib, GTK and GStreamer where the last
+// parameter of the C function is a GError ** that is set to an error when the function fails.
+// The Go function leaves out that parameter and returns an error, or a value and an error.
+// The GError is converted into a *GError and freed by calling gErrorFree with it, which
+// must be the address of g_error_free:
+//
+//	// gboolean g_file_set_contents(const gchar *filename, const gchar *contents, gssize length, GError **error);
+//	var setContents func(filename, contents string, length int) (bool, error)
+//	purego.RegisterLibFuncWith(&setContents, glib, "g_file_set_contents", purego.WithGError(gErrorFree))
+//
+// The value is returned as the C function returned it, which is usually FALSE or NULL on failure.
+// Variadic functions are not supported. WithGError panics if gErrorFree is 0.
+func WithGError(gErrorFree uintptr) FuncOption {
+	if gErrorFree == 0 {
+		panic("purego: WithGError needs g_error_free")
+	}
+	return func(cfg *funcConfig) {
+		cfg.gErrorFree = gErrorFree
+	}
+}
+
+// registerGError sets fn to a function that calls cfn with a GError ** appended to its arguments
+// and converts the GError into an error. cfn is registered as a function without the error result.
+func registerGError(fn reflect.Value, cfn uintptr, cfg *funcConfig) {
+	ty := fn.Type()
+	var out []reflect.Type
+	switch {
+	case ty.NumOut() == 1 && ty.Out(0) == errorType:
+	case ty.NumOut() == 2 && ty.Out(1) == errorType:
+		out = append(out, ty.Out(0))
+	default:
+		panic("purego: WithGError needs a function that returns an error or a value and an error")
+	}
+	if ty.IsVariadic() {
+		panic("purego: WithGError doesn't support variadic functions")
+	}
+	if cfg.negativeErrno || cfg.fortran {
+		panic("purego: WithGError can't be combined with WithNegativeErrno or WithFortran")
+	}
+	in := make([]reflect.Type, ty.NumIn(), ty.NumIn()+1)
+	for i := range in {
+		in[i] = ty.In(i)
+	}
+	// the GError * is stored in a uintptr since it points to C memory
+	in = append(in, reflect.TypeOf((*uintptr)(nil)))
+	call := reflect.New(reflect.FuncOf(in, out, false))
+	free := cfg.gErrorFree
+	cfg.gErrorFree = 0
+	registerFunc(call.Interface(), cfn, cfg)
+	call = call.Elem()
+	fn.Set(reflect.MakeFunc(ty, func(args []reflect.Value) []reflect.Value {
+		var slot uintptr
+		results := call.Call(append(args, reflect.ValueOf(&slot)))
+		err := reflect.New(errorType).Elem()
+		if slot != 0 {
+			g := *(**gerror)(unsafe.Pointer(&slot))
+			err.Set(reflect.ValueOf(&GError{
+				Domain:  g.domain,
+				Code:    g.code,
+				Message: strings.GoString(uintptr(unsafe.Pointer(g.message))),
+			}))
+			SyscallN(free, slot)
+		}
+		return append(results, err)
+	}))
+}
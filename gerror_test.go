@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestGError(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libgerrortest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libgerrortest", "gerror.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	errorFree, err := purego.Dlsym(lib, "test_error_free")
+	if err != nil {
+		t.Fatal(err)
+	}
+	freedAddr, err := purego.Dlsym(lib, "freed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	freed := *(**int32)(unsafe.Pointer(&freedAddr))
+
+	var parsePositive func(n int32) (int32, error)
+	purego.RegisterLibFuncWith(&parsePositive, lib, "parse_positive", purego.WithGError(errorFree))
+	var checkPositive func(n int32) error
+	purego.RegisterLibFuncWith(&checkPositive, lib, "check_positive", purego.WithGError(errorFree))
+
+	if n, err := parsePositive(5); n != 5 || err != nil {
+		t.Errorf("parse_positive(5) got %d, %v wanted 5, nil", n, err)
+	}
+	_, err = parsePositive(-3)
+	var gerr *purego.GError
+	if !errors.As(err, &gerr) {
+		t.Fatalf("parse_positive(-3) got %v wanted a *GError", err)
+	}
+	if gerr.Domain != 7 || gerr.Code != -3 || gerr.Message != "not positive" {
+		t.Errorf("parse_positive(-3) got %+v", *gerr)
+	}
+	if *freed != 1 {
+		t.Errorf("the GError was freed %d times wanted 1", *freed)
+	}
+	if err := checkPositive(1); err != nil {
+		t.Errorf("check_positive(1) got %v wanted nil", err)
+	}
+	if err := checkPositive(0); err == nil || err.Error() != "not positive" {
+		t.Errorf("check_positive(0) got %v wanted not positive", err)
+	}
+	if *freed != 2 {
+		t.Errorf("the GError was freed %d times wanted 2", *freed)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("WithGError didn't panic for a function without an error result")
+		}
+	}()
+	var bad func(n int32) int32
+	purego.RegisterLibFuncWith(&bad, lib, "parse_positive", purego.WithGError(errorFree))
+}
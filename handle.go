@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Handle is a token that refers to a Go value and can be passed to C as a uintptr or as the
+// void* user data of a callback. Go pointers may not be kept by C after a call returns, so a
+// callback that needs a Go value should receive a Handle and resolve it with Value instead.
+//
+// A Handle works like runtime/cgo.Handle but doesn't require Cgo:
+//
+//	h := purego.NewHandle(state)
+//	defer h.Delete()
+//	start(cb, uintptr(h))
+//
+//	cb := purego.NewCallback(func(userdata uintptr) {
+//		state := purego.Handle(userdata).Value().(*State)
+//		...
+//	})
+//
+// The zero Handle is never valid.
+type Handle uintptr
+
+var (
+	handles   sync.Map // map[Handle]interface{}
+	handleIdx uintptr  // atomic
+)
+
+// NewHandle returns a handle for v. It is valid until Delete is called,
+// even if v is no longer referenced anywhere else.
+func NewHandle(v interface{}) Handle {
+	h := atomic.AddUintptr(&handleIdx, 1)
+	if h == 0 {
+		panic("purego: ran out of handle space")
+	}
+	handles.Store(Handle(h), v)
+	return Handle(h)
+}
+
+// Value returns the value h was created for. It panics if h is invalid.
+func (h Handle) Value() interface{} {
+	v, ok := handles.Load(h)
+	if !ok {
+		panic("purego: misuse of an invalid Handle")
+	}
+	return v
+}
+
+// Delete invalidates h so that its value can be garbage collected. It panics if h is already invalid.
+func (h Handle) Delete() {
+	if _, ok := handles.LoadAndDelete(h); !ok {
+		panic("purego: misuse of an invalid Handle")
+	}
+}
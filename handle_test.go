@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestHandle(t *testing.T) {
+	type state struct{ n int }
+	s := &state{n: 42}
+	h := purego.NewHandle(s)
+	if h == 0 {
+		t.Fatal("NewHandle returned the zero Handle")
+	}
+	if h2 := purego.NewHandle(s); h2 == h {
+		t.Errorf("NewHandle returned %d twice", h)
+	} else {
+		h2.Delete()
+	}
+	if got := purego.Handle(uintptr(h)).Value().(*state); got != s {
+		t.Errorf("Value got %p wanted %p", got, s)
+	}
+	h.Delete()
+
+	mustPanic := func(name string, f func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s of a deleted Handle didn't panic", name)
+			}
+		}()
+		f()
+	}
+	mustPanic("Value", func() { h.Value() })
+	mustPanic("Delete", func() { h.Delete() })
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build ((darwin || freebsd || linux) && (amd64 || arm64)) || (windows && amd64)
+
+package purego
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"unsafe"
+)
+
+// Hook is a redirection of the calls of a C function installed by InstallHook.
+type Hook struct {
+	// Target is the address of the function that is redirected.
+	Target uintptr
+
+	mu sync.Mutex
+	// patched is where the jump is written after the landing pad of Target.
+	patched uintptr
+	// stub runs the instructions that the jump replaced and continues in Target after them.
+	stub uintptr
+	// saved is what the jump replaced.
+	saved    []byte
+	restored bool
+}
+
+// InstallHook overwrites the start of the C function at target with a jump to replacement,
+// usually a callback created with NewCallback, so that every call of the function calls replacement
+// instead, including the calls of the library to its own functions that Interpose doesn't see.
+// Original returns a function that behaves like target did before for the replacement to forward
+// a call:
+//
+//	var open func(path string, flags int32) int32
+//	h, err := purego.InstallHook(openAddr, purego.NewCallback(func(path *byte, flags int32) int32 {
+//		log.Println("open", strings.GoString(uintptr(unsafe.Pointer(path))))
+//		return open(path, flags)
+//	}))
+//	purego.RegisterFunc(&open, h.Original())
+//
+// The instructions that the jump replaces are copied to the original which fails for instructions
+// that address memory or branch relative to where they are, for example when the function is only
+// a jump to another one. Hook that function instead. The function must not be running on another
+// thread while InstallHook or Restore modify its first instructions.
+//
+// An ENDBR64 instruction on amd64 or a BTI, PACIASP or PACIBSP instruction on arm64 at the start
+// of the function stays in place since callers must land on it when Intel CET or BTI is enforced.
+// On Linux the original is mapped close enough to the function to jump back to it with a direct
+// branch that neither of them checks.
+//
+// On macOS with the hardened runtime the original is mapped with MAP_JIT which needs the
+// com.apple.security.cs.allow-jit entitlement, and modifying the function needs the
+// com.apple.security.cs.disable-executable-page-protection entitlement. InstallHook returns an
+// error that names the missing entitlement.
+func InstallHook(target, replacement uintptr) (*Hook, error) {
+	if target == 0 {
+		return nil, errors.New("purego: hook target is nil")
+	}
+	if replacement == 0 {
+		return nil, errors.New("purego: replacement of the hook is nil")
+	}
+	jump := hookJump(replacement)
+	code := unsafe.Slice((*byte)(imagePointer(target)), maxLandingPadLen+len(jump)+maxInstructionLen-1)
+	// the landing pad of indirect branches stays in place for callers from pages that enforce it
+	pad := landingPadLen(code)
+	n, err := prologueLen(code[pad:], len(jump))
+	if err != nil {
+		return nil, errors.New("purego: can't hook the function at 0x" + strconv.FormatUint(uint64(target), 16) + ": " + err.Error())
+	}
+	h := &Hook{Target: target, patched: target + uintptr(pad), saved: append([]byte(nil), code[pad:pad+n]...)}
+	// the original starts with the landing pad too since it may sign the return address
+	size := pad + n + len(hookJump(0))
+	h.stub, err = allocCode(size, target, func(stub uintptr, b []byte) {
+		copy(b, code[:pad+n])
+		copy(b[pad+n:], hookJumpBack(stub+uintptr(pad+n), h.patched+uintptr(n)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for len(jump) < n {
+		jump = append(jump, hookPadding)
+	}
+	if err := writeCode(h.patched, jump); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Hook is like InstallHook for the function name of the library.
+func (l *Library) Hook(name string, replacement uintptr) (*Hook, error) {
+	target, err := l.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return InstallHook(target, replacement)
+}
+
+// Original returns the address of a function that behaves like Target before InstallHook.
+// It stays valid after Restore.
+func (h *Hook) Original() uintptr {
+	return h.stub
+}
+
+// Restore puts back the instructions that InstallHook replaced. Calling it again does nothing.
+func (h *Hook) Restore() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.restored {
+		return nil
+	}
+	if err := writeCode(h.patched, h.saved); err != nil {
+		return err
+	}
+	h.restored = true
+	return nil
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+)
+
+const (
+	// maxInstructionLen is the length of the longest x86 instruction.
+	maxInstructionLen = 15
+	// maxLandingPadLen is the length of the ENDBR64 instruction that a function may start with.
+	maxLandingPadLen = 4
+	// hookPadding fills the rest of the last instruction that the jump replaced with int3.
+	hookPadding = 0xcc
+)
+
+// hookJump returns jmp [rip+0] followed by the address to. Unlike mov rax, imm64 and jmp rax
+// it keeps rax which holds the number of vector registers of a variadic call.
+func hookJump(to uintptr) []byte {
+	b := []byte{0xff, 0x25, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint64(b[6:], uint64(to))
+	return b
+}
+
+// hookJumpBack returns a jump from the original at from back to the function at to. It is a direct
+// jump if to is in range since an indirect one may only land on ENDBR64 when Intel CET enforces
+// indirect branch tracking. Otherwise it is like hookJump.
+func hookJumpBack(from, to uintptr) []byte {
+	off := int64(to) - int64(from+5)
+	if off < -1<<31 || off >= 1<<31 {
+		return hookJump(to)
+	}
+	b := []byte{0xe9, 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(b[1:], uint32(off))
+	return b
+}
+
+// landingPadLen returns 4 if code starts with ENDBR64 which indirect calls must land on
+// when Intel CET enforces indirect branch tracking.
+func landingPadLen(code []byte) int {
+	if code[0] == 0xf3 && code[1] == 0x0f && code[2] == 0x1e && code[3] == 0xfa {
+		return 4
+	}
+	return 0
+}
+
+// prologueLen returns the length of the whole instructions at the start of code that span at least
+// want bytes. It decodes the instructions compilers emit at the start of a function and fails
+// for the others and for those that are relative to the instruction pointer.
+func prologueLen(code []byte, want int) (int, error) {
+	n := 0
+	for n < want {
+		l, err := instructionLen(code[n:])
+		if err != nil {
+			return 0, err
+		}
+		n += l
+	}
+	return n, nil
+}
+
+// instructionLen returns the length of the instruction at the start of code.
+func instructionLen(code []byte) (int, error) {
+	i := 0
+	opsize16, rexW := false, false
+prefixes:
+	for ; i < 4; i++ {
+		switch code[i] {
+		case 0x66:
+			opsize16 = true
+		case 0x67, 0xf0, 0xf2, 0xf3, 0x26, 0x2e, 0x36, 0x3e, 0x64, 0x65:
+		default:
+			break prefixes
+		}
+	}
+	if code[i]&0xf0 == 0x40 { // REX
+		rexW = code[i]&0x08 != 0
+		i++
+	}
+	imm32 := 4
+	if opsize16 {
+		imm32 = 2
+	}
+	op := code[i]
+	i++
+	switch {
+	case op >= 0x50 && op <= 0x5f, op == 0x90, op == 0x98, op == 0x99, op == 0xc9, op == 0xf4:
+		return i, nil
+	case op >= 0xb0 && op <= 0xb7, op == 0x6a, op == 0xa8, op&0xc7 == 0x04 && op < 0x40:
+		return i + 1, nil
+	case op >= 0xb8 && op <= 0xbf:
+		if rexW {
+			return i + 8, nil
+		}
+		return i + imm32, nil
+	case op == 0x68, op == 0xa9, op&0xc7 == 0x05 && op < 0x40:
+		return i + imm32, nil
+	case op&0xc4 == 0x00 && op < 0x40, op >= 0x84 && op <= 0x8b, op == 0x8d, op == 0x63, op == 0xd1, op == 0xd3, op == 0xfe, op == 0xff:
+		return modRMLen(code, i, 0)
+	case op == 0x80, op == 0x83, op == 0x6b, op == 0xc0, op == 0xc1, op == 0xc6:
+		return modRMLen(code, i, 1)
+	case op == 0x81, op == 0x69, op == 0xc7:
+		return modRMLen(code, i, imm32)
+	case op == 0xf6, op == 0xf7:
+		// only test has an immediate
+		imm := 0
+		if (code[i]>>3)&7 == 0 {
+			imm = 1
+			if op == 0xf7 {
+				imm = imm32
+			}
+		}
+		return modRMLen(code, i, imm)
+	case op == 0x0f:
+		op2 := code[i]
+		i++
+		switch {
+		case op2 == 0x05, op2 == 0x0b, op2 == 0x31:
+			return i, nil
+		case op2 >= 0x80 && op2 <= 0x8f:
+			return 0, errors.New("relative conditional jump")
+		case op2 == 0x1e, op2 == 0x1f, op2 >= 0x10 && op2 <= 0x17, op2 >= 0x28 && op2 <= 0x2f, op2 >= 0x40 && op2 <= 0x6f,
+			op2 >= 0x74 && op2 <= 0x76, op2 == 0x7e, op2 == 0x7f, op2 >= 0x90 && op2 <= 0x9f, op2 == 0xa3, op2 == 0xab,
+			op2 == 0xaf, op2 == 0xb6, op2 == 0xb7, op2 == 0xbe, op2 == 0xbf, op2 >= 0xd0:
+			return modRMLen(code, i, 0)
+		case op2 == 0x70, op2 == 0xc6, op2 == 0xba:
+			return modRMLen(code, i, 1)
+		}
+		return 0, errors.New("unsupported instruction 0x0f 0x" + strconv.FormatUint(uint64(op2), 16))
+	case op == 0xe8, op == 0xe9, op == 0xeb, op >= 0x70 && op <= 0x7f, op >= 0xe0 && op <= 0xe3:
+		return 0, errors.New("relative jump or call")
+	case op == 0xc3, op == 0xc2, op == 0xcc:
+		return 0, errors.New("the function is too short")
+	}
+	return 0, errors.New("unsupported instruction 0x" + strconv.FormatUint(uint64(op), 16))
+}
+
+// modRMLen returns the length of an instruction whose ModRM byte is at code[i] followed by imm bytes.
+func modRMLen(code []byte, i, imm int) (int, error) {
+	modrm := code[i]
+	i++
+	mod, rm := modrm>>6, modrm&7
+	if mod != 3 && rm == 4 { // SIB
+		if mod == 0 && code[i]&7 == 5 {
+			i += 4 // no base register but a 32 bit displacement
+		}
+		i++
+	}
+	switch {
+	case mod == 0 && rm == 5:
+		return 0, errors.New("instruction relative to rip")
+	case mod == 1:
+		i++
+	case mod == 2:
+		i += 4
+	}
+	return i + imm, nil
+}
+
+// flushInstructionCache does nothing since x86 keeps the instruction cache coherent with stores.
+func flushInstructionCache(start, n uintptr) {}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	maxInstructionLen = 4
+	// maxLandingPadLen is the length of the BTI or PACIASP instruction that a function may start with.
+	maxLandingPadLen = 4
+	// hookPadding is never needed since every instruction is 4 bytes.
+	hookPadding = 0
+)
+
+// hookJump returns ldr x16, #8 and br x16 followed by the address to.
+// x16 is the scratch register of veneers so it doesn't hold an argument.
+func hookJump(to uintptr) []byte {
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint32(b, 0x58000050)
+	binary.LittleEndian.PutUint32(b[4:], 0xd61f0200)
+	binary.LittleEndian.PutUint64(b[8:], uint64(to))
+	return b
+}
+
+// hookJumpBack returns a jump from the original at from back to the function at to. It is a direct
+// branch if to is in range since an indirect one may only land on a BTI instruction in pages that
+// enforce BTI. Otherwise it is like hookJump.
+func hookJumpBack(from, to uintptr) []byte {
+	off := int64(to) - int64(from)
+	if off < -1<<27 || off >= 1<<27 {
+		return hookJump(to)
+	}
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, 0x14000000|uint32(off>>2)&0x3ffffff)
+	return b
+}
+
+// landingPadLen returns 4 if code starts with an instruction that indirect branches may land on:
+// BTI or PACIASP and PACIBSP which also are landing pads.
+func landingPadLen(code []byte) int {
+	switch op := binary.LittleEndian.Uint32(code); {
+	case op&0xffffff3f == 0xd503241f, op == 0xd503233f, op == 0xd503237f:
+		return 4
+	}
+	return 0
+}
+
+// prologueLen returns want after checking that none of the instructions in the first want bytes
+// of code is relative to the program counter.
+func prologueLen(code []byte, want int) (int, error) {
+	for i := 0; i < want; i += 4 {
+		op := binary.LittleEndian.Uint32(code[i:])
+		switch {
+		case op&0x1f000000 == 0x10000000:
+			return 0, errors.New("adr or adrp")
+		case op&0x7c000000 == 0x14000000:
+			return 0, errors.New("relative branch")
+		case op&0xff000010 == 0x54000000, op&0x7e000000 == 0x34000000, op&0x7e000000 == 0x36000000:
+			return 0, errors.New("relative conditional branch")
+		case op&0x3b000000 == 0x18000000:
+			return 0, errors.New("load of a literal")
+		case op == 0xd65f03c0:
+			return 0, errors.New("the function is too short")
+		}
+	}
+	return want, nil
+}
+
+// flushInstructionCache makes the instruction cache see the code that was written to [start, start+n).
+//
+//go:noescape
+func flushInstructionCache(start, n uintptr)
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+#include "textflag.h"
+
+// func flushInstructionCache(start, n uintptr)
+// Cache lines are at least 16 bytes so every line of the range is cleaned and invalidated.
+TEXT ·flushInstructionCache(SB), NOSPLIT|NOFRAME, $0-16
+	MOVD start+0(FP), R0
+	MOVD n+8(FP), R1
+	ADD  R0, R1, R1
+	AND  $~15, R0, R2
+
+clean:
+	CMP  R1, R2
+	BHS  invalidate
+	WORD $0xd50b7b22 // dc cvau, x2
+	ADD  $16, R2
+	B    clean
+
+invalidate:
+	WORD $0xd5033b9f // dsb ish
+	AND  $~15, R0, R2
+
+loop:
+	CMP  R1, R2
+	BHS  done
+	WORD $0xd50b7522 // ic ivau, x2
+	ADD  $16, R2
+	B    loop
+
+done:
+	WORD $0xd5033b9f // dsb ish
+	WORD $0xd5033fdf // isb
+	RET
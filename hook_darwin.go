@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build amd64 || arm64
+
+package purego
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// writeCodeHint is added to the error of writeCode since the hardened runtime doesn't allow
+// modifying the text of a library without the entitlement.
+const writeCodeHint = " (the hardened runtime needs the com.apple.security.cs.disable-executable-page-protection entitlement)"
+
+// mapJIT is MAP_JIT which the syscall package doesn't define.
+const mapJIT = 0x800
+
+// errJITEntitlement is returned by allocCode when the hardened runtime refuses executable memory.
+var errJITEntitlement = errors.New("purego: executable memory can't be mapped: the hardened runtime needs the com.apple.security.cs.allow-jit entitlement")
+
+var jitWriteProtect struct {
+	once sync.Once
+	fn   uintptr
+}
+
+// allocCode maps size bytes of memory, lets fill write the code at the address p to b and makes it
+// executable. near is unused since macOS doesn't use BTI and the original of a hook can jump back
+// through a register. The mapping is never unmapped since a thread may still run the code.
+//
+// Without the hardened runtime the memory is made executable after it is written. With it the memory
+// is mapped with MAP_JIT, which needs the com.apple.security.cs.allow-jit entitlement, and on arm64
+// pthread_jit_write_protect_np makes it writable for the thread while fill runs.
+func allocCode(size int, near uintptr, fill func(p uintptr, b []byte)) (uintptr, error) {
+	mem, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return 0, errors.New("purego: mmap failed: " + err.Error())
+	}
+	p := uintptr(unsafe.Pointer(&mem[0]))
+	fill(p, mem)
+	if err := syscall.Mprotect(mem, syscall.PROT_READ|syscall.PROT_EXEC); err == nil {
+		flushInstructionCache(p, uintptr(size))
+		return p, nil
+	}
+	_ = syscall.Munmap(mem)
+
+	mem, err = syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE|syscall.PROT_EXEC, syscall.MAP_ANON|syscall.MAP_PRIVATE|mapJIT)
+	if err != nil {
+		if err == syscall.EPERM || err == syscall.EACCES || err == syscall.EINVAL {
+			return 0, errJITEntitlement
+		}
+		return 0, errors.New("purego: mmap failed: " + err.Error())
+	}
+	p = uintptr(unsafe.Pointer(&mem[0]))
+	if runtime.GOARCH == "arm64" {
+		jitWriteProtect.once.Do(func() {
+			jitWriteProtect.fn, _ = Dlsym(RTLD_DEFAULT, "pthread_jit_write_protect_np")
+		})
+		if jitWriteProtect.fn == 0 {
+			_ = syscall.Munmap(mem)
+			return 0, errors.New("purego: pthread_jit_write_protect_np is missing")
+		}
+		// the protection is switched for the thread
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		SyscallN(jitWriteProtect.fn, 0)
+		defer SyscallN(jitWriteProtect.fn, 1)
+	}
+	fill(p, mem)
+	flushInstructionCache(p, uintptr(size))
+	return p, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (freebsd || linux) && (amd64 || arm64)
+
+package purego
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+)
+
+// writeCodeHint is added to the error of writeCode.
+const writeCodeHint = ""
+
+// allocCode maps size bytes of memory close to near if possible, lets fill write the code at the
+// address p to b and makes it executable. The mapping is never unmapped since a thread may still
+// run the code.
+func allocCode(size int, near uintptr, fill func(p uintptr, b []byte)) (uintptr, error) {
+	p, err := mmapNear(size, near)
+	if err != nil {
+		return 0, errors.New("purego: mmap failed: " + err.Error())
+	}
+	b := unsafe.Slice((*byte)(imagePointer(p)), size)
+	fill(p, b)
+	if err := syscall.Mprotect(b, syscall.PROT_READ|syscall.PROT_EXEC); err != nil {
+		return 0, errors.New("purego: mprotect failed: " + err.Error())
+	}
+	flushInstructionCache(p, uintptr(size))
+	return p, nil
+}
+
+// mmapNear maps size bytes of anonymous read-write memory. It asks for the 64 MB below near first
+// so that the original of a hook can jump back with a direct branch.
+func mmapNear(size int, near uintptr) (uintptr, error) {
+	const distance = 64 << 20
+	prot, flags := syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE
+	if near > distance {
+		hint := (near - distance) &^ uintptr(syscall.Getpagesize()-1)
+		p, _, errno := syscall.Syscall6(syscall.SYS_MMAP, hint, uintptr(size), uintptr(prot), uintptr(flags), ^uintptr(0), 0)
+		if errno == 0 {
+			return p, nil
+		}
+	}
+	p, _, errno := syscall.Syscall6(syscall.SYS_MMAP, 0, uintptr(size), uintptr(prot), uintptr(flags), ^uintptr(0), 0)
+	if errno != 0 {
+		return 0, errno
+	}
+	return p, nil
+}
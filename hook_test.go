@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux) && (amd64 || arm64)
+
+package purego_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestHook(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libhooktest.so")
+	if err := buildSharedLib("CC", libFileName, "-O0", filepath.Join("libhooktest", "hook.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatalf("OpenLibrary(%q) failed: %v", libFileName, err)
+	}
+	defer lib.Close()
+
+	var sumRange func(from, to int32) int32
+	var sumTo func(to int32) int32
+	lib.RegisterFunc(&sumRange, "sum_range")
+	lib.RegisterFunc(&sumTo, "sum_to")
+
+	var original func(from, to int32) int32
+	calls := 0
+	h, err := lib.Hook("sum_range", purego.NewCallback(func(from, to int32) int32 {
+		calls++
+		return original(from, to) * 2
+	}))
+	if err != nil {
+		t.Fatalf("Hook failed: %v", err)
+	}
+	purego.RegisterFunc(&original, h.Original())
+	if got := sumRange(1, 5); got != 20 {
+		t.Errorf("sum_range got %d wanted 20", got)
+	}
+	// the call of the library to its own function is redirected too
+	if got := sumTo(5); got != 20 {
+		t.Errorf("sum_to got %d wanted 20", got)
+	}
+	if calls != 2 {
+		t.Errorf("the replacement was called %d times wanted 2", calls)
+	}
+
+	if err := h.Restore(); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if got := sumRange(1, 5); got != 10 {
+		t.Errorf("sum_range got %d after Restore wanted 10", got)
+	}
+	if got := original(1, 5); got != 10 {
+		t.Errorf("Original got %d after Restore wanted 10", got)
+	}
+	if calls != 2 {
+		t.Errorf("the replacement was called after Restore")
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux) && (amd64 || arm64)
+
+package purego
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+)
+
+// writeCode copies code to the address p in the text of a library. The pages stay executable while
+// they are writable unless the system forbids it so that other code in them keeps running.
+func writeCode(p uintptr, code []byte) error {
+	page := uintptr(syscall.Getpagesize())
+	start := p &^ (page - 1)
+	pages := unsafe.Slice((*byte)(imagePointer(start)), (p+uintptr(len(code))-start+page-1)&^(page-1))
+	if err := syscall.Mprotect(pages, syscall.PROT_READ|syscall.PROT_WRITE|syscall.PROT_EXEC); err != nil {
+		if err := syscall.Mprotect(pages, syscall.PROT_READ|syscall.PROT_WRITE); err != nil {
+			return errors.New("purego: mprotect failed: " + err.Error() + writeCodeHint)
+		}
+	}
+	copy(unsafe.Slice((*byte)(imagePointer(p)), len(code)), code)
+	if err := syscall.Mprotect(pages, syscall.PROT_READ|syscall.PROT_EXEC); err != nil {
+		return errors.New("purego: mprotect failed: " + err.Error())
+	}
+	flushInstructionCache(p, uintptr(len(code)))
+	return nil
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build amd64
+
+package purego
+
+import (
+	"errors"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// allocCode allocates size bytes of memory, lets fill write the code at the address p to b and
+// makes it executable. The memory is never freed since a thread may still run the code. near is
+// unused so the original of a hook usually jumps back through memory.
+func allocCode(size int, near uintptr, fill func(p uintptr, b []byte)) (uintptr, error) {
+	p, err := windows.VirtualAlloc(0, uintptr(size), windows.MEM_RESERVE|windows.MEM_COMMIT, windows.PAGE_READWRITE)
+	if err != nil {
+		return 0, errors.New("purego: VirtualAlloc failed: " + err.Error())
+	}
+	fill(p, unsafe.Slice((*byte)(imagePointer(p)), size))
+	var old uint32
+	if err := windows.VirtualProtect(p, uintptr(size), windows.PAGE_EXECUTE_READ, &old); err != nil {
+		return 0, errors.New("purego: VirtualProtect failed: " + err.Error())
+	}
+	return p, nil
+}
+
+// writeCode copies code to the address p in the text of a module and puts back the protection afterwards.
+func writeCode(p uintptr, code []byte) error {
+	var old uint32
+	if err := windows.VirtualProtect(p, uintptr(len(code)), windows.PAGE_EXECUTE_READWRITE, &old); err != nil {
+		return errors.New("purego: VirtualProtect failed: " + err.Error())
+	}
+	copy(unsafe.Slice((*byte)(imagePointer(p)), len(code)), code)
+	return windows.VirtualProtect(p, uintptr(len(code)), old, &old)
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+// Package hrtime binds the high-resolution monotonic timer of the platform through purego:
+// QueryPerformanceCounter on Windows, mach_absolute_time on macOS and clock_gettime on FreeBSD and Linux.
+// Game and audio bindings often need the same clock as the C library they bind to measure
+// frame and buffer times with double precision.
+package hrtime
+
+import (
+	"fmt"
+	"time"
+)
+
+// Clock is a monotonic time source.
+type Clock struct {
+	name string
+	read func() uint64
+	// scale converts ticks to seconds.
+	scale      float64
+	resolution time.Duration
+	base       uint64
+}
+
+// source is a timer of the platform which is tried in order by Open.
+type source struct {
+	name string
+	// open returns a function reading the timer, the number of nanoseconds of a tick as num/den and
+	// the resolution of the timer.
+	open func() (read func() uint64, num, den uint64, resolution time.Duration, err error)
+}
+
+// calibrationPeriod is how long Open measures a timer against the monotonic clock of package time.
+const calibrationPeriod = 5 * time.Millisecond
+
+// Open returns the first timer of the platform that is available and passes calibration.
+// A timer passes calibration if it advances at the rate of the monotonic clock of package time
+// within 25%, which catches wrong bindings such as a misread timebase. If no timer passes
+// Open falls back to package time and Name returns "go".
+func Open() *Clock {
+	for _, s := range sources {
+		read, num, den, res, err := s.open()
+		if err != nil {
+			continue
+		}
+		if err := calibrate(read, num, den); err != nil {
+			continue
+		}
+		return &Clock{
+			name:       s.name,
+			read:       read,
+			scale:      float64(num) / float64(den) / 1e9,
+			resolution: res,
+			base:       read(),
+		}
+	}
+	start := time.Now()
+	return &Clock{
+		name:       "go",
+		read:       func() uint64 { return uint64(time.Since(start)) },
+		scale:      1e-9,
+		resolution: time.Nanosecond,
+	}
+}
+
+func calibrate(read func() uint64, num, den uint64) error {
+	if num == 0 || den == 0 {
+		return fmt.Errorf("hrtime: invalid timebase %d/%d", num, den)
+	}
+	start := time.Now()
+	t0 := read()
+	time.Sleep(calibrationPeriod)
+	t1 := read()
+	elapsed := time.Since(start)
+	if t1 <= t0 {
+		return fmt.Errorf("hrtime: timer didn't advance")
+	}
+	measured := float64(t1-t0) * float64(num) / float64(den)
+	if ratio := measured / float64(elapsed); ratio < 0.75 || ratio > 1.25 {
+		return fmt.Errorf("hrtime: timer measured %v in %v", time.Duration(measured), elapsed)
+	}
+	return nil
+}
+
+// Name returns the name of the platform function the clock reads.
+func (c *Clock) Name() string {
+	return c.name
+}
+
+// Resolution returns the smallest interval the clock can measure.
+func (c *Clock) Resolution() time.Duration {
+	return c.resolution
+}
+
+// Ticks returns the raw value of the timer.
+func (c *Clock) Ticks() uint64 {
+	return c.read()
+}
+
+// Seconds returns the seconds since Open. It is relative to Open so that a float64 keeps
+// nanosecond precision for over 100 days.
+func (c *Clock) Seconds() float64 {
+	return float64(c.read()-c.base) * c.scale
+}
+
+// Since returns the time elapsed since the value returned by Seconds.
+func (c *Clock) Since(seconds float64) time.Duration {
+	return time.Duration((c.Seconds() - seconds) * 1e9)
+}
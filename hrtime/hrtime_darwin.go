@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package hrtime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+)
+
+const libc = "/usr/lib/libSystem.B.dylib"
+
+const _CLOCK_UPTIME_RAW = 8
+
+var sources = []source{
+	{name: "mach_absolute_time", open: openMachAbsoluteTime},
+	clockGettime("clock_gettime(CLOCK_UPTIME_RAW)", _CLOCK_UPTIME_RAW),
+}
+
+// machTimebaseInfo is struct mach_timebase_info.
+type machTimebaseInfo struct {
+	Numer, Denom uint32
+}
+
+func openMachAbsoluteTime() (func() uint64, uint64, uint64, time.Duration, error) {
+	lib, err := purego.OpenLibrary(libc)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	var mach_absolute_time func() uint64
+	var mach_timebase_info func(info *machTimebaseInfo) int32
+	lib.RegisterFunc(&mach_absolute_time, "mach_absolute_time")
+	lib.RegisterFunc(&mach_timebase_info, "mach_timebase_info")
+	var info machTimebaseInfo
+	if ret := mach_timebase_info(&info); ret != 0 {
+		return nil, 0, 0, 0, fmt.Errorf("hrtime: mach_timebase_info failed with %d", ret)
+	}
+	// a tick is numer/denom nanoseconds which is 1 on Intel and 125/3 on Apple silicon
+	res := time.Duration(info.Numer / info.Denom)
+	if res == 0 {
+		res = 1
+	}
+	return mach_absolute_time, uint64(info.Numer), uint64(info.Denom), res, nil
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package hrtime
+
+const libc = "libc.so.7"
+
+const (
+	_CLOCK_MONOTONIC         = 4
+	_CLOCK_MONOTONIC_PRECISE = 11
+)
+
+var sources = []source{
+	clockGettime("clock_gettime(CLOCK_MONOTONIC_PRECISE)", _CLOCK_MONOTONIC_PRECISE),
+	clockGettime("clock_gettime(CLOCK_MONOTONIC)", _CLOCK_MONOTONIC),
+}
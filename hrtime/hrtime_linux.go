@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package hrtime
+
+const libc = "libc.so.6"
+
+const (
+	_CLOCK_MONOTONIC     = 1
+	_CLOCK_MONOTONIC_RAW = 4
+)
+
+var sources = []source{
+	clockGettime("clock_gettime(CLOCK_MONOTONIC_RAW)", _CLOCK_MONOTONIC_RAW),
+	clockGettime("clock_gettime(CLOCK_MONOTONIC)", _CLOCK_MONOTONIC),
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package hrtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/purego/hrtime"
+)
+
+func TestClock(t *testing.T) {
+	c := hrtime.Open()
+	if c.Name() == "go" {
+		t.Fatal("no platform timer passed calibration")
+	}
+	t.Logf("clock %s with resolution %v", c.Name(), c.Resolution())
+	if c.Resolution() <= 0 || c.Resolution() > time.Millisecond {
+		t.Errorf("Resolution got %v wanted at most 1ms", c.Resolution())
+	}
+
+	start := time.Now()
+	s0 := c.Seconds()
+	time.Sleep(20 * time.Millisecond)
+	d := c.Since(s0)
+	elapsed := time.Since(start)
+	if d < 20*time.Millisecond || d > elapsed {
+		t.Errorf("Since got %v wanted between 20ms and %v", d, elapsed)
+	}
+
+	prev := c.Ticks()
+	for i := 0; i < 1000; i++ {
+		now := c.Ticks()
+		if now < prev {
+			t.Fatalf("Ticks went backwards from %d to %d", prev, now)
+		}
+		prev = now
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package hrtime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+)
+
+// timespec is struct timespec where time_t and long are both the size of a C long.
+type timespec struct {
+	Sec  int
+	Nsec int
+}
+
+// clockGettime returns a source reading clock_gettime(clock) from libc.
+func clockGettime(name string, clock int32) source {
+	return source{
+		name: name,
+		open: func() (func() uint64, uint64, uint64, time.Duration, error) {
+			lib, err := purego.OpenLibrary(libc)
+			if err != nil {
+				return nil, 0, 0, 0, err
+			}
+			var clock_gettime, clock_getres func(clock int32, ts *timespec) int32
+			for _, fn := range []struct {
+				fptr interface{}
+				name string
+			}{{&clock_gettime, "clock_gettime"}, {&clock_getres, "clock_getres"}} {
+				if _, err := lib.Lookup(fn.name); err != nil {
+					return nil, 0, 0, 0, err
+				}
+				lib.RegisterFunc(fn.fptr, fn.name)
+			}
+			var res timespec
+			if clock_getres(clock, &res) != 0 {
+				return nil, 0, 0, 0, fmt.Errorf("hrtime: clock %d is not supported", clock)
+			}
+			read := func() uint64 {
+				var ts timespec
+				clock_gettime(clock, &ts)
+				return uint64(ts.Sec)*1e9 + uint64(ts.Nsec)
+			}
+			return read, 1, 1, time.Duration(res.Sec)*time.Second + time.Duration(res.Nsec), nil
+		},
+	}
+}
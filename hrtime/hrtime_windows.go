@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package hrtime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+)
+
+var sources = []source{
+	{name: "QueryPerformanceCounter", open: openQueryPerformanceCounter},
+}
+
+func openQueryPerformanceCounter() (func() uint64, uint64, uint64, time.Duration, error) {
+	lib, err := purego.OpenLibrary("kernel32.dll")
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+	var QueryPerformanceCounter, QueryPerformanceFrequency func(v *int64) bool
+	lib.RegisterFunc(&QueryPerformanceCounter, "QueryPerformanceCounter")
+	lib.RegisterFunc(&QueryPerformanceFrequency, "QueryPerformanceFrequency")
+	var freq int64
+	if !QueryPerformanceFrequency(&freq) || freq <= 0 {
+		return nil, 0, 0, 0, errors.New("hrtime: QueryPerformanceFrequency failed")
+	}
+	read := func() uint64 {
+		var v int64
+		QueryPerformanceCounter(&v)
+		return uint64(v)
+	}
+	res := time.Second / time.Duration(freq)
+	if res == 0 {
+		res = 1
+	}
+	return read, uint64(time.Second), uint64(freq), res, nil
+}
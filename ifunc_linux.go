@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// ifuncArgHWCap is _IFUNC_ARG_HWCAP which tells the resolvers of arm64 that their second
+// parameter points to an ifuncArg.
+const ifuncArgHWCap = 1 << 62
+
+// ifuncArg is __ifunc_arg_t of arm64.
+type ifuncArg struct {
+	size   uint64
+	hwcap  uint64
+	hwcap2 uint64
+}
+
+// ResolveIFunc calls resolver, the function a symbol of type STT_GNU_IFUNC holds the address of,
+// and returns the address of the implementation it picks for the machine. glibc defines functions
+// such as memcpy and strlen this way so that the fastest version for the CPU is used and calling
+// the resolver in place of the function returns a function pointer instead of doing its work.
+//
+// Dlsym and Library.Lookup already return the implementation. ResolveIFunc is for addresses read
+// from a symbol table directly, for example with debug/elf. The resolver is passed the hardware
+// capabilities of the auxiliary vector as glibc does.
+func ResolveIFunc(resolver uintptr) uintptr {
+	hwcap, _ := auxvValue(atHWCap)
+	if runtime.GOARCH == "arm64" {
+		hwcap2, _ := auxvValue(atHWCap2)
+		arg := ifuncArg{size: uint64(unsafe.Sizeof(ifuncArg{})), hwcap: uint64(hwcap), hwcap2: uint64(hwcap2)}
+		impl, _, _ := SyscallN(resolver, uintptr(uint64(hwcap)|ifuncArgHWCap), uintptr(unsafe.Pointer(&arg)))
+		return impl
+	}
+	impl, _, _ := SyscallN(resolver, hwcap)
+	return impl
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"debug/elf"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestResolveIFunc(t *testing.T) {
+	lib, err := purego.OpenLibrary("libc.so.6")
+	if err != nil {
+		t.Skip("glibc isn't available:", err)
+	}
+	defer lib.Close()
+	info, err := lib.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := elf.Open(info.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	syms, err := f.DynamicSymbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range syms {
+		if s.Name != "strlen" || elf.ST_TYPE(s.Info) != elf.SymType(10) { // STT_GNU_IFUNC
+			continue
+		}
+		want, err := lib.Lookup("strlen")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := purego.ResolveIFunc(info.Base + uintptr(s.Value)); got != want {
+			t.Errorf("ResolveIFunc of strlen got %#x wanted %#x which Lookup returned", got, want)
+		}
+		return
+	}
+	t.Skip("strlen isn't an indirect function")
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (freebsd || linux) && !(amd64 || arm64)
+
+// Package cgotraceback provides the C functions purego registers with runtime.SetCgoTraceback
+// on platforms where purego calls C through package internal/cgo.
+package cgotraceback
+
+// this file is placed inside internal/cgotraceback and not package purego
+// because Cgo and assembly files can't be in the same package.
+
+/*
+#cgo linux LDFLAGS: -ldl
+
+#define _GNU_SOURCE
+#include <stdint.h>
+#include <dlfcn.h>
+#include <signal.h>
+#include <ucontext.h>
+
+struct cgoTracebackArg {
+	uintptr_t context;
+	uintptr_t sigContext;
+	uintptr_t *buf;
+	uintptr_t max;
+};
+
+struct cgoSymbolizerArg {
+	uintptr_t pc;
+	const char *file;
+	uintptr_t lineno;
+	const char *func;
+	uintptr_t entry;
+	uintptr_t more;
+	uintptr_t data;
+};
+
+// signalPC returns the PC of the interrupted code in the signal context ctx or 0 if it is unknown.
+static uintptr_t signalPC(void *ctx) {
+	ucontext_t *uc = ctx;
+#if defined(__linux__) && defined(__i386__)
+	return uc->uc_mcontext.gregs[REG_EIP];
+#elif defined(__linux__) && defined(__arm__)
+	return uc->uc_mcontext.arm_pc;
+#elif defined(__FreeBSD__) && defined(__i386__)
+	return uc->uc_mcontext.mc_eip;
+#else
+	return 0;
+#endif
+}
+
+// traceback only reports the PC a signal interrupted. Unwinding C frames from a signal handler
+// isn't safe without frame pointers and the PC is enough to name the C function and library.
+void purego_traceback(void *p) {
+	struct cgoTracebackArg *arg = p;
+	uintptr_t n = 0;
+	if (arg->sigContext != 0 && arg->max > 1) {
+		uintptr_t pc = signalPC((void *)arg->sigContext);
+		if (pc != 0) {
+			arg->buf[n++] = pc;
+		}
+	}
+	if (n < arg->max) {
+		arg->buf[n] = 0;
+	}
+}
+
+// symbolizer names the function and library of a PC with dladdr.
+void purego_symbolizer(void *p) {
+	struct cgoSymbolizerArg *arg = p;
+	Dl_info info;
+	arg->more = 0;
+	if (arg->pc == 0 || dladdr((void *)arg->pc, &info) == 0) {
+		arg->file = 0;
+		arg->func = 0;
+		arg->entry = 0;
+		return;
+	}
+	arg->file = info.dli_fname;
+	arg->lineno = 0;
+	arg->func = info.dli_sname;
+	arg->entry = (uintptr_t)info.dli_saddr;
+}
+*/
+import "C"
+
+import "unsafe"
+
+// Traceback and Symbolizer are the C functions to pass to runtime.SetCgoTraceback.
+var (
+	Traceback  = unsafe.Pointer(C.purego_traceback)
+	Symbolizer = unsafe.Pointer(C.purego_symbolizer)
+)
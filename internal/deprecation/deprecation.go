@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package deprecation reports uses of deprecated purego APIs.
+package deprecation
+
+import (
+	"context"
+	"runtime/trace"
+)
+
+// Category is the category of the runtime/trace log events of deprecated APIs.
+const Category = "purego.deprecated"
+
+// Use reports a use of the deprecated API name which is replaced by replacement.
+// It logs a runtime/trace event while tracing is enabled and costs a single check otherwise,
+// so that deprecated aliases can call it on every use.
+func Use(name, replacement string) {
+	if !trace.IsEnabled() {
+		return
+	}
+	trace.Log(context.Background(), Category, name+" is deprecated: use "+replacement+" instead")
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package deprecation_test
+
+import (
+	"bytes"
+	"runtime/trace"
+	"testing"
+
+	"github.com/jwijenbergh/purego/internal/deprecation"
+)
+
+func TestUse(t *testing.T) {
+	// not tracing so nothing is logged
+	deprecation.Use("Before", "After")
+
+	var buf bytes.Buffer
+	if err := trace.Start(&buf); err != nil {
+		t.Fatal(err)
+	}
+	deprecation.Use("Old", "New")
+	trace.Stop()
+
+	if !bytes.Contains(buf.Bytes(), []byte("Old is deprecated: use New instead")) {
+		t.Errorf("trace doesn't contain the deprecation of Old")
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(deprecation.Category)) {
+		t.Errorf("trace doesn't contain the category %q", deprecation.Category)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("Before is deprecated")) {
+		t.Errorf("trace contains a deprecation from before tracing started")
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package fake holds the registry of fake libraries installed by package puregotest.
+// It lives in an internal package so that purego can consult it without exposing
+// the registry as part of its own API.
+package fake
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Library is a set of Go functions standing in for the symbols of a shared library.
+type Library struct {
+	Name   string
+	handle uintptr
+	syms   map[string]uintptr
+}
+
+var (
+	// enabled is non-zero while at least one library is installed.
+	// It keeps the cost of the hooks in purego to a single atomic load otherwise.
+	enabled int32
+	// numFuncs is the number of fake symbols ever added.
+	numFuncs int32
+
+	mu      sync.RWMutex
+	libs    = map[string]*Library{}
+	handles = map[uintptr]*Library{}
+	funcs   = map[uintptr]reflect.Value{}
+	// keep holds the memory backing every fake address so that it is never reused.
+	keep = map[uintptr]*byte{}
+)
+
+// newAddr returns a unique non-zero address that can never be the address of C code.
+// mu must be held.
+func newAddr() uintptr {
+	p := new(byte)
+	addr := uintptr(unsafe.Pointer(p))
+	keep[addr] = p
+	return addr
+}
+
+// NewLibrary returns an empty fake library called name.
+func NewLibrary(name string) *Library {
+	mu.Lock()
+	defer mu.Unlock()
+	return &Library{Name: name, handle: newAddr(), syms: map[string]uintptr{}}
+}
+
+// Add makes fn the implementation of the symbol name and returns its fake address.
+func (l *Library) Add(name string, fn reflect.Value) uintptr {
+	mu.Lock()
+	defer mu.Unlock()
+	addr, ok := l.syms[name]
+	if !ok {
+		addr = newAddr()
+		l.syms[name] = addr
+		atomic.AddInt32(&numFuncs, 1)
+	}
+	funcs[addr] = fn
+	return addr
+}
+
+// Symbol returns the fake address of the symbol name.
+func (l *Library) Symbol(name string) (uintptr, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	addr, ok := l.syms[name]
+	return addr, ok
+}
+
+// Handle returns the fake handle returned by Open for this library.
+func (l *Library) Handle() uintptr {
+	return l.handle
+}
+
+// Install makes Open return l for its name until Uninstall is called.
+func Install(l *Library) {
+	mu.Lock()
+	defer mu.Unlock()
+	libs[l.Name] = l
+	handles[l.handle] = l
+	atomic.StoreInt32(&enabled, int32(len(libs)))
+}
+
+// Uninstall removes l from the set of installed libraries.
+func Uninstall(l *Library) {
+	mu.Lock()
+	defer mu.Unlock()
+	if libs[l.Name] == l {
+		delete(libs, l.Name)
+	}
+	delete(handles, l.handle)
+	atomic.StoreInt32(&enabled, int32(len(libs)))
+}
+
+// Enabled reports whether any fake library is installed.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) != 0
+}
+
+// Open returns the handle of the installed library called name.
+func Open(name string) (uintptr, bool) {
+	if !Enabled() {
+		return 0, false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	l, ok := libs[name]
+	if !ok {
+		return 0, false
+	}
+	return l.handle, true
+}
+
+// IsHandle reports whether handle was returned by Open.
+func IsHandle(handle uintptr) bool {
+	if !Enabled() {
+		return false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := handles[handle]
+	return ok
+}
+
+// Symbol looks up name in the installed library with the given handle.
+// ok is false if handle is not a fake handle.
+func Symbol(handle uintptr, name string) (addr uintptr, ok bool) {
+	if !Enabled() {
+		return 0, false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	if l, ok := handles[handle]; ok {
+		addr, ok := l.syms[name]
+		return addr, ok
+	}
+	return 0, false
+}
+
+// GlobalSymbol looks up name in every installed library. It is used for lookups through
+// pseudo-handles like RTLD_DEFAULT which search all libraries.
+func GlobalSymbol(name string) (addr uintptr, ok bool) {
+	if !Enabled() {
+		return 0, false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, l := range libs {
+		if addr, ok := l.syms[name]; ok {
+			return addr, true
+		}
+	}
+	return 0, false
+}
+
+// Func returns the Go function implementing the fake symbol at addr.
+func Func(addr uintptr) (reflect.Value, bool) {
+	if atomic.LoadInt32(&numFuncs) == 0 {
+		return reflect.Value{}, false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	fn, ok := funcs[addr]
+	return fn, ok
+}
@@ -41,6 +41,22 @@ func GoString(c uintptr) string {
 	if ptr == nil {
 		return ""
 	}
+	return string(unsafe.Slice((*byte)(ptr), strlen(ptr)))
+}
+
+// GoStringNoCopy returns a Go string that references the null-terminated char* c without copying it.
+// The memory must not change or be freed while the string is used.
+func GoStringNoCopy(c uintptr) string {
+	ptr := *(*unsafe.Pointer)(unsafe.Pointer(&c))
+	if ptr == nil {
+		return ""
+	}
+	b := unsafe.Slice((*byte)(ptr), strlen(ptr))
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+// strlen returns the length of the null-terminated char* ptr.
+func strlen(ptr unsafe.Pointer) int {
 	var length int
 	for {
 		if *(*byte)(unsafe.Add(ptr, uintptr(length))) == '\x00' {
@@ -48,5 +64,5 @@ func GoString(c uintptr) string {
 		}
 		length++
 	}
-	return string(unsafe.Slice((*byte)(ptr), length))
+	return length
 }
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// Interposition is an override of the calls of a library to a function it imports installed by Interpose.
+type Interposition struct {
+	// Name is the name of the imported function.
+	Name string
+
+	mu sync.Mutex
+	// slots are the GOT or IAT entries of the function in the library and saved is what they held.
+	slots    []importSlot
+	saved    []uintptr
+	original uintptr
+	restored bool
+}
+
+// importSlot is a GOT or IAT entry of an imported function.
+type importSlot struct {
+	addr uintptr
+	// relro is true if the entry is in the part of the GOT that the loader made read-only
+	// after relocating (RELRO).
+	relro bool
+}
+
+// Interpose makes the calls of the library handle to the function name, which it imports from another
+// library, call replacement instead, usually a callback created with NewCallback. It patches the entries
+// of name in the global offset table of an ELF library or in the import address table of a Windows module
+// so it only affects the calls of that library and works on a library that is already loaded without
+// LD_PRELOAD. Restore undoes it. This allows mocking a C function in tests or instrumenting it:
+//
+//	var getenv func(name *byte) *byte
+//	ip, err := purego.Interpose(lib, "getenv", purego.NewCallback(func(name *byte) *byte {
+//		calls++
+//		return getenv(name)
+//	}))
+//	purego.RegisterFunc(&getenv, ip.Original())
+//
+// Calls of the library that don't go through its imports, for example of its own functions, are not
+// affected. Calls that run concurrently with Interpose or Restore may call either function.
+// Interpose isn't supported on macOS where the imports are bound with chained fixups.
+func Interpose(handle uintptr, name string, replacement uintptr) (*Interposition, error) {
+	if replacement == 0 {
+		return nil, errors.New("purego: replacement of " + name + " is nil")
+	}
+	slots, original, err := importSlots(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 {
+		return nil, errors.New("purego: the library doesn't import " + name)
+	}
+	ip := &Interposition{Name: name, slots: slots, saved: make([]uintptr, len(slots)), original: original}
+	for i, slot := range slots {
+		ip.saved[i] = atomic.LoadUintptr((*uintptr)(imagePointer(slot.addr)))
+	}
+	if ip.original == 0 {
+		ip.original = ip.saved[0]
+	}
+	if err := ip.write(func(int) uintptr { return replacement }); err != nil {
+		return nil, err
+	}
+	return ip, nil
+}
+
+// Interpose is like Interpose for the library.
+func (l *Library) Interpose(name string, replacement uintptr) (*Interposition, error) {
+	return Interpose(l.Handle(), name, replacement)
+}
+
+// Original returns the address of the function that the library called before Interpose.
+// The replacement calls it to forward a call.
+func (ip *Interposition) Original() uintptr {
+	return ip.original
+}
+
+// Restore puts back what the patched entries held before Interpose. Calling it again does nothing.
+func (ip *Interposition) Restore() error {
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+	if ip.restored {
+		return nil
+	}
+	if err := ip.write(func(i int) uintptr { return ip.saved[i] }); err != nil {
+		return err
+	}
+	ip.restored = true
+	return nil
+}
+
+// protectMu serializes the stores to import slots since making the page of a slot writable and
+// protecting it again would race with a store to another slot on the same page.
+var protectMu sync.Mutex
+
+// write stores value(i) in the i-th slot making the memory writable while doing so.
+func (ip *Interposition) write(value func(i int) uintptr) error {
+	protectMu.Lock()
+	defer protectMu.Unlock()
+	for i, slot := range ip.slots {
+		if err := writeProtected(slot, value(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
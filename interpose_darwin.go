@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "errors"
+
+// The imports of a Mach-O image are bound through chained fixups or the lazy and non-lazy
+// symbol pointers of dyld which aren't patched by Interpose.
+
+func importSlots(uintptr, string) ([]importSlot, uintptr, error) {
+	return nil, 0, errors.New("purego: Interpose isn't supported on macOS")
+}
+
+func writeProtected(importSlot, uintptr) error {
+	return errors.New("purego: Interpose isn't supported on macOS")
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import (
+	"debug/elf"
+	"errors"
+	"os"
+	"sync/atomic"
+	"syscall"
+)
+
+// importRelocs are the relocation types of each machine that bind a GOT entry to an imported function:
+// the jump slot of its PLT entry and the GOT entry of code built with -fno-plt or taking its address.
+var importRelocs = map[elf.Machine][2]uint32{
+	elf.EM_X86_64:  {uint32(elf.R_X86_64_JMP_SLOT), uint32(elf.R_X86_64_GLOB_DAT)},
+	elf.EM_AARCH64: {uint32(elf.R_AARCH64_JUMP_SLOT), uint32(elf.R_AARCH64_GLOB_DAT)},
+	elf.EM_386:     {uint32(elf.R_386_JMP_SLOT), uint32(elf.R_386_GLOB_DAT)},
+	elf.EM_ARM:     {uint32(elf.R_ARM_JUMP_SLOT), uint32(elf.R_ARM_GLOB_DAT)},
+}
+
+// importSlots returns the addresses of the GOT entries of the function name in the library handle
+// and the address of the function if an entry is still bound lazily to the PLT of the library.
+func importSlots(handle uintptr, name string) ([]importSlot, uintptr, error) {
+	info, err := DlInfo(handle)
+	if err != nil {
+		return nil, 0, err
+	}
+	path := info.Path
+	if path == "" {
+		if path, err = os.Executable(); err != nil {
+			return nil, 0, err
+		}
+	}
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+	types, ok := importRelocs[f.Machine]
+	if !ok {
+		return nil, 0, errors.New("purego: Interpose doesn't support " + f.Machine.String())
+	}
+	syms, err := f.DynamicSymbols()
+	if err != nil {
+		return nil, 0, err
+	}
+	// the loader makes the whole pages of PT_GNU_RELRO read-only
+	page := uint64(os.Getpagesize())
+	var relroStart, relroEnd uint64
+	for _, p := range f.Progs {
+		if p.Type == elf.PT_GNU_RELRO {
+			relroStart, relroEnd = p.Vaddr&^(page-1), (p.Vaddr+p.Memsz)&^(page-1)
+		}
+	}
+	var slots []importSlot
+	for _, s := range f.Sections {
+		if (s.Type != elf.SHT_RELA && s.Type != elf.SHT_REL) || int(s.Link) >= len(f.Sections) || f.Sections[s.Link].Type != elf.SHT_DYNSYM {
+			continue
+		}
+		data, err := s.Data()
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, r := range readRelocs(f, s.Type, data) {
+			// DynamicSymbols leaves out the null symbol at index 0
+			if (r.typ != types[0] && r.typ != types[1]) || r.sym == 0 || int(r.sym) > len(syms) || syms[r.sym-1].Name != name {
+				continue
+			}
+			slots = append(slots, importSlot{addr: info.Base + uintptr(r.off), relro: r.off >= relroStart && r.off < relroEnd})
+		}
+	}
+	// a lazily bound entry still points into the PLT which would bind it again when called
+	// so the function is looked up like the loader would
+	var original uintptr
+	for _, slot := range slots {
+		v := *(*uintptr)(imagePointer(slot.addr))
+		for _, plt := range []string{".plt", ".plt.sec", ".plt.got"} {
+			if s := f.Section(plt); s != nil && v >= info.Base+uintptr(s.Addr) && v < info.Base+uintptr(s.Addr+s.Size) {
+				original, _ = Dlsym(handle, name)
+			}
+		}
+	}
+	return slots, original, nil
+}
+
+// elfReloc is an entry of a relocation section.
+type elfReloc struct {
+	off uint64
+	sym uint32
+	typ uint32
+}
+
+// readRelocs decodes the relocations of a section of type typ with the contents data.
+func readRelocs(f *elf.File, typ elf.SectionType, data []byte) []elfReloc {
+	size := 12 // Elf32_Rela is 12 and Elf32_Rel 8 bytes
+	if f.Class == elf.ELFCLASS64 {
+		size = 24 // Elf64_Rela is 24 and Elf64_Rel 16 bytes
+	}
+	if typ == elf.SHT_REL {
+		// Rel is Rela without the addend
+		size -= size / 3
+	}
+	var relocs []elfReloc
+	for ; len(data) >= size; data = data[size:] {
+		if f.Class == elf.ELFCLASS64 {
+			info := f.ByteOrder.Uint64(data[8:])
+			relocs = append(relocs, elfReloc{off: f.ByteOrder.Uint64(data), sym: uint32(info >> 32), typ: uint32(info)})
+		} else {
+			info := f.ByteOrder.Uint32(data[4:])
+			relocs = append(relocs, elfReloc{off: uint64(f.ByteOrder.Uint32(data)), sym: info >> 8, typ: info & 0xff})
+		}
+	}
+	return relocs
+}
+
+// writeProtected stores value to slot. If slot is read-only because of RELRO its page is made
+// writable for the store and read-only again afterwards. If that fails the store is undone.
+func writeProtected(slot importSlot, value uintptr) error {
+	p := (*uintptr)(imagePointer(slot.addr))
+	if !slot.relro {
+		atomic.StoreUintptr(p, value)
+		return nil
+	}
+	page := uintptr(os.Getpagesize())
+	start := slot.addr &^ (page - 1)
+	if _, _, errno := syscall.Syscall(syscall.SYS_MPROTECT, start, page, syscall.PROT_READ|syscall.PROT_WRITE); errno != 0 {
+		return errors.New("purego: mprotect failed: " + errno.Error())
+	}
+	old := atomic.SwapUintptr(p, value)
+	if _, _, errno := syscall.Syscall(syscall.SYS_MPROTECT, start, page, syscall.PROT_READ); errno != 0 {
+		atomic.StoreUintptr(p, old)
+		return errors.New("purego: mprotect failed, the entry was left unchanged but is writable: " + errno.Error())
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego_test
+
+import (
+	"os"
+	"path/filepath"
+	stdstrings "strings"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+func TestInterpose(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libinterposetest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libinterposetest", "interpose.c")); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PUREGO_INTERPOSE", "real")
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatalf("OpenLibrary(%q) failed: %v", libFileName, err)
+	}
+	defer lib.Close()
+
+	var lookupEnv func(name string) string
+	lib.RegisterFunc(&lookupEnv, "lookup_env")
+	if got := lookupEnv("PUREGO_INTERPOSE"); got != "real" {
+		t.Fatalf("lookup_env got %q wanted %q", got, "real")
+	}
+
+	mocked := []byte("mocked\x00")
+	var getenv func(name uintptr) uintptr
+	var names []string
+	ip, err := lib.Interpose("getenv", purego.NewCallback(func(name uintptr) uintptr {
+		names = append(names, strings.GoString(name))
+		if r := getenv(name); r != 0 {
+			return uintptr(unsafe.Pointer(&mocked[0]))
+		}
+		return 0
+	}))
+	if err != nil {
+		t.Fatalf("Interpose failed: %v", err)
+	}
+	purego.RegisterFunc(&getenv, ip.Original())
+	if got := lookupEnv("PUREGO_INTERPOSE"); got != "mocked" {
+		t.Errorf("lookup_env got %q wanted %q", got, "mocked")
+	}
+	if len(names) != 1 || names[0] != "PUREGO_INTERPOSE" {
+		t.Errorf("the replacement was called with %q", names)
+	}
+
+	if err := ip.Restore(); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if err := ip.Restore(); err != nil {
+		t.Fatalf("the second Restore failed: %v", err)
+	}
+	if got := lookupEnv("PUREGO_INTERPOSE"); got != "real" {
+		t.Errorf("lookup_env got %q after Restore wanted %q", got, "real")
+	}
+	if len(names) != 1 {
+		t.Errorf("the replacement was called after Restore")
+	}
+
+	if _, err := lib.Interpose("no_such_import", purego.NewCallback(func() {})); err == nil {
+		t.Errorf("Interpose of a function that isn't imported didn't fail")
+	}
+}
+
+func TestInterposeKeepsRELRO(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libinterposetest.so")
+	// binding every import when the library is loaded puts the whole GOT into RELRO
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libinterposetest", "interpose.c"), "-Wl,-z,relro,-z,now"); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatalf("OpenLibrary(%q) failed: %v", libFileName, err)
+	}
+	defer lib.Close()
+	// mappings returns the lines of /proc/self/maps of the library with their address range and protection
+	mappings := func() []string {
+		maps, err := os.ReadFile("/proc/self/maps")
+		if err != nil {
+			t.Skipf("reading /proc/self/maps failed: %v", err)
+		}
+		var lines []string
+		for _, line := range stdstrings.Split(string(maps), "\n") {
+			if f := stdstrings.Fields(line); len(f) >= 6 && f[5] == libFileName {
+				lines = append(lines, f[0]+" "+f[1])
+			}
+		}
+		return lines
+	}
+	before := stdstrings.Join(mappings(), "\n")
+	if !stdstrings.Contains(before, " r--p") {
+		t.Skip("the library has no read-only mapping for RELRO")
+	}
+
+	ip, err := lib.Interpose("getenv", purego.NewCallback(func(name uintptr) uintptr { return 0 }))
+	if err != nil {
+		t.Fatalf("Interpose failed: %v", err)
+	}
+	if got := stdstrings.Join(mappings(), "\n"); got != before {
+		t.Errorf("the mappings of the library changed with Interpose from\n%s\nto\n%s", before, got)
+	}
+	if err := ip.Restore(); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if got := stdstrings.Join(mappings(), "\n"); got != before {
+		t.Errorf("the mappings of the library changed with Restore from\n%s\nto\n%s", before, got)
+	}
+}
+
+func TestInterposeConcurrentRELRO(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libinterposetest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libinterposetest", "interpose.c"), "-Wl,-z,relro,-z,now"); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatalf("OpenLibrary(%q) failed: %v", libFileName, err)
+	}
+	defer lib.Close()
+	// the GOT entries of getenv and atoi are on the same read-only page
+	replacements := map[string]uintptr{
+		"getenv": purego.NewCallback(func(name uintptr) uintptr { return 0 }),
+		"atoi":   purego.NewCallback(func(s uintptr) int32 { return 0 }),
+	}
+	var wg sync.WaitGroup
+	for name, replacement := range replacements {
+		wg.Add(1)
+		go func(name string, replacement uintptr) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				ip, err := lib.Interpose(name, replacement)
+				if err != nil {
+					t.Errorf("Interpose(%q) failed: %v", name, err)
+					return
+				}
+				if err := ip.Restore(); err != nil {
+					t.Errorf("Restore of %q failed: %v", name, err)
+					return
+				}
+			}
+		}(name, replacement)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"errors"
+	"sync/atomic"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// importSlots returns the addresses of the import address table entries of the function name
+// in the module handle. The entries are bound when the module is loaded so the original is
+// what they hold.
+func importSlots(handle uintptr, name string) ([]importSlot, uintptr, error) {
+	dir, err := dataDirectory(handle, 1) // IMAGE_DIRECTORY_ENTRY_IMPORT
+	if err != nil || dir == 0 {
+		return nil, 0, err
+	}
+	u32 := func(off uintptr) uint32 { return *(*uint32)(imagePointer(handle + off)) }
+	ptrSize := unsafe.Sizeof(uintptr(0))
+	var slots []importSlot
+	// the descriptors are IMAGE_IMPORT_DESCRIPTOR and end with one that is zero
+	for desc := dir; u32(desc+12) != 0; desc += 20 {
+		names, iat := uintptr(u32(desc)), uintptr(u32(desc+16))
+		if names == 0 {
+			// without OriginalFirstThunk the names were overwritten by the binding
+			continue
+		}
+		for i := uintptr(0); ; i++ {
+			thunk := *(*uintptr)(imagePointer(handle + names + i*ptrSize))
+			if thunk == 0 {
+				break
+			}
+			if thunk>>(ptrSize*8-1) != 0 {
+				continue // imported by ordinal
+			}
+			// IMAGE_IMPORT_BY_NAME starts with a 2 byte hint
+			if strings.GoString(handle+thunk+2) == name {
+				slots = append(slots, importSlot{addr: handle + iat + i*ptrSize})
+			}
+		}
+	}
+	return slots, 0, nil
+}
+
+// writeProtected stores value to slot after making it writable and puts back the protection
+// afterwards. If that fails the store is undone.
+func writeProtected(slot importSlot, value uintptr) error {
+	size := unsafe.Sizeof(uintptr(0))
+	var prot uint32
+	if err := windows.VirtualProtect(slot.addr, size, windows.PAGE_READWRITE, &prot); err != nil {
+		return errors.New("purego: VirtualProtect failed: " + err.Error())
+	}
+	p := (*uintptr)(imagePointer(slot.addr))
+	old := atomic.SwapUintptr(p, value)
+	if err := windows.VirtualProtect(slot.addr, size, prot, &prot); err != nil {
+		atomic.StoreUintptr(p, old)
+		return errors.New("purego: VirtualProtect failed, the entry was left unchanged but is writable: " + err.Error())
+	}
+	return nil
+}
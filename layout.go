@@ -0,0 +1,1001 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// cLayout is the memory layout of the C type a Go type is marshaled to.
+type cLayout struct {
+	size  uintptr
+	align uintptr
+	// fields is the layout of every field of a struct in declaration order.
+	fields []cField
+	// elem and len describe the elements of an array.
+	elem *cLayout
+	len  int
+	// goSize is the size of the Go type.
+	goSize uintptr
+	// funcType is the type of a Go func which is a C function pointer created with NewCallback.
+	funcType reflect.Type
+	// goKind and cKind are the kinds of a number that has a different size in C than in Go
+	// because of the width tag. cKind is reflect.Invalid for other types.
+	goKind, cKind reflect.Kind
+	// sliceType is the type of a slice field which is a pointer to its first element in C.
+	sliceType reflect.Type
+	// sameAsGo is true if the C layout is identical to the Go layout
+	// which means values can be copied between Go and C memory as is.
+	sameAsGo bool
+	// union is true if the fields of a struct all start at offset 0 like the members of a C union.
+	union bool
+	// largest is the index in fields of the largest member of a union and activeOffset plus one
+	// is the Go offset of its string field tagged as active, or 0 if it has none.
+	largest      int
+	activeOffset uintptr
+	// hasActive is true if the layout contains a union with an active field, whose name checkActive
+	// validates before the value is copied to C.
+	hasActive bool
+}
+
+// cField is a field of a struct laid out like C does.
+type cField struct {
+	name   string
+	cname  string // the name of the field in C if it is renamed by the name tag
+	index  int    // index of the field in the Go struct
+	offset uintptr
+	// goOffset is the offset of the field in the Go struct.
+	goOffset uintptr
+	typ      reflect.Type
+	layout   *cLayout
+	// embedded is true for an embedded struct whose fields are promoted like those of an anonymous
+	// struct or union in C.
+	embedded bool
+	// length is the index in fields of the field tagged as the length of a slice field plus one
+	// and lengthOf is the index of the slice field plus one for the length field.
+	length, lengthOf int
+}
+
+var layouts sync.Map // map[reflect.Type]*cLayout
+
+// layoutOf returns the C layout of the Go type t or an error describing why t can't be represented in C.
+func layoutOf(t reflect.Type) (*cLayout, error) {
+	if l, ok := layouts.Load(t); ok {
+		return l.(*cLayout), nil
+	}
+	l, err := computeLayout(t)
+	if err != nil {
+		return nil, err
+	}
+	layouts.Store(t, l)
+	return l, nil
+}
+
+func computeLayout(t reflect.Type) (*cLayout, error) {
+	l, err := kindLayout(t)
+	if err != nil {
+		return nil, err
+	}
+	l.goSize = t.Size()
+	return l, nil
+}
+
+func kindLayout(t reflect.Type) (*cLayout, error) {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int8, reflect.Uint8, reflect.Int16, reflect.Uint16, reflect.Int32, reflect.Uint32,
+		reflect.Float32, reflect.Int, reflect.Uint, reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer:
+		return &cLayout{size: t.Size(), align: uintptr(t.Align()), sameAsGo: true}, nil
+	case reflect.Func:
+		// a Go func is a pointer to a closure and has to be converted to a C function pointer
+		return &cLayout{size: t.Size(), align: uintptr(t.Align()), funcType: t}, nil
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		l := &cLayout{size: 8, align: uintptr(t.Align()), sameAsGo: true}
+		if runtime.GOOS == "windows" && runtime.GOARCH == "386" {
+			// MSVC aligns 8 byte types to 8 even on 32-bit while Go aligns them to 4
+			l.align = 8
+			l.sameAsGo = false
+		}
+		return l, nil
+	case reflect.Struct:
+		return structLayout(t)
+	case reflect.Array:
+		el, err := layoutOf(t.Elem())
+		if err != nil {
+			return nil, errors.New("purego: element of " + t.String() + ": " + strings.TrimPrefix(err.Error(), "purego: "))
+		}
+		return &cLayout{
+			size:  el.size * uintptr(t.Len()),
+			align: el.align,
+			elem:  el,
+			len:   t.Len(),
+			// the elements are laid out back to back in C which Go only does if their sizes agree
+			sameAsGo:  el.sameAsGo && el.size == el.goSize,
+			hasActive: el.hasActive,
+		}, nil
+	default:
+		return nil, errors.New("purego: " + t.String() + " has no C representation")
+	}
+}
+
+func structLayout(t reflect.Type) (*cLayout, error) {
+	fieldErr := func(f reflect.StructField, err error) error {
+		return errors.New("purego: field " + f.Name + " of " + t.String() + ": " + strings.TrimPrefix(err.Error(), "purego: "))
+	}
+	// tags on blank fields apply to the whole struct so they are collected before the other fields
+	// are laid out, which lets the union marker come after the field tagged as active
+	var st layoutTag
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Name == "_" {
+			tag, err := parseLayoutTag(f.Tag.Get("purego"))
+			if err != nil {
+				return nil, fieldErr(f, err)
+			}
+			st.packed = st.packed || tag.packed
+			st.union = st.union || tag.union
+			if tag.align > st.align {
+				st.align = tag.align
+			}
+		}
+	}
+	l := &cLayout{align: 1, sameAsGo: true, union: st.union}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		var tag layoutTag
+		if f.Name != "_" {
+			var err error
+			if tag, err = parseLayoutTag(f.Tag.Get("purego")); err != nil {
+				return nil, fieldErr(f, err)
+			}
+		}
+		if tag.active {
+			if !st.union || f.Type.Kind() != reflect.String || l.activeOffset != 0 {
+				return nil, fieldErr(f, errors.New("purego: active must tag the only string field of a union"))
+			}
+			l.activeOffset = f.Offset + 1
+			l.hasActive = true
+			l.sameAsGo = false
+			continue
+		}
+		if tag.skip {
+			// the field only exists in Go
+			l.sameAsGo = false
+			continue
+		}
+		var fl *cLayout
+		var err error
+		switch {
+		case tag.width != 0:
+			fl, err = resizedLayout(f.Type, tag.width)
+		case f.Type.Kind() == reflect.Slice:
+			fl = &cLayout{size: unsafe.Sizeof(uintptr(0)), align: unsafe.Alignof(uintptr(0)), goSize: f.Type.Size(), sliceType: f.Type}
+		default:
+			fl, err = layoutOf(f.Type)
+		}
+		if err != nil {
+			return nil, fieldErr(f, err)
+		}
+		align := fl.align
+		if st.packed || tag.packed {
+			align = 1
+		}
+		if tag.align > align {
+			align = tag.align
+		}
+		offset := alignUp(l.size, align)
+		if st.union {
+			offset = 0
+		}
+		cf := cField{name: f.Name, cname: tag.name, index: i, offset: offset, goOffset: f.Offset, typ: f.Type, layout: fl,
+			embedded: f.Anonymous && f.Type.Kind() == reflect.Struct}
+		if tag.lengthOf != "" {
+			j := l.fieldIndex(tag.lengthOf)
+			if j < 0 || l.fields[j].layout.sliceType == nil || l.fields[j].length != 0 {
+				return nil, fieldErr(f, errors.New("purego: len("+tag.lengthOf+") must name a preceding slice field without another length"))
+			}
+			if k := f.Type.Kind(); k < reflect.Int || k > reflect.Uintptr {
+				return nil, fieldErr(f, errors.New("purego: the length of a slice must be an integer"))
+			}
+			cf.lengthOf = j + 1
+			l.fields[j].length = len(l.fields) + 1
+		}
+		if st.union && (len(l.fields) == 0 || fl.size > l.fields[l.largest].layout.size) {
+			l.largest = len(l.fields)
+		}
+		l.hasActive = l.hasActive || fl.hasActive
+		l.fields = append(l.fields, cf)
+		if end := offset + fl.size; end > l.size {
+			l.size = end
+		}
+		if align > l.align {
+			l.align = align
+		}
+		if offset != f.Offset || !fl.sameAsGo || tag.lengthOf != "" {
+			l.sameAsGo = false
+		}
+	}
+	for _, f := range l.fields {
+		if f.layout.sliceType != nil && f.length == 0 {
+			return nil, errors.New("purego: slice field " + f.name + " of " + t.String() + " needs a field tagged len(" + f.name + ")")
+		}
+	}
+	if st.align > l.align {
+		l.align = st.align
+	}
+	l.size = alignUp(l.size, l.align)
+	// Go pads structs ending in a zero-sized field while C doesn't
+	// so the sizes have to be compared too.
+	if l.size != t.Size() || l.align != uintptr(t.Align()) {
+		l.sameAsGo = false
+	}
+	return l, nil
+}
+
+// layoutTag is the parsed purego tag of a struct field. See Sizeof for its meaning.
+type layoutTag struct {
+	packed   bool
+	union    bool
+	active   bool
+	align    uintptr
+	skip     bool
+	width    uintptr
+	lengthOf string
+	name     string
+}
+
+func parseLayoutTag(tag string) (layoutTag, error) {
+	var lt layoutTag
+	if tag == "" {
+		return lt, nil
+	}
+	if tag == "-" {
+		lt.skip = true
+		return lt, nil
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		opt = strings.TrimSpace(opt)
+		name, arg, hasArg := strings.Cut(opt, "(")
+		if hasArg {
+			if !strings.HasSuffix(arg, ")") {
+				return lt, errors.New("purego: unknown option " + strconv.Quote(opt) + " in tag " + strconv.Quote(tag))
+			}
+			arg = arg[:len(arg)-1]
+		}
+		switch {
+		case opt == "packed":
+			lt.packed = true
+		case opt == "union":
+			lt.union = true
+		case opt == "active":
+			lt.active = true
+		case name == "width" && hasArg:
+			n, err := strconv.ParseUint(arg, 10, 8)
+			if err != nil || n != 1 && n != 2 && n != 4 && n != 8 {
+				return lt, errors.New("purego: width in tag " + strconv.Quote(tag) + " must be 1, 2, 4 or 8")
+			}
+			lt.width = uintptr(n)
+		case name == "len" && hasArg && arg != "":
+			lt.lengthOf = arg
+		case name == "name" && hasArg && arg != "":
+			lt.name = arg
+		case strings.HasPrefix(opt, "align(") && strings.HasSuffix(opt, ")"):
+			n, err := strconv.ParseUint(opt[len("align("):len(opt)-1], 10, 32)
+			if err != nil || n == 0 || n&(n-1) != 0 {
+				return lt, errors.New("purego: alignment in tag " + strconv.Quote(tag) + " must be a power of two")
+			}
+			lt.align = uintptr(n)
+		default:
+			return lt, errors.New("purego: unknown option " + strconv.Quote(opt) + " in tag " + strconv.Quote(tag))
+		}
+	}
+	return lt, nil
+}
+
+// resizedLayout returns the layout of the number type t stored in width bytes in C.
+func resizedLayout(t reflect.Type, width uintptr) (*cLayout, error) {
+	l := &cLayout{size: width, align: width, goSize: t.Size(), goKind: t.Kind()}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		l.cKind = [...]reflect.Kind{1: reflect.Int8, 2: reflect.Int16, 4: reflect.Int32, 8: reflect.Int64}[width]
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		l.cKind = [...]reflect.Kind{1: reflect.Uint8, 2: reflect.Uint16, 4: reflect.Uint32, 8: reflect.Uint64}[width]
+	case reflect.Float32, reflect.Float64:
+		switch width {
+		case 4:
+			l.cKind = reflect.Float32
+		case 8:
+			l.cKind = reflect.Float64
+		default:
+			return nil, errors.New("purego: the width of a float must be 4 or 8")
+		}
+	default:
+		return nil, errors.New("purego: width needs a number but the field is " + t.String())
+	}
+	if runtime.GOOS == "windows" && runtime.GOARCH == "386" && width == 8 {
+		l.align = 8
+	} else if width > unsafe.Alignof(uintptr(0)) {
+		l.align = unsafe.Alignof(uintptr(0))
+	}
+	l.sameAsGo = width == t.Size() && l.align == uintptr(t.Align())
+	return l, nil
+}
+
+// fieldIndex returns the index in l.fields of the field with the Go name name or -1.
+func (l *cLayout) fieldIndex(name string) int {
+	for i, f := range l.fields {
+		if f.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// readNumber returns the number of kind k at p as an int64, uint64 or float64 of the same bits.
+func readNumber(p unsafe.Pointer, k reflect.Kind) (i int64, u uint64, f float64) {
+	switch k {
+	case reflect.Int8:
+		i = int64(*(*int8)(p))
+	case reflect.Int16:
+		i = int64(*(*int16)(p))
+	case reflect.Int32:
+		i = int64(*(*int32)(p))
+	case reflect.Int64:
+		i = *(*int64)(p)
+	case reflect.Int:
+		i = int64(*(*int)(p))
+	case reflect.Uint8:
+		u = uint64(*(*uint8)(p))
+	case reflect.Uint16:
+		u = uint64(*(*uint16)(p))
+	case reflect.Uint32:
+		u = uint64(*(*uint32)(p))
+	case reflect.Uint64:
+		u = *(*uint64)(p)
+	case reflect.Uint:
+		u = uint64(*(*uint)(p))
+	case reflect.Uintptr:
+		u = uint64(*(*uintptr)(p))
+	case reflect.Float32:
+		f = float64(*(*float32)(p))
+	case reflect.Float64:
+		f = *(*float64)(p)
+	}
+	switch {
+	case k >= reflect.Int && k <= reflect.Int64:
+		u = uint64(i)
+	case k >= reflect.Uint && k <= reflect.Uintptr:
+		i = int64(u)
+	}
+	return i, u, f
+}
+
+// convertNumber converts the number of kind from at src into kind to at dst.
+func convertNumber(dst unsafe.Pointer, to reflect.Kind, src unsafe.Pointer, from reflect.Kind) {
+	i, u, f := readNumber(src, from)
+	switch to {
+	case reflect.Int8:
+		*(*int8)(dst) = int8(i)
+	case reflect.Int16:
+		*(*int16)(dst) = int16(i)
+	case reflect.Int32:
+		*(*int32)(dst) = int32(i)
+	case reflect.Int64:
+		*(*int64)(dst) = i
+	case reflect.Int:
+		*(*int)(dst) = int(i)
+	case reflect.Uint8:
+		*(*uint8)(dst) = uint8(u)
+	case reflect.Uint16:
+		*(*uint16)(dst) = uint16(u)
+	case reflect.Uint32:
+		*(*uint32)(dst) = uint32(u)
+	case reflect.Uint64:
+		*(*uint64)(dst) = u
+	case reflect.Uint:
+		*(*uint)(dst) = uint(u)
+	case reflect.Uintptr:
+		*(*uintptr)(dst) = uintptr(u)
+	case reflect.Float32:
+		*(*float32)(dst) = float32(f)
+	case reflect.Float64:
+		*(*float64)(dst) = f
+	}
+}
+
+// sliceHeader is the memory layout of a slice.
+type sliceHeader struct {
+	data     unsafe.Pointer
+	len, cap int
+}
+
+func alignUp(n, align uintptr) uintptr {
+	return (n + align - 1) &^ (align - 1)
+}
+
+// zero clears the n bytes at p.
+func zero(p unsafe.Pointer, n uintptr) {
+	b := unsafe.Slice((*byte)(p), n)
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// fieldCallbacks is how copyToC converts func fields into callbacks. A nil *fieldCallbacks
+// caches the callbacks like CallbackCached.
+type fieldCallbacks struct {
+	lifetime CallbackLifetime
+	// owned are the callbacks that must be released with releaseFuncCallback once C
+	// doesn't use the copy anymore.
+	owned []uintptr
+}
+
+// callback returns the C function pointer of the func field f.
+func (c *fieldCallbacks) callback(f reflect.Value) uintptr {
+	if c == nil {
+		return funcCallback(f)
+	}
+	cb, release := funcArgCallback(f, c.lifetime)
+	if release {
+		c.owned = append(c.owned, cb)
+	}
+	return cb
+}
+
+// release releases the callbacks in c.owned.
+func (c *fieldCallbacks) release() {
+	for _, cb := range c.owned {
+		releaseFuncCallback(cb)
+	}
+	c.owned = nil
+}
+
+// copyToC writes the Go value at src, of the type l was computed for, to dst in the C layout.
+// Func fields are converted with cbs.
+func (l *cLayout) copyToC(dst, src unsafe.Pointer, cbs *fieldCallbacks) {
+	switch {
+	case l.funcType != nil:
+		*(*uintptr)(dst) = cbs.callback(reflect.NewAt(l.funcType, src).Elem())
+	case l.cKind != reflect.Invalid:
+		convertNumber(dst, l.cKind, src, l.goKind)
+	case l.sliceType != nil:
+		*(*unsafe.Pointer)(dst) = (*sliceHeader)(src).data
+	case l.elem != nil && !l.sameAsGo:
+		for i := 0; i < l.len; i++ {
+			l.elem.copyToC(unsafe.Add(dst, uintptr(i)*l.elem.size), unsafe.Add(src, uintptr(i)*l.elem.goSize), cbs)
+		}
+	case l.union && !l.sameAsGo:
+		zero(dst, l.size)
+		if f, ok := l.activeMember(src); ok {
+			f.layout.copyToC(dst, unsafe.Add(src, f.goOffset), cbs)
+		}
+	case l.fields != nil && !l.sameAsGo:
+		for _, f := range l.fields {
+			if f.lengthOf != 0 {
+				// the length is the length of the slice rather than the value of the field
+				s := l.fields[f.lengthOf-1]
+				n := (*sliceHeader)(unsafe.Add(src, s.goOffset)).len
+				convertNumber(unsafe.Add(dst, f.offset), f.layout.kind(f.typ), unsafe.Pointer(&n), reflect.Int)
+				continue
+			}
+			f.layout.copyToC(unsafe.Add(dst, f.offset), unsafe.Add(src, f.goOffset), cbs)
+		}
+	default:
+		// scalars are represented the same way and only their alignment can differ
+		copy(unsafe.Slice((*byte)(dst), l.size), unsafe.Slice((*byte)(src), l.size))
+	}
+}
+
+// activeMember returns the member of the union l that is copied to C from the Go value at src:
+// the one its active field names or else the largest one. checkActive must have accepted src.
+func (l *cLayout) activeMember(src unsafe.Pointer) (cField, bool) {
+	if l.activeOffset != 0 {
+		if name := *(*string)(unsafe.Add(src, l.activeOffset-1)); name != "" {
+			if i := l.fieldIndex(name); i >= 0 {
+				return l.fields[i], true
+			}
+		}
+	}
+	if len(l.fields) == 0 {
+		return cField{}, false
+	}
+	return l.fields[l.largest], true
+}
+
+// checkActive returns an error if the active field of a union in the Go value at src names
+// no member of the union. It is checked before anything is copied to C since the name is only
+// known once the value is.
+func (l *cLayout) checkActive(src unsafe.Pointer) error {
+	switch {
+	case !l.hasActive:
+		return nil
+	case l.elem != nil:
+		for i := 0; i < l.len; i++ {
+			if err := l.elem.checkActive(unsafe.Add(src, uintptr(i)*l.elem.goSize)); err != nil {
+				return err
+			}
+		}
+	case l.union:
+		if l.activeOffset != 0 {
+			if name := *(*string)(unsafe.Add(src, l.activeOffset-1)); name != "" && l.fieldIndex(name) < 0 {
+				return errors.New("purego: the active member " + name + " of a union doesn't exist")
+			}
+		}
+		if f, ok := l.activeMember(src); ok {
+			return f.layout.checkActive(unsafe.Add(src, f.goOffset))
+		}
+	default:
+		for _, f := range l.fields {
+			if err := f.layout.checkActive(unsafe.Add(src, f.goOffset)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// activeArgs returns the indexes of the parameters of the function type ty that are structs or
+// pointers to structs containing a union with an active field.
+func activeArgs(ty reflect.Type) []int {
+	var args []int
+	for i := 0; i < ty.NumIn(); i++ {
+		t := ty.In(i)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			continue
+		}
+		if l, err := layoutOf(t); err == nil && l.hasActive {
+			args = append(args, i)
+		}
+	}
+	return args
+}
+
+// checkActiveArg calls checkActive for the struct or pointer to a struct v.
+func checkActiveArg(v reflect.Value) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+	} else {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p
+	}
+	l, err := layoutOf(v.Type().Elem())
+	if err != nil {
+		return err
+	}
+	return l.checkActive(v.UnsafePointer())
+}
+
+// copyFromC reads the C value at src into the Go value at dst. It is the inverse of copyToC.
+func (l *cLayout) copyFromC(dst, src unsafe.Pointer) {
+	switch {
+	case l.funcType != nil:
+		reflect.NewAt(l.funcType, dst).Elem().Set(cFunc(l.funcType, *(*uintptr)(src)))
+	case l.cKind != reflect.Invalid:
+		convertNumber(dst, l.goKind, src, l.cKind)
+	case l.elem != nil && !l.sameAsGo:
+		for i := 0; i < l.len; i++ {
+			l.elem.copyFromC(unsafe.Add(dst, uintptr(i)*l.elem.goSize), unsafe.Add(src, uintptr(i)*l.elem.size))
+		}
+	case l.fields != nil && !l.sameAsGo:
+		for _, f := range l.fields {
+			if f.layout.sliceType != nil {
+				// the slice refers to the memory C points to with the length C stored
+				lf := l.fields[f.length-1]
+				var n int
+				convertNumber(unsafe.Pointer(&n), reflect.Int, unsafe.Add(src, lf.offset), lf.layout.kind(lf.typ))
+				s := (*sliceHeader)(unsafe.Add(dst, f.goOffset))
+				data := *(*unsafe.Pointer)(unsafe.Add(src, f.offset))
+				if data == nil || n < 0 {
+					n = 0
+				}
+				if data != s.data || n > s.cap {
+					s.data, s.cap = data, n
+				}
+				s.len = n
+				continue
+			}
+			f.layout.copyFromC(unsafe.Add(dst, f.goOffset), unsafe.Add(src, f.offset))
+		}
+	default:
+		copy(unsafe.Slice((*byte)(dst), l.size), unsafe.Slice((*byte)(src), l.size))
+	}
+}
+
+// cCopy is a copy of a Go struct in its C layout. It is passed to C in place of
+// a pointer to a struct whose C layout differs from the Go one.
+type cCopy struct {
+	layout *cLayout
+	goPtr  unsafe.Pointer
+	// mem is aligned for any field and has an extra word so that even
+	// zero-sized structs have an address.
+	mem unsafe.Pointer
+}
+
+// cCopyWords is the number of words of the memory of a cCopy of the layout l.
+func cCopyWords(l *cLayout) int {
+	return int(l.size+7)/8 + 1
+}
+
+// newCCopy returns a C copy of the struct ptr points to in memory of a if its C layout differs from its Go layout.
+// Func fields are converted with cbs.
+func newCCopy(ptr reflect.Value, a *Arena, cbs *fieldCallbacks) (*cCopy, bool) {
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Type().Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	l, err := layoutOf(ptr.Type().Elem())
+	if err != nil || l.sameAsGo {
+		return nil, false
+	}
+	c := &cCopy{layout: l, goPtr: ptr.UnsafePointer(), mem: unsafe.Pointer(&a.words(cCopyWords(l))[0])}
+	l.copyToC(c.pointer(), c.goPtr, cbs)
+	return c, true
+}
+
+func (c *cCopy) pointer() unsafe.Pointer {
+	return c.mem
+}
+
+// copyBack copies the changes C made to the copy into the Go struct.
+func (c *cCopy) copyBack() {
+	c.layout.copyFromC(c.goPtr, c.pointer())
+}
+
+// outParam is a pointer to a pointer such as **T or *unsafe.Pointer through which C returns a pointer.
+// C gets the address of slot in memory the garbage collector doesn't scan instead of the address of
+// the Go pointer, which C would overwrite without the write barrier the garbage collector relies on.
+// The pointer C stored in slot is then written to the Go pointer by copyBack.
+type outParam struct {
+	ptr  reflect.Value
+	slot *uintptr
+}
+
+// newOutParam returns the out parameter for ptr if it is a non-nil pointer to a pointer.
+// The slot is allocated from a and holds the current value of the pointer since C may read it too.
+func newOutParam(ptr reflect.Value, a *Arena) (outParam, bool) {
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() {
+		return outParam{}, false
+	}
+	switch ptr.Type().Elem().Kind() {
+	case reflect.Ptr, reflect.UnsafePointer:
+	default:
+		return outParam{}, false
+	}
+	slot := (*uintptr)(a.Alloc(unsafe.Sizeof(uintptr(0)), unsafe.Alignof(uintptr(0))))
+	*slot = ptr.Elem().Pointer()
+	return outParam{ptr: ptr, slot: slot}, true
+}
+
+// copyBack writes the pointer C stored in the slot to the Go pointer.
+func (o outParam) copyBack() {
+	p := *(*unsafe.Pointer)(unsafe.Pointer(o.slot))
+	v := o.ptr.Elem()
+	if v.Kind() == reflect.UnsafePointer {
+		v.SetPointer(p)
+		return
+	}
+	v.Set(reflect.NewAt(v.Type().Elem(), p))
+}
+
+// toC returns a copy of the struct v in the C layout l. It is padded to whole
+// words so that it can be passed in registers or on the stack word by word.
+// Func fields are converted with cbs.
+func (l *cLayout) toC(v reflect.Value, cbs *fieldCallbacks) []uint64 {
+	mem := make([]uint64, (l.size+7)/8+1)
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	l.copyToC(unsafe.Pointer(&mem[0]), p.UnsafePointer(), cbs)
+	return mem
+}
+
+// goPointers appends the Go pointers held by the fields and elements of v to keepAlive.
+// The C layout of a struct is made of plain words the garbage collector doesn't scan, so
+// the memory its pointers and slices refer to has to be kept alive separately.
+func goPointers(v reflect.Value, keepAlive []interface{}) []interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.UnsafePointer, reflect.Slice, reflect.Map:
+		if p := v.UnsafePointer(); p != nil {
+			keepAlive = append(keepAlive, p)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			keepAlive = goPointers(v.Field(i), keepAlive)
+		}
+	case reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.Ptr, reflect.UnsafePointer, reflect.Slice, reflect.Map, reflect.Struct, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				keepAlive = goPointers(v.Index(i), keepAlive)
+			}
+		}
+	}
+	return keepAlive
+}
+
+// fromC returns the Go value of type t of the C value in the layout l at mem.
+func (l *cLayout) fromC(t reflect.Type, mem unsafe.Pointer) reflect.Value {
+	p := reflect.New(t)
+	l.copyFromC(p.UnsafePointer(), mem)
+	return p.Elem()
+}
+
+// cScalar is a scalar in the C layout of a type.
+type cScalar struct {
+	offset uintptr
+	size   uintptr
+	kind   reflect.Kind
+}
+
+// scalars appends the scalars making up the C layout l of the Go type t in memory order.
+// base is added to their offsets.
+func (l *cLayout) scalars(t reflect.Type, base uintptr, out []cScalar) []cScalar {
+	switch t.Kind() {
+	case reflect.Struct:
+		for _, f := range l.fields {
+			out = f.layout.scalars(f.typ, base+f.offset, out)
+		}
+	case reflect.Array:
+		for i := 0; i < l.len; i++ {
+			out = l.elem.scalars(t.Elem(), base+uintptr(i)*l.elem.size, out)
+		}
+	default:
+		out = append(out, cScalar{offset: base, size: l.size, kind: l.kind(t)})
+	}
+	return out
+}
+
+// hasUnion reports whether the layout l of the Go type t contains a union.
+func (l *cLayout) hasUnion(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct:
+		if l.union {
+			return true
+		}
+		for _, f := range l.fields {
+			if f.layout.hasUnion(f.typ) {
+				return true
+			}
+		}
+	case reflect.Array:
+		return l.elem.hasUnion(t.Elem())
+	}
+	return false
+}
+
+// kind returns the kind of the C type of the scalar layout l of the Go type t.
+func (l *cLayout) kind(t reflect.Type) reflect.Kind {
+	if l.cKind != reflect.Invalid {
+		return l.cKind
+	}
+	return t.Kind()
+}
+
+// field returns the field of a struct layout following a dotted path such as "Header.Size".
+// The returned offset is relative to the start of the outermost struct.
+func (l *cLayout) field(path string) (cField, uintptr, bool) {
+	var offset uintptr
+	for {
+		name, rest, nested := strings.Cut(path, ".")
+		var found *cField
+		for i := range l.fields {
+			if l.fields[i].name == name || l.fields[i].cname == name {
+				found = &l.fields[i]
+				break
+			}
+		}
+		if found == nil {
+			// the fields of embedded structs are promoted like those of anonymous structs and unions in C
+			for _, f := range l.fields {
+				if f.embedded {
+					if pf, off, ok := f.layout.field(path); ok {
+						return pf, offset + f.offset + off, true
+					}
+				}
+			}
+			return cField{}, 0, false
+		}
+		offset += found.offset
+		if !nested {
+			return *found, offset, true
+		}
+		l, path = found.layout, rest
+	}
+}
+
+// CheckLayout returns an error if values of type t can't be passed to C by RegisterFunc and callbacks.
+// It is useful to validate struct types when a binding is initialized rather than on the first call.
+func CheckLayout(t reflect.Type) error {
+	_, err := layoutOf(t)
+	return err
+}
+
+// Sizeof returns the size in bytes of the C type that purego marshals x to. For structs this includes
+// any padding the C compiler inserts. It panics if the type of x has no C representation.
+//
+// Structs with a non-default layout are described with struct tags. A field tagged `purego:"packed"`
+// isn't padded to its natural alignment like __attribute__((packed)) on a member in C and a field
+// tagged `purego:"align(N)"` is aligned to at least N bytes like __attribute__((aligned(N))).
+// On a blank field the tags apply to the whole struct, so that
+//
+//	struct __attribute__((packed)) header { uint8_t kind; uint32_t length; };
+//
+// is written as
+//
+//	type header struct {
+//		_      struct{} `purego:"packed"`
+//		Kind   uint8
+//		Length uint32
+//	}
+//
+// More tags let one Go struct be idiomatic in Go and match the C struct at the same time:
+//
+//   - `purego:"-"` skips a field that only exists in Go such as a cache. It keeps its value when C writes the struct.
+//   - `purego:"width(N)"` stores an integer or float field in N bytes in C, for example an int as an
+//     int16_t or a float64 as a float. Values are truncated or sign extended like a Go conversion.
+//   - `purego:"len(Field)"` marks an integer field as the length of the preceding slice field Field,
+//     which is a pointer to its first element in C. The length of the slice is stored in the length field
+//     regardless of its Go value. When C writes the struct the slice is set to the pointer and length C
+//     stored, so it may refer to C memory. A slice field needs a length field.
+//   - `purego:"name(cname)"` sets the name of the field in C which Offsetof accepts as well.
+//   - `purego:"union"` on a blank field makes the struct a C union whose fields all start at offset 0.
+//     When C writes it every field is read from the same memory. When it is passed to C only one field
+//     is copied since the Go fields don't share their memory: the one whose name is the value of the
+//     string field tagged `purego:"active"`, which only exists in Go, or else the largest one.
+//     A call whose active field names no member panics before anything is passed to C.
+//
+// An embedded struct is laid out like a nested one, which is also the layout of an anonymous struct
+// in C, and Offsetof finds its fields without its name like C finds those of an anonymous struct or
+// union. This models event structs such as
+//
+//	struct event { uint32_t type; union { struct key key; struct motion motion; }; };
+//
+// as
+//
+//	type eventData struct {
+//		_      struct{} `purego:"union"`
+//		Member string   `purego:"active"`
+//		Key    key
+//		Motion motion
+//	}
+//
+//	type event struct {
+//		Type uint32
+//		eventData
+//	}
+//
+// where a key event is passed to C with
+//
+//	e := event{Type: keyDown}
+//	e.Key, e.Member = key{Code: 42}, "Key"
+//
+// Options are separated by commas such as `purego:"len(Data),width(4)"`.
+func Sizeof(x interface{}) uintptr {
+	return mustLayout(x).size
+}
+
+// Alignof returns the alignment in bytes of the C type that purego marshals x to.
+// It panics if the type of x has no C representation.
+func Alignof(x interface{}) uintptr {
+	return mustLayout(x).align
+}
+
+// Offsetof returns the offset in bytes of field in the C struct that purego marshals the struct x to.
+// Fields of nested structs are named with a dotted path like "Header.Size".
+// It panics if x is not a struct with such a field or if its type has no C representation.
+func Offsetof(x interface{}, field string) uintptr {
+	l := mustLayout(x)
+	if _, offset, ok := l.field(field); ok {
+		return offset
+	}
+	panic("purego: " + reflect.TypeOf(x).String() + " has no field " + field)
+}
+
+func mustLayout(x interface{}) *cLayout {
+	t := reflect.TypeOf(x)
+	if t == nil {
+		panic("purego: nil has no C representation")
+	}
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// StructHandle is a copy of a Go struct in its C layout that stays valid until it is released.
+// Pointers to structs passed to C functions are only valid during the call, which is not enough
+// for C libraries that keep a pointer to a struct such as a table of callbacks. Fields of func
+// type are converted to C function pointers with NewCallback which live as long as the handle.
+type StructHandle struct {
+	c   *cCopy
+	cbs fieldCallbacks
+}
+
+// structHandles keeps the handles and the Go structs they copy alive while C may use them even if
+// Go doesn't reference them.
+var structHandles struct {
+	sync.Mutex
+	m map[*StructHandle]struct{}
+}
+
+// NewStructHandle copies the struct ptr points to into memory allocated outside of the Go heap
+// that is kept until Release is called. It panics if ptr isn't a non-nil pointer to a struct,
+// the struct has no C representation or the active field of a union in it names no member.
+func NewStructHandle(ptr interface{}) *StructHandle {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Type().Elem().Kind() != reflect.Struct {
+		panic("purego: NewStructHandle requires a non-nil pointer to a struct")
+	}
+	l, err := layoutOf(v.Type().Elem())
+	if err != nil {
+		panic(err)
+	}
+	if err := l.checkActive(v.UnsafePointer()); err != nil {
+		panic(err)
+	}
+	h := &StructHandle{
+		c:   &cCopy{layout: l, goPtr: v.UnsafePointer(), mem: cAlloc(uintptr(cCopyWords(l)) * 8)},
+		cbs: fieldCallbacks{lifetime: CallbackPerCall},
+	}
+	h.Store()
+	structHandles.Lock()
+	defer structHandles.Unlock()
+	if structHandles.m == nil {
+		structHandles.m = map[*StructHandle]struct{}{}
+	}
+	structHandles.m[h] = struct{}{}
+	return h
+}
+
+// Pointer returns the address of the C struct. It must not be used after Release.
+func (h *StructHandle) Pointer() unsafe.Pointer {
+	return h.c.pointer()
+}
+
+// Store copies the Go struct into the C struct again after it was changed.
+// The callbacks of the previous func fields are released. It returns an error and leaves the
+// C struct unchanged if the active field of a union names no member.
+func (h *StructHandle) Store() error {
+	if err := h.c.layout.checkActive(h.c.goPtr); err != nil {
+		return err
+	}
+	old := h.cbs.owned
+	h.cbs.owned = nil
+	h.c.layout.copyToC(h.c.pointer(), h.c.goPtr, &h.cbs)
+	for _, cb := range old {
+		releaseFuncCallback(cb)
+	}
+	return nil
+}
+
+// Load copies the changes C made to the C struct into the Go struct.
+// Function pointers C stored are converted to Go funcs that call them.
+func (h *StructHandle) Load() {
+	h.c.copyBack()
+}
+
+// Release frees the C struct and releases the callbacks of its func fields. C must not use them
+// anymore. Callbacks can't be released on Windows so they are kept there. Release does nothing
+// if the handle was already released.
+func (h *StructHandle) Release() {
+	structHandles.Lock()
+	defer structHandles.Unlock()
+	if _, ok := structHandles.m[h]; !ok {
+		return
+	}
+	delete(structHandles.m, h)
+	h.cbs.release()
+	cFree(h.c.mem)
+}
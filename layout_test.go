@@ -0,0 +1,404 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestLayout(t *testing.T) {
+	type header struct {
+		Kind uint8
+		Size uint32
+	}
+	type message struct {
+		Header  header
+		Flag    bool
+		Payload unsafe.Pointer
+		Value   float64
+	}
+	var m message
+	if got, want := purego.Sizeof(m), unsafe.Sizeof(m); got != want {
+		t.Errorf("Sizeof got %d wanted %d", got, want)
+	}
+	if got, want := purego.Alignof(m), unsafe.Alignof(m); got != want {
+		t.Errorf("Alignof got %d wanted %d", got, want)
+	}
+	if got, want := purego.Offsetof(m, "Payload"), unsafe.Offsetof(m.Payload); got != want {
+		t.Errorf("Offsetof(Payload) got %d wanted %d", got, want)
+	}
+	if got, want := purego.Offsetof(m, "Header.Size"), unsafe.Offsetof(m.Header.Size); got != want {
+		t.Errorf("Offsetof(Header.Size) got %d wanted %d", got, want)
+	}
+}
+
+func TestLayoutTrailingZeroSize(t *testing.T) {
+	// Go pads a struct ending in a zero-sized field but C doesn't
+	type trailing struct {
+		A int32
+		B struct{}
+	}
+	if got := purego.Sizeof(trailing{}); got != 4 {
+		t.Errorf("Sizeof got %d wanted %d", got, 4)
+	}
+}
+
+func TestCheckLayout(t *testing.T) {
+	type bad struct {
+		Name string
+	}
+	if err := purego.CheckLayout(reflect.TypeOf(bad{})); err == nil {
+		t.Errorf("CheckLayout of struct with string field succeeded")
+	}
+	type good struct {
+		Name *byte
+		Len  uintptr
+	}
+	if err := purego.CheckLayout(reflect.TypeOf(good{})); err != nil {
+		t.Errorf("CheckLayout failed: %v", err)
+	}
+}
+
+type packedHeader struct {
+	_      struct{} `purego:"packed"`
+	Kind   uint8
+	Length uint32
+	Flags  uint16
+}
+
+func TestLayoutTags(t *testing.T) {
+	var p packedHeader
+	if got := purego.Sizeof(p); got != 7 {
+		t.Errorf("Sizeof(packed) got %d wanted %d", got, 7)
+	}
+	if got := purego.Alignof(p); got != 1 {
+		t.Errorf("Alignof(packed) got %d wanted %d", got, 1)
+	}
+	if got := purego.Offsetof(p, "Length"); got != 1 {
+		t.Errorf("Offsetof(Length) got %d wanted %d", got, 1)
+	}
+	type aligned struct {
+		A uint8
+		B uint8 `purego:"align(8)"`
+	}
+	if got := purego.Offsetof(aligned{}, "B"); got != 8 {
+		t.Errorf("Offsetof(B) got %d wanted %d", got, 8)
+	}
+	if got := purego.Sizeof(aligned{}); got != 16 {
+		t.Errorf("Sizeof(aligned) got %d wanted %d", got, 16)
+	}
+	type badAlign struct {
+		A uint8 `purego:"align(3)"`
+	}
+	if err := purego.CheckLayout(reflect.TypeOf(badAlign{})); err == nil {
+		t.Errorf("CheckLayout of alignment that isn't a power of two succeeded")
+	}
+}
+
+func TestPackedStructPointer(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var toGo func(dst *packedHeader, src *byte, n uintptr) unsafe.Pointer
+	var toC func(dst *byte, src *packedHeader, n uintptr) unsafe.Pointer
+	purego.RegisterLibFunc(&toGo, libc, "memcpy")
+	purego.RegisterLibFunc(&toC, libc, "memcpy")
+
+	want := packedHeader{Kind: 1, Length: 0x05040302, Flags: 0x0706}
+	var raw [7]byte
+	toC(&raw[0], &want, uintptr(len(raw)))
+	if raw != [7]byte{1, 2, 3, 4, 5, 6, 7} {
+		t.Errorf("C got % x", raw)
+	}
+	var got packedHeader
+	toGo(&got, &raw[0], uintptr(len(raw)))
+	if got != want {
+		t.Errorf("Go got %+v wanted %+v", got, want)
+	}
+}
+
+func TestLayoutArrays(t *testing.T) {
+	type table struct {
+		Count   uint8
+		Entries [2]packedHeader
+		Name    [5]byte
+	}
+	var x table
+	if got := purego.Sizeof(x.Entries); got != 14 {
+		t.Errorf("Sizeof([2]packedHeader) got %d wanted %d", got, 14)
+	}
+	if got := purego.Offsetof(x, "Name"); got != 15 {
+		t.Errorf("Offsetof(Name) got %d wanted %d", got, 15)
+	}
+	if got := purego.Sizeof(x); got != 20 {
+		t.Errorf("Sizeof(table) got %d wanted %d", got, 20)
+	}
+}
+
+type taggedBuffer struct {
+	cache map[string]int `purego:"-"`
+	ID    int            `purego:"width(2),name(id)"`
+	Data  []byte
+	Len   int     `purego:"len(Data),width(4)"`
+	Scale float64 `purego:"width(4)"`
+}
+
+func TestLayoutFieldTags(t *testing.T) {
+	var b taggedBuffer
+	if got, want := purego.Sizeof(b), 2*unsafe.Sizeof(uintptr(0))+8; got != want {
+		t.Errorf("Sizeof got %d wanted %d", got, want)
+	}
+	if got := purego.Offsetof(b, "id"); got != 0 {
+		t.Errorf("Offsetof(id) got %d wanted 0", got)
+	}
+	if got, want := purego.Offsetof(b, "Len"), 2*unsafe.Sizeof(uintptr(0)); got != want {
+		t.Errorf("Offsetof(Len) got %d wanted %d", got, want)
+	}
+
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var toGo func(dst *taggedBuffer, src unsafe.Pointer, n uintptr) unsafe.Pointer
+	var toC func(dst unsafe.Pointer, src *taggedBuffer, n uintptr) unsafe.Pointer
+	purego.RegisterLibFunc(&toGo, libc, "memcpy")
+	purego.RegisterLibFunc(&toC, libc, "memcpy")
+
+	type cBuffer struct {
+		id    int16
+		data  *byte
+		len   int32
+		scale float32
+	}
+	data := []byte("purego")
+	b = taggedBuffer{cache: map[string]int{}, ID: 7, Data: data, Len: 100, Scale: 0.5}
+	var c cBuffer
+	toC(unsafe.Pointer(&c), &b, unsafe.Sizeof(c))
+	// the length is the length of the slice rather than the value of the field
+	if c.id != 7 || c.data != &data[0] || c.len != 6 || c.scale != 0.5 {
+		t.Errorf("C got %+v", c)
+	}
+
+	c.id, c.len, c.scale = -1, 2, 1.5
+	toGo(&b, unsafe.Pointer(&c), unsafe.Sizeof(c))
+	if b.ID != -1 || string(b.Data) != "pu" || b.Len != 2 || b.Scale != 1.5 || b.cache == nil {
+		t.Errorf("Go got %+v", b)
+	}
+}
+
+func TestLayoutFieldTagErrors(t *testing.T) {
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf(struct{ Data []byte }{}),
+		reflect.TypeOf(struct {
+			N    int `purego:"len(Data)"`
+			Data []byte
+		}{}),
+		reflect.TypeOf(struct {
+			A int32 `purego:"width(3)"`
+		}{}),
+		reflect.TypeOf(struct {
+			A *byte `purego:"width(4)"`
+		}{}),
+		reflect.TypeOf(struct {
+			F float64 `purego:"width(2)"`
+		}{}),
+	} {
+		if err := purego.CheckLayout(typ); err == nil {
+			t.Errorf("CheckLayout of %v succeeded", typ)
+		}
+	}
+}
+
+type keyEvent struct {
+	Code uint16
+	Down bool
+}
+
+type motionEvent struct {
+	X, Y int32
+}
+
+type eventData struct {
+	_      struct{} `purego:"union"`
+	Key    keyEvent
+	Motion motionEvent
+}
+
+type event struct {
+	Type uint8
+	eventData
+	Time uint32
+}
+
+type activeEventData struct {
+	_      struct{} `purego:"union"`
+	Member string   `purego:"active"`
+	Key    keyEvent
+	Motion motionEvent
+}
+
+type activeEvent struct {
+	Type uint8
+	activeEventData
+	Time uint32
+}
+
+func TestLayoutUnion(t *testing.T) {
+	var e event
+	if got := purego.Sizeof(e.eventData); got != 8 {
+		t.Errorf("Sizeof(union) got %d wanted %d", got, 8)
+	}
+	if got := purego.Alignof(e.eventData); got != 4 {
+		t.Errorf("Alignof(union) got %d wanted %d", got, 4)
+	}
+	for _, field := range []string{"eventData", "Key", "Motion", "Motion.Y", "eventData.Key.Down"} {
+		want := map[string]uintptr{"eventData": 4, "Key": 4, "Motion": 4, "Motion.Y": 8, "eventData.Key.Down": 6}[field]
+		if got := purego.Offsetof(e, field); got != want {
+			t.Errorf("Offsetof(%s) got %d wanted %d", field, got, want)
+		}
+	}
+	if got := purego.Offsetof(e, "Time"); got != 12 {
+		t.Errorf("Offsetof(Time) got %d wanted %d", got, 12)
+	}
+
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var toGo func(dst *event, src *byte, n uintptr) unsafe.Pointer
+	var toC func(dst *byte, src *event, n uintptr) unsafe.Pointer
+	purego.RegisterLibFunc(&toGo, libc, "memcpy")
+	purego.RegisterLibFunc(&toC, libc, "memcpy")
+
+	var raw [16]byte
+	e = event{Type: 2, Time: 9}
+	e.Motion = motionEvent{X: 0x0201, Y: 3}
+	toC(&raw[0], &e, uintptr(len(raw)))
+	if want := [16]byte{2, 0, 0, 0, 1, 2, 0, 0, 3, 0, 0, 0, 9, 0, 0, 0}; raw != want {
+		t.Errorf("C got % x wanted % x", raw, want)
+	}
+	var got event
+	toGo(&got, &raw[0], uintptr(len(raw)))
+	if got.Motion != e.Motion || got.Key != (keyEvent{Code: 0x0201}) || got.Time != 9 {
+		t.Errorf("Go got %+v", got)
+	}
+}
+
+func TestLayoutUnionActive(t *testing.T) {
+	if got, want := purego.Sizeof(activeEvent{}), purego.Sizeof(event{}); got != want {
+		t.Errorf("Sizeof with an active field got %d wanted %d", got, want)
+	}
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var toC func(dst *byte, src *activeEvent, n uintptr) unsafe.Pointer
+	var toGo func(dst *activeEvent, src *byte, n uintptr) unsafe.Pointer
+	purego.RegisterLibFunc(&toC, libc, "memcpy")
+	purego.RegisterLibFunc(&toGo, libc, "memcpy")
+
+	for _, test := range []struct {
+		name string
+		set  func(e *activeEvent)
+		want [16]byte
+	}{
+		{"smaller member", func(e *activeEvent) {
+			e.Key, e.Member = keyEvent{Code: 0x0201, Down: true}, "Key"
+		}, [16]byte{2, 0, 0, 0, 1, 2, 1, 0, 0, 0, 0, 0, 9, 0, 0, 0}},
+		// the active member is copied even if it is zero and another member is not
+		{"zero member", func(e *activeEvent) {
+			e.Key, e.Motion, e.Member = keyEvent{Code: 0x0201}, motionEvent{}, "Motion"
+		}, [16]byte{2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0}},
+		// without an active member the largest one is copied
+		{"largest member", func(e *activeEvent) {
+			e.Key, e.Motion = keyEvent{Code: 7}, motionEvent{X: 5, Y: 6}
+		}, [16]byte{2, 0, 0, 0, 5, 0, 0, 0, 6, 0, 0, 0, 9, 0, 0, 0}},
+		{"zero value", func(e *activeEvent) {}, [16]byte{2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0}},
+	} {
+		e := activeEvent{Type: 2, Time: 9}
+		test.set(&e)
+		raw := [16]byte{0: 0xff, 4: 0xff, 8: 0xff}
+		toC(&raw[0], &e, uintptr(len(raw)))
+		if raw != test.want {
+			t.Errorf("%s: C got % x wanted % x", test.name, raw, test.want)
+		}
+	}
+
+	// C writes every member and leaves the active one alone
+	raw := [16]byte{2, 0, 0, 0, 1, 2, 0, 0, 3, 0, 0, 0, 9, 0, 0, 0}
+	got := activeEvent{activeEventData: activeEventData{Member: "Key"}}
+	toGo(&got, &raw[0], uintptr(len(raw)))
+	if got.Member != "Key" || got.Key != (keyEvent{Code: 0x0201}) || got.Motion != (motionEvent{X: 0x0201, Y: 3}) {
+		t.Errorf("Go got %+v", got)
+	}
+
+	// a misspelled member is rejected before C is called
+	misspelled := activeEvent{activeEventData: activeEventData{Member: "Button"}}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("an active member that doesn't exist didn't panic")
+			}
+		}()
+		raw := [16]byte{0: 0xff}
+		defer func() {
+			if raw[0] != 0xff {
+				t.Error("C was called with an active member that doesn't exist")
+			}
+		}()
+		toC(&raw[0], &misspelled, uintptr(len(raw)))
+	}()
+	e := activeEvent{activeEventData: activeEventData{Member: "Key"}}
+	h := purego.NewStructHandle(&e)
+	defer h.Release()
+	e.Member = "Button"
+	if err := h.Store(); err == nil {
+		t.Error("Store with an active member that doesn't exist didn't fail")
+	}
+	if _, err := purego.NewCallbackTable(misspelled); err == nil {
+		t.Error("NewCallbackTable with an active member that doesn't exist didn't fail")
+	}
+
+	// the union marker doesn't have to come before the active field
+	type activeFirst struct {
+		Member string `purego:"active"`
+		Key    keyEvent
+		Motion motionEvent
+		_      struct{} `purego:"union"`
+	}
+	if err := purego.CheckLayout(reflect.TypeOf(activeFirst{})); err != nil {
+		t.Errorf("an active field before the union marker was rejected: %v", err)
+	} else if got := purego.Sizeof(activeFirst{}); got != 8 {
+		t.Errorf("Sizeof with the union marker last got %d wanted %d", got, 8)
+	}
+
+	type badActive struct {
+		Member string `purego:"active"`
+		X      int32
+	}
+	if err := purego.CheckLayout(reflect.TypeOf(badActive{})); err == nil {
+		t.Error("an active field outside of a union was accepted")
+	}
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// LiveResource is a callback or library handle that was created while leak tracking was on
+// and hasn't been released yet.
+type LiveResource struct {
+	// Kind is "callback" or "library".
+	Kind string
+	// Addr is the C function pointer of a callback or the handle of a library.
+	Addr uintptr
+	// Name is the name of the Go function of a callback or the path of a library.
+	Name string
+	// Stack is the call stack that created the resource, one "function\n\tfile:line" per frame.
+	Stack string
+}
+
+// leakTrackingEnabled is non-zero while the creation of resources is recorded.
+var leakTrackingEnabled int32
+
+// liveResources are the resources created while leak tracking was on by kind and address.
+// A library handle opened several times has a resource for every reference.
+var liveResources struct {
+	sync.Mutex
+	m map[resourceKey][]LiveResource
+}
+
+type resourceKey struct {
+	kind string
+	addr uintptr
+}
+
+// EnableLeakTracking turns the recording of where callbacks and library handles are created on or off.
+// It is off by default since capturing the call stack of every creation is slow. Resources created
+// while it is on are listed by LiveResources until they are released, callbacks by the lifetimes of
+// WithFuncArgs or NewOwnedCallback and libraries by Dlclose or Library.Close. While it is on, the panic
+// of NewCallback when the maximum number of callbacks has been reached names the most common call site.
+//
+// Callbacks can't be released on Windows and only libraries opened with OpenLibrary are tracked there.
+func EnableLeakTracking(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&leakTrackingEnabled, v)
+}
+
+// trackResource records the creation of the resource kind at addr if leak tracking is on.
+func trackResource(kind string, addr uintptr, name string) {
+	if atomic.LoadInt32(&leakTrackingEnabled) == 0 {
+		return
+	}
+	r := LiveResource{Kind: kind, Addr: addr, Name: name, Stack: creationStack()}
+	key := resourceKey{kind, addr}
+	liveResources.Lock()
+	defer liveResources.Unlock()
+	if liveResources.m == nil {
+		liveResources.m = map[resourceKey][]LiveResource{}
+	}
+	liveResources.m[key] = append(liveResources.m[key], r)
+}
+
+// untrackResource forgets the latest creation of the resource kind at addr.
+func untrackResource(kind string, addr uintptr) {
+	key := resourceKey{kind, addr}
+	liveResources.Lock()
+	defer liveResources.Unlock()
+	rs := liveResources.m[key]
+	switch len(rs) {
+	case 0:
+	case 1:
+		delete(liveResources.m, key)
+	default:
+		liveResources.m[key] = rs[:len(rs)-1]
+	}
+}
+
+// creationStack returns the call stack of the caller of purego.
+func creationStack() string {
+	return formatStack(callerPCs())
+}
+
+// callerPCs returns the program counters of the call stack.
+func callerPCs() []uintptr {
+	pcs := make([]uintptr, 32)
+	return pcs[:runtime.Callers(3, pcs)]
+}
+
+// formatStack formats the call stack pcs leaving out the frames of purego.
+func formatStack(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+	frames := runtime.CallersFrames(pcs)
+	var b strings.Builder
+	inside := true
+	for {
+		f, more := frames.Next()
+		// leave out the frames of purego itself up to the first one of the caller
+		if inside && (strings.HasPrefix(f.Function, "github.com/jwijenbergh/purego.") || strings.HasPrefix(f.Function, "reflect.")) {
+			if !more {
+				break
+			}
+			continue
+		}
+		inside = false
+		b.WriteString(f.Function + "\n\t" + f.File + ":" + strconv.Itoa(f.Line) + "\n")
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+// funcName returns the name of the Go function fn.
+func funcName(fn reflect.Value) string {
+	if f := runtime.FuncForPC(fn.Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
+}
+
+// LiveResources returns the callbacks and library handles created while leak tracking was on that
+// haven't been released, sorted by kind and creation stack.
+func LiveResources() []LiveResource {
+	liveResources.Lock()
+	var all []LiveResource
+	for _, rs := range liveResources.m {
+		all = append(all, rs...)
+	}
+	liveResources.Unlock()
+	sort.SliceStable(all, func(i, j int) bool {
+		a, b := all[i], all[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Stack != b.Stack {
+			return a.Stack < b.Stack
+		}
+		return a.Addr < b.Addr
+	})
+	return all
+}
+
+// DumpLiveResources writes LiveResources to w grouped by creation stack with the most common stack first,
+// for example at shutdown:
+//
+//	purego.EnableLeakTracking(true)
+//	defer purego.DumpLiveResources(os.Stderr)
+func DumpLiveResources(w io.Writer) error {
+	for _, g := range groupResources(LiveResources()) {
+		r := g[0]
+		if _, err := fmt.Fprintf(w, "%d %s(s) such as %s at %#x created at:\n%s\n", len(g), r.Kind, r.Name, r.Addr, r.Stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupResources groups rs by kind and stack and sorts the groups by size.
+func groupResources(rs []LiveResource) [][]LiveResource {
+	var groups [][]LiveResource
+	for i := 0; i < len(rs); {
+		j := i + 1
+		for j < len(rs) && rs[j].Kind == rs[i].Kind && rs[j].Stack == rs[i].Stack {
+			j++
+		}
+		groups = append(groups, rs[i:j])
+		i = j
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i]) > len(groups[j])
+	})
+	return groups
+}
+
+// callbackLeakHint returns where most of the live callbacks were created for the panic
+// when there are no callbacks left, or "" if leak tracking is off.
+func callbackLeakHint() string {
+	if atomic.LoadInt32(&leakTrackingEnabled) == 0 {
+		return ""
+	}
+	var callbacks []LiveResource
+	for _, r := range LiveResources() {
+		if r.Kind == "callback" {
+			callbacks = append(callbacks, r)
+		}
+	}
+	groups := groupResources(callbacks)
+	if len(groups) == 0 {
+		return ""
+	}
+	return "; " + strconv.Itoa(len(groups[0])) + " were created at:\n" + groups[0][0].Stack
+}
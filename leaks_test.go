@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
+
+package purego_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func leakedCallback(x int) int { return x }
+
+func TestLeakTracking(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libvartest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libvartest", "var.c")); err != nil {
+		t.Fatal(err)
+	}
+	purego.EnableLeakTracking(true)
+	defer purego.EnableLeakTracking(false)
+
+	cb := purego.NewCallback(leakedCallback)
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	find := func(kind string, addr uintptr) (purego.LiveResource, bool) {
+		for _, r := range purego.LiveResources() {
+			if r.Kind == kind && r.Addr == addr {
+				return r, true
+			}
+		}
+		return purego.LiveResource{}, false
+	}
+
+	r, ok := find("callback", cb)
+	if !ok {
+		t.Fatalf("callback %#x isn't live", cb)
+	}
+	if !strings.HasSuffix(r.Name, ".leakedCallback") {
+		t.Errorf("callback name is %q", r.Name)
+	}
+	if !strings.HasPrefix(r.Stack, "github.com/jwijenbergh/purego_test.TestLeakTracking\n") {
+		t.Errorf("callback stack doesn't start with the test:\n%s", r.Stack)
+	}
+	if r, ok := find("library", lib); !ok || r.Name != libFileName {
+		t.Errorf("library %#x isn't live: %+v", lib, r)
+	}
+
+	var buf bytes.Buffer
+	if err := purego.DumpLiveResources(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "1 library(s) such as "+libFileName) {
+		t.Errorf("the dump doesn't list the library:\n%s", buf.String())
+	}
+
+	if err := purego.Dlclose(lib); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := find("library", lib); ok {
+		t.Errorf("library %#x is live after Dlclose", lib)
+	}
+}
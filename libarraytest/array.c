@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stddef.h>
+#include <stdint.h>
+
+// sum_values returns the sum of the integers the NULL-terminated array values points to.
+int32_t sum_values(int32_t **values) {
+    int32_t sum = 0;
+    for (; *values != NULL; values++) {
+        sum += **values;
+    }
+    return sum;
+}
+
+static const char *extensions[] = {"VK_KHR_surface", "VK_KHR_swapchain", NULL};
+
+const char **extension_names(void) {
+    return extensions;
+}
+
+static int32_t one = 1, two = 2;
+static int32_t *numbers[] = {&one, &two, NULL};
+
+int32_t **number_list(int empty) {
+    static int32_t *none[] = {NULL};
+    return empty ? none : numbers;
+}
+
+void **no_list(void) {
+    return NULL;
+}
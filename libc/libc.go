@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+// Package libc binds the functions of the C library that almost every binding needs to manage
+// memory and strings so that they don't have to be declared again by every package.
+// The C library is opened and the functions are looked up on the first call of any of them.
+//
+// On Windows the functions are those of the Universal C Runtime (ucrtbase.dll). Memory allocated by
+// a library linked against another C runtime must be released by that library and not with Free.
+package libc
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+var funcs struct {
+	once sync.Once
+	err  error
+
+	malloc   func(size uintptr) unsafe.Pointer
+	free     func(p unsafe.Pointer)
+	memcpy   func(dst, src unsafe.Pointer, n uintptr) unsafe.Pointer
+	strlen   func(s unsafe.Pointer) uintptr
+	strerror func(errnum int32) string
+	getenv   func(name string) uintptr
+}
+
+// Load opens the C library and looks up the functions of this package if that hasn't happened yet.
+// The functions call it themselves and panic if it fails so it is only needed to check for an error.
+func Load() error {
+	funcs.once.Do(func() {
+		lib, err := purego.OpenLibrary(name)
+		if err != nil {
+			funcs.err = err
+			return
+		}
+		for _, f := range []struct {
+			fptr interface{}
+			name string
+		}{
+			{&funcs.malloc, "malloc"},
+			{&funcs.free, "free"},
+			{&funcs.memcpy, "memcpy"},
+			{&funcs.strlen, "strlen"},
+			{&funcs.strerror, "strerror"},
+			{&funcs.getenv, "getenv"},
+		} {
+			if err := lib.RegisterFuncE(f.fptr, f.name); err != nil {
+				funcs.err = err
+				return
+			}
+		}
+	})
+	return funcs.err
+}
+
+func load() {
+	if err := Load(); err != nil {
+		panic(err)
+	}
+}
+
+// Malloc allocates size bytes of C memory which must be released with Free.
+// It returns nil if the memory can't be allocated.
+func Malloc(size uintptr) unsafe.Pointer {
+	load()
+	return funcs.malloc(size)
+}
+
+// Free releases the memory p returned by Malloc or by a C function that allocates with malloc.
+// p may be nil.
+func Free(p unsafe.Pointer) {
+	load()
+	funcs.free(p)
+}
+
+// Memcpy copies n bytes from src to dst which must not overlap and returns dst.
+func Memcpy(dst, src unsafe.Pointer, n uintptr) unsafe.Pointer {
+	load()
+	return funcs.memcpy(dst, src, n)
+}
+
+// Strlen returns the length of the null-terminated string s.
+func Strlen(s unsafe.Pointer) uintptr {
+	load()
+	return funcs.strlen(s)
+}
+
+// Strerror returns the message of the C library for the error number errnum.
+func Strerror(errnum int32) string {
+	load()
+	return funcs.strerror(errnum)
+}
+
+// Getenv returns the value of the environment variable name as the C library sees it and whether it is set.
+// It can differ from os.Getenv since os.Setenv only changes the environment of C when cgo is used.
+func Getenv(name string) (string, bool) {
+	load()
+	p := funcs.getenv(name)
+	if p == 0 {
+		return "", false
+	}
+	return strings.GoString(p), true
+}
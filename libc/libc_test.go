@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package libc_test
+
+import (
+	"os"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/libc"
+)
+
+func TestLibc(t *testing.T) {
+	if err := libc.Load(); err != nil {
+		t.Fatal(err)
+	}
+	src := []byte("hello\x00")
+	p := libc.Malloc(uintptr(len(src)))
+	if p == nil {
+		t.Fatal("Malloc returned nil")
+	}
+	defer libc.Free(p)
+	if got := libc.Memcpy(p, unsafe.Pointer(&src[0]), uintptr(len(src))); got != p {
+		t.Errorf("Memcpy returned %p wanted %p", got, p)
+	}
+	if got := unsafe.Slice((*byte)(p), len(src)); string(got) != string(src) {
+		t.Errorf("Memcpy copied %q wanted %q", got, src)
+	}
+	if got := libc.Strlen(p); got != 5 {
+		t.Errorf("Strlen got %d wanted 5", got)
+	}
+	if msg := libc.Strerror(2); msg == "" {
+		t.Errorf("Strerror(ENOENT) returned an empty message")
+	}
+	libc.Free(nil)
+}
+
+func TestGetenv(t *testing.T) {
+	// the environment variables set when the process started are visible to C
+	for _, name := range []string{"PATH", "Path"} {
+		want, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if got, ok := libc.Getenv(name); !ok || got != want {
+			t.Errorf("Getenv(%q) = %q, %v wanted %q", name, got, ok, want)
+		}
+	}
+	if got, ok := libc.Getenv("PUREGO_LIBC_TEST_UNSET"); ok {
+		t.Errorf("Getenv of an unset variable returned %q", got)
+	}
+}
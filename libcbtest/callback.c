@@ -11,3 +11,68 @@ int callCallback(const void *fp, const char *s) {
     ((callback)(fp))(s, strlen(s));
     return sentinel;
 }
+
+#include <stdarg.h>
+
+typedef void (*log_callback)(int level, const char *fmt, va_list ap);
+
+void callLog(log_callback cb, int level, const char *fmt, ...) {
+    va_list ap;
+    va_start(ap, fmt);
+    cb(level, fmt, ap);
+    va_end(ap);
+}
+
+struct io_callbacks {
+    int version;
+    int (*read)(void *user, char *buf, int n);
+    void (*close)(void *user);
+    void *user;
+};
+
+// useIO reads from io and closes it if it has a close function.
+// It returns the version times 100 plus the sum of the bytes read.
+int useIO(const struct io_callbacks *io) {
+    char buf[4];
+    int n = io->read(io->user, buf, sizeof buf);
+    int sum = io->version * 100;
+    for (int i = 0; i < n; i++) {
+        sum += buf[i];
+    }
+    if (io->close) {
+        io->close(io->user);
+    }
+    return sum;
+}
+
+// many_callback has more parameters than syscall.NewCallback accepts on windows/amd64.
+typedef long long (*many_callback)(
+    long long, double, long long, long long, long long, long long, long long, long long, long long, long long,
+    long long, long long, long long, long long, long long, long long, long long, long long, long long, long long,
+    long long, long long, long long, long long, long long, long long, long long, long long, long long, long long,
+    long long, long long, long long, long long, long long, long long, long long, long long, long long, long long,
+    long long, long long, long long, long long, long long, long long, long long, long long, long long, long long,
+    long long, long long, long long, long long, long long, long long, long long, long long, long long, long long,
+    long long, long long, long long, long long, long long, long long, long long, long long, long long, double);
+
+// callMany calls cb with the numbers from 1 to 70 where the second and the last are doubles.
+long long callMany(many_callback cb) {
+    return cb(
+        1, 2.0, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14,
+        15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28,
+        29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42,
+        43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56,
+        57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70.0);
+}
+
+typedef int (*enum_callback)(int item, void *user);
+
+// enumerate calls cb with the items from 0 to n-1 and returns the sum of its results.
+// cb may call enumerate again for nested items.
+int enumerate(enum_callback cb, int n, void *user) {
+    int sum = 0;
+    for (int i = 0; i < n; i++) {
+        sum += cb(i, user);
+    }
+    return sum;
+}
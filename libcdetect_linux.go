@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"bufio"
+	"debug/elf"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LibC is a C library a program or library on Linux is built for.
+type LibC int
+
+const (
+	LibCUnknown LibC = iota // The C library isn't known.
+	LibCNone                // There is no C library such as in a static program.
+	LibCGlibc               // The GNU C library of most distributions.
+	LibCMusl                // The musl C library of Alpine Linux.
+)
+
+func (c LibC) String() string {
+	switch c {
+	case LibCNone:
+		return "no C library"
+	case LibCGlibc:
+		return "glibc"
+	case LibCMusl:
+		return "musl"
+	default:
+		return "unknown C library"
+	}
+}
+
+var processLibC struct {
+	once    sync.Once
+	libc    LibC
+	version string // the version of glibc such as "2.36"
+}
+
+// DetectLibC returns the C library the process runs against which is found from the
+// libraries mapped into it.
+func DetectLibC() LibC {
+	processLibC.once.Do(func() {
+		processLibC.libc = detectLibC()
+		if processLibC.libc != LibCGlibc {
+			return
+		}
+		// gnu_get_libc_version returns a static string like "2.36"
+		if sym := fnDlsym(RTLD_DEFAULT, "gnu_get_libc_version"); sym != 0 {
+			var version func() string
+			RegisterFunc(&version, sym)
+			processLibC.version = version()
+		}
+	})
+	return processLibC.libc
+}
+
+// detectLibC looks for the dynamic loader or the C library in /proc/self/maps.
+func detectLibC() LibC {
+	f, err := os.Open("/proc/self/maps")
+	if err != nil {
+		return LibCUnknown
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 6 {
+			continue
+		}
+		if c := libCOf(filepath.Base(fields[len(fields)-1])); c != LibCUnknown {
+			return c
+		}
+	}
+	return LibCNone
+}
+
+// libCOf returns the C library that the library or dynamic loader name belongs to.
+func libCOf(name string) LibC {
+	switch {
+	case strings.HasPrefix(name, "ld-musl-"), strings.HasPrefix(name, "libc.musl-"), name == "libc.so":
+		// musl is libc.so on Alpine and its loader is linked as ld-musl-<arch>.so.1
+		return LibCMusl
+	case name == "libc.so.6", strings.HasPrefix(name, "ld-linux"), strings.HasPrefix(name, "libc-2."):
+		return LibCGlibc
+	}
+	return LibCUnknown
+}
+
+// LibCError is returned by Dlopen on Linux if a library couldn't be loaded and it was built for
+// another C library than the process runs against, such as a library built for glibc on Alpine
+// Linux, or for a newer version of glibc. The error of the dynamic loader only says which symbol
+// or library is missing.
+type LibCError struct {
+	// Library is the library that was loaded.
+	Library string
+	// Want is the C library the library was built for and Have the one of the process.
+	Want, Have LibC
+	// Version is the version of glibc such as "GLIBC_2.34" the library needs and HaveVersion the
+	// version of the glibc of the process. They are only set if both are built for glibc.
+	Version, HaveVersion string
+	// Err is the error of the dynamic loader.
+	Err error
+}
+
+func (e *LibCError) Error() string {
+	if e.Want == e.Have {
+		return e.Err.Error() + " (" + e.Library + " needs " + e.Version + " but the process runs against glibc " + e.HaveVersion + ")"
+	}
+	hint := ""
+	switch e.Have {
+	case LibCMusl:
+		hint = " as on Alpine Linux; use a build of the library for musl or a glibc based image"
+	case LibCGlibc:
+		hint = "; use a build of the library for glibc"
+	}
+	return e.Err.Error() + " (" + e.Library + " was built for " + e.Want.String() + " but the process runs against " + e.Have.String() + hint + ")"
+}
+
+func (e *LibCError) Unwrap() error {
+	return e.Err
+}
+
+// diagnoseLibC returns a LibCError wrapping err if the library name can't be loaded because it
+// was built for another C library or a newer glibc. Otherwise it returns err unchanged.
+func diagnoseLibC(name string, err error) error {
+	path, ok := findLibrary(name)
+	if !ok {
+		return err
+	}
+	f, ferr := elf.Open(path)
+	if ferr != nil {
+		return err
+	}
+	defer f.Close()
+	needed, _ := f.ImportedLibraries()
+	want := LibCUnknown
+	for _, n := range needed {
+		if c := libCOf(n); c != LibCUnknown {
+			want = c
+			break
+		}
+	}
+	have := DetectLibC()
+	if want == LibCUnknown || have == LibCUnknown {
+		return err
+	}
+	if want != have {
+		return &LibCError{Library: name, Want: want, Have: have, Err: err}
+	}
+	if want != LibCGlibc || processLibC.version == "" {
+		return err
+	}
+	// the newest version of glibc the library needs
+	defs, derr := versionDefinitions(f)
+	if derr != nil {
+		return err
+	}
+	newest := ""
+	for _, d := range defs {
+		if strings.HasPrefix(d.Version, "GLIBC_") && !strings.HasPrefix(d.Version, "GLIBC_PRIVATE") &&
+			(newest == "" || compareVersions(d.Version[len("GLIBC_"):], newest[len("GLIBC_"):]) > 0) {
+			newest = d.Version
+		}
+	}
+	if newest == "" || compareVersions(newest[len("GLIBC_"):], processLibC.version) <= 0 {
+		return err
+	}
+	return &LibCError{Library: name, Want: want, Have: have, Version: newest, HaveVersion: processLibC.version, Err: err}
+}
+
+// compareVersions compares the dotted versions a and b such as "2.34" and "2.4" by their numbers.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestDetectLibC(t *testing.T) {
+	libc := purego.DetectLibC()
+	if libc != purego.LibCGlibc && libc != purego.LibCMusl {
+		t.Fatalf("DetectLibC returned %v", libc)
+	}
+	other := "libc.musl-x86_64.so.1"
+	if libc == purego.LibCMusl {
+		other = "libc.so.6"
+	}
+
+	// a library built for the other C library whose libc is missing as it would be
+	dir := t.TempDir()
+	if err := buildSharedLib("CC", filepath.Join(dir, other), filepath.Join("libdeps", "c.c"), "-Wl,-soname,"+other); err != nil {
+		t.Fatal(err)
+	}
+	lib := filepath.Join(dir, "libother.so")
+	if err := buildSharedLib("CC", lib, filepath.Join("libdeps", "b.c"), "-L"+dir, "-l:"+other, "-nodefaultlibs"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, other)); err != nil {
+		t.Fatal(err)
+	}
+	_, err := purego.Dlopen(lib, purego.RTLD_NOW)
+	var libcErr *purego.LibCError
+	if !errors.As(err, &libcErr) {
+		t.Fatalf("Dlopen returned %v, want a *LibCError", err)
+	}
+	if libcErr.Have != libc || libcErr.Want == libc || !strings.Contains(err.Error(), "was built for "+libcErr.Want.String()) {
+		t.Errorf("Dlopen returned %v", err)
+	}
+	var dlerr purego.Dlerror
+	if !errors.As(err, &dlerr) {
+		t.Errorf("%v doesn't wrap the Dlerror", err)
+	}
+}
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd
+
+package purego
+
+// diagnoseLibC returns err since there is only one C library on macOS and FreeBSD.
+func diagnoseLibC(_ string, err error) error {
+	return err
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+static void (*on_unload)(void);
+
+void set_on_unload(void (*fn)(void)) {
+    on_unload = fn;
+}
+
+__attribute__((destructor)) static void unload(void) {
+    if (on_unload) {
+        on_unload();
+    }
+}
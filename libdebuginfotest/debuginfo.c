@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stdarg.h>
+#include <stddef.h>
+
+typedef int count_t;
+
+struct point {
+    int x, y;
+};
+
+count_t add(int a, const int b) {
+    return a + b;
+}
+
+double scale(double v, float s) {
+    return v * s;
+}
+
+struct point make_point(int x, int y) {
+    struct point p = {x, y};
+    return p;
+}
+
+int sum(int n, ...) {
+    va_list args;
+    va_start(args, n);
+    int total = 0;
+    for (int i = 0; i < n; i++) {
+        total += va_arg(args, int);
+    }
+    va_end(args);
+    return total;
+}
+
+size_t length(const char *s) {
+    size_t n = 0;
+    while (s[n] != 0) {
+        n++;
+    }
+    return n;
+}
+
+void nothing(void) {
+}
@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// counter is global state that every copy of the library has its own instance of.
+static int counter;
+
+int increment(void) {
+    return ++counter;
+}
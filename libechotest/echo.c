@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stdbool.h>
+#include <stdint.h>
+
+struct mixed {
+    int8_t a;
+    double b;
+    uint16_t c;
+};
+
+struct floats {
+    float x, y, z;
+};
+
+struct ints {
+    int32_t v[3];
+};
+
+// echo_NAME returns its argument and echo_NAME_stack its last argument after the integer
+// and float registers are used up.
+#define ECHO(T, NAME)                                                                             \
+    T echo_##NAME(T v) { return v; }                                                              \
+    T echo_##NAME##_stack(int64_t a0, int64_t a1, int64_t a2, int64_t a3, int64_t a4, int64_t a5, \
+                          int64_t a6, int64_t a7, double f0, double f1, double f2, double f3,     \
+                          double f4, double f5, double f6, double f7, T v) { return v; }
+
+ECHO(int8_t, int8)
+ECHO(int16_t, int16)
+ECHO(int32_t, int32)
+ECHO(int64_t, int64)
+ECHO(uint8_t, uint8)
+ECHO(uint16_t, uint16)
+ECHO(uint32_t, uint32)
+ECHO(uint64_t, uint64)
+ECHO(float, float)
+ECHO(double, double)
+ECHO(bool, bool)
+ECHO(struct mixed, mixed)
+ECHO(struct floats, floats)
+ECHO(struct ints, ints)
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// These functions follow the calling convention gfortran uses for the BLAS routines they are named after.
+
+#include <stddef.h>
+
+// SUBROUTINE DSCAL(N, DA, DX, INCX)
+void dscal_(const int *n, const double *da, double *dx, const int *incx) {
+    for (int i = 0; i < *n; i++) {
+        dx[i * *incx] *= *da;
+    }
+}
+
+// LOGICAL FUNCTION LSAME(CA, CB)
+int lsame_(const char *ca, const char *cb, size_t ca_len, size_t cb_len) {
+    char a = ca[0] | 0x20, b = cb[0] | 0x20;
+    return ca_len == 1 && cb_len == 1 && a == b;
+}
+
+// INTEGER FUNCTION COUNT(S, LOGICAL SKIP) counts the characters of S that aren't blank unless SKIP is set.
+int count_(const char *s, const int *skip, size_t s_len) {
+    if (*skip) {
+        return 0;
+    }
+    int n = 0;
+    for (size_t i = 0; i < s_len; i++) {
+        n += s[i] != ' ';
+    }
+    return n;
+}
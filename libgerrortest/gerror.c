@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+// GError as it is declared by GLib.
+typedef struct {
+    uint32_t domain;
+    int code;
+    char *message;
+} GError;
+
+int freed = 0;
+
+void test_error_free(GError *err) {
+    free(err->message);
+    free(err);
+    freed++;
+}
+
+// parse_positive returns n if it is positive and sets err otherwise.
+int parse_positive(int n, GError **err) {
+    if (n > 0) {
+        return n;
+    }
+    GError *e = malloc(sizeof(GError));
+    e->domain = 7;
+    e->code = n;
+    e->message = strdup("not positive");
+    *err = e;
+    return 0;
+}
+
+void check_positive(int n, GError **err) {
+    parse_positive(n, err);
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+int sum_range(int from, int to) {
+    int sum = 0;
+    for (int i = from; i < to; i++) {
+        sum += i;
+    }
+    return sum;
+}
+
+int sum_to(int to) {
+    return sum_range(0, to);
+}
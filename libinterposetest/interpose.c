@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stdlib.h>
+
+const char *lookup_env(const char *name) {
+    return getenv(name);
+}
+
+int parse_int(const char *s) {
+    return atoi(s);
+}
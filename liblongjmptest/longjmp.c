@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <setjmp.h>
+
+static void fail(jmp_buf *err, int value) {
+    longjmp(*err, value);
+}
+
+// decode calls longjmp with value through a frame of its own if value isn't 0 and otherwise
+// returns 42 like a library that reports errors with longjmp.
+int decode(jmp_buf *err, int value) {
+    if (value != 0) {
+        fail(err, value);
+    }
+    return 42;
+}
+
+// decodeWith calls cb and then longjmps with its result if it isn't 0.
+int decodeWith(jmp_buf *err, int (*cb)(int), int x) {
+    int value = cb(x);
+    return decode(err, value) + x;
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stdlib.h>
+
+typedef struct widget {
+    int id;
+    int size;
+} widget;
+
+int widget_create(int id, widget **out) {
+    widget *w = malloc(sizeof(widget));
+    if (w == NULL) {
+        return -1;
+    }
+    w->id = id;
+    w->size = id * 2;
+    *out = w;
+    return 0;
+}
+
+void widget_destroy(widget *w) {
+    free(w);
+}
+
+int buffer_alloc(size_t n, void **out) {
+    *out = calloc(n, 1);
+    return *out == NULL ? -1 : 0;
+}
+
+// widget_id returns the id of the widget *in points to which is an in-out parameter
+int widget_id(widget **in) {
+    return *in == NULL ? -1 : (*in)->id;
+}
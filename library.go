@@ -0,0 +1,407 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+// Library is a shared library opened with OpenLibrary. Every call to OpenLibrary with the same name
+// returns the same Library so that packages binding the same library independently share its state.
+//
+// Many C libraries require a global initialization function such as XInitThreads or curl_global_init
+// to be called once before any other function. OnInit registers such a function and functions
+// registered with Library.RegisterFunc run it before their first call.
+type Library struct {
+	name   string
+	handle uintptr
+	// file identifies the file the library was loaded from. It is nil if name isn't a path.
+	file os.FileInfo
+
+	// pending is non-zero while there are init functions that haven't run yet.
+	// It is checked on every call so it is accessed atomically to keep calls cheap.
+	pending int32
+
+	// initMu is held while the init functions run, which happens without mu so that they can call
+	// functions of the library. initThread is the ID of the thread running them, which the goroutine
+	// is locked to, or 0.
+	initMu     sync.Mutex
+	initThread uintptr
+
+	mu    sync.Mutex
+	refs  int
+	names []string // every name the library was opened with
+	inits []*libraryInit
+	funcs []*funcConfig // functions registered with RegisterFunc for Rebind
+	cbs   []uintptr     // callbacks created with NewCallback which are released on Close
+}
+
+// libraryInit is an init function registered with Library.OnInit.
+type libraryInit struct {
+	key  string
+	fn   func() error
+	done bool
+	err  error
+}
+
+var (
+	librariesMu sync.Mutex
+	libraries   = map[string]*Library{} // keyed by every name in Library.names
+)
+
+// OpenLibrary opens the shared library name with Dlopen (LoadLibrary on Windows) and returns it.
+// If the library is already open, the existing Library is returned and its reference count is
+// incremented. Every call to OpenLibrary should be balanced with a call to Library.Close.
+//
+// Different names that refer to the same library, such as a symlink and its target, return the
+// same Library. Two names are the same library if the dynamic loader returns the same handle for
+// them or if they are paths to the same file.
+func OpenLibrary(name string) (*Library, error) {
+	librariesMu.Lock()
+	defer librariesMu.Unlock()
+	if l, ok := libraries[name]; ok {
+		l.mu.Lock()
+		l.refs++
+		l.mu.Unlock()
+		return l, nil
+	}
+	handle, err := openLibrary(name)
+	if err != nil {
+		return nil, err
+	}
+	file := libraryFile(name)
+	for _, l := range libraries {
+		if l.handle != handle && (file == nil || l.file == nil || !os.SameFile(file, l.file)) {
+			continue
+		}
+		// l already holds a reference to the library so the one just taken is released
+		if err := closeLibrary(handle); err != nil {
+			return nil, err
+		}
+		l.mu.Lock()
+		l.refs++
+		l.names = append(l.names, name)
+		l.mu.Unlock()
+		libraries[name] = l
+		return l, nil
+	}
+	l := &Library{name: name, handle: handle, file: file, refs: 1, names: []string{name}}
+	libraries[name] = l
+	return l, nil
+}
+
+// libraryFile returns the FileInfo of the library name if it is a path to a file.
+// Bare names like "libc.so.6" are searched for by the dynamic loader so nil is returned for them.
+func libraryFile(name string) os.FileInfo {
+	if filepath.Base(name) == name {
+		return nil
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return nil
+	}
+	return fi
+}
+
+// Name returns the name the library was first opened with.
+func (l *Library) Name() string {
+	return l.name
+}
+
+// Handle returns the handle of the library as returned by Dlopen (LoadLibrary on Windows).
+func (l *Library) Handle() uintptr {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.handle
+}
+
+// Lookup returns the address of the symbol name in the library. It implements Resolver.
+func (l *Library) Lookup(name string) (uintptr, error) {
+	return loadSymbol(l.Handle(), name)
+}
+
+// RegisterFunc is like RegisterFunc but looks up the C function name in the library.
+// Before every call the registered function runs the init functions of the library
+// that haven't run yet. It panics if the symbol can't be found.
+func (l *Library) RegisterFunc(fptr interface{}, name string) {
+	sym, err := l.Lookup(name)
+	if err != nil {
+		panic(err)
+	}
+	cfg := &funcConfig{library: l, name: name, rebindable: true}
+	registerFunc(fptr, sym, cfg)
+	l.addFunc(cfg)
+}
+
+// RegisterFuncE is like l.RegisterFunc but returns an error instead of panicking.
+func (l *Library) RegisterFuncE(fptr interface{}, name string) (err error) {
+	defer recoverError(&err)
+	l.RegisterFunc(fptr, name)
+	return nil
+}
+
+// RegisterFuncWith is like l.RegisterFunc but applies opts to the function.
+func (l *Library) RegisterFuncWith(fptr interface{}, name string, opts ...FuncOption) {
+	sym, err := l.Lookup(name)
+	if err != nil {
+		panic(err)
+	}
+	cfg := newFuncConfig(name, opts)
+	cfg.library = l
+	cfg.rebindable = true
+	registerFunc(fptr, sym, cfg)
+	l.addFunc(cfg)
+}
+
+func (l *Library) addFunc(cfg *funcConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.funcs = append(l.funcs, cfg)
+}
+
+// Rebind switches every function registered with l.RegisterFunc to the function of the same name in
+// the library handle, which is usually a newer build of the library loaded from another path. It is
+// meant for reloading plugins during development without registering the functions again.
+//
+// Either every function is switched or, if a symbol is missing from handle, none is and an error is
+// returned. Each function is switched atomically but calls that run concurrently with Rebind may call
+// functions of both libraries. The init functions registered with OnInit run again before the next call
+// since the new library hasn't been initialized. Rebind waits for init functions that are running and
+// returns an error if it is called by one.
+//
+// l takes over handle and returns its previous handle. The caller must close it with Dlclose
+// (FreeLibrary on Windows) once no call into the previous library is running anymore.
+// Note that the dynamic loader returns the handle of the loaded library if the same path is opened
+// again, so the new build has to be loaded from another path or after the previous one was closed.
+func (l *Library) Rebind(handle uintptr) (old uintptr, err error) {
+	if l.initializing() {
+		return 0, errors.New("purego: " + l.name + " can't be rebound by its init functions")
+	}
+	// the init functions are reset below so they must not be running
+	l.initMu.Lock()
+	defer l.initMu.Unlock()
+	librariesMu.Lock()
+	defer librariesMu.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.refs == 0 {
+		return 0, errors.New("purego: " + l.name + " is closed")
+	}
+	syms := make([]uintptr, len(l.funcs))
+	for i, cfg := range l.funcs {
+		sym, err := loadSymbol(handle, cfg.name)
+		if err != nil {
+			return 0, err
+		}
+		f, isFake := fake.Func(sym)
+		_, wasFake := fake.Func(cfg.sym)
+		if isFake != wasFake || (isFake && f.Type() != cfg.typ) {
+			return 0, errors.New("purego: " + cfg.name + " can't be rebound to a symbol of a different kind")
+		}
+		syms[i] = sym
+	}
+	for i, cfg := range l.funcs {
+		atomic.StoreUintptr(&cfg.sym, syms[i])
+	}
+	for _, init := range l.inits {
+		init.done = false
+		init.err = nil
+	}
+	if len(l.inits) > 0 {
+		atomic.StoreInt32(&l.pending, 1)
+	}
+	old, l.handle = l.handle, handle
+	// the functions looked up in the previous handle itself can't be called once it is closed
+	unregister(func(key registryKey) bool {
+		return key.handle == old
+	})
+	return old, nil
+}
+
+// OnInit registers fn to be called once before the first call of any function registered with
+// l.RegisterFunc. Init functions run in the order they were registered. Registering a function
+// with a key that was already registered does nothing which lets packages that bind the same
+// library independently each declare the initialization they depend on, for example
+//
+//	lib.OnInit("XInitThreads", func() error {
+//		if xInitThreads() == 0 {
+//			return errors.New("XInitThreads failed")
+//		}
+//		return nil
+//	})
+//
+// fn may call functions registered with l.RegisterFunc. They don't wait for the init functions
+// while they run on the goroutine that runs fn, whereas calls from other goroutines wait until
+// every init function has run.
+func (l *Library) OnInit(key string, fn func() error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, init := range l.inits {
+		if init.key == key {
+			return
+		}
+	}
+	l.inits = append(l.inits, &libraryInit{key: key, fn: fn})
+	atomic.StoreInt32(&l.pending, 1)
+}
+
+// Init runs the init functions of l that haven't run yet and returns the first error any of them returned.
+// It is not necessary to call Init but it allows handling errors from init functions which would otherwise
+// cause the first call of a registered function to panic.
+func (l *Library) Init() error {
+	if atomic.LoadInt32(&l.pending) == 0 {
+		return l.initErr()
+	}
+	// a function called by an init function must not wait for them
+	if l.initializing() {
+		return nil
+	}
+	l.initMu.Lock()
+	defer l.initMu.Unlock()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	atomic.StoreUintptr(&l.initThread, curThreadID())
+	defer atomic.StoreUintptr(&l.initThread, 0)
+	for {
+		l.mu.Lock()
+		var next *libraryInit
+		for _, init := range l.inits {
+			if !init.done {
+				next = init
+				break
+			}
+		}
+		if next == nil {
+			err := l.initErrLocked()
+			if err == nil {
+				// a failed init stays pending so that every following call reports it
+				atomic.StoreInt32(&l.pending, 0)
+			}
+			l.mu.Unlock()
+			return err
+		}
+		next.done = true
+		l.mu.Unlock()
+		err := next.fn()
+		l.mu.Lock()
+		next.err = err
+		l.mu.Unlock()
+	}
+}
+
+// initializing reports whether the init functions of l are running on the current thread,
+// which no other goroutine can run on while they do.
+func (l *Library) initializing() bool {
+	t := atomic.LoadUintptr(&l.initThread)
+	return t != 0 && t == curThreadID()
+}
+
+func (l *Library) initErr() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.initErrLocked()
+}
+
+func (l *Library) initErrLocked() error {
+	for _, init := range l.inits {
+		if init.err != nil {
+			return init.err
+		}
+	}
+	return nil
+}
+
+// runInits is called before every call of a function registered with l.RegisterFunc.
+func (l *Library) runInits() {
+	if atomic.LoadInt32(&l.pending) == 0 {
+		return
+	}
+	if err := l.Init(); err != nil {
+		panic("purego: initializing " + l.name + ": " + err.Error())
+	}
+}
+
+// NewCallback is like the function NewCallback but the callback is owned by l and released by
+// ReleaseAll or once l is closed, so that a plugin which is loaded and unloaded repeatedly
+// doesn't leak the callbacks it hands to the library. C must not call the callback after that.
+func (l *Library) NewCallback(fn interface{}) uintptr {
+	return l.NewCallbackWith(fn)
+}
+
+// NewCallbackWith is like the function NewCallbackWith but the callback is owned by l as for
+// Library.NewCallback.
+func (l *Library) NewCallbackWith(fn interface{}, opts ...CallbackOption) uintptr {
+	cb := NewCallbackWith(fn, opts...)
+	l.mu.Lock()
+	l.cbs = append(l.cbs, cb)
+	l.mu.Unlock()
+	return cb
+}
+
+// ReleaseAll releases every callback created with l.NewCallback and l.NewCallbackWith so that
+// their slots can be reused by new callbacks. Callbacks can't be released on Windows so they are
+// kept there. Functions registered with RegisterFunc for the callbacks are removed from
+// RegisteredFuncs.
+func (l *Library) ReleaseAll() {
+	l.mu.Lock()
+	cbs := l.cbs
+	l.cbs = nil
+	l.mu.Unlock()
+	if len(cbs) == 0 {
+		return
+	}
+	released := make(map[uintptr]bool, len(cbs))
+	for _, cb := range cbs {
+		releaseCallback(cb)
+		released[cb] = true
+	}
+	unregister(func(key registryKey) bool {
+		return key.name == "" && released[key.addr]
+	})
+}
+
+// Close decrements the reference count of the library. Once every OpenLibrary call has been
+// balanced the library is closed with Dlclose (FreeLibrary on Windows), the callbacks owned by l
+// are released and a later call to OpenLibrary opens it anew. Functions registered with l must not
+// be called after that.
+func (l *Library) Close() error {
+	librariesMu.Lock()
+	defer librariesMu.Unlock()
+	l.mu.Lock()
+	if l.refs == 0 {
+		l.mu.Unlock()
+		return errors.New("purego: " + l.name + " is already closed")
+	}
+	l.refs--
+	refs := l.refs
+	handle := l.handle
+	l.mu.Unlock()
+	if refs > 0 {
+		return nil
+	}
+	for _, name := range l.names {
+		if libraries[name] == l {
+			delete(libraries, name)
+		}
+	}
+	// the destructors of the library may still call its callbacks while it is closed. If closing
+	// fails the library may still be loaded so they are kept.
+	if err := closeLibrary(handle); err != nil {
+		return err
+	}
+	l.ReleaseAll()
+	unregister(func(key registryKey) bool {
+		return key.library == l
+	})
+	return nil
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestOpenLibraryAliases(t *testing.T) {
+	p, err := purego.NewProcessResolver(os.Getpid())
+	if err != nil {
+		t.Fatalf("NewProcessResolver failed: %v", err)
+	}
+	var libc string
+	for _, m := range p.Modules() {
+		if filepath.Base(m.Path) == "libc.so.6" || strings.HasPrefix(filepath.Base(m.Path), "libc-") {
+			libc = m.Path
+			break
+		}
+	}
+	if libc == "" {
+		t.Skip("libc is not loaded")
+	}
+	dir := t.TempDir()
+	link1 := filepath.Join(dir, "libc1.so")
+	link2 := filepath.Join(dir, "libc2.so")
+	for _, link := range []string{link1, link2} {
+		if err := os.Symlink(libc, link); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var libs []*purego.Library
+	for _, name := range []string{"libc.so.6", libc, link1, link2} {
+		l, err := purego.OpenLibrary(name)
+		if err != nil {
+			t.Fatalf("OpenLibrary(%q) failed: %v", name, err)
+		}
+		libs = append(libs, l)
+	}
+	for i, l := range libs[1:] {
+		if l != libs[0] {
+			t.Errorf("library #%d is not shared with %s", i+1, libs[0].Name())
+		}
+	}
+	for _, l := range libs {
+		if err := l.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+	if err := libs[0].Close(); err == nil {
+		t.Errorf("closing a library more often than it was opened didn't fail")
+	}
+}
+
+func TestLibraryCloseRunsDestructors(t *testing.T) {
+	if purego.CallbackCapacity() == 0 {
+		t.Skip("callbacks can't be released")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libclosetest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libclosetest", "close.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatalf("OpenLibrary(%q) failed: %v", libFileName, err)
+	}
+	free := purego.FreeCallbacks()
+	var unloaded bool
+	var setOnUnload func(fn uintptr)
+	lib.RegisterFunc(&setOnUnload, "set_on_unload")
+	setOnUnload(lib.NewCallback(func() { unloaded = true }))
+	// the destructor calls the callback while the library is closed
+	if err := lib.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !unloaded {
+		t.Skip("the library wasn't unloaded by Close")
+	}
+	if got := purego.FreeCallbacks(); got != free {
+		t.Errorf("FreeCallbacks after Close got %d wanted %d", got, free)
+	}
+}
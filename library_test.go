@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/puregotest"
+)
+
+func TestLibraryOnInit(t *testing.T) {
+	var calls []string
+	lib := puregotest.NewLibrary("libinit.so").
+		Func("global_init", func() int32 { calls = append(calls, "global_init"); return 0 }).
+		Func("work", func(x int32) int32 { calls = append(calls, "work"); return x * 2 })
+	puregotest.Install(t, lib)
+
+	// two packages that open and bind the same library independently
+	a, err := purego.OpenLibrary("libinit.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	b, err := purego.OpenLibrary("libinit.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	if a != b {
+		t.Fatal("OpenLibrary returned different Libraries for the same name")
+	}
+
+	var globalInit func() int32
+	var workA, workB func(int32) int32
+	purego.RegisterResolverFunc(&globalInit, a, "global_init")
+	for _, l := range []struct {
+		lib  *purego.Library
+		work *func(int32) int32
+	}{{a, &workA}, {b, &workB}} {
+		l.lib.OnInit("global_init", func() error {
+			if globalInit() != 0 {
+				return errors.New("global_init failed")
+			}
+			return nil
+		})
+		l.lib.RegisterFunc(l.work, "work")
+	}
+	if len(calls) != 0 {
+		t.Fatalf("init ran before the first call: %v", calls)
+	}
+	if got := workA(2); got != 4 {
+		t.Errorf("work(2) = %d, want 4", got)
+	}
+	if got := workB(3); got != 6 {
+		t.Errorf("work(3) = %d, want 6", got)
+	}
+	want := []string{"global_init", "work", "work"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", calls, want)
+		}
+	}
+}
+
+func TestLibraryInitError(t *testing.T) {
+	lib := puregotest.NewLibrary("libinitfail.so").
+		Func("work", func() int32 { return 1 })
+	puregotest.Install(t, lib)
+
+	l, err := purego.OpenLibrary("libinitfail.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	initErr := errors.New("no display")
+	l.OnInit("connect", func() error { return initErr })
+	var work func() int32
+	l.RegisterFunc(&work, "work")
+	if err := l.Init(); err != initErr {
+		t.Fatalf("Init() = %v, want %v", err, initErr)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("calling a function of a library whose init failed didn't panic")
+		}
+	}()
+	work()
+}
+
+func TestLibraryInitCallsLibrary(t *testing.T) {
+	var ready int32
+	lib := puregotest.NewLibrary("libinitself.so").
+		Func("setup", func() int32 { atomic.StoreInt32(&ready, 1); return 0 }).
+		Func("work", func() int32 { return atomic.LoadInt32(&ready) })
+	puregotest.Install(t, lib)
+
+	l, err := purego.OpenLibrary("libinitself.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var setup, work func() int32
+	l.RegisterFunc(&setup, "setup")
+	l.RegisterFunc(&work, "work")
+	started := make(chan struct{})
+	l.OnInit("setup", func() error {
+		close(started)
+		// give the other goroutine time to call work while the init function runs
+		time.Sleep(10 * time.Millisecond)
+		if setup() != 0 {
+			return errors.New("setup failed")
+		}
+		return nil
+	})
+
+	results := make(chan int32, 2)
+	go func() {
+		<-started
+		results <- work()
+	}()
+	go func() {
+		results <- work()
+	}()
+	for i := 0; i < 2; i++ {
+		select {
+		case got := <-results:
+			if got != 1 {
+				t.Errorf("work() = %d, want 1 after the init function ran", got)
+			}
+		case <-time.After(time.Minute):
+			// the library isn't closed since Close would deadlock too
+			t.Fatal("calling a function of the library from its init function deadlocked")
+		}
+	}
+	l.Close()
+}
+
+func TestLibraryRebindDuringInit(t *testing.T) {
+	v1 := puregotest.NewLibrary("libreinit-v1.so").Func("version", func() int32 { return 1 })
+	v2 := puregotest.NewLibrary("libreinit-v2.so").Func("version", func() int32 { return 2 })
+	puregotest.Install(t, v1, v2)
+	l, err := purego.OpenLibrary("libreinit-v1.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	var version func() int32
+	l.RegisterFunc(&version, "version")
+	var inits int32
+	started, release := make(chan struct{}), make(chan struct{})
+	l.OnInit("init", func() error {
+		if atomic.AddInt32(&inits, 1) == 1 {
+			close(started)
+			<-release
+		}
+		if _, err := l.Rebind(l.Handle()); err == nil {
+			return errors.New("Rebind from an init function succeeded")
+		}
+		return nil
+	})
+	initErr := make(chan error, 1)
+	go func() { initErr <- l.Init() }()
+	<-started
+
+	h2, err := openLibrary("libreinit-v2.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rebound := make(chan error, 1)
+	go func() {
+		_, err := l.Rebind(h2)
+		rebound <- err
+	}()
+	select {
+	case <-rebound:
+		t.Error("Rebind returned while an init function was running")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	if err := <-initErr; err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	if err := <-rebound; err != nil {
+		t.Fatalf("Rebind failed: %v", err)
+	}
+	// the init function runs again for the new library
+	if got := version(); got != 2 || atomic.LoadInt32(&inits) != 2 {
+		t.Errorf("version() = %d after %d inits, want 2 after 2", got, atomic.LoadInt32(&inits))
+	}
+}
+
+func TestLibraryRebind(t *testing.T) {
+	v1 := puregotest.NewLibrary("libplugin-v1.so").
+		Func("version", func() int32 { return 1 }).
+		Func("scale", func(x int32) int32 { return x })
+	v2 := puregotest.NewLibrary("libplugin-v2.so").
+		Func("version", func() int32 { return 2 }).
+		Func("scale", func(x int32) int32 { return x * 2 })
+	broken := puregotest.NewLibrary("libplugin-broken.so").
+		Func("version", func() int32 { return 3 })
+	puregotest.Install(t, v1, v2, broken)
+
+	l, err := purego.OpenLibrary("libplugin-v1.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	var inits int
+	l.OnInit("init", func() error { inits++; return nil })
+	var version func() int32
+	var scale func(int32) int32
+	l.RegisterFunc(&version, "version")
+	l.RegisterFunc(&scale, "scale")
+	if got := version(); got != 1 || inits != 1 {
+		t.Fatalf("version() = %d after %d inits, want 1 after 1", got, inits)
+	}
+
+	h2, err := openLibrary("libplugin-v2.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	old, err := l.Rebind(h2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1, _ := openLibrary("libplugin-v1.so"); old != h1 {
+		t.Errorf("Rebind returned handle %#x, want %#x", old, h1)
+	}
+	if l.Handle() != h2 {
+		t.Errorf("Handle() = %#x after Rebind, want %#x", l.Handle(), h2)
+	}
+	if got := version(); got != 2 || inits != 2 {
+		t.Errorf("version() = %d after %d inits, want 2 after 2", got, inits)
+	}
+	if got := scale(3); got != 6 {
+		t.Errorf("scale(3) = %d, want 6", got)
+	}
+
+	// a library missing one of the functions leaves every function alone
+	h3, err := openLibrary("libplugin-broken.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Rebind(h3); err == nil {
+		t.Error("Rebind to a library missing a function succeeded")
+	}
+	if got := version(); got != 2 {
+		t.Errorf("version() = %d after a failed Rebind, want 2", got)
+	}
+}
+
+func TestLibraryCallbacks(t *testing.T) {
+	if runtime.GOOS == "windows" || purego.CallbackCapacity() == 0 {
+		t.Skip("callbacks can't be released")
+	}
+	puregotest.Install(t, puregotest.NewLibrary("libplugin.so"))
+	free := purego.FreeCallbacks()
+	for i := 0; i < 3; i++ {
+		// a plugin that is loaded and unloaded repeatedly
+		lib, err := purego.OpenLibrary("libplugin.so")
+		if err != nil {
+			t.Fatal(err)
+		}
+		cb := lib.NewCallback(func(x int32) int32 { return x + 1 })
+		if got, _, _ := purego.SyscallN(cb, 41); int32(got) != 42 {
+			t.Errorf("callback returned %d wanted 42", int32(got))
+		}
+		lib.NewCallback(func() {})
+		lib.ReleaseAll()
+		if got := purego.FreeCallbacks(); got != free {
+			t.Errorf("FreeCallbacks after ReleaseAll got %d wanted %d", got, free)
+		}
+		lib.NewCallback(func() {})
+		if err := lib.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if got := purego.FreeCallbacks(); got != free {
+			t.Errorf("FreeCallbacks after Close got %d wanted %d", got, free)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stddef.h>
+#include <stdint.h>
+#include <string.h>
+
+typedef struct { int32_t x, y; } point;
+typedef struct { float x, y, z, w; } vec4;
+typedef struct { double a, b, c, d; } mat2;
+typedef struct { int64_t a, b, c; } big;
+typedef struct { int32_t i; float f; double d; } mixed;
+typedef struct __attribute__((packed)) { uint8_t kind; int32_t value; } packed;
+
+point point_add(point a, point b) {
+    point p = {a.x + b.x, a.y + b.y};
+    return p;
+}
+
+vec4 vec4_scale(vec4 v, float s) {
+    vec4 r = {v.x * s, v.y * s, v.z * s, v.w * s};
+    return r;
+}
+
+mat2 mat2_diagonal(double a, double d) {
+    mat2 m = {a, 0, 0, d};
+    return m;
+}
+
+int64_t big_sum(big b) {
+    return b.a + b.b + b.c;
+}
+
+big big_make(int64_t a, int64_t b, int64_t c) {
+    big r = {a, b, c};
+    return r;
+}
+
+mixed mixed_double(mixed m) {
+    mixed r = {m.i * 2, m.f * 2, m.d * 2};
+    return r;
+}
+
+int32_t packed_value(packed p) {
+    return p.kind == 7 ? p.value : -1;
+}
+
+typedef struct { float m[4]; } mat2f;
+typedef struct { char name[16]; int32_t id; } record;
+
+mat2f mat2f_scale(mat2f a, float s) {
+    mat2f r;
+    for (int i = 0; i < 4; i++) r.m[i] = a.m[i] * s;
+    return r;
+}
+
+record record_make(int32_t id) {
+    record r = {"purego", id};
+    return r;
+}
+
+int32_t record_id(record r) {
+    return r.name[0] == 'p' ? r.id : -1;
+}
+
+int32_t apply_point(int32_t (*cb)(point), point p) {
+    return cb(p);
+}
+
+int32_t apply_mat2f(int32_t (*cb)(mat2f), mat2f m) {
+    return cb(m);
+}
+
+int32_t apply_record(int32_t (*cb)(int32_t, record), record r) {
+    return cb(1, r);
+}
+
+typedef struct {
+    int32_t (*add)(int32_t, int32_t);
+    int32_t (*mul)(int32_t, int32_t);
+    int32_t base;
+} ops;
+
+static const ops *registered_ops;
+
+void ops_register(const ops *o) {
+    registered_ops = o;
+}
+
+int32_t ops_run(int32_t a, int32_t b) {
+    return registered_ops->base + registered_ops->mul(registered_ops->add(a, b), b);
+}
+
+int32_t ops_apply(ops o, int32_t a, int32_t b) {
+    return o.base + o.mul(o.add(a, b), b);
+}
+
+static int32_t sub(int32_t a, int32_t b) {
+    return a - b;
+}
+
+void ops_fill(ops *o) {
+    o->add = sub;
+    o->base = 100;
+}
+
+typedef struct {
+    uint8_t version;
+    int64_t size;
+    double ratio;
+    char name[8];
+} info;
+
+int32_t info_fill(info *i) {
+    if (i == NULL) {
+        return -1;
+    }
+    i->version = 3;
+    i->size = 1LL << 40;
+    i->ratio = 0.5;
+    memcpy(i->name, "purego", 7);
+    return 0;
+}
+
+typedef struct {
+    int64_t flags;
+    double scale;
+    int32_t level;
+    char name[32];
+    int64_t extra[4];
+} options;
+
+int64_t options_sum(int32_t first, options o, int64_t last) {
+    int64_t sum = first + o.flags + (int64_t)o.scale + o.level + (int64_t)strlen(o.name) + last;
+    for (int i = 0; i < 4; i++) sum += o.extra[i];
+    // the callee owns its copy of the struct
+    memset(&o, 0, sizeof(o));
+    return sum;
+}
+
+int64_t options_diff(options a, options b) {
+    return (a.flags - b.flags) * 1000 + (a.extra[3] - b.extra[3]);
+}
+
+typedef struct {
+    const int64_t *data;
+    int64_t len;
+} int_buffer;
+
+int64_t int_buffer_sum(int_buffer b, void (*before)(void)) {
+    before();
+    int64_t sum = 0;
+    for (int64_t i = 0; i < b.len; i++) sum += b.data[i];
+    return sum;
+}
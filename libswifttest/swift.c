@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// clang lowers swiftcall functions like the Swift compiler so these stand in
+// for functions exported from a Swift library.
+
+#define SWIFTCALL __attribute__((swiftcall))
+#define SWIFT_CONTEXT __attribute__((swift_context))
+#define SWIFT_ERROR __attribute__((swift_error_result))
+
+SWIFTCALL long add_self(long x, void *SWIFT_CONTEXT self) {
+    return x + (long)self;
+}
+
+SWIFTCALL long checked_div(long a, long b, void *SWIFT_CONTEXT self, void **SWIFT_ERROR error) {
+    if (b == 0) {
+        *error = (void *)0xdead;
+        return 0;
+    }
+    return a / b;
+}
+
+SWIFTCALL double scale_self(double x, long a, long b, long c, long d, long e, long f, long g, long h, void *SWIFT_CONTEXT self) {
+    return x * (double)(a + b + c + d + e + f + g + h + (long)self);
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+namespace geometry {
+
+struct Point {
+    int x, y;
+    Point(int x, int y) : x(x), y(y) {}
+    int sum() const { return x + y; }
+};
+
+int scale(int v) { return v * 2; }
+int scale(int v, int factor) { return v * factor; }
+
+template <typename T>
+T twice(T v) { return v + v; }
+
+template int twice<int>(int);
+
+int point_sum(int x, int y) { return Point(x, y).sum(); }
+
+} // namespace geometry
+
+extern "C" int plain_c(int v) { return v + 1; }
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+__thread int tls_value = 7;
+
+void set_tls_value(int v) {
+    tls_value = v;
+}
+
+int *tls_value_location(void) {
+    return &tls_value;
+}
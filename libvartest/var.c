@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+int counter = 1;
+
+const char version[] = "1.2.3";
+
+const char *name = "purego";
+
+struct config {
+    int level;
+    double ratio;
+} config = {3, 0.5};
+
+int get_counter(void) {
+    return counter;
+}
+
+double config_product(void) {
+    return config.level * config.ratio;
+}
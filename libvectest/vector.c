@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include <stdint.h>
+
+typedef float float4 __attribute__((vector_size(16)));
+typedef double double2 __attribute__((vector_size(16)));
+
+float4 float4_scale(float4 v, float s) {
+    return v * s;
+}
+
+double2 double2_add(double2 a, double b, double2 c) {
+    return a + c + b;
+}
+
+// the vector in the last float register is followed by integers
+int64_t float4_sum(int64_t a, float4 v0, float4 v1, float4 v2, float4 v3, float4 v4, float4 v5, float4 v6, float4 v7, int64_t b) {
+    float4 s = v0 + v1 + v2 + v3 + v4 + v5 + v6 + v7;
+    return a + (int64_t)(s[0] + s[1] + s[2] + s[3]) + b;
+}
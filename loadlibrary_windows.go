@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+// Flags of LoadLibraryEx and SetDefaultDllDirectories that control where a DLL and its
+// dependencies are searched for. Source: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-loadlibraryexw
+const (
+	LOAD_WITH_ALTERED_SEARCH_PATH       = 0x00000008 // Search the directory of an absolute path instead of the directory of the application.
+	LOAD_LIBRARY_SEARCH_DLL_LOAD_DIR    = 0x00000100 // Search the directory of the DLL for its dependencies. The name must be an absolute path.
+	LOAD_LIBRARY_SEARCH_APPLICATION_DIR = 0x00000200 // Search the directory of the application.
+	LOAD_LIBRARY_SEARCH_USER_DIRS       = 0x00000400 // Search the directories added with AddDllDirectory.
+	LOAD_LIBRARY_SEARCH_SYSTEM32        = 0x00000800 // Search the System32 directory.
+	LOAD_LIBRARY_SEARCH_DEFAULT_DIRS    = 0x00001000 // Search the application directory, the directories added with AddDllDirectory and System32.
+)
+
+var (
+	kernel32                     = windows.NewLazySystemDLL("kernel32.dll")
+	procAddDllDirectory          = kernel32.NewProc("AddDllDirectory")
+	procRemoveDllDirectory       = kernel32.NewProc("RemoveDllDirectory")
+	errLoadLibrarySearchUnusable = errors.New("purego: LOAD_LIBRARY_SEARCH flags need KB2533623 on Windows 7")
+)
+
+// LoadLibraryEx loads the DLL name like LoadLibrary but searches for it and its dependencies only
+// where flags says to. Loading with LOAD_LIBRARY_SEARCH_SYSTEM32 or LOAD_LIBRARY_SEARCH_DEFAULT_DIRS
+// prevents DLL hijacking through the current directory and PATH. The handle can be used with
+// RegisterLibFunc and released with windows.FreeLibrary.
+//
+// If the system doesn't permit loading the library the returned error matches ErrRestricted.
+// If DLLs it depends on can't be found the error is a *MissingDependencyError that lists them.
+// The dependencies are searched for in the standard search order regardless of flags.
+func LoadLibraryEx(name string, flags uint32) (uintptr, error) {
+	if h, ok := fake.Open(name); ok {
+		return h, nil
+	}
+	handle, err := windows.LoadLibraryEx(loaderPath(name), 0, uintptr(flags))
+	if err != nil {
+		return 0, loadLibraryError(name, err)
+	}
+	return uintptr(handle), nil
+}
+
+// longPathThreshold is the length from which a path is passed with the \\?\ prefix. It is MAX_PATH
+// minus the 12 characters of an 8.3 file name like os uses. The length in bytes is never smaller
+// than the length in UTF-16 code units so non-ASCII paths get the prefix early rather than late.
+const longPathThreshold = 248
+
+// loaderPath returns the path LoadLibraryExW is passed for the library name. Paths longer than
+// MAX_PATH only load with the \\?\ prefix unless the application opted into long paths in its
+// manifest, and the prefix needs an absolute path with backslashes and without . and .. elements.
+// Names without a directory are searched for by the loader and are returned unchanged.
+func loaderPath(name string) string {
+	if len(name) < longPathThreshold || !strings.ContainsAny(name, `\/`) || strings.HasPrefix(name, `\\?\`) {
+		return name
+	}
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return name
+	}
+	if strings.HasPrefix(abs, `\\`) {
+		// \\server\share\dir is \\?\UNC\server\share\dir
+		return `\\?\UNC\` + abs[2:]
+	}
+	return `\\?\` + abs
+}
+
+// loadLibraryError converts an error of LoadLibrary for the DLL name that is caused by a policy
+// such as a process mitigation forbidding the library to one that matches ErrRestricted, and one
+// caused by missing dependencies to a *MissingDependencyError.
+func loadLibraryError(name string, err error) error {
+	switch err {
+	case windows.ERROR_ACCESS_DENIED, windows.ERROR_INVALID_IMAGE_HASH:
+		// the library exists but a policy such as a process mitigation forbids loading it
+		err = restrictedError{err}
+	case windows.ERROR_MOD_NOT_FOUND:
+		// the DLL or one of its dependencies is missing
+		err = diagnoseDependencies(name, err)
+	}
+	return err
+}
+
+// SetDefaultDllDirectories sets the directories that LoadLibrary, and so OpenLibrary, search for DLLs
+// and their dependencies for the whole process to those in flags, which are LOAD_LIBRARY_SEARCH flags.
+// Applications should call it early with LOAD_LIBRARY_SEARCH_DEFAULT_DIRS so that DLLs are never
+// loaded from the current directory or PATH.
+func SetDefaultDllDirectories(flags uint32) error {
+	if err := procAddDllDirectory.Find(); err != nil {
+		return errLoadLibrarySearchUnusable
+	}
+	return windows.SetDefaultDllDirectories(flags)
+}
+
+// DllDirectory is a directory added to the DLL search path with AddDllDirectory.
+type DllDirectory uintptr
+
+// AddDllDirectory adds the absolute path dir to the directories that are searched with
+// LOAD_LIBRARY_SEARCH_USER_DIRS, which is part of LOAD_LIBRARY_SEARCH_DEFAULT_DIRS. If several
+// directories are added the order they are searched in is unspecified.
+func AddDllDirectory(dir string) (DllDirectory, error) {
+	if err := procAddDllDirectory.Find(); err != nil {
+		return 0, errLoadLibrarySearchUnusable
+	}
+	p, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return 0, err
+	}
+	r, _, err := procAddDllDirectory.Call(uintptr(unsafe.Pointer(p)))
+	if r == 0 {
+		return 0, err
+	}
+	return DllDirectory(r), nil
+}
+
+// Remove removes d from the DLL search path. DLLs that were already loaded from it stay loaded.
+func (d DllDirectory) Remove() error {
+	if r, _, err := procRemoveDllDirectory.Call(uintptr(d)); r == 0 {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestLoadLibraryEx(t *testing.T) {
+	handle, err := purego.LoadLibraryEx("kernel32.dll", purego.LOAD_LIBRARY_SEARCH_SYSTEM32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer windows.FreeLibrary(windows.Handle(handle))
+	var getCurrentProcessId func() uint32
+	purego.RegisterLibFunc(&getCurrentProcessId, handle, "GetCurrentProcessId")
+	if got, want := getCurrentProcessId(), windows.GetCurrentProcessId(); got != want {
+		t.Errorf("GetCurrentProcessId returned %d, want %d", got, want)
+	}
+
+	if _, err := purego.LoadLibraryEx("purego_missing.dll", purego.LOAD_LIBRARY_SEARCH_SYSTEM32); err == nil {
+		t.Error("loading a missing DLL succeeded")
+	}
+}
+
+func TestAddDllDirectory(t *testing.T) {
+	dir, err := purego.AddDllDirectory(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dir.Remove(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLoadPackagedLibrary(t *testing.T) {
+	if purego.IsPackagedProcess() {
+		t.Skip("the test doesn't run packaged")
+	}
+	_, err := purego.LoadPackagedLibrary("kernel32.dll")
+	if !errors.Is(err, windows.APPMODEL_ERROR_NO_PACKAGE) {
+		t.Errorf("LoadPackagedLibrary in a process without a package returned %v, want APPMODEL_ERROR_NO_PACKAGE", err)
+	}
+	// OpenLibrary doesn't use LoadPackagedLibrary outside of a package
+	lib, err := purego.OpenLibrary("kernel32.dll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lib.Close()
+}
+
+func TestOpenLibraryLongUnicodePath(t *testing.T) {
+	dll := filepath.Join(t.TempDir(), "libcbtest.dll")
+	if err := buildSharedLib("CC", dll, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	// a directory with non-ASCII characters whose path is longer than MAX_PATH
+	dir := t.TempDir()
+	for len(dir) < 300 {
+		dir = filepath.Join(dir, "Bibliothèque-库-"+strings.Repeat("x", 40))
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(dll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := filepath.Join(dir, "libcbtest.dll")
+	if err := os.WriteFile(long, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, load := range []struct {
+		name string
+		fn   func(string) (uintptr, error)
+	}{
+		{"OpenLibrary", func(path string) (uintptr, error) {
+			lib, err := purego.OpenLibrary(path)
+			if err != nil {
+				return 0, err
+			}
+			defer lib.Close()
+			return lib.Lookup("callCallback")
+		}},
+		{"LoadLibraryEx", func(path string) (uintptr, error) {
+			h, err := purego.LoadLibraryEx(path, purego.LOAD_LIBRARY_SEARCH_DLL_LOAD_DIR|purego.LOAD_LIBRARY_SEARCH_SYSTEM32)
+			if err != nil {
+				return 0, err
+			}
+			defer windows.FreeLibrary(windows.Handle(h))
+			return windows.GetProcAddress(windows.Handle(h), "callCallback")
+		}},
+	} {
+		if _, err := load.fn(long); err != nil {
+			t.Errorf("%s of a path of %d bytes failed: %v", load.name, len(long), err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
+	"unsafe"
+)
+
+// JmpBuf is a jmp_buf that a C library jumps to with longjmp to report an error, as libpng and
+// libjpeg do. longjmp would skip the Go frames of the caller and corrupt the goroutine, so a function
+// registered with RegisterFunc that has a parameter of type *JmpBuf is guarded: the parameter isn't
+// passed to C but right before the call setjmp is called with the JmpBuf on a C frame of its own,
+// which the longjmp returns to. The Go function must return an error last, which is a *LongjmpError
+// if the C function called longjmp and nil otherwise. The other results are zero after a longjmp.
+//
+//	// void decode(struct decoder *d, jmp_buf *err);
+//	var decode func(buf *purego.JmpBuf, d unsafe.Pointer, err unsafe.Pointer) error
+//	purego.RegisterLibFunc(&decode, lib, "decode")
+//	buf := purego.NewJmpBuf()
+//	err := decode(buf, d, buf.Pointer())
+//
+// The C function must only jump to the JmpBuf of the call and not over frames of Go callbacks
+// it called that haven't returned. Guarded calls are only supported on amd64 and arm64 on Linux,
+// macOS and FreeBSD.
+type JmpBuf struct {
+	p unsafe.Pointer
+	// jumped is the value the last guarded call passed to longjmp or 0 if it returned.
+	jumped int32
+}
+
+// jmpBufWords is the size of a JmpBuf in words. It is larger than the jmp_buf and sigjmp_buf of
+// glibc, musl, macOS and FreeBSD which is at most 312 bytes on arm64.
+const jmpBufWords = 64
+
+// NewJmpBuf returns a JmpBuf whose memory is allocated by Go. It must stay reachable while C can
+// jump to it.
+func NewJmpBuf() *JmpBuf {
+	return &JmpBuf{p: unsafe.Pointer(new([jmpBufWords]uint64))}
+}
+
+// JmpBufAt returns a JmpBuf for the jmp_buf at p that the C library owns, for example the one
+// png_set_longjmp_fn returns for a png_struct.
+func JmpBufAt(p unsafe.Pointer) *JmpBuf {
+	return &JmpBuf{p: p}
+}
+
+// Pointer returns the address of the jmp_buf.
+func (b *JmpBuf) Pointer() unsafe.Pointer {
+	return b.p
+}
+
+// LongjmpError is the error a guarded call returns if the C function called longjmp with its JmpBuf.
+type LongjmpError struct {
+	// Value is the value passed to longjmp, which setjmp returns and which is never 0.
+	Value int
+}
+
+func (e *LongjmpError) Error() string {
+	return "purego: the C function called longjmp with " + strconv.Itoa(e.Value)
+}
+
+var jmpBufPtrType = reflect.TypeOf((*JmpBuf)(nil))
+
+var guardcallABI0 uintptr
+
+// guardcallArgs is passed to guardcall which calls setjmp with jmpbuf, stores its result in jumped
+// and calls args like syscall9X if it is 0.
+type guardcallArgs struct {
+	args   *syscall9Args
+	jmpbuf unsafe.Pointer
+	setjmp uintptr
+	jumped uintptr
+}
+
+var setjmpSym struct {
+	once sync.Once
+	addr uintptr
+}
+
+// guardedCall calls the C function of args after setjmp with b and stores the value passed to
+// longjmp in b.jumped.
+func (b *JmpBuf) guardedCall(args *syscall9Args) {
+	a := guardcallArgs{args: args, jmpbuf: b.p, setjmp: setjmpSym.addr}
+	runtime_cgocall(guardcallABI0, unsafe.Pointer(&a))
+	runtime.KeepAlive(b)
+	b.jumped = int32(a.jumped)
+}
+
+// jmpBufParam returns the index of the *JmpBuf parameter of the function type ty or -1 if it
+// has none. It panics if there are several.
+func jmpBufParam(ty reflect.Type) int {
+	index := -1
+	for i := 0; i < ty.NumIn(); i++ {
+		if ty.In(i) == jmpBufPtrType {
+			if index >= 0 {
+				panic("purego: a function can only have one *JmpBuf parameter: " + ty.String())
+			}
+			index = i
+		}
+	}
+	return index
+}
+
+// registerGuarded sets fn, whose type has a *JmpBuf parameter and returns an error last, to a
+// function that calls cfn without the error result and returns a *LongjmpError if it jumped.
+// It panics if the last result isn't an error or the platform doesn't support guarded calls.
+func registerGuarded(fn reflect.Value, cfn uintptr, cfg *funcConfig) {
+	ty := fn.Type()
+	if ty.NumOut() == 0 || ty.Out(ty.NumOut()-1) != errorType {
+		panic("purego: a function with a *JmpBuf parameter must return an error last: " + ty.String())
+	}
+	if swiftArgs(ty) {
+		panic("purego: a Swift function can't have a *JmpBuf parameter: " + ty.String())
+	}
+	setjmpSym.once.Do(func() {
+		setjmpSym.addr = setjmpAddr()
+	})
+	if guardcallABI0 == 0 || setjmpSym.addr == 0 {
+		panic("purego: guarded calls with a *JmpBuf are only supported on amd64 and arm64 on Linux, macOS and FreeBSD")
+	}
+	buf := jmpBufParam(ty)
+	in := make([]reflect.Type, ty.NumIn())
+	for i := range in {
+		in[i] = ty.In(i)
+	}
+	out := make([]reflect.Type, ty.NumOut()-1)
+	for i := range out {
+		out[i] = ty.Out(i)
+	}
+	call := reflect.New(reflect.FuncOf(in, out, ty.IsVariadic()))
+	cfg.guarded = true
+	registerFunc(call.Interface(), cfn, cfg)
+	call = call.Elem()
+	fn.Set(reflect.MakeFunc(ty, func(args []reflect.Value) []reflect.Value {
+		b := args[buf].Interface().(*JmpBuf)
+		if b == nil {
+			panic("purego: the *JmpBuf of a guarded call is nil")
+		}
+		b.jumped = 0
+		var results []reflect.Value
+		if ty.IsVariadic() {
+			results = call.CallSlice(args)
+		} else {
+			results = call.Call(args)
+		}
+		err := reflect.New(errorType).Elem()
+		if b.jumped != 0 {
+			for i := range results {
+				results[i] = reflect.Zero(out[i])
+			}
+			err.Set(reflect.ValueOf(&LongjmpError{Value: int(b.jumped)}))
+		}
+		return append(results, err)
+	}))
+}
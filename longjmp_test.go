@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux) && (amd64 || arm64)
+
+package purego_test
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestGuardedCall(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "liblongjmptest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("liblongjmptest", "longjmp.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var decode func(buf *purego.JmpBuf, err unsafe.Pointer, value int32) (int32, error)
+	var decodeWith func(err unsafe.Pointer, cb func(x int32) int32, x int32, buf *purego.JmpBuf) (int32, error)
+	purego.RegisterLibFunc(&decode, lib, "decode")
+	purego.RegisterLibFunc(&decodeWith, lib, "decodeWith")
+
+	buf := purego.NewJmpBuf()
+	if got, err := decode(buf, buf.Pointer(), 0); got != 42 || err != nil {
+		t.Errorf("decode without longjmp got %d, %v wanted 42, nil", got, err)
+	}
+	got, err := decode(buf, buf.Pointer(), 7)
+	var jmpErr *purego.LongjmpError
+	if !errors.As(err, &jmpErr) || jmpErr.Value != 7 || got != 0 {
+		t.Errorf("decode with longjmp got %d, %v wanted 0 and a LongjmpError with 7", got, err)
+	}
+
+	// the callback has returned when C jumps
+	got, err = decodeWith(buf.Pointer(), func(x int32) int32 { return x - 1 }, 3, buf)
+	if !errors.As(err, &jmpErr) || jmpErr.Value != 2 {
+		t.Errorf("decodeWith got %d, %v wanted a LongjmpError with 2", got, err)
+	}
+	if got, err := decodeWith(buf.Pointer(), func(x int32) int32 { return 0 }, 3, buf); got != 45 || err != nil {
+		t.Errorf("decodeWith without longjmp got %d, %v wanted 45, nil", got, err)
+	}
+
+	// the goroutines keep working after many jumps
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := purego.NewJmpBuf()
+			for j := int32(1); j <= 1000; j++ {
+				if _, err := decode(buf, buf.Pointer(), j); !errors.As(err, new(*purego.LongjmpError)) {
+					t.Errorf("decode %d got %v wanted a LongjmpError", j, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var noError func(buf *purego.JmpBuf, err unsafe.Pointer, value int32) int32
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterLibFunc of a guarded function without an error result didn't panic")
+		}
+	}()
+	purego.RegisterLibFunc(&noError, lib, "decode")
+}
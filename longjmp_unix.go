@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+// setjmpAddr returns the address of setjmp of the C library.
+func setjmpAddr() uintptr {
+	return fnDlsym(RTLD_DEFAULT, "setjmp")
+}
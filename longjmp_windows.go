@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+// setjmpAddr returns 0 since guarded calls aren't supported on Windows.
+func setjmpAddr() uintptr {
+	return 0
+}
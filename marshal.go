@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import "reflect"
+
+// CMarshaler is implemented by types that convert themselves into the C value they are passed as.
+// The value is an integer or pointer of at most the size of a register, such as a time_t:
+//
+//	type Time struct{ time.Time }
+//
+//	// MarshalC converts t into a time_t.
+//	func (t Time) MarshalC() uintptr { return uintptr(t.Unix()) }
+//
+//	// UnmarshalC converts the time_t c into t.
+//	func (t *Time) UnmarshalC(c uintptr) { t.Time = time.Unix(int64(c), 0) }
+//
+//	// double difftime(time_t time1, time_t time0);
+//	var difftime func(t1, t0 Time) float64
+//
+// Functions registered with RegisterFunc call MarshalC for their arguments and callbacks call it
+// for their result. MarshalC is called on the value that is passed so it should have a value receiver.
+type CMarshaler interface {
+	MarshalC() uintptr
+}
+
+// CUnmarshaler is implemented by types that convert the C value they are returned as into themselves.
+// Functions registered with RegisterFunc call UnmarshalC for their result and callbacks call it for
+// their parameters. UnmarshalC is called on a pointer to a new zero value. See CMarshaler.
+type CUnmarshaler interface {
+	UnmarshalC(c uintptr)
+}
+
+var (
+	cMarshalerType   = reflect.TypeOf((*CMarshaler)(nil)).Elem()
+	cUnmarshalerType = reflect.TypeOf((*CUnmarshaler)(nil)).Elem()
+	uintptrType      = reflect.TypeOf(uintptr(0))
+)
+
+// isCMarshaler reports whether values of type t are passed to C as what MarshalC returns.
+func isCMarshaler(t reflect.Type) bool {
+	return t.Implements(cMarshalerType)
+}
+
+// isCUnmarshaler reports whether values of type t are converted from C with UnmarshalC.
+func isCUnmarshaler(t reflect.Type) bool {
+	return reflect.PtrTo(t).Implements(cUnmarshalerType)
+}
+
+// unmarshalC returns the value of type t that the C value c is converted into.
+func unmarshalC(t reflect.Type, c uintptr) reflect.Value {
+	v := reflect.New(t)
+	v.Interface().(CUnmarshaler).UnmarshalC(c)
+	return v.Elem()
+}
+
+// cMarshalingFunc returns fn or, if fn has parameters that implement CUnmarshaler or a result that
+// implements CMarshaler, a function that takes and returns their C values as uintptr instead, so
+// that the callback machinery only deals with words.
+func cMarshalingFunc(fn interface{}) interface{} {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return fn
+	}
+	ty := v.Type()
+	in := make([]reflect.Type, ty.NumIn())
+	unmarshal := make([]bool, ty.NumIn())
+	converted := false
+	for i := range in {
+		in[i] = ty.In(i)
+		if isCUnmarshaler(in[i]) {
+			in[i], unmarshal[i], converted = uintptrType, true, true
+		}
+	}
+	out := make([]reflect.Type, ty.NumOut())
+	for i := range out {
+		out[i] = ty.Out(i)
+	}
+	marshal := ty.NumOut() == 1 && isCMarshaler(out[0])
+	if marshal {
+		out[0], converted = uintptrType, true
+	}
+	if !converted {
+		return fn
+	}
+	return reflect.MakeFunc(reflect.FuncOf(in, out, ty.IsVariadic()), func(args []reflect.Value) []reflect.Value {
+		for i, a := range args {
+			if unmarshal[i] {
+				args[i] = unmarshalC(ty.In(i), uintptr(a.Uint()))
+			}
+		}
+		ret := v.Call(args)
+		if marshal {
+			ret[0] = reflect.ValueOf(ret[0].Interface().(CMarshaler).MarshalC())
+		}
+		return ret
+	}).Interface()
+}
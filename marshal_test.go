@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
+
+package purego_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+)
+
+// cTime is a time.Time that is passed to C as a time_t.
+type cTime struct {
+	time.Time
+}
+
+func (t cTime) MarshalC() uintptr {
+	return uintptr(t.Unix())
+}
+
+func (t *cTime) UnmarshalC(c uintptr) {
+	t.Time = time.Unix(int64(c), 0)
+}
+
+func TestCMarshaler(t *testing.T) {
+	name, err := getSystemLibrary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	libc, err := openLibrary(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var difftime func(t1, t0 cTime) float64
+	purego.RegisterLibFunc(&difftime, libc, "difftime")
+	var cNow func(tloc *int64) cTime
+	purego.RegisterLibFunc(&cNow, libc, "time")
+
+	t0 := cTime{time.Unix(1000, 0)}
+	if got := difftime(cTime{t0.Add(90 * time.Second)}, t0); got != 90 {
+		t.Errorf("difftime got %v wanted 90", got)
+	}
+	now := cNow(nil)
+	if d := time.Since(now.Time); d < -time.Minute || d > time.Minute {
+		t.Errorf("time returned %v", now)
+	}
+
+	// callbacks unmarshal their parameters and marshal their result
+	cb := purego.NewCallback(func(t cTime, d int32) cTime {
+		return cTime{t.Add(time.Duration(d) * time.Second)}
+	})
+	var later func(t cTime, d int32) cTime
+	purego.RegisterFunc(&later, cb)
+	if got := later(t0, 5); got.Unix() != 1005 {
+		t.Errorf("callback returned %v wanted %v", got, time.Unix(1005, 0))
+	}
+}
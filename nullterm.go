@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// WithNullTerminatedArrays passes []*T and []unsafe.Pointer arguments as NULL-terminated arrays of
+// pointers like []string is passed as a NULL-terminated char**. Many C APIs take such lists, for
+// example the environment of execve or the extension names of Vulkan:
+//
+//	// int execve(const char *path, char *const argv[], char *const envp[]);
+//	var execve func(path string, argv []string, envp []*byte) int32
+//	purego.RegisterLibFuncWith(&execve, libc, "execve", purego.WithNullTerminatedArrays())
+//
+// Without the option such a slice is passed as a pointer to its first element. A nil slice is NULL.
+// The array is only valid during the call.
+//
+// Returning a NULL-terminated array is supported without the option since a slice can't be returned
+// otherwise: a []*T, []unsafe.Pointer or []string result holds the elements up to the terminating NULL.
+// The strings are copied into Go memory and the array is not freed.
+func WithNullTerminatedArrays() FuncOption {
+	return func(cfg *funcConfig) {
+		cfg.nullTerminated = true
+	}
+}
+
+// isPointerSlice reports whether t is []*T or []unsafe.Pointer.
+func isPointerSlice(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+	k := t.Elem().Kind()
+	return k == reflect.Ptr || k == reflect.UnsafePointer
+}
+
+// isNullTerminatedSlice reports whether a C function can return a NULL-terminated array as t.
+func isNullTerminatedSlice(t reflect.Type) bool {
+	return isPointerSlice(t) || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String && t.Elem() != wstringType
+}
+
+// nullTerminated returns a NULL-terminated array of the pointers in the slice v allocated from a.
+// The pointers are stored as uintptr since the arena is not scanned by the garbage collector,
+// so v must be kept alive during the call.
+func (a *Arena) nullTerminated(v reflect.Value) unsafe.Pointer {
+	if v.IsNil() {
+		return nil
+	}
+	ptrs := unsafe.Slice((*uintptr)(a.Alloc(unsafe.Sizeof(uintptr(0))*uintptr(v.Len()+1), unsafe.Alignof(uintptr(0)))), v.Len()+1)
+	for i := 0; i < v.Len(); i++ {
+		ptrs[i] = v.Index(i).Pointer()
+	}
+	ptrs[v.Len()] = 0
+	return unsafe.Pointer(&ptrs[0])
+}
+
+// fromNullTerminated returns the slice of type t holding the elements of the NULL-terminated array p.
+// It is nil if p is NULL.
+func fromNullTerminated(t reflect.Type, p uintptr) reflect.Value {
+	s := reflect.Zero(t)
+	if p == 0 {
+		return s
+	}
+	array := *(*unsafe.Pointer)(unsafe.Pointer(&p))
+	for i := uintptr(0); ; i++ {
+		e := *(*uintptr)(unsafe.Add(array, i*unsafe.Sizeof(uintptr(0))))
+		if e == 0 {
+			return s
+		}
+		var v reflect.Value
+		switch t.Elem().Kind() {
+		case reflect.String:
+			v = reflect.ValueOf(strings.GoString(e)).Convert(t.Elem())
+		case reflect.UnsafePointer:
+			v = reflect.ValueOf(*(*unsafe.Pointer)(unsafe.Pointer(&e))).Convert(t.Elem())
+		default:
+			v = reflect.NewAt(t.Elem().Elem(), *(*unsafe.Pointer)(unsafe.Pointer(&e)))
+		}
+		s = reflect.Append(s, v)
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestNullTerminatedArrays(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libarraytest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libarraytest", "array.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var sumValues func(values []*int32) int32
+	purego.RegisterLibFuncWith(&sumValues, lib, "sum_values", purego.WithNullTerminatedArrays())
+	a, b, c := int32(1), int32(20), int32(300)
+	if got := sumValues([]*int32{&a, &b, &c}); got != 321 {
+		t.Errorf("sum_values got %d wanted 321", got)
+	}
+	if got := sumValues([]*int32{}); got != 0 {
+		t.Errorf("sum_values of an empty slice got %d wanted 0", got)
+	}
+
+	var extensionNames func() []string
+	purego.RegisterLibFunc(&extensionNames, lib, "extension_names")
+	if got, want := extensionNames(), []string{"VK_KHR_surface", "VK_KHR_swapchain"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("extension_names got %q wanted %q", got, want)
+	}
+	var numberList func(empty bool) []*int32
+	purego.RegisterLibFunc(&numberList, lib, "number_list")
+	if got := numberList(false); len(got) != 2 || *got[0] != 1 || *got[1] != 2 {
+		t.Errorf("number_list got %v", got)
+	}
+	if got := numberList(true); got != nil {
+		t.Errorf("number_list(true) got %v wanted nil", got)
+	}
+	var noList func() []unsafe.Pointer
+	purego.RegisterLibFunc(&noList, lib, "no_list")
+	if got := noList(); got != nil {
+		t.Errorf("no_list got %v wanted nil", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("RegisterLibFunc didn't panic for a []int32 result")
+		}
+	}()
+	var bad func() []int32
+	purego.RegisterLibFunc(&bad, lib, "number_list")
+}
@@ -15,6 +15,7 @@ import (
 	"unsafe"
 
 	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/internal/deprecation"
 )
 
 // TODO: support try/catch?
@@ -167,6 +168,7 @@ func GetClass(name string) Class {
 //
 // Deprecated: use RegisterClass instead
 func AllocateClassPair(super Class, name string, extraBytes uintptr) Class {
+	deprecation.Use("objc.AllocateClassPair", "objc.RegisterClass")
 	return objc_allocateClassPair(super, name, extraBytes)
 }
 
@@ -484,6 +486,7 @@ func (c Class) AddMethod(name SEL, imp IMP, types string) bool {
 //
 // Deprecated: use RegisterClass instead
 func (c Class) AddIvar(name string, ty interface{}, types string) bool {
+	deprecation.Use("objc.Class.AddIvar", "objc.RegisterClass")
 	typeOf := reflect.TypeOf(ty)
 	size := typeOf.Size()
 	alignment := uint8(math.Log2(float64(typeOf.Align())))
@@ -512,6 +515,7 @@ func (c Class) InstanceVariable(name string) Ivar {
 //
 // Deprecated: use RegisterClass instead
 func (c Class) Register() {
+	deprecation.Use("objc.Class.Register", "objc.RegisterClass")
 	objc_registerClassPair(c)
 }
 
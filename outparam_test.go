@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+type widget struct {
+	ID   int32
+	Size int32
+}
+
+func TestOutParams(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libouttest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libouttest", "out.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var widgetCreate func(id int32, out **widget) int32
+	purego.RegisterLibFunc(&widgetCreate, lib, "widget_create")
+	var widgetDestroy func(w *widget)
+	purego.RegisterLibFunc(&widgetDestroy, lib, "widget_destroy")
+	var widgetID func(in **widget) int32
+	purego.RegisterLibFunc(&widgetID, lib, "widget_id")
+	var bufferAlloc func(n uintptr, out *unsafe.Pointer) int32
+	purego.RegisterLibFunc(&bufferAlloc, lib, "buffer_alloc")
+	var free func(p unsafe.Pointer)
+	purego.RegisterLibFunc(&free, lib, "free")
+
+	var w *widget
+	if widgetCreate(21, &w) != 0 || w == nil {
+		t.Fatal("widget_create failed")
+	}
+	if w.ID != 21 || w.Size != 42 {
+		t.Errorf("widget_create returned %+v", *w)
+	}
+	// the out parameter passes the current value to C too
+	if got := widgetID(&w); got != 21 {
+		t.Errorf("widget_id got %d wanted 21", got)
+	}
+	widgetDestroy(w)
+
+	var buf unsafe.Pointer
+	if bufferAlloc(16, &buf) != 0 || buf == nil {
+		t.Fatal("buffer_alloc failed")
+	}
+	if b := unsafe.Slice((*byte)(buf), 16); b[15] != 0 {
+		t.Errorf("buffer_alloc returned memory that isn't zeroed")
+	}
+	free(buf)
+
+	type packed struct {
+		_ struct{} `purego:"packed"`
+		A int8
+		B int32
+	}
+	var create func(id int32, out **packed) int32
+	if err := purego.RegisterFuncE(&create, 1); err == nil {
+		t.Errorf("RegisterFunc with a pointer to a pointer to a packed struct succeeded")
+	}
+
+	// C overwrites Go pointers in the heap while the garbage collector runs
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				runtime.GC()
+			}
+		}
+	}()
+	type holder struct {
+		w *widget
+	}
+	for i := int32(0); i < 1000; i++ {
+		h := &holder{w: &widget{ID: -1}}
+		if widgetCreate(i, &h.w) != 0 || h.w.ID != i {
+			t.Fatalf("%d: widget_create returned %+v", i, *h.w)
+		}
+		widgetDestroy(h.w)
+	}
+	close(stop)
+	wg.Wait()
+}
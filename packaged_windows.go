@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+var (
+	procLoadPackagedLibrary       = kernel32.NewProc("LoadPackagedLibrary")
+	procGetCurrentPackageFullName = kernel32.NewProc("GetCurrentPackageFullName")
+
+	packagedProcess struct {
+		once sync.Once
+		ok   bool
+	}
+)
+
+// IsPackagedProcess reports whether the process runs with the identity of an MSIX or UWP package
+// such as an app installed from the Microsoft Store. A packaged process finds the DLLs it bundles
+// in the dependency graph of its package, which OpenLibrary searches first in such a process.
+func IsPackagedProcess() bool {
+	packagedProcess.once.Do(func() {
+		// GetCurrentPackageFullName only exists since Windows 8
+		if procGetCurrentPackageFullName.Find() != nil {
+			return
+		}
+		var n uint32
+		r, _, _ := procGetCurrentPackageFullName.Call(uintptr(unsafe.Pointer(&n)), 0)
+		// a packaged process needs a buffer for its name, others have none
+		packagedProcess.ok = windows.Errno(r) == windows.ERROR_INSUFFICIENT_BUFFER
+	})
+	return packagedProcess.ok
+}
+
+// LoadPackagedLibrary loads the DLL name from the dependency graph of the package of the process
+// with LoadPackagedLibrary, which is the only way a UWP app in an AppContainer can load the DLLs
+// it bundles. name must be relative to the root of the package and must not contain "..".
+// The handle can be used with RegisterLibFunc and released with windows.FreeLibrary.
+//
+// It returns windows.APPMODEL_ERROR_NO_PACKAGE if the process isn't packaged, see IsPackagedProcess.
+// OpenLibrary uses LoadPackagedLibrary itself in a packaged process for names it accepts.
+func LoadPackagedLibrary(name string) (uintptr, error) {
+	if h, ok := fake.Open(name); ok {
+		return h, nil
+	}
+	handle, err := loadPackagedLibrary(name)
+	if err != nil {
+		return 0, loadLibraryError(name, err)
+	}
+	return handle, nil
+}
+
+func loadPackagedLibrary(name string) (uintptr, error) {
+	if err := procLoadPackagedLibrary.Find(); err != nil {
+		return 0, windows.APPMODEL_ERROR_NO_PACKAGE
+	}
+	p, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return 0, err
+	}
+	r, _, err := procLoadPackagedLibrary.Call(uintptr(unsafe.Pointer(p)), 0)
+	if r == 0 {
+		return 0, err
+	}
+	return r, nil
+}
+
+// isPackageRelative reports whether name is a path LoadPackagedLibrary accepts.
+func isPackageRelative(name string) bool {
+	if filepath.IsAbs(name) || filepath.VolumeName(name) != "" || strings.HasPrefix(name, `\`) || strings.HasPrefix(name, "/") {
+		return false
+	}
+	for _, elem := range strings.FieldsFunc(name, func(r rune) bool { return r == '\\' || r == '/' }) {
+		if elem == ".." {
+			return false
+		}
+	}
+	return true
+}
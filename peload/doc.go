@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package peload loads a Windows DLL from memory.
+//
+// LoadLibrary needs a file, so a program that embeds a DLL would have to write it to a temporary
+// file first. Load instead maps the sections of the DLL held in a byte slice, applies its base
+// relocations, resolves its imports with LoadLibrary and GetProcAddress, registers its exception
+// handling table and runs its TLS callbacks and DllMain. The addresses returned by Library.Lookup
+// can be passed to purego.RegisterFunc and a Library is a purego.Resolver:
+//
+//	//go:embed foo.dll
+//	var fooDLL []byte
+//
+//	lib, err := peload.Load(fooDLL)
+//	if err != nil {
+//		return err
+//	}
+//	var foo func(int32) int32
+//	purego.RegisterResolverFunc(&foo, lib, "foo")
+//
+// A DLL loaded from memory is not known to the Windows loader. GetModuleHandle and GetProcAddress
+// don't find it, it doesn't receive DLL_THREAD_ATTACH notifications, and thread-local variables
+// declared with __declspec(thread) are not supported. Delay-loaded imports are resolved by the
+// DLL itself when they are first called.
+package peload
@@ -0,0 +1,478 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package peload
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/jwijenbergh/purego"
+)
+
+const (
+	dllProcessDetach = 0
+	dllProcessAttach = 1
+
+	ptrSize = unsafe.Sizeof(uintptr(0))
+)
+
+var (
+	kernel32                   = windows.NewLazySystemDLL("kernel32.dll")
+	procFlushInstructionCache  = kernel32.NewProc("FlushInstructionCache")
+	procRtlAddFunctionTable    = kernel32.NewProc("RtlAddFunctionTable")
+	procRtlDeleteFunctionTable = kernel32.NewProc("RtlDeleteFunctionTable")
+)
+
+// Library is a DLL loaded by Load.
+type Library struct {
+	base    uintptr
+	mem     []byte
+	entry   uintptr // DllMain or 0 if the DLL has no entry point
+	exports map[string]uintptr
+	imports []windows.Handle
+	// functionTable is the address of the exception handling table registered with RtlAddFunctionTable.
+	functionTable uintptr
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// image is the layout of a DLL taken from its optional header.
+type image struct {
+	base       uint64
+	size       uint32
+	headerSize uint32
+	entry      uint32
+	dirs       []pe.DataDirectory
+	is64       bool
+}
+
+// Load maps the DLL in data into memory and initializes it. data isn't referenced after Load returns.
+func Load(data []byte) (*Library, error) {
+	lib, err := load(data)
+	if err != nil {
+		return nil, fmt.Errorf("peload: %w", err)
+	}
+	return lib, nil
+}
+
+func load(data []byte) (lib *Library, err error) {
+	f, err := pe.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if f.Machine != machine() {
+		return nil, fmt.Errorf("the DLL is not for %s", runtime.GOARCH)
+	}
+	if f.Characteristics&pe.IMAGE_FILE_DLL == 0 {
+		return nil, errors.New("the image is not a DLL")
+	}
+	var img image
+	switch oh := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader64:
+		img = image{base: oh.ImageBase, size: oh.SizeOfImage, headerSize: oh.SizeOfHeaders, entry: oh.AddressOfEntryPoint, dirs: dataDirs(oh.DataDirectory[:], oh.NumberOfRvaAndSizes), is64: true}
+	case *pe.OptionalHeader32:
+		img = image{base: uint64(oh.ImageBase), size: oh.SizeOfImage, headerSize: oh.SizeOfHeaders, entry: oh.AddressOfEntryPoint, dirs: dataDirs(oh.DataDirectory[:], oh.NumberOfRvaAndSizes)}
+	default:
+		return nil, errors.New("the DLL has no optional header")
+	}
+	if img.is64 != (ptrSize == 8) {
+		return nil, fmt.Errorf("the DLL is not for %s", runtime.GOARCH)
+	}
+
+	// Load at the preferred base if possible which saves relocating the image.
+	base, err := windows.VirtualAlloc(uintptr(img.base), uintptr(img.size), windows.MEM_RESERVE|windows.MEM_COMMIT, windows.PAGE_READWRITE)
+	if err != nil {
+		base, err = windows.VirtualAlloc(0, uintptr(img.size), windows.MEM_RESERVE|windows.MEM_COMMIT, windows.PAGE_READWRITE)
+		if err != nil {
+			return nil, err
+		}
+	}
+	lib = &Library{base: base, mem: unsafe.Slice((*byte)(*(*unsafe.Pointer)(unsafe.Pointer(&base))), img.size)}
+	defer func() {
+		if err != nil {
+			lib.free()
+			lib = nil
+		}
+	}()
+	// A malformed DLL can make the offsets below point outside of the image.
+	// Nothing of the DLL has run yet so this is reported as an error.
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(runtime.Error); !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("malformed DLL: %v", r)
+		}
+	}()
+	if err := lib.mapSections(data, f, &img); err != nil {
+		return nil, err
+	}
+	if err := lib.relocate(f, &img); err != nil {
+		return nil, err
+	}
+	if err := lib.resolveImports(&img); err != nil {
+		return nil, err
+	}
+	if err := lib.readExports(&img); err != nil {
+		return nil, err
+	}
+	if err := lib.protect(f, &img); err != nil {
+		return nil, err
+	}
+	lib.addFunctionTable(&img)
+	for _, cb := range lib.tlsCallbacks(&img) {
+		purego.SyscallN(cb, base, dllProcessAttach, 0)
+	}
+	if img.entry != 0 {
+		lib.entry = base + uintptr(img.entry)
+		if r, _, _ := purego.SyscallN(lib.entry, base, dllProcessAttach, 0); r == 0 {
+			lib.entry = 0
+			return nil, errors.New("DllMain failed")
+		}
+	}
+	return lib, nil
+}
+
+// dataDirs returns the first n of dirs that the optional header says are present.
+func dataDirs(dirs []pe.DataDirectory, n uint32) []pe.DataDirectory {
+	if n < uint32(len(dirs)) {
+		return dirs[:n]
+	}
+	return dirs
+}
+
+// machine returns the machine type of the DLLs that can be loaded.
+func machine() uint16 {
+	switch runtime.GOARCH {
+	case "386":
+		return pe.IMAGE_FILE_MACHINE_I386
+	case "amd64":
+		return pe.IMAGE_FILE_MACHINE_AMD64
+	case "arm":
+		return pe.IMAGE_FILE_MACHINE_ARMNT
+	case "arm64":
+		return pe.IMAGE_FILE_MACHINE_ARM64
+	}
+	return 0
+}
+
+// dir returns the data directory i or a zero directory if the DLL doesn't have it.
+func (img *image) dir(i int) pe.DataDirectory {
+	if i < len(img.dirs) {
+		return img.dirs[i]
+	}
+	return pe.DataDirectory{}
+}
+
+func (l *Library) u16(rva uint32) uint16 { return binary.LittleEndian.Uint16(l.mem[rva:]) }
+func (l *Library) u32(rva uint32) uint32 { return binary.LittleEndian.Uint32(l.mem[rva:]) }
+
+// ptr returns the pointer sized value at rva.
+func (l *Library) ptr(rva uint32) uint64 {
+	if ptrSize == 8 {
+		return binary.LittleEndian.Uint64(l.mem[rva:])
+	}
+	return uint64(l.u32(rva))
+}
+
+func (l *Library) setPtr(rva uint32, v uintptr) {
+	if ptrSize == 8 {
+		binary.LittleEndian.PutUint64(l.mem[rva:], uint64(v))
+	} else {
+		binary.LittleEndian.PutUint32(l.mem[rva:], uint32(v))
+	}
+}
+
+// cstring returns the null-terminated string at rva.
+func (l *Library) cstring(rva uint32) string {
+	s := l.mem[rva:]
+	if i := bytes.IndexByte(s, 0); i >= 0 {
+		s = s[:i]
+	}
+	return string(s)
+}
+
+// mapSections copies the headers and the sections of the DLL into the image.
+func (l *Library) mapSections(data []byte, f *pe.File, img *image) error {
+	copy(l.mem, data[:img.headerSize])
+	for _, s := range f.Sections {
+		n := s.Size
+		if s.VirtualSize != 0 && s.VirtualSize < n {
+			n = s.VirtualSize
+		}
+		if n == 0 {
+			continue
+		}
+		if uint64(s.Offset)+uint64(n) > uint64(len(data)) || uint64(s.VirtualAddress)+uint64(n) > uint64(img.size) {
+			return fmt.Errorf("section %s is out of bounds", s.Name)
+		}
+		copy(l.mem[s.VirtualAddress:], data[s.Offset:s.Offset+n])
+	}
+	return nil
+}
+
+// relocate applies the base relocations if the DLL isn't loaded at its preferred base.
+func (l *Library) relocate(f *pe.File, img *image) error {
+	delta := uint64(l.base) - img.base
+	if delta == 0 {
+		return nil
+	}
+	dir := img.dir(pe.IMAGE_DIRECTORY_ENTRY_BASERELOC)
+	if dir.Size == 0 {
+		if f.Characteristics&pe.IMAGE_FILE_RELOCS_STRIPPED != 0 {
+			return errors.New("the DLL can't be loaded at another base and its base is in use")
+		}
+		return nil
+	}
+	for rva, end := dir.VirtualAddress, dir.VirtualAddress+dir.Size; rva+8 <= end; {
+		page, size := l.u32(rva), l.u32(rva+4)
+		if size < 8 {
+			return errors.New("invalid base relocation block")
+		}
+		for e := rva + 8; e+2 <= rva+size; e += 2 {
+			entry := l.u16(e)
+			off := page + uint32(entry&0xfff)
+			switch entry >> 12 {
+			case 0: // IMAGE_REL_BASED_ABSOLUTE pads the block
+			case 3: // IMAGE_REL_BASED_HIGHLOW
+				binary.LittleEndian.PutUint32(l.mem[off:], l.u32(off)+uint32(delta))
+			case 10: // IMAGE_REL_BASED_DIR64
+				binary.LittleEndian.PutUint64(l.mem[off:], binary.LittleEndian.Uint64(l.mem[off:])+delta)
+			default:
+				return fmt.Errorf("unsupported base relocation type %d", entry>>12)
+			}
+		}
+		rva += size
+	}
+	return nil
+}
+
+// resolveImports loads the DLLs imported by the DLL and fills its import address table.
+func (l *Library) resolveImports(img *image) error {
+	dir := img.dir(pe.IMAGE_DIRECTORY_ENTRY_IMPORT)
+	if dir.Size == 0 {
+		return nil
+	}
+	ordinalFlag := uint64(1) << (ptrSize*8 - 1)
+	// The import descriptors are 20 bytes and end with one whose name is 0.
+	for desc := dir.VirtualAddress; ; desc += 20 {
+		lookup, name, iat := l.u32(desc), l.u32(desc+12), l.u32(desc+16)
+		if name == 0 {
+			return nil
+		}
+		if lookup == 0 {
+			lookup = iat
+		}
+		dll := l.cstring(name)
+		h, err := windows.LoadLibrary(dll)
+		if err != nil {
+			return fmt.Errorf("failed to load %s: %w", dll, err)
+		}
+		l.imports = append(l.imports, h)
+		for ; ; lookup, iat = lookup+uint32(ptrSize), iat+uint32(ptrSize) {
+			thunk := l.ptr(lookup)
+			if thunk == 0 {
+				break
+			}
+			var addr uintptr
+			var sym string
+			if thunk&ordinalFlag != 0 {
+				sym = fmt.Sprint("#", uint16(thunk))
+				addr, err = windows.GetProcAddressByOrdinal(h, uintptr(uint16(thunk)))
+			} else {
+				// IMAGE_IMPORT_BY_NAME is a 2 byte hint followed by the name
+				sym = l.cstring(uint32(thunk) + 2)
+				addr, err = windows.GetProcAddress(h, sym)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to find %s in %s: %w", sym, dll, err)
+			}
+			l.setPtr(iat, addr)
+		}
+	}
+}
+
+// readExports reads the names and addresses of the exported functions.
+func (l *Library) readExports(img *image) error {
+	l.exports = map[string]uintptr{}
+	dir := img.dir(pe.IMAGE_DIRECTORY_ENTRY_EXPORT)
+	if dir.Size == 0 {
+		return nil
+	}
+	exp := dir.VirtualAddress
+	numFuncs, numNames := l.u32(exp+20), l.u32(exp+24)
+	funcs, names, ordinals := l.u32(exp+28), l.u32(exp+32), l.u32(exp+36)
+	for i := uint32(0); i < numNames; i++ {
+		name := l.cstring(l.u32(names + 4*i))
+		idx := uint32(l.u16(ordinals + 2*i))
+		if idx >= numFuncs {
+			return fmt.Errorf("export %s has an invalid ordinal", name)
+		}
+		rva := l.u32(funcs + 4*idx)
+		if rva >= dir.VirtualAddress && rva < dir.VirtualAddress+dir.Size {
+			// A forwarder is a string like "NTDLL.RtlAllocateHeap" instead of code.
+			addr, err := l.forward(l.cstring(rva))
+			if err != nil {
+				return fmt.Errorf("export %s: %w", name, err)
+			}
+			l.exports[name] = addr
+			continue
+		}
+		l.exports[name] = l.base + uintptr(rva)
+	}
+	return nil
+}
+
+// forward returns the address of the function the export forwarder fwd refers to.
+func (l *Library) forward(fwd string) (uintptr, error) {
+	i := strings.LastIndexByte(fwd, '.')
+	if i < 0 {
+		return 0, fmt.Errorf("invalid forwarder %s", fwd)
+	}
+	h, err := windows.LoadLibrary(fwd[:i] + ".dll")
+	if err != nil {
+		return 0, err
+	}
+	l.imports = append(l.imports, h)
+	sym := fwd[i+1:]
+	if !strings.HasPrefix(sym, "#") {
+		return windows.GetProcAddress(h, sym)
+	}
+	var ordinal uint16
+	if _, err := fmt.Sscan(sym[1:], &ordinal); err != nil {
+		return 0, fmt.Errorf("invalid forwarder %s", fwd)
+	}
+	return windows.GetProcAddressByOrdinal(h, uintptr(ordinal))
+}
+
+// protect sets the protection of every section to what its characteristics ask for.
+func (l *Library) protect(f *pe.File, img *image) error {
+	var old uint32
+	if err := windows.VirtualProtect(l.base, uintptr(img.headerSize), windows.PAGE_READONLY, &old); err != nil {
+		return err
+	}
+	for _, s := range f.Sections {
+		size := s.VirtualSize
+		if size == 0 {
+			size = s.Size
+		}
+		if size == 0 {
+			continue
+		}
+		exec := s.Characteristics&pe.IMAGE_SCN_MEM_EXECUTE != 0
+		read := s.Characteristics&pe.IMAGE_SCN_MEM_READ != 0
+		write := s.Characteristics&pe.IMAGE_SCN_MEM_WRITE != 0
+		var prot uint32
+		switch {
+		case exec && write:
+			prot = windows.PAGE_EXECUTE_READWRITE
+		case exec && read:
+			prot = windows.PAGE_EXECUTE_READ
+		case exec:
+			prot = windows.PAGE_EXECUTE
+		case write:
+			prot = windows.PAGE_READWRITE
+		case read:
+			prot = windows.PAGE_READONLY
+		default:
+			prot = windows.PAGE_NOACCESS
+		}
+		if err := windows.VirtualProtect(l.base+uintptr(s.VirtualAddress), uintptr(size), prot, &old); err != nil {
+			return fmt.Errorf("failed to protect section %s: %w", s.Name, err)
+		}
+	}
+	procFlushInstructionCache.Call(uintptr(windows.CurrentProcess()), l.base, uintptr(img.size))
+	return nil
+}
+
+// addFunctionTable registers the unwind information of the DLL so that exceptions
+// can be raised through its functions. Only 64-bit DLLs have one.
+func (l *Library) addFunctionTable(img *image) {
+	dir := img.dir(pe.IMAGE_DIRECTORY_ENTRY_EXCEPTION)
+	if dir.Size == 0 || !img.is64 {
+		return
+	}
+	// RUNTIME_FUNCTION is 12 bytes on amd64 and 8 bytes on arm64.
+	entrySize := uint32(12)
+	if runtime.GOARCH == "arm64" {
+		entrySize = 8
+	}
+	table := l.base + uintptr(dir.VirtualAddress)
+	if r, _, _ := procRtlAddFunctionTable.Call(table, uintptr(dir.Size/entrySize), l.base); r != 0 {
+		l.functionTable = table
+	}
+}
+
+// tlsCallbacks returns the TLS callbacks of the DLL.
+func (l *Library) tlsCallbacks(img *image) []uintptr {
+	dir := img.dir(pe.IMAGE_DIRECTORY_ENTRY_TLS)
+	if dir.Size == 0 {
+		return nil
+	}
+	// AddressOfCallBacks is the fourth pointer of IMAGE_TLS_DIRECTORY. It is a relocated address.
+	callbacks := l.ptr(dir.VirtualAddress + 3*uint32(ptrSize))
+	if callbacks == 0 {
+		return nil
+	}
+	var cbs []uintptr
+	for rva := uint32(uintptr(callbacks) - l.base); ; rva += uint32(ptrSize) {
+		cb := uintptr(l.ptr(rva))
+		if cb == 0 {
+			return cbs
+		}
+		cbs = append(cbs, cb)
+	}
+}
+
+// Lookup returns the address of the function the DLL exports as name.
+func (l *Library) Lookup(name string) (uintptr, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return 0, errors.New("peload: the DLL is closed")
+	}
+	if addr, ok := l.exports[name]; ok {
+		return addr, nil
+	}
+	return 0, fmt.Errorf("peload: the DLL doesn't export %s", name)
+}
+
+// Close calls DllMain to detach the DLL and then unloads it.
+// Functions of the DLL must not be called afterwards.
+func (l *Library) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	if l.entry != 0 {
+		purego.SyscallN(l.entry, l.base, dllProcessDetach, 0)
+	}
+	return l.free()
+}
+
+// free releases the memory of the DLL and the DLLs it imported.
+func (l *Library) free() error {
+	if l.functionTable != 0 {
+		procRtlDeleteFunctionTable.Call(l.functionTable)
+		l.functionTable = 0
+	}
+	for _, h := range l.imports {
+		windows.FreeLibrary(h)
+	}
+	l.imports = nil
+	l.mem = nil
+	return windows.VirtualFree(l.base, 0, windows.MEM_RELEASE)
+}
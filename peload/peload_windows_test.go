@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package peload_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/peload"
+)
+
+const dllSource = `
+#include <windows.h>
+
+static int attached;
+static int value = 40;
+static int *value_ptr = &value;
+
+BOOL WINAPI DllMain(HINSTANCE instance, DWORD reason, LPVOID reserved) {
+	if (reason == DLL_PROCESS_ATTACH) attached = 1;
+	return TRUE;
+}
+
+__declspec(dllexport) int is_attached(void) { return attached; }
+__declspec(dllexport) int add_value(int a) { return a + *value_ptr; }
+__declspec(dllexport) DWORD current_pid(void) { return GetCurrentProcessId(); }
+`
+
+func buildDLL(t *testing.T) []byte {
+	out, err := exec.Command("go", "env", "CC").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc, err := exec.LookPath(strings.TrimSpace(string(out)))
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "test.c")
+	if err := os.WriteFile(src, []byte(dllSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dll := filepath.Join(dir, "test.dll")
+	if out, err := exec.Command(cc, "-shared", "-o", dll, src).CombinedOutput(); err != nil {
+		t.Fatalf("failed to build the DLL: %v\n%s", err, out)
+	}
+	data, err := os.ReadFile(dll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestLoad(t *testing.T) {
+	data := buildDLL(t)
+	// The second copy can't be loaded at the preferred base so it is relocated.
+	for i := 0; i < 2; i++ {
+		lib, err := peload.Load(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer lib.Close()
+
+		var isAttached func() int32
+		var addValue func(int32) int32
+		var currentPid func() uint32
+		purego.RegisterResolverFunc(&isAttached, lib, "is_attached")
+		purego.RegisterResolverFunc(&addValue, lib, "add_value")
+		purego.RegisterResolverFunc(&currentPid, lib, "current_pid")
+		if got := isAttached(); got != 1 {
+			t.Errorf("copy %d: is_attached got %d wanted 1", i, got)
+		}
+		if got := addValue(2); got != 42 {
+			t.Errorf("copy %d: add_value(2) got %d wanted 42", i, got)
+		}
+		if got := currentPid(); got != uint32(os.Getpid()) {
+			t.Errorf("copy %d: current_pid got %d wanted %d", i, got, os.Getpid())
+		}
+		if _, err := lib.Lookup("missing"); err == nil {
+			t.Errorf("copy %d: Lookup of a missing export returned no error", i)
+		}
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	if _, err := peload.Load([]byte("not a DLL")); err == nil {
+		t.Errorf("Load of garbage returned no error")
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := peload.Load(data); err == nil || !strings.Contains(err.Error(), "not a DLL") {
+		t.Errorf("Load of an executable got error %v wanted not a DLL", err)
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+// Proc is a C function that is called with uintptr arguments like windows.Proc of golang.org/x/sys/windows.
+// It's meant for code migrating from windows.LazyProc and for occasional raw calls that don't warrant
+// defining a Go func type for RegisterFunc.
+type Proc struct {
+	// Name is the name of the symbol the function was found at. It is empty for NewProc.
+	Name string
+	addr uintptr
+}
+
+// NewProc returns the Proc of the C function at addr. It panics if addr is 0.
+func NewProc(addr uintptr) *Proc {
+	if addr == 0 {
+		panic("purego: addr is nil")
+	}
+	return &Proc{addr: addr}
+}
+
+// FindProc returns the Proc of the symbol name in the library handle returned by Dlopen
+// (LoadLibrary on Windows).
+func FindProc(handle uintptr, name string) (*Proc, error) {
+	addr, err := loadSymbol(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	return &Proc{Name: name, addr: addr}, nil
+}
+
+// Proc is like FindProc but looks up the symbol name in the library.
+// Library.Rebind doesn't change the Proc which keeps calling the function of the previous library.
+func (l *Library) Proc(name string) (*Proc, error) {
+	return FindProc(l.Handle(), name)
+}
+
+// Addr returns the address of the function.
+func (p *Proc) Addr() uintptr {
+	return p.addr
+}
+
+// Call calls the function with args and returns its result in r1. r2 holds the second integer
+// result register on Windows and the first float result register elsewhere, the same as SyscallN.
+// The arguments have the same limits as for SyscallN.
+//
+// As with windows.Proc, err is never nil. It is the syscall.Errno of GetLastError on Windows and
+// of errno elsewhere right after the call. Most functions only set it on failure so check r1 first.
+//
+//go:uintptrescapes
+func (p *Proc) Call(args ...uintptr) (r1, r2 uintptr, err error) {
+	return callProc(p.addr, args)
+}
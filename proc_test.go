@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"math"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestProcCall(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := purego.Dlopen(library, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	strtol, err := purego.FindProc(libc, "strtol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strtol.Name != "strtol" || strtol.Addr() == 0 {
+		t.Errorf("FindProc got %+v", strtol)
+	}
+	if _, err := purego.FindProc(libc, "no_such_function"); err == nil {
+		t.Errorf("FindProc of a missing symbol succeeded")
+	}
+
+	s := []byte("-42\x00")
+	if r1, _, err := strtol.Call(uintptr(unsafe.Pointer(&s[0])), 0, 10); int(r1) != -42 || err == nil {
+		t.Errorf("strtol(-42) = %d, %v", int(r1), err)
+	}
+	s = []byte("99999999999999999999999\x00")
+	r1, _, err := strtol.Call(uintptr(unsafe.Pointer(&s[0])), 0, 10)
+	if int64(r1) != math.MaxInt64 || err != syscall.ERANGE {
+		t.Errorf("strtol of an out of range number = %d, %v wanted %d, %v", int64(r1), err, int64(math.MaxInt64), syscall.ERANGE)
+	}
+
+	p := purego.NewProc(strtol.Addr())
+	if p.Name != "" || p.Addr() != strtol.Addr() {
+		t.Errorf("NewProc got %+v", p)
+	}
+}
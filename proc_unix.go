@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+import (
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// errnoAccessor is the C function that returns the address of errno on the calling thread.
+var errnoAccessor struct {
+	once sync.Once
+	addr uintptr
+}
+
+func errnoLocation() uintptr {
+	errnoAccessor.once.Do(func() {
+		name := "__errno_location" // glibc and musl
+		if runtime.GOOS != "linux" {
+			name = "__error"
+		}
+		errnoAccessor.addr, _ = Dlsym(RTLD_DEFAULT, name)
+	})
+	return errnoAccessor.addr
+}
+
+func callProc(fn uintptr, args []uintptr) (r1, r2 uintptr, err error) {
+	// errno belongs to the thread so the goroutine mustn't move before it is read
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	r1, r2, _ = SyscallN(fn, args...)
+	var errno syscall.Errno
+	if accessor := errnoLocation(); accessor != 0 {
+		p, _, _ := SyscallN(accessor)
+		errno = syscall.Errno(*(*int32)(*(*unsafe.Pointer)(unsafe.Pointer(&p))))
+	}
+	return r1, r2, errno
+}
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "syscall"
+
+func callProc(fn uintptr, args []uintptr) (r1, r2 uintptr, err error) {
+	// syscall.Syscall9 reads GetLastError on the thread of the call
+	r1, r2, errno := SyscallN(fn, args...)
+	return r1, r2, syscall.Errno(errno)
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ProcTable is a struct of function fields registered with RegisterProcTable. It fits APIs such as
+// OpenGL, EGL and Vulkan whose functions are looked up through a GetProcAddress-style function and
+// whose addresses depend on the current context, instance or device.
+type ProcTable struct {
+	mu    sync.Mutex
+	v     reflect.Value // the struct
+	funcs []*procTableFunc
+}
+
+// procTableFunc is a function field of a ProcTable.
+type procTableFunc struct {
+	index    int
+	name     string
+	optional bool
+	// cfg and fn are the settings and the registered function once the symbol was found.
+	cfg *funcConfig
+	fn  reflect.Value
+}
+
+// RegisterProcTable registers every exported function field of the struct tptr points to with the
+// address r returns for its name. The name is the name of the field or the one in its symbol tag.
+// A missing symbol is an error unless the tag marks it optional in which case the field is set to nil:
+//
+//	type gl struct {
+//		Clear      func(mask uint32)
+//		ClearColor func(r, g, b, a float32)
+//		// only available with OpenGL 4.3 or KHR_debug
+//		DebugMessageCallback func(callback, userParam uintptr) `symbol:"glDebugMessageCallback,optional"`
+//	}
+//	var funcs gl
+//	table, err := purego.RegisterProcTable(&funcs, purego.ProcAddressResolver(glXGetProcAddress))
+//
+// Call Resolve on the returned ProcTable when the context or device changes.
+// It panics if tptr isn't a pointer to a struct.
+func RegisterProcTable(tptr interface{}, r Resolver) (t *ProcTable, err error) {
+	v := reflect.ValueOf(tptr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		panic("purego: tptr must be a pointer to a struct")
+	}
+	t = &ProcTable{v: v.Elem()}
+	ty := t.v.Type()
+	for i := 0; i < ty.NumField(); i++ {
+		f := ty.Field(i)
+		if f.PkgPath != "" || f.Type.Kind() != reflect.Func {
+			continue
+		}
+		pf := &procTableFunc{index: i, name: f.Name}
+		if tag, ok := f.Tag.Lookup("symbol"); ok {
+			name, opt, _ := strings.Cut(tag, ",")
+			switch opt {
+			case "":
+			case "optional":
+				pf.optional = true
+			default:
+				return nil, errors.New("purego: field " + f.Name + " of " + ty.String() + " has an unknown symbol option: " + opt)
+			}
+			if name != "" {
+				pf.name = name
+			}
+		}
+		t.funcs = append(t.funcs, pf)
+	}
+	if err := t.Resolve(r); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Resolve looks up every function of t again with r, for example after making another OpenGL context
+// current or with the resolver of another Vulkan device. Either every function that isn't optional is
+// found or none is changed and an error is returned. Each function switches to its new address
+// atomically, including copies of the function values taken before, so calls that run concurrently
+// with Resolve may call the functions of either. The fields of optional functions that are missing
+// are set to nil and calling a copy of them taken before panics.
+func (t *ProcTable) Resolve(r Resolver) (err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	syms := make([]uintptr, len(t.funcs))
+	for i, f := range t.funcs {
+		sym, err := r.Lookup(f.name)
+		if err == nil && sym == 0 {
+			err = errors.New("purego: " + f.name + " resolved to NULL")
+		}
+		if err != nil {
+			if f.optional {
+				continue
+			}
+			return err
+		}
+		syms[i] = sym
+	}
+	defer recoverError(&err)
+	for i, f := range t.funcs {
+		field := t.v.Field(f.index)
+		switch {
+		case syms[i] == 0:
+			if f.cfg != nil {
+				atomic.StoreUintptr(&f.cfg.sym, 0)
+			}
+			field.Set(reflect.Zero(field.Type()))
+		case f.cfg == nil:
+			cfg := &funcConfig{name: f.name, rebindable: true}
+			registerFunc(field.Addr().Interface(), syms[i], cfg)
+			f.cfg, f.fn = cfg, reflect.ValueOf(field.Interface())
+		default:
+			atomic.StoreUintptr(&f.cfg.sym, syms[i])
+			field.Set(f.fn)
+		}
+	}
+	return nil
+}
+
+// Missing returns the names of the optional functions of t that weren't found by the last Resolve.
+func (t *ProcTable) Missing() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var names []string
+	for _, f := range t.funcs {
+		if f.optional && (f.cfg == nil || atomic.LoadUintptr(&f.cfg.sym) == 0) {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
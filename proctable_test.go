@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+type procs struct {
+	Conv  func(c int32) int32 `symbol:"conv"`
+	Lower func(c int32) int32 `symbol:"lower,optional"`
+	// not registered
+	skipped func()
+	Count   int
+}
+
+func TestProcTable(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	// resolver returns a Resolver that looks up the names in symbols in libc
+	resolver := func(symbols map[string]string) purego.Resolver {
+		return purego.ResolverFunc(func(name string) (uintptr, error) {
+			if sym, ok := symbols[name]; ok {
+				return purego.LibraryResolver(libc).Lookup(sym)
+			}
+			return 0, errors.New("undefined symbol: " + name)
+		})
+	}
+
+	var p procs
+	table, err := purego.RegisterProcTable(&p, resolver(map[string]string{"conv": "toupper"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.Conv('a'); got != 'A' {
+		t.Errorf("Conv('a') got %c wanted A", got)
+	}
+	if p.Lower != nil || p.skipped != nil {
+		t.Errorf("missing optional and unexported fields are set")
+	}
+	if got := table.Missing(); !reflect.DeepEqual(got, []string{"lower"}) {
+		t.Errorf("Missing got %v wanted [lower]", got)
+	}
+
+	// a copy taken before Resolve calls the new function too
+	conv := p.Conv
+	if err := table.Resolve(resolver(map[string]string{"conv": "tolower", "lower": "tolower"})); err != nil {
+		t.Fatal(err)
+	}
+	if got := conv('A'); got != 'a' {
+		t.Errorf("Conv('A') after Resolve got %c wanted a", got)
+	}
+	if p.Lower == nil || p.Lower('B') != 'b' || len(table.Missing()) != 0 {
+		t.Errorf("optional function wasn't registered after it became available")
+	}
+
+	// nothing changes if a function that isn't optional is missing
+	if err := table.Resolve(resolver(nil)); err == nil {
+		t.Errorf("Resolve without conv succeeded")
+	}
+	if got := p.Conv('A'); got != 'a' {
+		t.Errorf("Conv('A') after a failed Resolve got %c wanted a", got)
+	}
+
+	lower := p.Lower
+	if err := table.Resolve(resolver(map[string]string{"conv": "toupper"})); err != nil {
+		t.Fatal(err)
+	}
+	if p.Lower != nil {
+		t.Errorf("missing optional function wasn't cleared")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("calling a function that is no longer resolved didn't panic")
+			}
+		}()
+		lower('C')
+	}()
+
+	var bad struct {
+		F func() `symbol:"f,weak"`
+	}
+	if _, err := purego.RegisterProcTable(&bad, resolver(nil)); err == nil {
+		t.Errorf("unknown symbol option didn't fail")
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package puregotest_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/puregotest"
+)
+
+func TestFakeDlopen(t *testing.T) {
+	var greeting string
+	puregotest.Install(t, puregotest.NewLibrary("libgreet.so.1").
+		Func("greet", func(name string) { greeting = "hello " + name }))
+
+	lib, err := purego.Dlopen("libgreet.so.1", purego.RTLD_NOW)
+	if err != nil {
+		t.Fatalf("Dlopen failed: %v", err)
+	}
+	defer purego.Dlclose(lib)
+
+	var greet func(name string)
+	purego.RegisterLibFunc(&greet, lib, "greet")
+	greet("purego")
+	if greeting != "hello purego" {
+		t.Errorf("greet got %q wanted %q", greeting, "hello purego")
+	}
+	if _, err := purego.Dlsym(lib, "missing"); err == nil {
+		t.Errorf("Dlsym of missing symbol succeeded")
+	}
+}
+
+func TestFakeSymbolRealHandle(t *testing.T) {
+	puregotest.Install(t, puregotest.NewLibrary("libgreet.so.1").Func("greet", func() {}))
+
+	name := map[string]string{"darwin": "/usr/lib/libSystem.B.dylib", "freebsd": "libc.so.7"}[runtime.GOOS]
+	if name == "" {
+		name = "libc.so.6"
+	}
+	libc, err := purego.Dlopen(name, purego.RTLD_NOW)
+	if err != nil {
+		t.Fatalf("Dlopen failed: %v", err)
+	}
+	defer purego.Dlclose(libc)
+	// a real library doesn't export the symbols of the fake ones
+	if _, err := purego.Dlsym(libc, "greet"); err == nil {
+		t.Errorf("Dlsym of a fake symbol through the handle of a real library succeeded")
+	}
+	if _, err := purego.Dlsym(libc, "strlen"); err != nil {
+		t.Errorf("Dlsym of strlen failed: %v", err)
+	}
+	if _, err := purego.Dlsym(purego.RTLD_DEFAULT, "greet"); err != nil {
+		t.Errorf("Dlsym of a fake symbol through RTLD_DEFAULT failed: %v", err)
+	}
+}
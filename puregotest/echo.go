@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package puregotest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+// EchoConfig configures CheckEcho.
+type EchoConfig struct {
+	// Iterations is the number of calls. It is 100 if zero.
+	Iterations int
+	// Rand is the source of the arguments. If nil a source seeded with the time is used
+	// and the seed is logged so that a failure can be reproduced.
+	Rand *rand.Rand
+}
+
+// CheckEcho calls fn with random arguments and fails tb unless fn returns its last argument unchanged.
+// fn is a function registered with purego for a C fixture that does so, for example:
+//
+//	struct point { int8_t x; double y; };
+//	struct point echo_point(int64_t a, float b, struct point p) { return p; }
+//
+// The other arguments are there to fill the registers so that the last one is passed where the ABI
+// puts it after them. The arguments can be integers, floats, bools, uintptrs and structs and arrays
+// of them. Integers and floats are random bit patterns so that the sign and the upper bits are
+// checked too, but floats are never NaN since converting a NaN may change its bits.
+func CheckEcho(tb testing.TB, fn interface{}, cfg *EchoConfig) {
+	tb.Helper()
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		panic("puregotest: fn must be a non-nil function")
+	}
+	ty := v.Type()
+	if ty.NumIn() == 0 || ty.NumOut() != 1 || ty.Out(0) != ty.In(ty.NumIn()-1) {
+		panic("puregotest: fn must return the type of its last argument")
+	}
+	iterations := 100
+	var r *rand.Rand
+	if cfg != nil {
+		if cfg.Iterations > 0 {
+			iterations = cfg.Iterations
+		}
+		r = cfg.Rand
+	}
+	if r == nil {
+		seed := time.Now().UnixNano()
+		tb.Logf("puregotest: CheckEcho seed %d", seed)
+		r = rand.New(rand.NewSource(seed))
+	}
+	args := make([]reflect.Value, ty.NumIn())
+	for i := 0; i < iterations; i++ {
+		for j := range args {
+			args[j] = randomValue(r, ty.In(j))
+		}
+		want := args[len(args)-1]
+		got := v.Call(args)[0]
+		if !sameBits(got, want) {
+			in := make([]string, len(args))
+			for j, a := range args {
+				in[j] = fmt.Sprintf("%#v", a.Interface())
+			}
+			tb.Fatalf("call %d with (%s) returned %#v wanted %#v", i, strings.Join(in, ", "), got.Interface(), want.Interface())
+		}
+	}
+}
+
+// randomValue returns a random value of the type t.
+func randomValue(r *rand.Rand, t reflect.Type) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// SetInt truncates to the size of the type
+		v.SetInt(int64(r.Uint64()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(r.Uint64())
+	case reflect.Float32:
+		f := math.Float32frombits(r.Uint32())
+		for f != f {
+			f = math.Float32frombits(r.Uint32())
+		}
+		v.SetFloat(float64(f))
+	case reflect.Float64:
+		f := math.Float64frombits(r.Uint64())
+		for math.IsNaN(f) {
+			f = math.Float64frombits(r.Uint64())
+		}
+		v.SetFloat(f)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).Name == "_" {
+				continue
+			}
+			// unexported fields are set through their address
+			f := v.Field(i)
+			reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem().Set(randomValue(r, f.Type()))
+		}
+	case reflect.Array:
+		for i := 0; i < t.Len(); i++ {
+			v.Index(i).Set(randomValue(r, t.Elem()))
+		}
+	default:
+		panic("puregotest: CheckEcho doesn't support " + t.String())
+	}
+	return v
+}
+
+// sameBits reports whether a and b are equal comparing floats by their bits so that -0 differs from 0.
+func sameBits(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.Float64bits(a.Float()) == math.Float64bits(b.Float())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if a.Type().Field(i).Name != "_" && !sameBits(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !sameBits(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	}
+	return a.Uint() == b.Uint()
+}
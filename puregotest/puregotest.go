@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// Package puregotest provides fake libraries for testing code built on purego.
+//
+// A fake library maps symbol names to Go functions. Once installed, Dlopen (LoadLibrary on Windows)
+// returns a fake handle for the library's name, Dlsym returns fake addresses for its symbols
+// and RegisterFunc binds those addresses directly to the Go functions. This makes it possible
+// to unit test binding logic without the real shared library being installed.
+//
+// The addresses returned for fake symbols are not executable and must only be given to RegisterFunc
+// or one of its wrappers. Passing them to SyscallN or to C code will crash the program.
+package puregotest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+// Library is a fake shared library whose symbols are implemented by Go functions.
+// It implements purego.Resolver.
+type Library struct {
+	lib *fake.Library
+}
+
+// NewLibrary returns an empty fake library that will stand in for the library called name.
+// The name must match the path given to Dlopen exactly.
+func NewLibrary(name string) *Library {
+	return &Library{lib: fake.NewLibrary(name)}
+}
+
+// Func makes fn the implementation of the symbol name and returns l to allow chaining.
+// The type of fn must be identical to the function type the symbol is registered with
+// otherwise RegisterFunc panics.
+func (l *Library) Func(name string, fn interface{}) *Library {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		panic("puregotest: fn must be a function")
+	}
+	if v.IsNil() {
+		panic("puregotest: fn must not be nil")
+	}
+	l.lib.Add(name, v)
+	return l
+}
+
+// Lookup returns the fake address of the symbol name.
+func (l *Library) Lookup(name string) (uintptr, error) {
+	addr, ok := l.lib.Symbol(name)
+	if !ok {
+		return 0, errors.New("puregotest: " + l.lib.Name + ": undefined symbol: " + name)
+	}
+	return addr, nil
+}
+
+// Handle returns the fake handle that Dlopen returns for l once it is installed.
+func (l *Library) Handle() uintptr {
+	return l.lib.Handle()
+}
+
+// Install installs libs for the duration of the test. They are uninstalled
+// automatically when the test and all its subtests complete.
+func Install(tb testing.TB, libs ...*Library) {
+	tb.Helper()
+	for _, l := range libs {
+		l := l
+		fake.Install(l.lib)
+		tb.Cleanup(func() {
+			fake.Uninstall(l.lib)
+		})
+	}
+}
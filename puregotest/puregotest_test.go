@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package puregotest_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/puregotest"
+)
+
+func TestFakeResolver(t *testing.T) {
+	lib := puregotest.NewLibrary("libfake.so").
+		Func("add", func(a, b int) int { return a + b })
+	puregotest.Install(t, lib)
+
+	var add func(a, b int) int
+	purego.RegisterResolverFunc(&add, lib, "add")
+	if got := add(2, 3); got != 5 {
+		t.Errorf("add got %d wanted %d", got, 5)
+	}
+	if _, err := lib.Lookup("sub"); err == nil {
+		t.Errorf("Lookup of missing symbol succeeded")
+	}
+}
+
+func TestFakeTypeMismatch(t *testing.T) {
+	lib := puregotest.NewLibrary("libfake.so").
+		Func("add", func(a, b int) int { return a + b })
+	puregotest.Install(t, lib)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RegisterResolverFunc with the wrong type did not panic")
+		}
+	}()
+	var add func(a, b int32) int32
+	purego.RegisterResolverFunc(&add, lib, "add")
+}
+
+func TestCheckEcho(t *testing.T) {
+	type pair struct {
+		A int8
+		b float32
+	}
+	lib := puregotest.NewLibrary("libecho.so").
+		Func("echo_pair", func(a uint16, b float64, p pair) pair { return p })
+	puregotest.Install(t, lib)
+
+	var echoPair func(a uint16, b float64, p pair) pair
+	purego.RegisterResolverFunc(&echoPair, lib, "echo_pair")
+	puregotest.CheckEcho(t, echoPair, &puregotest.EchoConfig{Iterations: 10, Rand: rand.New(rand.NewSource(1))})
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+// RegisteredFunc describes the Go functions registered for a C function with RegisterFunc,
+// RegisterLibFunc, Library.RegisterFunc or their variants. Functions registered again for the
+// same C function with the same type, such as the functions returned from C, are listed once.
+type RegisteredFunc struct {
+	// Name is the name of the symbol. It is empty if the function was registered by address.
+	Name string
+	// Library is the name of the Library or the path of the library handle the symbol was looked up in.
+	// It is empty if the function was registered by address or the path is unknown.
+	Library string
+	// Addr is the address of the C function which changes with Library.Rebind.
+	Addr uintptr
+	// Signature is the Go type of the function such as "func(string) int".
+	Signature string
+	// Calls is the number of calls of the function.
+	Calls uint64
+}
+
+// registryKey identifies the functions that are listed as one RegisteredFunc.
+type registryKey struct {
+	name    string
+	library *Library
+	handle  uintptr
+	addr    uintptr // only set for functions registered by address
+	typ     reflect.Type
+}
+
+type registryEntry struct {
+	// calls is first so that it is aligned for atomic access on 32-bit platforms.
+	calls uint64
+	key   registryKey
+	// cfg is the configuration of the first function registered for a symbol. It is nil for
+	// functions registered by address which refs counts instead.
+	cfg  *funcConfig
+	refs int
+	// path is the path of the library handle taken while the handle is still open.
+	path string
+}
+
+var registry struct {
+	sync.Mutex
+	m map[registryKey]*registryEntry
+}
+
+// register adds the function of type ty registered with cfg for the C function at cfn to the registry.
+// A function registered by address is removed once every Go function registered for it, which
+// are the only references to their cfg, has been garbage collected, since the C function pointers
+// an application gets may never be registered again.
+func register(cfg *funcConfig, ty reflect.Type, cfn uintptr) *registryEntry {
+	key := registryKey{name: cfg.name, library: cfg.library, handle: cfg.handle, typ: ty}
+	if key.name == "" {
+		key.addr = cfn
+		registry.Lock()
+		defer registry.Unlock()
+		e, ok := registry.m[key]
+		if !ok {
+			e = &registryEntry{key: key}
+			if registry.m == nil {
+				registry.m = map[registryKey]*registryEntry{}
+			}
+			registry.m[key] = e
+		}
+		e.refs++
+		runtime.SetFinalizer(cfg, func(*funcConfig) {
+			registry.Lock()
+			defer registry.Unlock()
+			// the entry may have been removed and added again in the meantime
+			if e.refs--; e.refs == 0 && registry.m[key] == e {
+				delete(registry.m, key)
+			}
+		})
+		return e
+	}
+	registry.Lock()
+	e, ok := registry.m[key]
+	registry.Unlock()
+	if ok {
+		return e
+	}
+	// DlInfo registers functions itself so the path is looked up without holding the lock
+	e = &registryEntry{key: key, cfg: cfg, path: handlePath(key.handle)}
+	registry.Lock()
+	defer registry.Unlock()
+	if registry.m == nil {
+		registry.m = map[registryKey]*registryEntry{}
+	}
+	if old, ok := registry.m[key]; ok {
+		return old
+	}
+	registry.m[key] = e
+	return e
+}
+
+// unregister removes the functions for which drop returns true from the registry.
+func unregister(drop func(registryKey) bool) {
+	registry.Lock()
+	defer registry.Unlock()
+	for key := range registry.m {
+		if drop(key) {
+			delete(registry.m, key)
+		}
+	}
+}
+
+// handlePath returns the path of the library handle or "" for pseudo-handles such as RTLD_DEFAULT.
+func handlePath(handle uintptr) string {
+	if handle == 0 || handle > ^uintptr(0)-16 || fake.IsHandle(handle) {
+		return ""
+	}
+	info, err := DlInfo(handle)
+	if err != nil {
+		return ""
+	}
+	return info.Path
+}
+
+// RegisteredFuncs returns every registered function sorted by library, name and signature
+// so that applications can audit which C functions they call. The functions of a Library are
+// removed once it is closed and those registered for a callback of a Library once it is released.
+// Functions registered by address are removed once they are garbage collected.
+// The functions that purego registers for the C function pointers that C returns or stores in
+// structs aren't listed.
+func RegisteredFuncs() []RegisteredFunc {
+	registry.Lock()
+	entries := make([]*registryEntry, 0, len(registry.m))
+	for _, e := range registry.m {
+		entries = append(entries, e)
+	}
+	registry.Unlock()
+	all := make([]RegisteredFunc, 0, len(entries))
+	for _, e := range entries {
+		f := RegisteredFunc{
+			Name:      e.key.name,
+			Addr:      e.key.addr,
+			Signature: e.key.typ.String(),
+			Calls:     atomic.LoadUint64(&e.calls),
+		}
+		if e.cfg != nil {
+			f.Addr = atomic.LoadUintptr(&e.cfg.sym)
+		}
+		if e.key.library != nil {
+			f.Library = e.key.library.Name()
+		} else {
+			f.Library = e.path
+		}
+		all = append(all, f)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		a, b := all[i], all[j]
+		if a.Library != b.Library {
+			return a.Library < b.Library
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Signature != b.Signature {
+			return a.Signature < b.Signature
+		}
+		return a.Addr < b.Addr
+	})
+	return all
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/puregotest"
+)
+
+func TestRegisteredFuncs(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var abs func(int32) int32
+	purego.RegisterLibFunc(&abs, libc, "abs")
+	// registering the same function again is listed once
+	var abs2 func(int32) int32
+	purego.RegisterLibFunc(&abs2, libc, "abs")
+	abs(-1)
+	abs2(-2)
+	abs(-3)
+
+	cb := purego.NewCallback(func(x int32) int32 { return x })
+	var byAddr func(x int32) int32
+	purego.RegisterFunc(&byAddr, cb)
+	byAddr(1)
+
+	var gotAbs, gotAddr bool
+	for _, f := range purego.RegisteredFuncs() {
+		switch {
+		case f.Name == "abs" && f.Signature == "func(int32) int32":
+			if gotAbs {
+				t.Errorf("abs is listed twice")
+			}
+			gotAbs = true
+			if f.Calls != 3 {
+				t.Errorf("abs has %d calls wanted 3", f.Calls)
+			}
+			if f.Addr == 0 {
+				t.Errorf("abs has no address")
+			}
+		case f.Name == "" && f.Addr == cb:
+			gotAddr = true
+			if f.Calls != 1 || f.Library != "" {
+				t.Errorf("function registered by address is %+v", f)
+			}
+		}
+	}
+	if !gotAbs || !gotAddr {
+		t.Errorf("RegisteredFuncs is missing abs (%t) or the function registered by address (%t)", gotAbs, gotAddr)
+	}
+	runtime.KeepAlive(byAddr)
+}
+
+func TestRegisteredFuncsFailed(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	before := len(purego.RegisteredFuncs())
+	var nilFunc func()
+	if err := purego.RegisterFuncE(&nilFunc, 0); err == nil {
+		t.Error("registering a nil C function didn't fail")
+	}
+	var unsupported func(map[int]int)
+	if err := purego.RegisterLibFuncE(&unsupported, libc, "abs"); err == nil {
+		t.Error("registering a function with a map parameter didn't fail")
+	}
+	if got := len(purego.RegisteredFuncs()); got != before {
+		t.Errorf("functions that failed to register are listed: %d functions wanted %d", got, before)
+	}
+}
+
+func TestRegisteredFuncsCollected(t *testing.T) {
+	cb := purego.NewCallback(func(x int32) int32 { return x })
+	listed := func() bool {
+		for _, f := range purego.RegisteredFuncs() {
+			if f.Addr == cb {
+				return true
+			}
+		}
+		return false
+	}
+	func() {
+		var byAddr func(int32) int32
+		purego.RegisterFunc(&byAddr, cb)
+		byAddr(1)
+		if !listed() {
+			t.Fatal("the function registered by address isn't listed")
+		}
+	}()
+	for i := 0; i < 10 && listed(); i++ {
+		runtime.GC()
+	}
+	if listed() {
+		t.Error("the function registered by address is listed after it was garbage collected")
+	}
+}
+
+func TestRegisteredFuncsRemoved(t *testing.T) {
+	v1 := puregotest.NewLibrary("libregistry-v1.so").
+		Func("version", func() int32 { return 1 })
+	v2 := puregotest.NewLibrary("libregistry-v2.so").
+		Func("version", func() int32 { return 2 })
+	puregotest.Install(t, v1, v2)
+	listed := func(match func(purego.RegisteredFunc) bool) bool {
+		for _, f := range purego.RegisteredFuncs() {
+			if match(f) {
+				return true
+			}
+		}
+		return false
+	}
+
+	l, err := purego.OpenLibrary("libregistry-v1.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var version func() int32
+	l.RegisterFunc(&version, "version")
+	h1, err := openLibrary("libregistry-v1.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var direct func() int32
+	purego.RegisterLibFunc(&direct, h1, "version")
+	cb := l.NewCallback(func(x int32) int32 { return x })
+	var byAddr func(int32) int32
+	purego.RegisterFunc(&byAddr, cb)
+	// a C function pointer returned by C gets a new Go function on every call
+	inner := purego.NewCallback(func(x int32) int32 { return -x })
+	var get func() func(int32) int32
+	purego.RegisterFunc(&get, purego.NewCallback(func() uintptr { return inner }))
+	if got := get()(3); got != -3 {
+		t.Fatalf("returned function got %d wanted -3", got)
+	}
+	if listed(func(f purego.RegisteredFunc) bool { return f.Addr == inner }) {
+		t.Error("the function returned by C is listed")
+	}
+
+	libraryFunc := func(f purego.RegisteredFunc) bool {
+		return f.Name == "version" && f.Library == "libregistry-v1.so"
+	}
+	if !listed(libraryFunc) {
+		t.Fatal("the function of the library isn't listed")
+	}
+	sym1, err := l.Lookup("version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := openLibrary("libregistry-v2.so")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Rebind(h2); err != nil {
+		t.Fatal(err)
+	}
+	if listed(func(f purego.RegisteredFunc) bool { return f.Addr == sym1 }) {
+		t.Error("the function looked up in the handle replaced by Rebind is still listed")
+	}
+
+	l.ReleaseAll()
+	if listed(func(f purego.RegisteredFunc) bool { return f.Addr == cb }) {
+		t.Error("the function registered for a released callback is still listed")
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if listed(libraryFunc) {
+		t.Error("the function of a closed library is still listed")
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// Resolver looks up the address of a symbol by its name. It decouples registering a function
+// from the way its address is found so that symbols can come from sources other than Dlsym
+// such as static tables, JIT engines or fakes in tests.
+type Resolver interface {
+	// Lookup returns the address of the symbol name. If the symbol can't be found
+	// a non-nil error is returned.
+	Lookup(name string) (uintptr, error)
+}
+
+// ResolverFunc is an adapter to allow the use of an ordinary function as a Resolver.
+type ResolverFunc func(name string) (uintptr, error)
+
+// Lookup calls f(name).
+func (f ResolverFunc) Lookup(name string) (uintptr, error) {
+	return f(name)
+}
+
+// LibraryResolver returns a Resolver that looks up symbols in the library handle
+// returned from Dlopen (or LoadLibrary on Windows).
+func LibraryResolver(handle uintptr) Resolver {
+	return ResolverFunc(func(name string) (uintptr, error) {
+		return loadSymbol(handle, name)
+	})
+}
+
+// ProcAddressResolver returns a Resolver that looks up symbols by calling the C function getProcAddress
+// with the arguments args followed by the name as a char*. It fits the functions extension-loading APIs
+// provide to get the address of their functions, for example
+//
+//	// void (*glXGetProcAddress(const GLubyte *procName))(void);
+//	gl := purego.ProcAddressResolver(glXGetProcAddress)
+//	// PFN_vkVoidFunction vkGetInstanceProcAddr(VkInstance instance, const char *pName);
+//	vk := purego.ProcAddressResolver(vkGetInstanceProcAddr, instance)
+//
+// A NULL result is an error, and so are 1, 2, 3 and -1 which some implementations of wglGetProcAddress
+// return for missing functions. It panics if there are too many arguments.
+func ProcAddressResolver(getProcAddress uintptr, args ...uintptr) Resolver {
+	if getProcAddress == 0 {
+		panic("purego: getProcAddress is nil")
+	}
+	if len(args) >= maxArgs {
+		panic("purego: too many arguments for getProcAddress")
+	}
+	args = append([]uintptr(nil), args...)
+	return ResolverFunc(func(name string) (uintptr, error) {
+		cname := strings.CString(name)
+		call := make([]uintptr, len(args)+1)
+		copy(call, args)
+		call[len(args)] = uintptr(unsafe.Pointer(cname))
+		addr, _, _ := SyscallN(getProcAddress, call...)
+		runtime.KeepAlive(cname)
+		switch addr {
+		case 0, 1, 2, 3, ^uintptr(0):
+			return 0, errors.New("purego: undefined symbol: " + name)
+		}
+		return addr, nil
+	})
+}
+
+// RegisterResolverFunc is a wrapper around RegisterFunc that uses the C function returned from r.Lookup(name).
+// It panics if r can't find the name symbol.
+func RegisterResolverFunc(fptr interface{}, r Resolver, name string) {
+	sym, err := r.Lookup(name)
+	if err != nil {
+		panic(err)
+	}
+	registerFunc(fptr, sym, &funcConfig{name: name})
+}
+
+// RegisterResolverFuncE is like RegisterResolverFunc but returns an error instead of panicking.
+func RegisterResolverFuncE(fptr interface{}, r Resolver, name string) (err error) {
+	defer recoverError(&err)
+	RegisterResolverFunc(fptr, r, name)
+	return nil
+}
+
+// RegisterResolverFuncWith is like RegisterResolverFunc but applies opts to the function.
+func RegisterResolverFuncWith(fptr interface{}, r Resolver, name string, opts ...FuncOption) {
+	sym, err := r.Lookup(name)
+	if err != nil {
+		panic(err)
+	}
+	registerFunc(fptr, sym, newFuncConfig(name, opts))
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"bufio"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Module describes a shared object or executable mapped into a process.
+type Module struct {
+	Path string  // Path is the file the module was mapped from as the process sees it.
+	Base uintptr // Base is the difference between the module's link-time and run-time addresses.
+}
+
+// ProcessResolver is a read-only Resolver for the modules loaded into another process.
+// It only reads /proc/<pid>/maps and the module files on disk; memory of the other
+// process is never accessed. The files are opened through /proc/<pid>/map_files, which needs
+// CAP_SYS_ADMIN, or else through /proc/<pid>/root so that they are those the process mapped even
+// if it runs in another mount namespace such as a container.
+//
+// The addresses returned by Lookup are only meaningful inside the other process. They must
+// not be passed to RegisterFunc or called in any way. ProcessResolver is intended for tooling
+// that generates call scripts for another process or validates which library versions it loaded.
+type ProcessResolver struct {
+	pid     int
+	modules []Module
+	files   map[string]string // module path to the name the file is opened with
+
+	mu      sync.Mutex
+	symbols map[string]map[string]uintptr // module path to symbol name to link-time address
+}
+
+// NewProcessResolver returns a ProcessResolver for the modules currently mapped by the process pid.
+// The module list is a snapshot; libraries loaded afterwards by pid require a new ProcessResolver.
+func NewProcessResolver(pid int) (*ProcessResolver, error) {
+	dir := "/proc/" + strconv.Itoa(pid)
+	f, err := os.Open(dir + "/maps")
+	if err != nil {
+		return nil, fmt.Errorf("purego: %w", err)
+	}
+	defer f.Close()
+
+	p := &ProcessResolver{pid: pid, files: map[string]string{}, symbols: map[string]map[string]uintptr{}}
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// each line looks like:
+		// 7f4c2a200000-7f4c2a228000 r--p 00000000 fd:01 1837  /usr/lib/x86_64-linux-gnu/libc.so.6
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || !strings.HasPrefix(fields[5], "/") {
+			continue // anonymous mappings and pseudo-files like [vdso] and [stack]
+		}
+		path := strings.Join(fields[5:], " ")
+		if strings.HasSuffix(path, " (deleted)") || seen[path] {
+			continue
+		}
+		if off, err := strconv.ParseUint(fields[2], 16, 64); err != nil || off != 0 {
+			continue // only the mapping of the start of the file tells where it was loaded
+		}
+		start, end, ok := strings.Cut(fields[0], "-")
+		startAddr, err := strconv.ParseUint(start, 16, 64)
+		if err == nil && ok {
+			_, err = strconv.ParseUint(end, 16, 64)
+		}
+		if err != nil || !ok {
+			return nil, fmt.Errorf("purego: malformed maps line %q", scanner.Text())
+		}
+		// the mapping itself is the most reliable, but path is shown relative to the root of
+		// this process if that can reach it (as for a chroot) and to the root of pid otherwise
+		files := []string{
+			dir + "/map_files/" + strings.TrimLeft(start, "0") + "-" + strings.TrimLeft(end, "0"),
+			dir + "/root" + path,
+			path,
+		}
+		for _, file := range files {
+			base, err := moduleBase(file, uintptr(startAddr))
+			if err != nil {
+				continue // not an ELF file (e.g. a mapped font or locale archive)
+			}
+			seen[path] = true
+			p.modules = append(p.modules, Module{Path: path, Base: base})
+			p.files[path] = file
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("purego: %w", err)
+	}
+	return p, nil
+}
+
+// moduleBase returns the load bias of the ELF file at path given the address its first byte is mapped at.
+func moduleBase(path string, start uintptr) (uintptr, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if f.Type == elf.ET_EXEC {
+		return 0, nil // non-PIE executables are linked at their run-time address
+	}
+	for _, prog := range f.Progs {
+		if prog.Type == elf.PT_LOAD && prog.Off == 0 {
+			return start - uintptr(prog.Vaddr), nil
+		}
+	}
+	return 0, errors.New("no PT_LOAD segment maps the ELF header")
+}
+
+// Pid returns the process id p resolves symbols for.
+func (p *ProcessResolver) Pid() int {
+	return p.pid
+}
+
+// Modules returns the modules that were mapped into the process when p was created.
+func (p *ProcessResolver) Modules() []Module {
+	return append([]Module(nil), p.modules...)
+}
+
+// Lookup returns the address of the symbol name in the other process.
+// The modules are searched in the order they are mapped. For indirect functions
+// (STT_GNU_IFUNC) such as memcpy of glibc it is the address of their resolver since
+// the implementation the other process picked can't be known without running it there.
+func (p *ProcessResolver) Lookup(name string) (uintptr, error) {
+	for _, m := range p.modules {
+		if addr, ok := p.lookup(m, name); ok {
+			return addr, nil
+		}
+	}
+	return 0, fmt.Errorf("purego: symbol %s not found in process %d", name, p.pid)
+}
+
+// LookupIn returns the address of the symbol name in the other process searching only
+// the module whose path is path.
+func (p *ProcessResolver) LookupIn(path, name string) (uintptr, error) {
+	for _, m := range p.modules {
+		if m.Path != path {
+			continue
+		}
+		if addr, ok := p.lookup(m, name); ok {
+			return addr, nil
+		}
+		return 0, fmt.Errorf("purego: symbol %s not found in %s", name, path)
+	}
+	return 0, fmt.Errorf("purego: module %s is not loaded in process %d", path, p.pid)
+}
+
+func (p *ProcessResolver) lookup(m Module, name string) (uintptr, bool) {
+	p.mu.Lock()
+	syms, ok := p.symbols[m.Path]
+	if !ok {
+		syms = readSymbols(p.files[m.Path])
+		p.symbols[m.Path] = syms
+	}
+	p.mu.Unlock()
+	addr, ok := syms[name]
+	if !ok {
+		return 0, false
+	}
+	return m.Base + addr, true
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestProcessResolver(t *testing.T) {
+	// resolving in our own process must agree with the dynamic linker
+	p, err := purego.NewProcessResolver(os.Getpid())
+	if err != nil {
+		t.Fatalf("NewProcessResolver failed: %v", err)
+	}
+	if len(p.Modules()) == 0 {
+		t.Fatalf("no modules found")
+	}
+	want, err := purego.Dlsym(purego.RTLD_DEFAULT, "puts")
+	if err != nil {
+		t.Fatalf("Dlsym failed: %v", err)
+	}
+	got, err := p.Lookup("puts")
+	if err != nil {
+		t.Fatalf("Lookup failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("Lookup got %#x wanted %#x", got, want)
+	}
+	if _, err := p.Lookup("purego_no_such_symbol"); err == nil {
+		t.Errorf("Lookup of missing symbol succeeded")
+	}
+}
+
+func TestProcessResolverContainer(t *testing.T) {
+	if dir := os.Getenv("PUREGO_TEST_CONTAINER_ROOT"); dir != "" {
+		// the helper process makes dir the root of a mount namespace of its own like a container
+		// runtime does and runs sleep in it
+		runtime.LockOSThread()
+		must := func(err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+		must(syscall.Unshare(syscall.CLONE_NEWNS))
+		must(syscall.Mount("", "/", "", syscall.MS_REC|syscall.MS_PRIVATE, ""))
+		must(syscall.Mount(dir, dir, "", syscall.MS_BIND|syscall.MS_REC, ""))
+		must(os.MkdirAll(filepath.Join(dir, "oldroot"), 0o755))
+		must(syscall.PivotRoot(dir, filepath.Join(dir, "oldroot")))
+		must(syscall.Chdir("/"))
+		must(syscall.Unmount("/oldroot", syscall.MNT_DETACH))
+		must(syscall.Exec("/sleep", []string{"sleep", "60"}, []string{"LD_LIBRARY_PATH=/containerlib"}))
+	}
+	sleep, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip("sleep not found")
+	}
+	f, err := elf.Open(sleep)
+	if err != nil {
+		t.Skipf("sleep is not an ELF file: %v", err)
+	}
+	defer f.Close()
+	var interp string
+	for _, prog := range f.Progs {
+		if prog.Type == elf.PT_INTERP {
+			b := make([]byte, prog.Filesz)
+			if _, err := prog.ReadAt(b, 0); err != nil {
+				t.Fatal(err)
+			}
+			interp = strings.TrimRight(string(b), "\x00")
+		}
+	}
+	needed, err := f.ImportedLibraries()
+	if interp == "" || err != nil || len(needed) == 0 {
+		t.Skip("sleep is not dynamically linked")
+	}
+	self, err := purego.NewProcessResolver(os.Getpid())
+	if err != nil {
+		t.Fatalf("NewProcessResolver failed: %v", err)
+	}
+	// the libraries are in a directory that only exists in the container
+	dir := t.TempDir()
+	copyFile := func(src, dst string) {
+		b, err := os.ReadFile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(dst, b, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	copyFile(sleep, filepath.Join(dir, "sleep"))
+	copyFile(interp, filepath.Join(dir, interp))
+	for _, lib := range needed {
+		var path string
+		for _, m := range self.Modules() {
+			if filepath.Base(m.Path) == lib {
+				path = m.Path
+			}
+		}
+		if path == "" {
+			t.Skipf("%s isn't loaded by the test", lib)
+		}
+		copyFile(path, filepath.Join(dir, "containerlib", lib))
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestProcessResolverContainer$")
+	cmd.Env = append(os.Environ(), "PUREGO_TEST_CONTAINER_ROOT="+dir)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+	lib := "/containerlib/" + needed[0]
+	for i := 0; i < 200; i++ {
+		maps, err := os.ReadFile("/proc/" + strconv.Itoa(cmd.Process.Pid) + "/maps")
+		if err != nil || stderr.Len() > 0 {
+			t.Skipf("the process can't enter a mount namespace of its own: %v %s", err, stderr.String())
+		}
+		if strings.Contains(string(maps), " "+lib+"\n") {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	p, err := purego.NewProcessResolver(cmd.Process.Pid)
+	if err != nil {
+		t.Fatalf("NewProcessResolver failed: %v", err)
+	}
+	if _, err = p.LookupIn(lib, "exit"); err != nil {
+		t.Errorf("LookupIn(%q) in the container failed: %v", lib, err)
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestRegisterResolverFunc(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	var strlen func(string) int
+	purego.RegisterResolverFunc(&strlen, purego.LibraryResolver(libc), "strlen")
+	if got := strlen("purego"); got != 6 {
+		t.Errorf("strlen got %d wanted %d", got, 6)
+	}
+}
+
+func TestRegisterResolverFuncNotFound(t *testing.T) {
+	errNotFound := errors.New("symbol not found")
+	r := purego.ResolverFunc(func(name string) (uintptr, error) {
+		return 0, errNotFound
+	})
+	defer func() {
+		if err, ok := recover().(error); !ok || !errors.Is(err, errNotFound) {
+			t.Errorf("expected panic with %v but got %v", errNotFound, err)
+		}
+	}()
+	var fn func()
+	purego.RegisterResolverFunc(&fn, r, "missing")
+}
+
+func TestProcAddressResolver(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the system library doesn't export GetProcAddress on Windows")
+	}
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatalf("couldn't get system library: %s", err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatalf("failed to dlopen: %s", err)
+	}
+	// dlsym(handle, name) works like the functions of extension-loading APIs
+	dlsym, err := purego.LibraryResolver(libc).Lookup("dlsym")
+	if err != nil {
+		t.Skipf("dlsym isn't in the system library: %v", err)
+	}
+	r := purego.ProcAddressResolver(dlsym, libc)
+	var strlen func(string) int
+	purego.RegisterResolverFuncWith(&strlen, r, "strlen")
+	if got := strlen("purego"); got != 6 {
+		t.Errorf("strlen got %d wanted %d", got, 6)
+	}
+	if _, err := r.Lookup("purego_missing_symbol"); err == nil {
+		t.Errorf("Lookup of a missing symbol succeeded")
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrRestricted is matched by errors.Is for errors from loading a library that failed because the
+// operating system doesn't permit it rather than because the library is missing or broken.
+// This happens for example when running under a seccomp filter that denies the system calls
+// the dynamic loader needs. Programs can use it to fall back to a pure Go implementation.
+var ErrRestricted = errors.New("purego: loading libraries is not permitted")
+
+var probe struct {
+	once       sync.Once
+	restricted bool
+}
+
+// CanLoadLibraries reports whether the process is permitted to load shared libraries.
+// It returns false if the system is known to deny the system calls the dynamic loader needs,
+// which is probed once. A true result doesn't guarantee that a particular library can be loaded.
+func CanLoadLibraries() bool {
+	probe.once.Do(func() {
+		probe.restricted = probeRestricted()
+	})
+	return !probe.restricted
+}
+
+// restrictedError is an error from loading a library that matches ErrRestricted.
+type restrictedError struct {
+	err error
+}
+
+func (e restrictedError) Error() string { return e.err.Error() }
+
+func (e restrictedError) Unwrap() error { return e.err }
+
+func (e restrictedError) Is(target error) bool { return target == ErrRestricted }
+
+// loadError returns err, which loading a library failed with, as a restrictedError if
+// CanLoadLibraries found that the process isn't permitted to load any library. The message
+// of the dynamic loader doesn't tell reliably why it failed.
+func loadError(err error) error {
+	if !CanLoadLibraries() {
+		return restrictedError{err}
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "syscall"
+
+// probeRestricted reports whether the process may not map executable memory
+// which the dynamic loader needs to load any library.
+func probeRestricted() bool {
+	mem, err := syscall.Mmap(-1, 0, syscall.Getpagesize(), syscall.PROT_READ|syscall.PROT_EXEC, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return err == syscall.EPERM || err == syscall.ENOSYS || err == syscall.EACCES
+	}
+	_ = syscall.Munmap(mem)
+	return false
+}
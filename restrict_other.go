@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || windows
+
+package purego
+
+// probeRestricted reports whether the process may not load libraries. There is no reliable
+// way to tell in advance on these platforms so it is assumed that they may.
+func probeRestricted() bool {
+	return false
+}
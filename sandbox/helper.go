@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package sandbox
+
+import (
+	"os"
+	"reflect"
+
+	"github.com/jwijenbergh/purego"
+)
+
+// helperEnv is set in the environment of the helper so that the executable serves the host
+// instead of running its main function.
+const helperEnv = "PUREGO_SANDBOX_HELPER"
+
+func init() {
+	if os.Getenv(helperEnv) != "1" {
+		return
+	}
+	// the pipes are passed as ExtraFiles so what the libraries print doesn't mix with the replies
+	serve(newConn(os.NewFile(3, "requests"), os.NewFile(4, "replies")))
+	os.Exit(0)
+}
+
+// helperFunc is a function looked up by the host.
+type helperFunc struct {
+	fn            reflect.Value
+	args, results []kind
+}
+
+// serve answers the requests of the host until it closes the pipe or a request can't be read.
+func serve(c *conn) {
+	var libs []*purego.Library
+	var funcs []helperFunc
+	for {
+		op, err := c.readByte()
+		if err != nil {
+			return
+		}
+		switch op {
+		case opOpen:
+			name, err := c.readBytes()
+			if err != nil {
+				return
+			}
+			lib, err := purego.OpenLibrary(string(name))
+			if err != nil {
+				c.writeError(err)
+				break
+			}
+			libs = append(libs, lib)
+			c.writeByte(statusOK)
+			c.writeUint64(uint64(len(libs) - 1))
+		case opLookup:
+			f, lib, name, err := readLookup(c)
+			if err != nil {
+				return
+			}
+			if lib >= uint64(len(libs)) {
+				return
+			}
+			if err := libs[lib].RegisterFuncE(f.fn.Interface(), name); err != nil {
+				c.writeError(err)
+				break
+			}
+			f.fn = f.fn.Elem()
+			funcs = append(funcs, f)
+			c.writeByte(statusOK)
+			c.writeUint64(uint64(len(funcs) - 1))
+		case opCall:
+			id, err := c.readUint64()
+			if err != nil || id >= uint64(len(funcs)) {
+				return
+			}
+			f := funcs[id]
+			args := make([]reflect.Value, len(f.args))
+			for i, k := range f.args {
+				if args[i], err = c.readValue(k, kindTypes[k]); err != nil {
+					return
+				}
+			}
+			results := f.fn.Call(args)
+			c.writeByte(statusOK)
+			for i, k := range f.results {
+				c.writeValue(k, results[i])
+			}
+			// the function may have written to the byte slices
+			for i, k := range f.args {
+				if k == kindBytes {
+					c.writeValue(k, args[i])
+				}
+			}
+		default:
+			return
+		}
+		if err := c.w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// readLookup reads the operands of opLookup and returns a pointer to a new function of the signature.
+func readLookup(c *conn) (f helperFunc, lib uint64, name string, err error) {
+	if lib, err = c.readUint64(); err != nil {
+		return
+	}
+	b, err := c.readBytes()
+	if err != nil {
+		return
+	}
+	name = string(b)
+	readKinds := func() ([]kind, []reflect.Type, error) {
+		n, err := c.readByte()
+		if err != nil {
+			return nil, nil, err
+		}
+		kinds := make([]kind, n)
+		types := make([]reflect.Type, n)
+		for i := range kinds {
+			k, err := c.readByte()
+			if err != nil {
+				return nil, nil, err
+			}
+			kinds[i], types[i] = kind(k), kindTypes[kind(k)]
+			if types[i] == nil {
+				return nil, nil, errUnknownKind
+			}
+		}
+		return kinds, types, nil
+	}
+	var in, out []reflect.Type
+	if f.args, in, err = readKinds(); err != nil {
+		return
+	}
+	if f.results, out, err = readKinds(); err != nil {
+		return
+	}
+	f.fn = reflect.New(reflect.FuncOf(in, out, false))
+	return
+}
+
+func (c *conn) writeError(err error) {
+	c.writeByte(statusError)
+	c.writeBytes([]byte(err.Error()))
+}
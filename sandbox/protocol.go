@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package sandbox
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+)
+
+// The host sends a request made of an op and its operands and the helper answers with a status
+// byte followed by the results or an error message. Integers are little endian and strings and
+// byte slices are prefixed by their length.
+const (
+	opOpen byte = iota + 1
+	opLookup
+	opCall
+)
+
+const (
+	statusOK byte = iota
+	statusError
+)
+
+// kind is the type of an argument or a result on the wire.
+type kind byte
+
+const (
+	kindInt8 kind = iota + 1
+	kindInt16
+	kindInt32
+	kindInt64
+	kindInt
+	kindUint8
+	kindUint16
+	kindUint32
+	kindUint64
+	kindUint
+	kindUintptr
+	kindFloat32
+	kindFloat64
+	kindBool
+	kindString
+	kindBytes
+)
+
+var errUnknownKind = errors.New("purego/sandbox: unknown kind")
+
+// kindTypes are the types the helper declares the functions with.
+var kindTypes = map[kind]reflect.Type{
+	kindInt8:    reflect.TypeOf(int8(0)),
+	kindInt16:   reflect.TypeOf(int16(0)),
+	kindInt32:   reflect.TypeOf(int32(0)),
+	kindInt64:   reflect.TypeOf(int64(0)),
+	kindInt:     reflect.TypeOf(int(0)),
+	kindUint8:   reflect.TypeOf(uint8(0)),
+	kindUint16:  reflect.TypeOf(uint16(0)),
+	kindUint32:  reflect.TypeOf(uint32(0)),
+	kindUint64:  reflect.TypeOf(uint64(0)),
+	kindUint:    reflect.TypeOf(uint(0)),
+	kindUintptr: reflect.TypeOf(uintptr(0)),
+	kindFloat32: reflect.TypeOf(float32(0)),
+	kindFloat64: reflect.TypeOf(float64(0)),
+	kindBool:    reflect.TypeOf(false),
+	kindString:  reflect.TypeOf(""),
+	kindBytes:   reflect.TypeOf([]byte(nil)),
+}
+
+// kindOf returns the kind of the type t or 0 if it can't be passed to the helper.
+// Pointers are left out since they would point into the memory of the host.
+func kindOf(t reflect.Type) kind {
+	switch t.Kind() {
+	case reflect.Int8:
+		return kindInt8
+	case reflect.Int16:
+		return kindInt16
+	case reflect.Int32:
+		return kindInt32
+	case reflect.Int64:
+		return kindInt64
+	case reflect.Int:
+		return kindInt
+	case reflect.Uint8:
+		return kindUint8
+	case reflect.Uint16:
+		return kindUint16
+	case reflect.Uint32:
+		return kindUint32
+	case reflect.Uint64:
+		return kindUint64
+	case reflect.Uint:
+		return kindUint
+	case reflect.Uintptr:
+		return kindUintptr
+	case reflect.Float32:
+		return kindFloat32
+	case reflect.Float64:
+		return kindFloat64
+	case reflect.Bool:
+		return kindBool
+	case reflect.String:
+		return kindString
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return kindBytes
+		}
+	}
+	return 0
+}
+
+// conn is one end of the pipes between the host and the helper.
+type conn struct {
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{r: bufio.NewReader(r), w: bufio.NewWriter(w)}
+}
+
+func (c *conn) writeByte(b byte) {
+	_ = c.w.WriteByte(b)
+}
+
+func (c *conn) writeUint64(v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	_, _ = c.w.Write(b[:])
+}
+
+func (c *conn) writeBytes(b []byte) {
+	c.writeUint64(uint64(len(b)))
+	_, _ = c.w.Write(b)
+}
+
+// writeValue writes v of kind k. Write errors are reported by the flush that ends the message.
+func (c *conn) writeValue(k kind, v reflect.Value) {
+	switch k {
+	case kindInt8, kindInt16, kindInt32, kindInt64, kindInt:
+		c.writeUint64(uint64(v.Int()))
+	case kindUint8, kindUint16, kindUint32, kindUint64, kindUint, kindUintptr:
+		c.writeUint64(v.Uint())
+	case kindFloat32, kindFloat64:
+		c.writeUint64(math.Float64bits(v.Float()))
+	case kindBool:
+		var b byte
+		if v.Bool() {
+			b = 1
+		}
+		c.writeByte(b)
+	case kindString:
+		c.writeBytes([]byte(v.String()))
+	case kindBytes:
+		c.writeBytes(v.Bytes())
+	}
+}
+
+func (c *conn) readByte() (byte, error) {
+	return c.r.ReadByte()
+}
+
+func (c *conn) readUint64() (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(c.r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b[:]), nil
+}
+
+// maxBytes bounds the length of a string or a byte slice so a corrupted message doesn't allocate everything.
+const maxBytes = 1 << 30
+
+func (c *conn) readBytes() ([]byte, error) {
+	n, err := c.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	if n > maxBytes {
+		return nil, errors.New("purego/sandbox: message too long")
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(c.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// readValue reads a value of kind k into a new value of type t.
+func (c *conn) readValue(k kind, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	switch k {
+	case kindBool:
+		b, err := c.readByte()
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b != 0)
+	case kindString:
+		b, err := c.readBytes()
+		if err != nil {
+			return v, err
+		}
+		v.SetString(string(b))
+	case kindBytes:
+		b, err := c.readBytes()
+		if err != nil {
+			return v, err
+		}
+		v.SetBytes(b)
+	default:
+		u, err := c.readUint64()
+		if err != nil {
+			return v, err
+		}
+		switch k {
+		case kindInt8, kindInt16, kindInt32, kindInt64, kindInt:
+			v.SetInt(int64(u))
+		case kindFloat32, kindFloat64:
+			v.SetFloat(math.Float64frombits(u))
+		default:
+			v.SetUint(u)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+// Package sandbox loads libraries in a helper process and calls their functions there so that
+// a crash or a memory corruption in a library that isn't trusted, such as a plugin, doesn't take
+// down the Go process. Start runs the executable again as the helper:
+//
+//	p, err := sandbox.Start()
+//	if err != nil {
+//		return err
+//	}
+//	defer p.Close()
+//	lib, err := p.Open("libplugin.so")
+//	if err != nil {
+//		return err
+//	}
+//	var process func(input []byte, n int32) (int32, error)
+//	if err := lib.RegisterFunc(&process, "plugin_process"); err != nil {
+//		return err
+//	}
+//	n, err := process(buf, int32(len(buf))) // err is ErrExited if the plugin crashed
+//
+// The helper runs the initialization of the packages that this package doesn't depend on if
+// they are initialized first but never main. The functions can only take and return integers,
+// floats, bools and strings and take byte slices which are copied to the helper and back after
+// the call since the helper doesn't share the memory of the Go process. Pointers and callbacks
+// aren't supported.
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"sync"
+)
+
+// ErrExited is returned by the calls after the helper process exited, for example because it crashed.
+var ErrExited = errors.New("purego/sandbox: the helper process exited")
+
+// Process is a helper process that loads libraries for the Go process.
+type Process struct {
+	cmd      *exec.Cmd
+	requests *os.File
+
+	mu  sync.Mutex
+	c   *conn
+	err error // set once the helper is gone
+}
+
+// Start runs the executable of the Go process again as a helper process.
+// Its standard output and error are those of the Go process.
+func Start() (*Process, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	reqR, reqW, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	repR, repW, err := os.Pipe()
+	if err != nil {
+		reqR.Close()
+		reqW.Close()
+		return nil, err
+	}
+	cmd := exec.Command(exe)
+	cmd.Env = append(os.Environ(), helperEnv+"=1")
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	cmd.ExtraFiles = []*os.File{reqR, repW}
+	err = cmd.Start()
+	// the helper has its own copies of its ends of the pipes
+	reqR.Close()
+	repW.Close()
+	if err != nil {
+		reqW.Close()
+		repR.Close()
+		return nil, err
+	}
+	return &Process{cmd: cmd, requests: reqW, c: newConn(repR, reqW)}, nil
+}
+
+// Close stops the helper process after the calls that are running returned. The libraries
+// and functions of the helper can't be used anymore. Calling it again does nothing.
+func (p *Process) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return nil
+	}
+	p.requests.Close()
+	p.err = errors.New("purego/sandbox: the helper process is closed")
+	err := p.cmd.Wait()
+	if _, ok := err.(*exec.ExitError); ok {
+		return fmt.Errorf("%w: %s", ErrExited, p.cmd.ProcessState)
+	}
+	return err
+}
+
+// exited records that the helper is gone after reading or writing the pipes failed and returns ErrExited.
+func (p *Process) exited() error {
+	if p.err != nil {
+		return p.err
+	}
+	p.requests.Close()
+	// the helper may still run if only the pipes failed
+	_ = p.cmd.Process.Kill()
+	_ = p.cmd.Wait()
+	p.err = fmt.Errorf("%w: %s", ErrExited, p.cmd.ProcessState)
+	return p.err
+}
+
+// roundTrip sends the request written by write and reads the status of the reply.
+// The caller holds p.mu and reads the rest of the reply if it succeeded.
+func (p *Process) roundTrip(write func(c *conn)) error {
+	if p.err != nil {
+		return p.err
+	}
+	write(p.c)
+	if err := p.c.w.Flush(); err != nil {
+		return p.exited()
+	}
+	status, err := p.c.readByte()
+	if err != nil {
+		return p.exited()
+	}
+	if status != statusOK {
+		msg, err := p.c.readBytes()
+		if err != nil {
+			return p.exited()
+		}
+		return errors.New(string(msg))
+	}
+	return nil
+}
+
+// readUint64 reads a number of the reply or returns ErrExited.
+func (p *Process) readUint64() (uint64, error) {
+	v, err := p.c.readUint64()
+	if err != nil {
+		return 0, p.exited()
+	}
+	return v, nil
+}
+
+// Library is a library loaded in the helper process.
+type Library struct {
+	p      *Process
+	handle uint64
+}
+
+// Open loads the library name in the helper process with purego.OpenLibrary.
+func (p *Process) Open(name string) (*Library, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := p.roundTrip(func(c *conn) {
+		c.writeByte(opOpen)
+		c.writeBytes([]byte(name))
+	})
+	if err != nil {
+		return nil, err
+	}
+	handle, err := p.readUint64()
+	if err != nil {
+		return nil, err
+	}
+	return &Library{p: p, handle: handle}, nil
+}
+
+// RegisterFunc looks up the function name in the library and stores a Go function in fptr,
+// a pointer to a variable of a function type, that calls it in the helper process. If the last
+// result of the function type is an error, the calls return ErrExited in it when the helper
+// process exited. Otherwise they panic with the error.
+func (l *Library) RegisterFunc(fptr interface{}, name string) error {
+	v := reflect.ValueOf(fptr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Func {
+		return errors.New("purego/sandbox: fptr must be a function pointer")
+	}
+	ty := v.Elem().Type()
+	args := make([]kind, ty.NumIn())
+	for i := range args {
+		if args[i] = kindOf(ty.In(i)); args[i] == 0 {
+			return fmt.Errorf("purego/sandbox: unsupported argument type %s", ty.In(i))
+		}
+	}
+	numOut := ty.NumOut()
+	hasErr := numOut > 0 && ty.Out(numOut-1) == reflect.TypeOf((*error)(nil)).Elem()
+	if hasErr {
+		numOut--
+	}
+	if numOut > 1 {
+		return errors.New("purego/sandbox: too many return values")
+	}
+	var results []kind
+	if numOut == 1 {
+		k := kindOf(ty.Out(0))
+		if k == 0 || k == kindBytes {
+			return fmt.Errorf("purego/sandbox: unsupported return type %s", ty.Out(0))
+		}
+		results = append(results, k)
+	}
+
+	p := l.p
+	p.mu.Lock()
+	err := p.roundTrip(func(c *conn) {
+		c.writeByte(opLookup)
+		c.writeUint64(l.handle)
+		c.writeBytes([]byte(name))
+		for _, kinds := range [][]kind{args, results} {
+			c.writeByte(byte(len(kinds)))
+			for _, k := range kinds {
+				c.writeByte(byte(k))
+			}
+		}
+	})
+	var id uint64
+	if err == nil {
+		id, err = p.readUint64()
+	}
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	fail := func(err error) []reflect.Value {
+		if !hasErr {
+			panic(err)
+		}
+		out := make([]reflect.Value, ty.NumOut())
+		for i := range out {
+			out[i] = reflect.Zero(ty.Out(i))
+		}
+		out[len(out)-1] = reflect.ValueOf(&err).Elem()
+		return out
+	}
+	v.Elem().Set(reflect.MakeFunc(ty, func(in []reflect.Value) []reflect.Value {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		err := p.roundTrip(func(c *conn) {
+			c.writeByte(opCall)
+			c.writeUint64(id)
+			for i, k := range args {
+				c.writeValue(k, in[i])
+			}
+		})
+		if err != nil {
+			return fail(err)
+		}
+		out := make([]reflect.Value, 0, ty.NumOut())
+		for i, k := range results {
+			r, err := p.c.readValue(k, ty.Out(i))
+			if err != nil {
+				return fail(p.exited())
+			}
+			out = append(out, r)
+		}
+		for i, k := range args {
+			if k != kindBytes {
+				continue
+			}
+			b, err := p.c.readBytes()
+			if err != nil {
+				return fail(p.exited())
+			}
+			copy(in[i].Bytes(), b)
+		}
+		if hasErr {
+			out = append(out, reflect.Zero(ty.Out(ty.NumOut()-1)))
+		}
+		return out
+	}))
+	return nil
+}
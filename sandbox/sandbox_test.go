@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package sandbox_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego/sandbox"
+)
+
+func libcName() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "/usr/lib/libSystem.B.dylib"
+	case "freebsd":
+		return "libc.so.7"
+	}
+	return "libc.so.6"
+}
+
+func TestSandbox(t *testing.T) {
+	p, err := sandbox.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	lib, err := p.Open(libcName())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Open("libdoesnotexist.so"); err == nil {
+		t.Errorf("Open of a missing library didn't fail")
+	}
+
+	var strlen func(s string) uintptr
+	if err := lib.RegisterFunc(&strlen, "strlen"); err != nil {
+		t.Fatal(err)
+	}
+	if got := strlen("hello"); got != 5 {
+		t.Errorf("strlen got %d wanted 5", got)
+	}
+	var memset func(b []byte, c int32, n uintptr) (uintptr, error)
+	if err := lib.RegisterFunc(&memset, "memset"); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 4)
+	if _, err := memset(buf, 'x', 3); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "xxx\x00" {
+		t.Errorf("memset wrote %q wanted %q", buf, "xxx\x00")
+	}
+	if err := lib.RegisterFunc(new(func()), "no_such_function"); err == nil {
+		t.Errorf("RegisterFunc of a missing function didn't fail")
+	}
+	if err := lib.RegisterFunc(new(func(*byte)), "strlen"); err == nil {
+		t.Errorf("RegisterFunc with a pointer argument didn't fail")
+	}
+
+	var abort func() error
+	if err := lib.RegisterFunc(&abort, "abort"); err != nil {
+		t.Fatal(err)
+	}
+	if err := abort(); !errors.Is(err, sandbox.ErrExited) {
+		t.Fatalf("abort returned %v wanted ErrExited", err)
+	}
+	if _, err := memset(buf, 'y', 1); !errors.Is(err, sandbox.ErrExited) {
+		t.Errorf("memset after the crash returned %v wanted ErrExited", err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("strlen after the crash didn't panic")
+			}
+		}()
+		strlen("")
+	}()
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+// syscall9XSEHABI0 is only used on Windows.
+var syscall9XSEHABI0 uintptr
+
+func translateExceptions() bool {
+	return false
+}
+
+func raiseException(*syscall9Args) {}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Exception is the panic value of a C function that raised a structured exception such as an
+// access violation while exception translation is enabled with EnableExceptionTranslation.
+type Exception struct {
+	// Code is the exception code, for example 0xC0000005 for an access violation.
+	Code uint32
+	// PC is the address of the instruction that raised the exception.
+	PC uintptr
+	// Addr is the address that was accessed by an access violation or an in-page error.
+	// It is 0 for other exceptions.
+	Addr uintptr
+	// Write reports whether the access that faulted was a write.
+	Write bool
+}
+
+func (e *Exception) Error() string {
+	s := "purego: exception 0x" + strconv.FormatUint(uint64(e.Code), 16)
+	if name, ok := exceptionNames[e.Code]; ok {
+		s += " (" + name + ")"
+	}
+	s += " at pc=0x" + strconv.FormatUint(uint64(e.PC), 16)
+	if e.Addr != 0 {
+		if e.Write {
+			s += " writing"
+		} else {
+			s += " reading"
+		}
+		s += " addr=0x" + strconv.FormatUint(uint64(e.Addr), 16)
+	}
+	return s
+}
+
+var exceptionNames = map[uint32]string{
+	0x80000002: "datatype misalignment",
+	0xC0000005: "access violation",
+	0xC0000006: "in-page error",
+	0xC000001D: "illegal instruction",
+	0xC000008C: "array bounds exceeded",
+	0xC000008E: "float divide by zero",
+	0xC0000094: "integer divide by zero",
+	0xC0000095: "integer overflow",
+	0xC0000096: "privileged instruction",
+}
+
+var (
+	// syscall9XSEHABI0 calls a C function like syscall9X but resumes at the end of the call
+	// if it raises an exception. It and sehHandlerABI0 are only set on amd64.
+	syscall9XSEHABI0 uintptr
+	// sehHandlerABI0 is the vectored exception handler that redirects an exception to the end of
+	// the innermost call of syscall9XSEH on the thread.
+	sehHandlerABI0 uintptr
+	// sehTLSOffset is the offset in the thread environment block of the TLS slot that points to
+	// the innermost call of syscall9XSEH on the thread. It is read by the assembly.
+	sehTLSOffset uintptr
+
+	sehEnabled int32
+	sehOnce    sync.Once
+	sehErr     error
+)
+
+// EnableExceptionTranslation turns the translation of structured exceptions on or off.
+// While it is on, an exception raised by a C function called through RegisterFunc, for example an
+// access violation from dereferencing a bad pointer, makes the call panic with an *Exception
+// instead of terminating the process. Software exceptions such as C++ exceptions and stack
+// overflows are never translated.
+//
+// The exception is caught by a vectored exception handler which runs before the handlers of the
+// library, so a library that catches access violations itself, for example a managed runtime, must
+// not be called while it is on. The state of the library after an exception is unknown: locks it held
+// are not released and memory it allocated is leaked, so it is meant to report the bug instead of
+// crashing rather than to continue using the library.
+//
+// It is only supported on windows/amd64 and returns an error elsewhere.
+func EnableExceptionTranslation(enabled bool) error {
+	if !enabled {
+		atomic.StoreInt32(&sehEnabled, 0)
+		return nil
+	}
+	sehOnce.Do(func() {
+		sehErr = installExceptionHandler()
+	})
+	if sehErr != nil {
+		return sehErr
+	}
+	atomic.StoreInt32(&sehEnabled, 1)
+	return nil
+}
+
+func installExceptionHandler() error {
+	if syscall9XSEHABI0 == 0 {
+		return errors.New("purego: exception translation is not supported on windows/" + runtime.GOARCH)
+	}
+	idx, _, err := kernel32.NewProc("TlsAlloc").Call()
+	if uint32(idx) == 0xFFFFFFFF {
+		return errors.New("purego: TlsAlloc failed: " + err.Error())
+	}
+	// only the first 64 slots are stored in the thread environment block itself
+	const tlsSlots, tlsSlotCount = 0x1480, 64
+	if idx >= tlsSlotCount {
+		kernel32.NewProc("TlsFree").Call(idx)
+		return errors.New("purego: no TLS slot left for exception translation")
+	}
+	sehTLSOffset = tlsSlots + idx*8
+	// the handler is called first so that it sees the exception before the Go runtime
+	if h, _, err := kernel32.NewProc("AddVectoredExceptionHandler").Call(1, sehHandlerABI0); h == 0 {
+		return errors.New("purego: AddVectoredExceptionHandler failed: " + err.Error())
+	}
+	return nil
+}
+
+// translateExceptions reports whether calls must be made with syscall9XSEH.
+func translateExceptions() bool {
+	return atomic.LoadInt32(&sehEnabled) != 0
+}
+
+// raiseException panics with the exception recorded by the handler in args, if any.
+func raiseException(args *syscall9Args) {
+	if args.excCode == 0 {
+		return
+	}
+	panic(&Exception{
+		Code:  uint32(args.excCode),
+		PC:    args.excPC,
+		Addr:  args.excAddr,
+		Write: args.excWrite == 1,
+	})
+}
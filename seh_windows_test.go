@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestExceptionTranslation(t *testing.T) {
+	if err := purego.EnableExceptionTranslation(true); err != nil {
+		if runtime.GOARCH == "amd64" {
+			t.Fatal(err)
+		}
+		t.Skip(err)
+	}
+	defer purego.EnableExceptionTranslation(false)
+
+	libc, err := openLibrary("ucrtbase.dll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var strlen func(s string) uintptr
+	purego.RegisterLibFunc(&strlen, libc, "strlen")
+	var strlenAt func(addr uintptr) uintptr
+	purego.RegisterLibFunc(&strlenAt, libc, "strlen")
+
+	func() {
+		defer func() {
+			r := recover()
+			var e *purego.Exception
+			if err, ok := r.(error); !ok || !errors.As(err, &e) {
+				t.Fatalf("recovered %v, want an *Exception", r)
+			}
+			if e.Code != 0xC0000005 || e.Addr != 8 || e.Write {
+				t.Errorf("got %+v, want an access violation reading address 8", *e)
+			}
+		}()
+		strlenAt(8)
+	}()
+
+	// the library keeps working after the exception
+	if n := strlen("purego"); n != 6 {
+		t.Errorf("strlen returned %d, want 6", n)
+	}
+}
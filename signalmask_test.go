@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"runtime"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+type sigset [128 / unsafe.Sizeof(uintptr(0))]uintptr
+
+func (s *sigset) has(sig syscall.Signal) bool {
+	const bits = 8 * unsafe.Sizeof(uintptr(0))
+	n := uintptr(sig) - 1
+	return s[n/bits]&(1<<(n%bits)) != 0
+}
+
+func TestWithBlockedSignals(t *testing.T) {
+	sym, err := purego.Dlsym(purego.RTLD_DEFAULT, "pthread_sigmask")
+	if err != nil {
+		t.Skip("pthread_sigmask not found")
+	}
+	// pthread_sigmask with a NULL set only stores the current mask in oset
+	var current, currentBlocked func(how int32, set, oset *sigset) int32
+	purego.RegisterFunc(&current, sym)
+	purego.RegisterFuncWith(&currentBlocked, sym, purego.WithBlockedSignals(syscall.SIGUSR1, syscall.SIGURG))
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var before, during, after sigset
+	if current(0, nil, &before) != 0 || currentBlocked(0, nil, &during) != 0 || current(0, nil, &after) != 0 {
+		t.Fatal("pthread_sigmask failed")
+	}
+	if before.has(syscall.SIGUSR1) {
+		t.Skip("SIGUSR1 is already blocked")
+	}
+	if !during.has(syscall.SIGUSR1) || !during.has(syscall.SIGURG) {
+		t.Errorf("SIGUSR1 and SIGURG weren't blocked during the call")
+	}
+	if after != before {
+		t.Errorf("the signal mask after the call differs from the one before")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithBlockedSignals of signal 0 didn't panic")
+		}
+	}()
+	purego.WithBlockedSignals(0)
+}
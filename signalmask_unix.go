@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+import (
+	"runtime"
+	"strconv"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// signalSet is a sigset_t. It is as large as the sigset_t of glibc and musl, which is larger than
+// those of the kernel, macOS and FreeBSD, and holds the bit of signal n at bit n-1 of its words.
+type signalSet [128 / unsafe.Sizeof(uintptr(0))]uintptr
+
+// wordBits is the number of signals in a word of a signalSet.
+const wordBits = 8 * unsafe.Sizeof(uintptr(0))
+
+// WithBlockedSignals blocks the signals sigs on the thread that calls the function for the duration
+// of each call and restores the signal mask of the thread afterwards. It helps with C libraries that
+// install signal handlers of their own or fail with EINTR when they are interrupted, for example
+// by the SIGURG the Go runtime sends to preempt goroutines:
+//
+//	purego.RegisterLibFuncWith(&decode, lib, "decode", purego.WithBlockedSignals(syscall.SIGURG, syscall.SIGPROF))
+//
+// A blocked signal stays pending and is delivered once the call returns. Callbacks the function
+// calls run with the signals blocked too. Signals that the C function causes itself such as SIGSEGV
+// can't be blocked. WithBlockedSignals panics if a signal is not valid.
+func WithBlockedSignals(sigs ...syscall.Signal) FuncOption {
+	set := new(signalSet)
+	for _, sig := range sigs {
+		if sig <= 0 || uintptr(sig) > uintptr(len(set))*wordBits {
+			panic("purego: invalid signal " + strconv.Itoa(int(sig)))
+		}
+		n := uintptr(sig) - 1
+		set[n/wordBits] |= 1 << (n % wordBits)
+	}
+	return func(cfg *funcConfig) {
+		cfg.blockedSignals = set
+	}
+}
+
+// The values of how for pthread_sigmask which differ on mips.
+var sigBlock, sigSetmask = func() (int32, int32) {
+	if runtime.GOOS == "linux" && runtime.GOARCH != "mips64" && runtime.GOARCH != "mips64le" {
+		return 0, 2
+	}
+	return 1, 3
+}()
+
+var fnPthreadSigmask struct {
+	once sync.Once
+	fn   func(how int32, set, oset *signalSet) int32
+}
+
+// pthreadSigmask calls pthread_sigmask of the C library which is looked up on first use.
+func pthreadSigmask(how int32, set, oset *signalSet) {
+	fnPthreadSigmask.once.Do(func() {
+		sym := fnDlsym(RTLD_DEFAULT, "pthread_sigmask")
+		if sym == 0 {
+			panic("purego: pthread_sigmask not found")
+		}
+		RegisterFunc(&fnPthreadSigmask.fn, sym)
+	})
+	if r := fnPthreadSigmask.fn(how, set, oset); r != 0 {
+		panic("purego: pthread_sigmask failed: " + syscall.Errno(r).Error())
+	}
+}
+
+// block locks the goroutine to its thread and adds s to the signal mask of the thread.
+// It returns the previous mask which unblock restores.
+func (s *signalSet) block() *signalSet {
+	if s == nil {
+		return nil
+	}
+	runtime.LockOSThread()
+	old := new(signalSet)
+	pthreadSigmask(sigBlock, s, old)
+	return old
+}
+
+// unblock restores the signal mask s returned by block and unlocks the goroutine from its thread.
+func (s *signalSet) unblock() {
+	if s == nil {
+		return
+	}
+	pthreadSigmask(sigSetmask, s, nil)
+	runtime.UnlockOSThread()
+}
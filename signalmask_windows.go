@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+// signalSet is only used on Unix where WithBlockedSignals sets it.
+type signalSet struct{}
+
+func (s *signalSet) block() *signalSet {
+	return nil
+}
+
+func (s *signalSet) unblock() {}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"runtime"
+	"unsafe"
+)
+
+// addStruct adds the struct v to the arguments of a C call following the System V AMD64 ABI or
+// the Windows x64 calling convention. numInts and numFloats are the number of registers used
+// so far. Memory that must stay alive during the call is appended to keepAlive. Func fields are
+// converted with cbs.
+func addStruct(v reflect.Value, numInts, numFloats *int, addStack, addInt, addFloat func(uintptr), cbs *fieldCallbacks, keepAlive []interface{}) []interface{} {
+	l, err := layoutOf(v.Type())
+	if err != nil {
+		panic(err)
+	}
+	if l.size == 0 {
+		return keepAlive
+	}
+	mem := l.toC(v, cbs)
+	if runtime.GOOS == "windows" {
+		switch l.size {
+		case 1, 2, 4, 8:
+			addInt(uintptr(mem[0]))
+			return keepAlive
+		}
+		// other structs are copied to memory and passed by reference
+		addInt(uintptr(unsafe.Pointer(&mem[0])))
+		return append(keepAlive, mem)
+	}
+	if classes, ok := l.classify(v.Type()); ok {
+		var ints, floats int
+		for _, c := range classes {
+			if c == classSSE {
+				floats++
+			} else {
+				ints++
+			}
+		}
+		if *numInts+ints <= numOfIntegerRegisters() && *numFloats+floats <= numOfFloats {
+			for i, c := range classes {
+				if c == classSSE {
+					addFloat(uintptr(mem[i]))
+				} else {
+					addInt(uintptr(mem[i]))
+				}
+			}
+			return keepAlive
+		}
+	}
+	// the struct is passed in memory which means it is copied onto the stack
+	for _, w := range mem[:(l.size+7)/8] {
+		addStack(uintptr(w))
+	}
+	return keepAlive
+}
+
+// prepareStructReturn returns the memory a struct of type t is returned at if it is too
+// large for registers. Its address is passed as a hidden first argument with addInt.
+// If the struct is returned in registers nil is returned.
+func prepareStructReturn(t reflect.Type, addInt func(uintptr)) ([]uint64, uintptr) {
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	if l.size == 0 {
+		return nil, 0
+	}
+	if runtime.GOOS == "windows" {
+		switch l.size {
+		case 1, 2, 4, 8:
+			return nil, 0
+		}
+	} else if _, ok := l.classify(t); ok {
+		return nil, 0
+	}
+	mem := make([]uint64, (l.size+7)/8+1)
+	addInt(uintptr(unsafe.Pointer(&mem[0])))
+	return mem, 0
+}
+
+// getStruct returns the struct of type t returned by a C call. mem is the memory returned
+// by prepareStructReturn.
+func getStruct(t reflect.Type, mem []uint64, syscall *syscall9Args) reflect.Value {
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	if mem == nil {
+		mem = make([]uint64, 3)
+		if runtime.GOOS == "windows" {
+			mem[0] = uint64(syscall.r1)
+		} else {
+			classes, _ := l.classify(t)
+			ints := []uintptr{syscall.r1, syscall.r3}
+			floats := []uintptr{syscall.r2, syscall.rf2}
+			for i, c := range classes {
+				if c == classSSE {
+					mem[i], floats = uint64(floats[0]), floats[1:]
+				} else {
+					mem[i], ints = uint64(ints[0]), ints[1:]
+				}
+			}
+		}
+	}
+	return l.fromC(t, unsafe.Pointer(&mem[0]))
+}
+
+// callbackStruct reads the struct argument of type t of a callback from frame which holds the float
+// registers followed by the integer registers and the stack. intsN and floatsN are the number of
+// registers used so far and stack is the index of the next stack word in frame.
+func callbackStruct(t reflect.Type, frame []uintptr, intsN, floatsN, stack *int) reflect.Value {
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	mem := make([]uint64, (l.size+7)/8+1)
+	if classes, ok := l.classify(t); ok {
+		var ints, floats int
+		for _, c := range classes {
+			if c == classSSE {
+				floats++
+			} else {
+				ints++
+			}
+		}
+		if *intsN+ints <= numOfIntegerRegisters() && *floatsN+floats <= numOfFloats {
+			for i, c := range classes {
+				if c == classSSE {
+					mem[i] = uint64(frame[*floatsN])
+					*floatsN++
+				} else {
+					mem[i] = uint64(frame[numOfFloats+*intsN])
+					*intsN++
+				}
+			}
+			return l.fromC(t, unsafe.Pointer(&mem[0]))
+		}
+	}
+	for i := range mem[:(l.size+7)/8] {
+		mem[i] = uint64(frame[*stack])
+		*stack++
+	}
+	return l.fromC(t, unsafe.Pointer(&mem[0]))
+}
+
+// argClass is the class of an eightbyte of a struct in the System V AMD64 ABI.
+type argClass uint8
+
+const (
+	classNone argClass = iota
+	classInteger
+	classSSE
+)
+
+// classify returns the class of every eightbyte of the struct with the Go type t
+// or false if the struct is passed in memory.
+func (l *cLayout) classify(t reflect.Type) ([]argClass, bool) {
+	if l.size > 16 {
+		return nil, false
+	}
+	classes := make([]argClass, (l.size+7)/8)
+	for _, s := range l.scalars(t, 0, nil) {
+		if s.offset%s.size != 0 {
+			// structs with unaligned fields are passed in memory
+			return nil, false
+		}
+		i := s.offset / 8
+		switch {
+		case s.kind != reflect.Float32 && s.kind != reflect.Float64:
+			classes[i] = classInteger
+		case classes[i] == classNone:
+			classes[i] = classSSE
+		}
+	}
+	for i, c := range classes {
+		if c == classNone {
+			// eightbytes only made of padding
+			classes[i] = classInteger
+		}
+	}
+	return classes, true
+}
@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"sort"
+	"unsafe"
+)
+
+// addStruct adds the struct v to the arguments of a C call following the AAPCS64 calling convention.
+// numInts and numFloats are the number of registers used so far. Memory that must stay alive during
+// the call is appended to keepAlive. Func fields are converted with cbs.
+func addStruct(v reflect.Value, numInts, numFloats *int, addStack, addInt, addFloat func(uintptr), cbs *fieldCallbacks, keepAlive []interface{}) []interface{} {
+	l, err := layoutOf(v.Type())
+	if err != nil {
+		panic(err)
+	}
+	if l.size == 0 {
+		return keepAlive
+	}
+	mem := l.toC(v, cbs)
+	words := mem[:(l.size+7)/8]
+	if hfa, ok := l.hfa(v.Type()); ok {
+		if *numFloats+len(hfa) <= numOfFloats {
+			// every member of a homogeneous floating-point aggregate gets its own register
+			for _, s := range hfa {
+				addFloat(loadScalar(mem, s))
+			}
+			return keepAlive
+		}
+		// once an HFA doesn't fit no more floats are passed in registers
+		*numFloats = numOfFloats
+		for _, w := range words {
+			addStack(uintptr(w))
+		}
+		return keepAlive
+	}
+	if l.size > 16 {
+		// large structs are copied to memory and passed by reference
+		addInt(uintptr(unsafe.Pointer(&mem[0])))
+		return append(keepAlive, mem)
+	}
+	if *numInts+len(words) > numOfIntegerRegisters() {
+		// a struct is never split between registers and the stack
+		*numInts = numOfIntegerRegisters()
+	}
+	for _, w := range words {
+		addInt(uintptr(w))
+	}
+	return keepAlive
+}
+
+// prepareStructReturn returns the memory a struct of type t is returned at and the value of R8
+// pointing to it if the struct is too large for registers. Otherwise it returns nil and 0.
+func prepareStructReturn(t reflect.Type, _ func(uintptr)) ([]uint64, uintptr) {
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	if _, ok := l.hfa(t); ok || l.size <= 16 {
+		return nil, 0
+	}
+	mem := make([]uint64, (l.size+7)/8+1)
+	return mem, uintptr(unsafe.Pointer(&mem[0]))
+}
+
+// getStruct returns the struct of type t returned by a C call. mem is the memory returned
+// by prepareStructReturn.
+func getStruct(t reflect.Type, mem []uint64, syscall *syscall9Args) reflect.Value {
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	if mem == nil {
+		mem = make([]uint64, 5)
+		if hfa, ok := l.hfa(t); ok {
+			regs := [...]uintptr{syscall.r2, syscall.rf2, syscall.rf3, syscall.rf4}
+			for i, s := range hfa {
+				storeScalar(mem, s, regs[i])
+			}
+		} else {
+			mem[0], mem[1] = uint64(syscall.r1), uint64(syscall.r3)
+		}
+	}
+	return l.fromC(t, unsafe.Pointer(&mem[0]))
+}
+
+// callbackStruct reads the struct argument of type t of a callback from frame which holds the float
+// registers followed by the integer registers and the stack. intsN and floatsN are the number of
+// registers used so far and stack is the index of the next stack word in frame.
+func callbackStruct(t reflect.Type, frame []uintptr, intsN, floatsN, stack *int) reflect.Value {
+	l, err := layoutOf(t)
+	if err != nil {
+		panic(err)
+	}
+	mem := make([]uint64, (l.size+7)/8+1)
+	words := mem[:(l.size+7)/8]
+	if hfa, ok := l.hfa(t); ok {
+		if *floatsN+len(hfa) <= numOfFloats {
+			for _, s := range hfa {
+				storeScalar(mem, s, frame[*floatsN])
+				*floatsN++
+			}
+			return l.fromC(t, unsafe.Pointer(&mem[0]))
+		}
+		*floatsN = numOfFloats
+		for i := range words {
+			mem[i] = uint64(frame[*stack])
+			*stack++
+		}
+		return l.fromC(t, unsafe.Pointer(&mem[0]))
+	}
+	// nextInt returns the index in frame of the next integer argument
+	nextInt := func() int {
+		if *intsN < numOfIntegerRegisters() {
+			*intsN++
+			return numOfFloats + *intsN - 1
+		}
+		*stack++
+		return *stack - 1
+	}
+	if l.size > 16 {
+		// large structs are passed by reference to a copy made by the caller
+		return l.fromC(t, *(*unsafe.Pointer)(unsafe.Pointer(&frame[nextInt()])))
+	}
+	if *intsN+len(words) > numOfIntegerRegisters() {
+		*intsN = numOfIntegerRegisters()
+	}
+	for i := range words {
+		mem[i] = uint64(frame[nextInt()])
+	}
+	return l.fromC(t, unsafe.Pointer(&mem[0]))
+}
+
+// hfa returns the members of the struct with the Go type t if it is a homogeneous floating-point
+// aggregate which is made of one to four floats or doubles of the same type.
+func (l *cLayout) hfa(t reflect.Type) ([]cScalar, bool) {
+	members := l.scalars(t, 0, nil)
+	if l.hasUnion(t) {
+		// the members of a union overlap and only count once if they are the same float
+		sort.SliceStable(members, func(i, j int) bool { return members[i].offset < members[j].offset })
+		n := 0
+		for _, s := range members {
+			if n > 0 && members[n-1].offset == s.offset {
+				if members[n-1] != s {
+					return nil, false
+				}
+				continue
+			}
+			members[n] = s
+			n++
+		}
+		members = members[:n]
+	}
+	if len(members) == 0 || len(members) > 4 {
+		return nil, false
+	}
+	kind := members[0].kind
+	if kind != reflect.Float32 && kind != reflect.Float64 {
+		return nil, false
+	}
+	for i, s := range members {
+		// the members must be laid out like an array without any padding
+		if s.kind != kind || s.offset != uintptr(i)*s.size {
+			return nil, false
+		}
+	}
+	if l.size != uintptr(len(members))*members[0].size {
+		return nil, false
+	}
+	return members, true
+}
+
+// loadScalar returns the bits of the float s in mem.
+func loadScalar(mem []uint64, s cScalar) uintptr {
+	p := unsafe.Add(unsafe.Pointer(&mem[0]), s.offset)
+	if s.size == 4 {
+		return uintptr(*(*uint32)(p))
+	}
+	return uintptr(*(*uint64)(p))
+}
+
+// storeScalar stores the bits of the float s held in a register to mem.
+func storeScalar(mem []uint64, s cScalar, reg uintptr) {
+	p := unsafe.Add(unsafe.Pointer(&mem[0]), s.offset)
+	if s.size == 4 {
+		*(*uint32)(p) = uint32(reg)
+		return
+	}
+	*(*uint64)(p) = uint64(reg)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux || windows) && !amd64 && !arm64
+
+package purego
+
+import (
+	"reflect"
+	"runtime"
+)
+
+func addStruct(reflect.Value, *int, *int, func(uintptr), func(uintptr), func(uintptr), *fieldCallbacks, []interface{}) []interface{} {
+	panic("purego: struct arguments are not supported on " + runtime.GOARCH)
+}
+
+func prepareStructReturn(reflect.Type, func(uintptr)) ([]uint64, uintptr) {
+	panic("purego: struct returns are not supported on " + runtime.GOARCH)
+}
+
+func getStruct(reflect.Type, []uint64, *syscall9Args) reflect.Value {
+	panic("purego: struct returns are not supported on " + runtime.GOARCH)
+}
+
+func callbackStruct(reflect.Type, []uintptr, *int, *int, *int) reflect.Value {
+	panic("purego: struct arguments are not supported on " + runtime.GOARCH)
+}
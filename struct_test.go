@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestStructs(t *testing.T) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("structs are only supported on amd64 and arm64")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libstructtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libstructtest", "struct.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type point struct{ X, Y int32 }
+	type vec4 struct{ X, Y, Z, W float32 }
+	type mat2 struct{ A, B, C, D float64 }
+	type big struct{ A, B, C int64 }
+	type mixed struct {
+		I int32
+		F float32
+		D float64
+	}
+	type packed struct {
+		_     struct{} `purego:"packed"`
+		Kind  uint8
+		Value int32
+	}
+
+	var pointAdd func(a, b point) point
+	purego.RegisterLibFunc(&pointAdd, lib, "point_add")
+	if got, want := pointAdd(point{1, 2}, point{3, 4}), (point{4, 6}); got != want {
+		t.Errorf("point_add got %+v wanted %+v", got, want)
+	}
+
+	var vec4Scale func(v vec4, s float32) vec4
+	purego.RegisterLibFunc(&vec4Scale, lib, "vec4_scale")
+	if got, want := vec4Scale(vec4{1, 2, 3, 4}, 2), (vec4{2, 4, 6, 8}); got != want {
+		t.Errorf("vec4_scale got %+v wanted %+v", got, want)
+	}
+
+	var mat2Diagonal func(a, d float64) mat2
+	purego.RegisterLibFunc(&mat2Diagonal, lib, "mat2_diagonal")
+	if got, want := mat2Diagonal(1.5, 2.5), (mat2{1.5, 0, 0, 2.5}); got != want {
+		t.Errorf("mat2_diagonal got %+v wanted %+v", got, want)
+	}
+
+	var bigSum func(b big) int64
+	purego.RegisterLibFunc(&bigSum, lib, "big_sum")
+	if got := bigSum(big{1, 2, 3}); got != 6 {
+		t.Errorf("big_sum got %d wanted %d", got, 6)
+	}
+
+	var bigMake func(a, b, c int64) big
+	purego.RegisterLibFunc(&bigMake, lib, "big_make")
+	if got, want := bigMake(1, 2, 3), (big{1, 2, 3}); got != want {
+		t.Errorf("big_make got %+v wanted %+v", got, want)
+	}
+
+	var mixedDouble func(m mixed) mixed
+	purego.RegisterLibFunc(&mixedDouble, lib, "mixed_double")
+	if got, want := mixedDouble(mixed{1, 2, 3}), (mixed{2, 4, 6}); got != want {
+		t.Errorf("mixed_double got %+v wanted %+v", got, want)
+	}
+
+	var packedValue func(p packed) int32
+	purego.RegisterLibFunc(&packedValue, lib, "packed_value")
+	if got := packedValue(packed{Kind: 7, Value: 42}); got != 42 {
+		t.Errorf("packed_value got %d wanted %d", got, 42)
+	}
+
+	type mat2f struct{ M [4]float32 }
+	type record struct {
+		Name [16]byte
+		ID   int32
+	}
+
+	var mat2fScale func(m mat2f, s float32) mat2f
+	purego.RegisterLibFunc(&mat2fScale, lib, "mat2f_scale")
+	if got, want := mat2fScale(mat2f{[4]float32{1, 2, 3, 4}}, 2), (mat2f{[4]float32{2, 4, 6, 8}}); got != want {
+		t.Errorf("mat2f_scale got %+v wanted %+v", got, want)
+	}
+
+	var recordMake func(id int32) record
+	purego.RegisterLibFunc(&recordMake, lib, "record_make")
+	r := recordMake(5)
+	if name := string(r.Name[:6]); name != "purego" || r.ID != 5 {
+		t.Errorf("record_make got %q %d wanted %q %d", name, r.ID, "purego", 5)
+	}
+
+	var recordID func(r record) int32
+	purego.RegisterLibFunc(&recordID, lib, "record_id")
+	if got := recordID(r); got != 5 {
+		t.Errorf("record_id got %d wanted %d", got, 5)
+	}
+
+	// structs larger than the registers are passed in memory which may take more stack than other arguments
+	type options struct {
+		Flags int64
+		Scale float64
+		Level int32
+		Name  [32]byte
+		Extra [4]int64
+	}
+	opts := options{Flags: 1, Scale: 2.5, Level: 3, Extra: [4]int64{4, 5, 6, 7}}
+	copy(opts.Name[:], "purego")
+	var optionsSum func(first int32, o options, last int64) int64
+	purego.RegisterLibFunc(&optionsSum, lib, "options_sum")
+	if got := optionsSum(100, opts, 1000); got != 100+1+2+3+6+4+5+6+7+1000 {
+		t.Errorf("options_sum got %d wanted %d", got, 100+1+2+3+6+4+5+6+7+1000)
+	}
+	if opts.Flags != 1 || opts.Name[0] != 'p' {
+		t.Errorf("options_sum changed the struct of the caller: %+v", opts)
+	}
+	var optionsDiff func(a, b options) int64
+	purego.RegisterLibFunc(&optionsDiff, lib, "options_diff")
+	other := opts
+	other.Flags, other.Extra[3] = 3, 2
+	if got := optionsDiff(other, opts); got != 1995 {
+		t.Errorf("options_diff got %d wanted %d", got, 1995)
+	}
+
+	var applyPoint func(cb uintptr, p point) int32
+	purego.RegisterLibFunc(&applyPoint, lib, "apply_point")
+	cb := purego.NewCallback(func(p point) int32 { return p.X*10 + p.Y })
+	if got := applyPoint(cb, point{1, 2}); got != 12 {
+		t.Errorf("apply_point got %d wanted %d", got, 12)
+	}
+
+	var applyMat2f func(cb uintptr, m mat2f) int32
+	purego.RegisterLibFunc(&applyMat2f, lib, "apply_mat2f")
+	cb = purego.NewCallback(func(m mat2f) int32 { return int32(m.M[0] + m.M[1] + m.M[2] + m.M[3]) })
+	if got := applyMat2f(cb, mat2f{[4]float32{1, 2, 3, 4}}); got != 10 {
+		t.Errorf("apply_mat2f got %d wanted %d", got, 10)
+	}
+
+	var applyRecord func(cb uintptr, r record) int32
+	purego.RegisterLibFunc(&applyRecord, lib, "apply_record")
+	cb = purego.NewCallback(func(n int32, r record) int32 {
+		if string(r.Name[:6]) != "purego" {
+			return -1
+		}
+		return n + r.ID
+	})
+	if got := applyRecord(cb, r); got != 6 {
+		t.Errorf("apply_record got %d wanted %d", got, 6)
+	}
+}
+
+func TestStructFuncFields(t *testing.T) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("structs are only supported on amd64 and arm64")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libstructtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libstructtest", "struct.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type ops struct {
+		Add  func(a, b int32) int32
+		Mul  func(a, b int32) int32
+		Base int32
+	}
+	var muls int
+	o := ops{
+		Add: func(a, b int32) int32 { return a + b },
+		Mul: func(a, b int32) int32 {
+			muls++
+			return a * b
+		},
+		Base: 1,
+	}
+
+	var opsApply func(o ops, a, b int32) int32
+	purego.RegisterLibFunc(&opsApply, lib, "ops_apply")
+	if got, want := opsApply(o, 2, 3), int32(1+(2+3)*3); got != want {
+		t.Errorf("ops_apply got %d wanted %d", got, want)
+	}
+
+	// C keeps the pointer to the struct after ops_register returns
+	var opsRegister func(o unsafe.Pointer)
+	purego.RegisterLibFunc(&opsRegister, lib, "ops_register")
+	var opsRun func(a, b int32) int32
+	purego.RegisterLibFunc(&opsRun, lib, "ops_run")
+	h := purego.NewStructHandle(&o)
+	defer h.Release()
+	opsRegister(h.Pointer())
+	runtime.GC()
+	if got, want := opsRun(4, 5), int32(1+(4+5)*5); got != want {
+		t.Errorf("ops_run got %d wanted %d", got, want)
+	}
+	o.Base = 10
+	h.Store()
+	if got, want := opsRun(4, 5), int32(10+(4+5)*5); got != want {
+		t.Errorf("ops_run after Store got %d wanted %d", got, want)
+	}
+
+	// C function pointers become Go funcs and callbacks become the original funcs again
+	var opsFill func(o *ops)
+	purego.RegisterLibFunc(&opsFill, lib, "ops_fill")
+	opsFill(&o)
+	if o.Base != 100 {
+		t.Errorf("ops_fill Base got %d wanted 100", o.Base)
+	}
+	if got := o.Add(7, 2); got != 5 {
+		t.Errorf("Add filled by C got %d wanted 5", got)
+	}
+	before := muls
+	if got := o.Mul(7, 2); got != 14 || muls != before+1 {
+		t.Errorf("Mul got %d and was called %d times wanted 14 and once", got, muls-before)
+	}
+}
+
+func TestStructFuncFieldsRelease(t *testing.T) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("structs are only supported on amd64 and arm64")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libstructtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libstructtest", "struct.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type ops struct {
+		Add  func(a, b int32) int32
+		Mul  func(a, b int32) int32
+		Base int32
+	}
+	var adds int
+	newOps := func() ops {
+		return ops{
+			Add:  func(a, b int32) int32 { adds++; return a + b },
+			Mul:  func(a, b int32) int32 { return a * b },
+			Base: 1,
+		}
+	}
+
+	// the callbacks of a struct argument live as long as the call with CallbackPerCall
+	var opsApply func(o ops, a, b int32) int32
+	purego.RegisterLibFuncWith(&opsApply, lib, "ops_apply", purego.WithFuncArgs(purego.CallbackPerCall))
+	free := purego.FreeCallbacks()
+	for i := 0; i < 10; i++ {
+		if got, want := opsApply(newOps(), 2, 3), int32(1+(2+3)*3); got != want {
+			t.Fatalf("ops_apply got %d wanted %d", got, want)
+		}
+	}
+	if got := purego.FreeCallbacks(); got != free {
+		t.Errorf("FreeCallbacks() = %d after calls with struct arguments, want %d", got, free)
+	}
+
+	// and those of a handle as long as the handle
+	var opsRegister func(o unsafe.Pointer)
+	purego.RegisterLibFunc(&opsRegister, lib, "ops_register")
+	var opsRun func(a, b int32) int32
+	purego.RegisterLibFunc(&opsRun, lib, "ops_run")
+	o := newOps()
+	h := purego.NewStructHandle(&o)
+	if got := purego.FreeCallbacks(); got != free-2 {
+		t.Errorf("FreeCallbacks() = %d with a handle, want %d", got, free-2)
+	}
+	opsRegister(h.Pointer())
+	runtime.GC()
+	if got, want := opsRun(4, 5), int32(1+(4+5)*5); got != want {
+		t.Errorf("ops_run got %d wanted %d", got, want)
+	}
+	o.Mul = func(a, b int32) int32 { return a * b * 2 }
+	h.Store()
+	if got := purego.FreeCallbacks(); got != free-2 {
+		t.Errorf("FreeCallbacks() = %d after Store, want %d", got, free-2)
+	}
+	if got, want := opsRun(4, 5), int32(1+(4+5)*5*2); got != want {
+		t.Errorf("ops_run after Store got %d wanted %d", got, want)
+	}
+	// the callbacks are turned back into the funcs they were made of
+	h.Load()
+	before := adds
+	if got := o.Add(1, 2); got != 3 || adds != before+1 {
+		t.Errorf("Add after Load got %d and was called %d times wanted 3 and once", got, adds-before)
+	}
+	h.Release()
+	h.Release()
+	if got := purego.FreeCallbacks(); got != free {
+		t.Errorf("FreeCallbacks() = %d after Release, want %d", got, free)
+	}
+}
+
+func TestStructOutParameter(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libstructtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libstructtest", "struct.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type info struct {
+		Version uint8
+		Size    int64
+		Ratio   float64
+		Name    [8]byte
+	}
+	var infoFill func(out *info) int32
+	purego.RegisterLibFunc(&infoFill, lib, "info_fill")
+	var got info
+	if ret := infoFill(&got); ret != 0 {
+		t.Fatalf("info_fill returned %d", ret)
+	}
+	want := info{Version: 3, Size: 1 << 40, Ratio: 0.5, Name: [8]byte{'p', 'u', 'r', 'e', 'g', 'o'}}
+	if got != want {
+		t.Errorf("info_fill got %+v wanted %+v", got, want)
+	}
+	if ret := infoFill(nil); ret != -1 {
+		t.Errorf("info_fill(nil) returned %d wanted -1", ret)
+	}
+
+	type named struct {
+		Name string
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("registering a pointer to a struct without a C representation didn't panic")
+			}
+		}()
+		var fill func(out *named) int32
+		purego.RegisterLibFunc(&fill, lib, "info_fill")
+	}()
+}
+
+func TestStructArgumentKeepAlive(t *testing.T) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("structs are only supported on amd64 and arm64")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libstructtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libstructtest", "struct.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	type intBuffer struct {
+		Data []int64
+		Len  int64 `purego:"len(Data)"`
+	}
+	var intBufferSum func(b intBuffer, before func()) int64
+	purego.RegisterLibFunc(&intBufferSum, lib, "int_buffer_sum")
+
+	// the only reference to the data is the slice inside the struct argument
+	// so purego must keep it alive while the GC runs before C reads it.
+	var collected int32
+	before := func() {
+		runtime.GC()
+		runtime.GC()
+		if atomic.LoadInt32(&collected) != 0 {
+			t.Errorf("memory referenced by a struct argument was collected during the call")
+		}
+	}
+	got := func() int64 {
+		data := &[4]int64{1, 2, 3, 4}
+		runtime.SetFinalizer(data, func(*[4]int64) { atomic.StoreInt32(&collected, 1) })
+		return intBufferSum(intBuffer{Data: data[:]}, before)
+	}()
+	if got != 10 {
+		t.Errorf("int_buffer_sum got %d wanted 10", got)
+	}
+}
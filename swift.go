@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SwiftSelf is the self argument of a function that uses the Swift calling convention.
+// A function registered with RegisterFunc that has a parameter of type SwiftSelf is called
+// with the Swift calling convention and the value is passed in the register Swift reserves
+// for self (X20 on arm64 and R13 on amd64) instead of in the next argument register.
+// Methods take the instance as self, static methods the metadata of the type and
+// closures their context. A function that doesn't use self must still have the parameter
+// to be called as a Swift function and can pass zero.
+//
+// The Swift calling convention is only supported on darwin/amd64 and darwin/arm64.
+// Only arguments and return values that Swift lowers to C scalars and pointers
+// such as Int, Double, Bool and class references are supported. Swift passes its
+// own structs, enums and String differently than C and these need a C shim.
+type SwiftSelf uintptr
+
+// SwiftError is the error a throwing Swift function returns in the register Swift reserves for it
+// (X21 on arm64 and R12 on amd64). A function registered with RegisterFunc that has a parameter
+// of type *SwiftError is called with the Swift calling convention and the error register
+// is stored into it after the call. The register is zero before the call so it is
+// only non-zero if the function threw, in which case it is a retained reference
+// to the boxed error and the return value is undefined.
+//
+//	// public func parse(_ x: Int) throws -> Int
+//	var parse func(x int, self purego.SwiftSelf, err *purego.SwiftError) int
+//	purego.RegisterLibFunc(&parse, lib, "$s5Parse5parseyS2iKF")
+type SwiftError uintptr
+
+var (
+	swiftSelfType     = reflect.TypeOf(SwiftSelf(0))
+	swiftErrorPtrType = reflect.TypeOf((*SwiftError)(nil))
+)
+
+// swiftArgs reports whether the function type ty has a SwiftSelf or *SwiftError parameter and thus
+// uses the Swift calling convention. It panics if either appears twice or the platform doesn't support it.
+func swiftArgs(ty reflect.Type) bool {
+	var self, err int
+	for i := 0; i < ty.NumIn(); i++ {
+		switch ty.In(i) {
+		case swiftSelfType:
+			self++
+		case swiftErrorPtrType:
+			err++
+		}
+	}
+	if self > 1 || err > 1 {
+		panic("purego: a Swift function can only have one SwiftSelf and one *SwiftError parameter: " + ty.String())
+	}
+	if self+err > 0 && !swiftSupported {
+		panic("purego: the Swift calling convention is only supported on darwin/amd64 and darwin/arm64")
+	}
+	return self+err > 0
+}
+
+// IsSwiftSymbol reports whether name is a symbol mangled by the Swift compiler.
+// The leading underscore of Mach-O symbols is accepted.
+func IsSwiftSymbol(name string) bool {
+	if strings.HasPrefix(name, "_$") || strings.HasPrefix(name, "__T0") {
+		name = name[1:]
+	}
+	// $s is used since Swift 5, $S by Swift 4.2 and _T0 by Swift 4
+	return strings.HasPrefix(name, "$s") || strings.HasPrefix(name, "$S") || strings.HasPrefix(name, "_T0")
+}
+
+// IsSwiftCallingConvention reports whether the function exported as name must be called with
+// the Swift calling convention, see SwiftSelf. It is false for C names, which include functions
+// exported with @_cdecl, and for the thunks of @objc methods, which use the C calling convention
+// and end in To. Both can be registered like any other C function.
+func IsSwiftCallingConvention(name string) bool {
+	return IsSwiftSymbol(name) && !strings.HasSuffix(name, "To")
+}
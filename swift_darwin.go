@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "unsafe"
+
+const swiftSupported = true
+
+var swiftcallABI0 uintptr
+
+// swiftcallArgs is passed to swiftcall which calls args like syscall9X but
+// with self in the register for the Swift context and stores the Swift error register in err.
+type swiftcallArgs struct {
+	args      *syscall9Args
+	self, err uintptr
+}
+
+// swiftcall calls the C function of args with the Swift calling convention and returns the Swift error register.
+func swiftcall(args *syscall9Args, self uintptr) uintptr {
+	a := swiftcallArgs{args: args, self: self}
+	runtime_cgocall(swiftcallABI0, unsafe.Pointer(&a))
+	return a.err
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux || windows
+
+package purego
+
+// swiftSupported is false since only darwin has a Swift calling convention trampoline.
+const swiftSupported = false
+
+func swiftcall(*syscall9Args, uintptr) uintptr {
+	panic("purego: the Swift calling convention is only supported on darwin/amd64 and darwin/arm64")
+}
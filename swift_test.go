@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestIsSwiftCallingConvention(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		symbol, call bool
+	}{
+		{"$s5Parse5parseyS2iKF", true, true},
+		{"_$s5Parse5parseyS2iKF", true, true},
+		{"$S5Parse5parseyS2iKF", true, true},
+		{"_T05Parse5parseS2iKF", true, true},
+		{"$s5Parse7ScannerC4nextSiyFTo", true, false},
+		{"parse_c", false, false},
+		{"_ZN3foo3barEi", false, false},
+	} {
+		if got := purego.IsSwiftSymbol(test.name); got != test.symbol {
+			t.Errorf("IsSwiftSymbol(%q) = %v, want %v", test.name, got, test.symbol)
+		}
+		if got := purego.IsSwiftCallingConvention(test.name); got != test.call {
+			t.Errorf("IsSwiftCallingConvention(%q) = %v, want %v", test.name, got, test.call)
+		}
+	}
+}
+
+func TestSwiftCall(t *testing.T) {
+	if runtime.GOOS != "darwin" {
+		t.Skip("the Swift calling convention is only supported on darwin")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libswifttest.dylib")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libswifttest", "swift.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("failed to dlopen %s: %s", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var addSelf func(x int, self purego.SwiftSelf) int
+	purego.RegisterLibFunc(&addSelf, lib, "add_self")
+	if got := addSelf(40, 2); got != 42 {
+		t.Errorf("add_self(40, 2) = %d, want 42", got)
+	}
+
+	var checkedDiv func(a, b int, self purego.SwiftSelf, err *purego.SwiftError) int
+	purego.RegisterLibFunc(&checkedDiv, lib, "checked_div")
+	var swiftErr purego.SwiftError
+	if got := checkedDiv(84, 2, 0, &swiftErr); got != 42 || swiftErr != 0 {
+		t.Errorf("checked_div(84, 2) = %d, %#x, want 42, 0", got, swiftErr)
+	}
+	checkedDiv(1, 0, 0, &swiftErr)
+	if swiftErr != 0xdead {
+		t.Errorf("checked_div(1, 0) error = %#x, want 0xdead", swiftErr)
+	}
+
+	// the self register isn't used for the stack arguments on amd64
+	var scaleSelf func(x float64, a, b, c, d, e, f, g, h int, self purego.SwiftSelf) float64
+	purego.RegisterLibFunc(&scaleSelf, lib, "scale_self")
+	if got := scaleSelf(1, 1, 2, 3, 4, 5, 6, 7, 8, 6); got != 42 {
+		t.Errorf("scale_self = %v, want 42", got)
+	}
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/demangle"
+)
+
+// Symbols returns the sorted names of the functions and variables the library exports
+// as they are passed to Lookup. C++ and Rust names are mangled, see LookupDemangled.
+func (l *Library) Symbols() ([]string, error) {
+	info, err := l.Info()
+	if err != nil {
+		return nil, err
+	}
+	names, err := exportedSymbols(info)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// SymbolVersion is a version of a symbol of an ELF library as returned by Library.SymbolVersions.
+type SymbolVersion struct {
+	// Version is the name of the version such as "GLIBC_2.2.5". It is empty if the symbol isn't versioned.
+	Version string
+	// Library is the library that must provide the version of a symbol the library imports such
+	// as "libc.so.6". It is empty for the symbols the library defines.
+	Library string
+	// Defined is true if the library defines the symbol and false if it imports it.
+	Defined bool
+	// Default is true for the version Lookup and the linker bind to by name. The versions that
+	// aren't the default are kept for programs linked against older releases of the library.
+	Default bool
+}
+
+// SymbolVersions returns every version of the symbol name in the dynamic symbol table of the library
+// from its .gnu.version_d and .gnu.version_r sections in the order of the table. A library may define
+// several versions of a function such as memcpy@GLIBC_2.2.5 and memcpy@@GLIBC_2.14 of which one is
+// the default, and the symbols it imports name the version and the library they need. Tools can use
+// them to decide which version to bind or to report which version a program needs that a library
+// lacks. Symbol versions only exist on Linux and FreeBSD so SymbolVersions returns an error elsewhere.
+func (l *Library) SymbolVersions(name string) ([]SymbolVersion, error) {
+	info, err := l.Info()
+	if err != nil {
+		return nil, err
+	}
+	return symbolVersions(info, name)
+}
+
+// LookupDemangled is like Lookup but finds the symbol by its demangled C++ or Rust name
+// as printed by c++filt, for example "Foo::bar(int) const" or "mycrate::foo".
+// Whitespace is ignored and the return type of a C++ template function may be left out.
+// Without a parameter list the name matches every overload and template instantiation,
+// so it must select one symbol.
+// Names must be fully qualified; see the demangle package for the format.
+func (l *Library) LookupDemangled(name string) (uintptr, error) {
+	syms, err := l.Symbols()
+	if err != nil {
+		return 0, err
+	}
+	want := squashSpaces(name)
+	withParams := strings.Contains(name, "(")
+	var matches []string
+	for _, sym := range syms {
+		s, err := demangle.Demangle(sym)
+		if err == demangle.ErrNotMangled {
+			// C names are matched as they are
+			s = sym
+		} else if err != nil {
+			continue
+		}
+		if !demangledMatch(s, want, withParams) {
+			continue
+		}
+		matches = append(matches, sym)
+	}
+	if len(matches) == 0 {
+		return 0, errors.New("purego: no symbol in " + l.Name() + " demangles to " + name)
+	}
+	addr, err := l.Lookup(matches[0])
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range matches[1:] {
+		// compilers emit aliases such as the complete and base object constructors at the same address
+		if a, err := l.Lookup(m); err != nil || a != addr {
+			return 0, errors.New("purego: " + name + " is ambiguous in " + l.Name() + ": " + strings.Join(matches, ", "))
+		}
+	}
+	return addr, nil
+}
+
+// demangledMatch reports whether the demangled name s matches want which has no whitespace.
+func demangledMatch(s, want string, withParams bool) bool {
+	if withParams {
+		return returnTypeMatch(s, want, strings.IndexByte(s, '('))
+	}
+	base, ok := withoutParams(s)
+	if !ok {
+		return squashSpaces(s) == want
+	}
+	if t, ok := withoutTemplateArgs(base); ok && returnTypeMatch(t, want, len(t)) {
+		return true
+	}
+	return returnTypeMatch(base, want, len(base))
+}
+
+// returnTypeMatch reports whether s matches want with or without the return type
+// of a template function, which ends at a space before the index end.
+func returnTypeMatch(s, want string, end int) bool {
+	if squashSpaces(s) == want {
+		return true
+	}
+	for i := 0; i < end; i++ {
+		if s[i] == ' ' && squashSpaces(s[i+1:]) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutParams returns the demangled function s without its parameter list and qualifiers.
+// It returns false if s is not a function.
+func withoutParams(s string) (string, bool) {
+	for _, q := range []string{" const", " volatile", " &&", " &"} {
+		s = strings.TrimSuffix(s, q)
+	}
+	if !strings.HasSuffix(s, ")") {
+		return "", false
+	}
+	depth := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		switch s[i] {
+		case ')':
+			depth++
+		case '(':
+			if depth--; depth == 0 {
+				return s[:i], true
+			}
+		}
+	}
+	return "", false
+}
+
+// withoutTemplateArgs returns the demangled name s without the template arguments it ends with.
+func withoutTemplateArgs(s string) (string, bool) {
+	if !strings.HasSuffix(s, ">") {
+		return "", false
+	}
+	depth := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		switch s[i] {
+		case '>':
+			depth++
+		case '<':
+			if depth--; depth == 0 {
+				return s[:i], true
+			}
+		}
+	}
+	return "", false
+}
+
+func squashSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// imagePointer converts the address p inside a loaded image to a pointer.
+func imagePointer(p uintptr) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&p))
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"bytes"
+	"errors"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+const (
+	mhMagic64     = 0xfeedfacf
+	lcSegment64   = 0x19
+	lcSymtab      = 0x2
+	nStab         = 0xe0
+	nType         = 0x0e
+	nSect         = 0x0e
+	nExt          = 0x01
+	segNameLength = 16
+)
+
+type machHeader64 struct {
+	magic, cputype, cpusubtype, filetype, ncmds, sizeofcmds, flags, reserved uint32
+}
+
+type loadCommand struct {
+	cmd, cmdsize uint32
+}
+
+type segmentCommand64 struct {
+	cmd, cmdsize                      uint32
+	segname                           [segNameLength]byte
+	vmaddr, vmsize, fileoff, filesize uint64
+	maxprot, initprot, nsects, flags  uint32
+}
+
+type symtabCommand struct {
+	cmd, cmdsize, symoff, nsyms, stroff, strsize uint32
+}
+
+type nlist64 struct {
+	strx  uint32
+	typ   uint8
+	sect  uint8
+	desc  uint16
+	value uint64
+}
+
+// exportedSymbols returns the external symbols defined by the image whose Mach-O header is at info.Base.
+// The symbol table is read from memory because the system libraries are only in the dyld shared cache.
+func exportedSymbols(info LibraryInfo) ([]string, error) {
+	hdr := (*machHeader64)(imagePointer(info.Base))
+	if hdr.magic != mhMagic64 {
+		return nil, errors.New("purego: not a 64-bit Mach-O image")
+	}
+	var text, linkedit *segmentCommand64
+	var symtab *symtabCommand
+	cmd := info.Base + unsafe.Sizeof(*hdr)
+	for i := uint32(0); i < hdr.ncmds; i++ {
+		lc := (*loadCommand)(imagePointer(cmd))
+		switch lc.cmd {
+		case lcSegment64:
+			seg := (*segmentCommand64)(imagePointer(cmd))
+			name := seg.segname[:]
+			if i := bytes.IndexByte(name, 0); i >= 0 {
+				name = name[:i]
+			}
+			switch string(name) {
+			case "__TEXT":
+				text = seg
+			case "__LINKEDIT":
+				linkedit = seg
+			}
+		case lcSymtab:
+			symtab = (*symtabCommand)(imagePointer(cmd))
+		}
+		cmd += uintptr(lc.cmdsize)
+	}
+	if text == nil || linkedit == nil || symtab == nil {
+		return nil, errors.New("purego: the image has no symbol table")
+	}
+	// file offsets in __LINKEDIT are relative to where the segment is mapped
+	slide := info.Base - uintptr(text.vmaddr)
+	base := slide + uintptr(linkedit.vmaddr) - uintptr(linkedit.fileoff)
+	var names []string
+	for i := uintptr(0); i < uintptr(symtab.nsyms); i++ {
+		sym := (*nlist64)(imagePointer(base + uintptr(symtab.symoff) + i*unsafe.Sizeof(nlist64{})))
+		if sym.typ&nStab != 0 || sym.typ&nType != nSect || sym.typ&nExt == 0 {
+			continue
+		}
+		name := strings.GoString(base + uintptr(symtab.stroff) + uintptr(sym.strx))
+		// C names have a leading underscore that Dlsym adds
+		if len(name) > 1 && name[0] == '_' {
+			names = append(names, name[1:])
+		}
+	}
+	return names, nil
+}
+
+func symbolVersions(LibraryInfo, string) ([]SymbolVersion, error) {
+	return nil, errors.New("purego: symbol versions only exist in ELF libraries")
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"os"
+)
+
+// exportedSymbols returns the functions and variables of the dynamic symbol table of the
+// ELF file of the library. The main program is read from the executable.
+func exportedSymbols(info LibraryInfo) ([]string, error) {
+	path := info.Path
+	if path == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return nil, err
+		}
+		path = exe
+	}
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	syms := readSymbols(path)
+	names := make([]string, 0, len(syms))
+	for name := range syms {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// sttGNUIFunc is STT_GNU_IFUNC which older versions of debug/elf don't define.
+const sttGNUIFunc = elf.SymType(10)
+
+// versionHidden is the bit set in a .gnu.version entry for a symbol version
+// that is not the default. The dynamic linker never binds to such a version by name.
+const versionHidden = 0x8000
+
+// readSymbols returns the link-time address of every function and object defined in the ELF file at path.
+// The dynamic symbol table is preferred since it holds exactly the symbols that are exported.
+// If a symbol has several versions the default one is returned like dlsym does.
+func readSymbols(path string) map[string]uintptr {
+	syms := map[string]uintptr{}
+	f, err := elf.Open(path)
+	if err != nil {
+		return syms
+	}
+	defer f.Close()
+	var versions []byte
+	list, err := f.DynamicSymbols()
+	if err != nil || len(list) == 0 {
+		list, _ = f.Symbols()
+	} else if sec := f.Section(".gnu.version"); sec != nil {
+		versions, _ = sec.Data()
+	}
+	for i, s := range list {
+		if s.Section == elf.SHN_UNDEF || s.Value == 0 {
+			continue
+		}
+		switch elf.ST_TYPE(s.Info) {
+		case elf.STT_FUNC, elf.STT_OBJECT, sttGNUIFunc:
+		default:
+			continue
+		}
+		// .gnu.version has an entry for every dynamic symbol including
+		// the null symbol at index 0 which DynamicSymbols skips.
+		hidden := false
+		if off := 2 * (i + 1); off+2 <= len(versions) {
+			hidden = f.ByteOrder.Uint16(versions[off:])&versionHidden != 0
+		}
+		if _, ok := syms[s.Name]; !ok || !hidden {
+			syms[s.Name] = uintptr(s.Value)
+		}
+	}
+	return syms
+}
+
+// symbolVersions returns the versions of every entry of the dynamic symbol table named name
+// in the ELF file of the library from .gnu.version, .gnu.version_d and .gnu.version_r.
+func symbolVersions(info LibraryInfo, name string) ([]SymbolVersion, error) {
+	path := info.Path
+	if path == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return nil, err
+		}
+		path = exe
+	}
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	list, err := f.DynamicSymbols()
+	if err != nil {
+		return nil, err
+	}
+	var versions []byte
+	if sec := f.Section(".gnu.version"); sec != nil {
+		if versions, err = sec.Data(); err != nil {
+			return nil, err
+		}
+	}
+	// the versions by their index in .gnu.version
+	defs, err := versionDefinitions(f)
+	if err != nil {
+		return nil, err
+	}
+	var found []SymbolVersion
+	for i, s := range list {
+		if s.Name != name {
+			continue
+		}
+		v := SymbolVersion{Defined: s.Section != elf.SHN_UNDEF, Default: true}
+		// .gnu.version has an entry for every dynamic symbol including
+		// the null symbol at index 0 which DynamicSymbols skips.
+		if off := 2 * (i + 1); off+2 <= len(versions) {
+			ndx := f.ByteOrder.Uint16(versions[off:])
+			v.Default = ndx&versionHidden == 0
+			if d, ok := defs[ndx&^versionHidden]; ok {
+				v.Version, v.Library = d.Version, d.Library
+			}
+		}
+		found = append(found, v)
+	}
+	if len(found) == 0 {
+		return nil, errors.New("purego: " + path + " has no dynamic symbol " + name)
+	}
+	return found, nil
+}
+
+// versionDefinitions returns the versions defined in .gnu.version_d and those required from
+// other libraries in .gnu.version_r by their index which .gnu.version refers to.
+func versionDefinitions(f *elf.File) (map[uint16]SymbolVersion, error) {
+	defs := map[uint16]SymbolVersion{}
+	for _, sec := range f.Sections {
+		if sec.Type != elf.SHT_GNU_VERDEF && sec.Type != elf.SHT_GNU_VERNEED {
+			continue
+		}
+		data, err := sec.Data()
+		if err != nil {
+			return nil, err
+		}
+		if int(sec.Link) >= len(f.Sections) {
+			return nil, errors.New("purego: " + sec.Name + " has no string table")
+		}
+		strtab, err := f.Sections[sec.Link].Data()
+		if err != nil {
+			return nil, err
+		}
+		str := func(off uint32) string {
+			if int(off) >= len(strtab) {
+				return ""
+			}
+			s := strtab[off:]
+			if i := bytes.IndexByte(s, 0); i >= 0 {
+				s = s[:i]
+			}
+			return string(s)
+		}
+		bo := f.ByteOrder
+		// both sections are linked lists whose entries hold the offset of the next one
+		for off := 0; off >= 0 && off+16 <= len(data); {
+			if sec.Type == elf.SHT_GNU_VERDEF {
+				if off+20 > len(data) {
+					break
+				}
+				// Elf_Verdef: version, flags, ndx, cnt uint16; hash, aux, next uint32
+				// followed by Elf_Verdaux: name, next uint32 of which the first names the version
+				flags, ndx := bo.Uint16(data[off+2:]), bo.Uint16(data[off+4:])
+				aux := off + int(bo.Uint32(data[off+12:]))
+				if flags&verFlagBase == 0 && aux+8 <= len(data) {
+					defs[ndx] = SymbolVersion{Version: str(bo.Uint32(data[aux:]))}
+				}
+				off = nextVersionEntry(off, bo.Uint32(data[off+16:]))
+				continue
+			}
+			// Elf_Verneed: version, cnt uint16; file, aux, next uint32
+			// followed by cnt Elf_Vernaux: hash uint32; flags, other uint16; name, next uint32
+			file := str(bo.Uint32(data[off+4:]))
+			aux := off + int(bo.Uint32(data[off+8:]))
+			for n := bo.Uint16(data[off+2:]); n > 0 && aux+16 <= len(data); n-- {
+				defs[bo.Uint16(data[aux+6:])] = SymbolVersion{Version: str(bo.Uint32(data[aux+8:])), Library: file}
+				next := bo.Uint32(data[aux+12:])
+				if next == 0 {
+					break
+				}
+				aux += int(next)
+			}
+			off = nextVersionEntry(off, bo.Uint32(data[off+12:]))
+		}
+	}
+	return defs, nil
+}
+
+// verFlagBase is VER_FLG_BASE which marks the definition of the version of the file itself.
+const verFlagBase = 1
+
+// nextVersionEntry returns the offset of the entry after the one at off or -1 if it is the last.
+func nextVersionEntry(off int, next uint32) int {
+	if next == 0 {
+		return -1
+	}
+	return off + int(next)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestSymbolVersions(t *testing.T) {
+	libc, err := purego.OpenLibrary("libc.so.6")
+	if err != nil {
+		t.Skip("glibc isn't available:", err)
+	}
+	defer libc.Close()
+	versions, err := libc.SymbolVersions("memcpy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := 0
+	for _, v := range versions {
+		if !v.Defined || v.Library != "" || !strings.HasPrefix(v.Version, "GLIBC_") {
+			t.Errorf("memcpy of glibc has the version %+v", v)
+		}
+		if v.Default {
+			defaults++
+		}
+	}
+	if defaults != 1 {
+		t.Errorf("memcpy of glibc has %d default versions in %+v wanted 1", defaults, versions)
+	}
+	if _, err := libc.SymbolVersions("does_not_exist"); err == nil {
+		t.Errorf("SymbolVersions of a missing symbol succeeded")
+	}
+
+	// the library imports strlen from glibc
+	libFileName := filepath.Join(t.TempDir(), "libcbtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lib.Close()
+	versions, err = lib.SymbolVersions("strlen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0].Defined || versions[0].Library != "libc.so.6" || !strings.HasPrefix(versions[0].Version, "GLIBC_") {
+		t.Errorf("strlen imported from glibc has the versions %+v", versions)
+	}
+}
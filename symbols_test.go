@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestLookupDemangled(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libsymbolstest.so")
+	if err := buildSharedLib("CXX", libFileName, filepath.Join("libsymbolstest", "symbols.cpp")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.OpenLibrary(libFileName)
+	if err != nil {
+		t.Fatalf("failed to open %s: %s", libFileName, err)
+	}
+	defer lib.Close()
+
+	syms, err := lib.Symbols()
+	if err != nil {
+		t.Fatalf("Symbols failed: %s", err)
+	}
+	if !sort.StringsAreSorted(syms) {
+		t.Errorf("Symbols are not sorted")
+	}
+	for _, want := range []string{"plain_c", "_ZN8geometry5scaleEi", "_ZN8geometry5twiceIiEET_S1_"} {
+		if i := sort.SearchStrings(syms, want); i == len(syms) || syms[i] != want {
+			t.Errorf("Symbols is missing %s", want)
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		args []int32
+		want int32
+	}{
+		{"geometry::scale(int)", []int32{21}, 42},
+		{"geometry::scale( int, int )", []int32{6, 7}, 42},
+		{"geometry::twice<int>(int)", []int32{21}, 42},
+		{"int geometry::twice<int>(int)", []int32{21}, 42},
+		{"geometry::twice", []int32{21}, 42},
+		{"geometry::point_sum", []int32{40, 2}, 42},
+		{"plain_c", []int32{41}, 42},
+	} {
+		sym, err := lib.LookupDemangled(test.name)
+		if err != nil {
+			t.Errorf("LookupDemangled(%q) failed: %s", test.name, err)
+			continue
+		}
+		var got int32
+		switch len(test.args) {
+		case 1:
+			var fn func(int32) int32
+			purego.RegisterFunc(&fn, sym)
+			got = fn(test.args[0])
+		case 2:
+			var fn func(int32, int32) int32
+			purego.RegisterFunc(&fn, sym)
+			got = fn(test.args[0], test.args[1])
+		}
+		if got != test.want {
+			t.Errorf("%s%v got %d wanted %d", test.name, test.args, got, test.want)
+		}
+	}
+
+	if _, err := lib.LookupDemangled("geometry::scale"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("LookupDemangled of an overloaded name got error %v wanted ambiguous", err)
+	}
+	if _, err := lib.LookupDemangled("scale(int)"); err == nil {
+		t.Errorf("LookupDemangled of an unqualified name returned no error")
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"errors"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// exportedSymbols returns the names in the export directory of the module mapped at info.Base.
+// Exports by ordinal only have no name and are left out.
+func exportedSymbols(info LibraryInfo) ([]string, error) {
+	exports, err := dataDirectory(info.Base, 0) // IMAGE_DIRECTORY_ENTRY_EXPORT
+	if err != nil || exports == 0 {
+		return nil, err
+	}
+	u32 := func(off uintptr) uint32 { return *(*uint32)(imagePointer(info.Base + off)) }
+	numberOfNames := u32(exports + 24)
+	addressOfNames := uintptr(u32(exports + 32))
+	names := make([]string, 0, numberOfNames)
+	for i := uintptr(0); i < uintptr(numberOfNames); i++ {
+		names = append(names, strings.GoString(info.Base+uintptr(u32(addressOfNames+4*i))))
+	}
+	return names, nil
+}
+
+// dataDirectory returns the address relative to base of the entry index of the data directories
+// of the module mapped at base or 0 if it is empty.
+func dataDirectory(base uintptr, index uintptr) (uintptr, error) {
+	u16 := func(off uintptr) uint16 { return *(*uint16)(imagePointer(base + off)) }
+	u32 := func(off uintptr) uint32 { return *(*uint32)(imagePointer(base + off)) }
+	if u16(0) != 0x5a4d { // MZ
+		return 0, errors.New("purego: the module has no DOS header")
+	}
+	nt := uintptr(u32(0x3c))
+	if u32(nt) != 0x4550 { // PE\0\0
+		return 0, errors.New("purego: the module has no PE header")
+	}
+	// the optional header follows the signature and the file header
+	opt := nt + 4 + 20
+	var dataDirs uintptr
+	switch u16(opt) {
+	case 0x10b: // PE32
+		dataDirs = opt + 96
+	case 0x20b: // PE32+
+		dataDirs = opt + 112
+	default:
+		return 0, errors.New("purego: unknown optional header")
+	}
+	if u32(dataDirs+8*index+4) == 0 {
+		return 0, nil
+	}
+	return uintptr(u32(dataDirs + 8*index)), nil
+}
+
+func symbolVersions(LibraryInfo, string) ([]SymbolVersion, error) {
+	return nil, errors.New("purego: symbol versions only exist in ELF libraries")
+}
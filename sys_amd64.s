@@ -24,6 +24,12 @@
 //	r1    uintptr
 //	r2    uintptr
 //	err   uintptr
+//	r3    uintptr
+//	rf2   uintptr
+//	rf3   uintptr
+//	rf4   uintptr
+//	arm64_r8 uintptr
+//	extra *callExtra
 // }
 // syscall9X must be called on the g0 stack with the
 // C calling convention (use libcCall).
@@ -32,8 +38,27 @@ DATA ·syscall9XABI0(SB)/8, $syscall9X(SB)
 TEXT syscall9X(SB), NOSPLIT|NOFRAME, $0
 	PUSHQ BP
 	MOVQ  SP, BP
-	SUBQ  $32, SP
-	MOVQ  DI, 24(BP) // save the pointer
+	SUBQ  $16, SP
+	MOVQ  DI, -8(BP) // save the pointer
+
+	// make space for a7 to a9 and the extra stack words after them keeping SP 16-byte aligned
+	MOVQ  syscall9Args_extra(DI), R11
+	XORL  CX, CX
+	TESTQ R11, R11
+	JZ    nostack
+	MOVQ  callExtra_nstack(R11), CX
+	MOVQ  callExtra_stack(R11), SI
+
+nostack:
+	LEAQ 4(CX), AX
+	ANDQ $~1, AX
+	SHLQ $3, AX
+	SUBQ AX, SP
+
+	// copy the extra stack words after a9
+	LEAQ 24(SP), DI
+	REP; MOVSQ
+	MOVQ -8(BP), DI
 
 	MOVQ syscall9Args_f1(DI), X0 // f1
 	MOVQ syscall9Args_f2(DI), X1 // f2
@@ -44,6 +69,20 @@ TEXT syscall9X(SB), NOSPLIT|NOFRAME, $0
 	MOVQ syscall9Args_f7(DI), X6 // f7
 	MOVQ syscall9Args_f8(DI), X7 // f8
 
+	// the upper halves of the vector registers
+	MOVQ  syscall9Args_extra(DI), R11
+	TESTQ R11, R11
+	JZ    novec
+	MOVHPD (callExtra_hi+0)(R11), X0
+	MOVHPD (callExtra_hi+8)(R11), X1
+	MOVHPD (callExtra_hi+16)(R11), X2
+	MOVHPD (callExtra_hi+24)(R11), X3
+	MOVHPD (callExtra_hi+32)(R11), X4
+	MOVHPD (callExtra_hi+40)(R11), X5
+	MOVHPD (callExtra_hi+48)(R11), X6
+	MOVHPD (callExtra_hi+56)(R11), X7
+
+novec:
 	MOVQ syscall9Args_fn(DI), R10 // fn
 	MOVQ syscall9Args_a2(DI), SI  // a2
 	MOVQ syscall9Args_a3(DI), DX  // a3
@@ -59,25 +98,30 @@ TEXT syscall9X(SB), NOSPLIT|NOFRAME, $0
 	MOVQ R11, 0(SP)  // push a7
 	MOVQ R12, 8(SP)  // push a8
 	MOVQ R13, 16(SP) // push a9
-	XORL AX, AX      // vararg: say "no float args"
+	MOVL $8, AX      // vararg: AL is an upper bound of the float args in registers
 
 	CALL R10
 
-	MOVQ 24(BP), DI              // get the pointer back
-	MOVQ AX, syscall9Args_r1(DI) // r1
-	MOVQ X0, syscall9Args_r2(DI) // r2
+	MOVQ -8(BP), DI               // get the pointer back
+	MOVQ AX, syscall9Args_r1(DI)  // r1
+	MOVQ X0, syscall9Args_r2(DI)  // r2
+	MOVQ DX, syscall9Args_r3(DI)  // r3
+	MOVQ X1, syscall9Args_rf2(DI) // rf2
 
-	XORL AX, AX  // no error (it's ignored anyway)
-	ADDQ $32, SP
+	MOVQ   syscall9Args_extra(DI), R11
+	TESTQ  R11, R11
+	JZ     novecret
+	MOVHPD X0, callExtra_rhi(R11) // upper half of the vector in X0
+
+novecret:
+	XORL AX, AX // no error (it's ignored anyway)
 	MOVQ BP, SP
 	POPQ BP
 	RET
 
 TEXT callbackasm1(SB), NOSPLIT|NOFRAME, $0
-	// remove return address from stack, we are not returning to callbackasm, but to its caller.
-	MOVQ 0(SP), AX
-	ADDQ $8, SP
-
+	// the entry of callbackasm jumped here with the callback index in AX
+	// and the return address to its caller on the stack.
 	MOVQ 0(SP), R10 // get the return SP so that we can align register args with stack args
 
 	// make space for first six int and 8 float arguments below the frame
@@ -100,14 +144,6 @@ TEXT callbackasm1(SB), NOSPLIT|NOFRAME, $0
 
 	MOVQ R10, 0(SP) // push the stack pointer below registers
 
-	// determine index into runtime·cbs table
-	MOVQ $callbackasm(SB), DX
-	SUBQ DX, AX
-	MOVQ $0, DX
-	MOVQ $5, CX               // divide by 5 because each call instruction in ·callbacks is 5 bytes long
-	DIVL CX
-	SUBQ $1, AX               // subtract 1 because return PC is to the next slot
-
 	// Switch from the host ABI to the Go ABI.
 	PUSH_REGS_HOST_TO_ABI0()
 
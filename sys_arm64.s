@@ -23,6 +23,12 @@
 //	r1    uintptr
 //	r2    uintptr
 //	err   uintptr
+//	r3    uintptr
+//	rf2   uintptr
+//	rf3   uintptr
+//	rf4   uintptr
+//	arm64_r8 uintptr
+//	extra *callExtra
 // }
 // syscall9X must be called on the g0 stack with the
 // C calling convention (use libcCall).
@@ -31,7 +37,35 @@ DATA ·syscall9XABI0(SB)/8, $syscall9X(SB)
 TEXT syscall9X(SB), NOSPLIT, $0
 	SUB  $16, RSP   // push structure pointer
 	MOVD R0, 8(RSP)
+	MOVD R19, (RSP) // R19 is callee-saved and holds RSP from here to the end of the call
+	MOVD RSP, R19
 
+	// make space for a9 and the extra stack words after it keeping RSP 16-byte aligned
+	MOVD syscall9Args_extra(R0), R9
+	MOVD $0, R10
+	CBZ  R9, nostack
+	MOVD callExtra_nstack(R9), R10
+	MOVD callExtra_stack(R9), R11
+
+nostack:
+	ADD  $2, R10, R12
+	AND  $~1, R12
+	LSL  $3, R12
+	MOVD RSP, R13
+	SUB  R12, R13
+	MOVD R13, RSP
+
+	// copy the extra stack words after a9
+	ADD $8, R13, R14
+
+copy:
+	CBZ    R10, copied
+	MOVD.P 8(R11), R15
+	MOVD.P R15, 8(R14)
+	SUB    $1, R10
+	B      copy
+
+copied:
 	FMOVD syscall9Args_f1(R0), F0 // f1
 	FMOVD syscall9Args_f2(R0), F1 // f2
 	FMOVD syscall9Args_f3(R0), F2 // f3
@@ -41,6 +75,27 @@ TEXT syscall9X(SB), NOSPLIT, $0
 	FMOVD syscall9Args_f7(R0), F6 // f7
 	FMOVD syscall9Args_f8(R0), F7 // f8
 
+	// the upper halves of the vector registers
+	MOVD syscall9Args_extra(R0), R9
+	CBZ  R9, novec
+	MOVD (callExtra_hi+0)(R9), R10
+	VMOV R10, V0.D[1]
+	MOVD (callExtra_hi+8)(R9), R10
+	VMOV R10, V1.D[1]
+	MOVD (callExtra_hi+16)(R9), R10
+	VMOV R10, V2.D[1]
+	MOVD (callExtra_hi+24)(R9), R10
+	VMOV R10, V3.D[1]
+	MOVD (callExtra_hi+32)(R9), R10
+	VMOV R10, V4.D[1]
+	MOVD (callExtra_hi+40)(R9), R10
+	VMOV R10, V5.D[1]
+	MOVD (callExtra_hi+48)(R9), R10
+	VMOV R10, V6.D[1]
+	MOVD (callExtra_hi+56)(R9), R10
+	VMOV R10, V7.D[1]
+
+novec:
 	MOVD syscall9Args_fn(R0), R12 // fn
 	MOVD syscall9Args_a2(R0), R1  // a2
 	MOVD syscall9Args_a3(R0), R2  // a3
@@ -50,14 +105,28 @@ TEXT syscall9X(SB), NOSPLIT, $0
 	MOVD syscall9Args_a7(R0), R6  // a7
 	MOVD syscall9Args_a8(R0), R7  // a8
 	MOVD syscall9Args_a9(R0), R8  // a9
-	MOVD syscall9Args_a1(R0), R0  // a1
+	MOVD R8, (RSP)                // push a9 onto stack
 
-	MOVD R8, (RSP) // push a9 onto stack
+	MOVD syscall9Args_arm64_r8(R0), R8 // indirect result location
+	MOVD syscall9Args_a1(R0), R0       // a1
 
 	BL (R12)
 
-	MOVD  8(RSP), R2              // pop structure pointer
+	MOVD  R19, RSP
+	MOVD  8(RSP), R2               // pop structure pointer
+	MOVD  (RSP), R19
 	ADD   $16, RSP
-	MOVD  R0, syscall9Args_r1(R2) // save r1
-	FMOVD F0, syscall9Args_r2(R2) // save r2
+	MOVD  R0, syscall9Args_r1(R2)  // save r1
+	MOVD  R1, syscall9Args_r3(R2)  // save r3
+	FMOVD F0, syscall9Args_r2(R2)  // save r2
+	FMOVD F1, syscall9Args_rf2(R2) // save rf2
+	FMOVD F2, syscall9Args_rf3(R2) // save rf3
+	FMOVD F3, syscall9Args_rf4(R2) // save rf4
+
+	MOVD syscall9Args_extra(R2), R9
+	CBZ  R9, novecret
+	VMOV V0.D[1], R10
+	MOVD R10, callExtra_rhi(R9) // save the upper half of the vector in V0
+
+novecret:
 	RET
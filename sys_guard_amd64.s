@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+#include "textflag.h"
+#include "go_asm.h"
+
+// guardcall calls setjmp and then a function like syscall9X so that a longjmp of the
+// function returns here instead of skipping Go frames.
+// guardcall takes a pointer to a struct like:
+// struct {
+//	args   *syscall9Args
+//	jmpbuf unsafe.Pointer
+//	setjmp uintptr
+//	jumped uintptr
+// }
+// The result of setjmp is stored in jumped and args is only called if it is 0. longjmp
+// restores SP and BP but no other registers so the pointer is reloaded from the frame.
+GLOBL ·guardcallABI0(SB), NOPTR|RODATA, $8
+DATA ·guardcallABI0(SB)/8, $guardcall(SB)
+TEXT guardcall(SB), NOSPLIT|NOFRAME, $0
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $16, SP
+	MOVQ  DI, -8(BP) // save the pointer
+
+	MOVQ guardcallArgs_setjmp(DI), AX
+	MOVQ guardcallArgs_jmpbuf(DI), DI
+	CALL AX
+
+	MOVQ    -8(BP), DI                 // get the pointer back
+	MOVLQSX AX, AX                     // setjmp returns an int
+	MOVQ    AX, guardcallArgs_jumped(DI)
+	TESTQ   AX, AX
+	JNZ     done
+
+	MOVQ guardcallArgs_args(DI), DI
+	CALL syscall9X(SB)
+
+done:
+	XORL AX, AX
+	MOVQ BP, SP
+	POPQ BP
+	RET
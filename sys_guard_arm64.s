@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+#include "textflag.h"
+#include "go_asm.h"
+
+// guardcall calls setjmp and then a function like syscall9X so that a longjmp of the
+// function returns here instead of skipping Go frames.
+// guardcall takes a pointer to a struct like:
+// struct {
+//	args   *syscall9Args
+//	jmpbuf unsafe.Pointer
+//	setjmp uintptr
+//	jumped uintptr
+// }
+// The result of setjmp is stored in jumped and args is only called if it is 0. longjmp
+// restores RSP, the frame pointer and the link register but not the argument registers
+// so the pointer is reloaded from the frame.
+GLOBL ·guardcallABI0(SB), NOPTR|RODATA, $8
+DATA ·guardcallABI0(SB)/8, $guardcall(SB)
+TEXT guardcall(SB), NOSPLIT, $16
+	MOVD R0, 8(RSP) // save the pointer
+
+	MOVD guardcallArgs_setjmp(R0), R12
+	MOVD guardcallArgs_jmpbuf(R0), R0
+	BL   (R12)
+
+	MOVD 8(RSP), R1 // get the pointer back
+	SXTW R0, R0     // setjmp returns an int
+	MOVD R0, guardcallArgs_jumped(R1)
+	CBNZ R0, done
+
+	MOVD guardcallArgs_args(R1), R0
+	BL   syscall9X(SB)
+
+done:
+	RET
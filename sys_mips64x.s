@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && linux && (mips64 || mips64le)
+
+#include "textflag.h"
+#include "go_asm.h"
+#include "funcdata.h"
+
+// syscall9X calls a function with the N64 calling convention.
+// syscall9X takes a pointer to a struct like:
+// struct {
+//	fn    uintptr
+//	a1    uintptr
+//	a2    uintptr
+//	a3    uintptr
+//	a4    uintptr
+//	a5    uintptr
+//	a6    uintptr
+//	a7    uintptr
+//	a8    uintptr
+//	a9    uintptr
+//	r1    uintptr
+//	r2    uintptr
+//	err   uintptr
+// }
+// The n-th argument is passed in the n-th integer register or the n-th float register
+// depending on its type so a1 to a8 are loaded into both R4 to R11 and F12 to F19.
+// syscall9X must be called on the g0 stack with the
+// C calling convention (use libcCall).
+GLOBL ·syscall9XABI0(SB), NOPTR|RODATA, $8
+DATA ·syscall9XABI0(SB)/8, $syscall9X(SB)
+TEXT syscall9X(SB), NOSPLIT|NOFRAME, $0
+	// The ninth argument is at 0(R29) where Go would save the link register
+	// so the frame is set up by hand.
+	ADDV $-32, R29
+	MOVV R31, 16(R29) // save the link register
+	MOVV R4, 24(R29)  // save the structure pointer
+
+	MOVV R4, R12
+	MOVV syscall9Args_a9(R12), R13
+	MOVV R13, 0(R29)               // push a9 onto stack
+	MOVV syscall9Args_fn(R12), R25 // fn in R25 as PIC code expects
+	MOVV syscall9Args_a1(R12), R4  // a1
+	MOVV syscall9Args_a2(R12), R5  // a2
+	MOVV syscall9Args_a3(R12), R6  // a3
+	MOVV syscall9Args_a4(R12), R7  // a4
+	MOVV syscall9Args_a5(R12), R8  // a5
+	MOVV syscall9Args_a6(R12), R9  // a6
+	MOVV syscall9Args_a7(R12), R10 // a7
+	MOVV syscall9Args_a8(R12), R11 // a8
+
+#ifndef GOMIPS64_softfloat
+	MOVD syscall9Args_a1(R12), F12 // a1
+	MOVD syscall9Args_a2(R12), F13 // a2
+	MOVD syscall9Args_a3(R12), F14 // a3
+	MOVD syscall9Args_a4(R12), F15 // a4
+	MOVD syscall9Args_a5(R12), F16 // a5
+	MOVD syscall9Args_a6(R12), F17 // a6
+	MOVD syscall9Args_a7(R12), F18 // a7
+	MOVD syscall9Args_a8(R12), F19 // a8
+#endif
+
+	JAL (R25)
+
+	MOVV 24(R29), R12              // pop structure pointer
+	MOVV R2, syscall9Args_r1(R12)  // save r1
+	MOVV R3, syscall9Args_r3(R12)  // save r3
+#ifndef GOMIPS64_softfloat
+	MOVD F0, syscall9Args_r2(R12)  // save r2
+#else
+	MOVV R2, syscall9Args_r2(R12)  // floats are returned in R2 without an FPU
+#endif
+	MOVV 16(R29), R31
+	ADDV $32, R29
+	RET
+
+// callbackasm1 is called by the entries of callbackasm in zcallback_mips64x.s
+// which leave the callback index in R1.
+//
+// The frame is laid out like:
+//	8(R29)    saved R31
+//	16(R29)   saved RSB (R28)
+//	32(R29)   callbackArgs
+//	64(R29)   F12 to F19
+//	128(R29)  R4 to R11
+//	192(R29)  the arguments on the stack of the caller
+// so that the registers are contiguous with the stack arguments.
+TEXT callbackasm1(SB), NOSPLIT|NOFRAME, $0
+	NO_LOCAL_POINTERS
+
+	ADDV $-192, R29
+	MOVV R31, 8(R29)
+	MOVV RSB, 16(R29)
+
+#ifndef GOMIPS64_softfloat
+	MOVD F12, 64(R29)
+	MOVD F13, 72(R29)
+	MOVD F14, 80(R29)
+	MOVD F15, 88(R29)
+	MOVD F16, 96(R29)
+	MOVD F17, 104(R29)
+	MOVD F18, 112(R29)
+	MOVD F19, 120(R29)
+#else
+	// floats are passed in the integer registers without an FPU
+	MOVV R4, 64(R29)
+	MOVV R5, 72(R29)
+	MOVV R6, 80(R29)
+	MOVV R7, 88(R29)
+	MOVV R8, 96(R29)
+	MOVV R9, 104(R29)
+	MOVV R10, 112(R29)
+	MOVV R11, 120(R29)
+#endif
+	MOVV R4, 128(R29)
+	MOVV R5, 136(R29)
+	MOVV R6, 144(R29)
+	MOVV R7, 152(R29)
+	MOVV R8, 160(R29)
+	MOVV R9, 168(R29)
+	MOVV R10, 176(R29)
+	MOVV R11, 184(R29)
+
+	// Create a struct callbackArgs on our stack.
+	ADDV $64, R29, R12
+	MOVV R1, (32+callbackArgs_index)(R29)  // callback index
+	MOVV R12, (32+callbackArgs_args)(R29)  // address of args vector
+	MOVV R0, (32+callbackArgs_result)(R29) // result
+
+	// Go code addresses globals relative to RSB which holds the gp of C here.
+	// Set it up like crosscall2 does: RSB = PC & 0xffffffff00000000
+	BGEZAL R0, 1(PC)
+	SRLV   $32, R31, RSB
+	SLLV   $32, RSB
+
+	// Get the ABIInternal function pointer
+	// without <ABIInternal> by using a closure.
+	MOVV ·callbackWrap_call(SB), R4
+	MOVV (R4), R4                   // fn unsafe.Pointer
+	ADDV $32, R29, R5               // frame (&callbackArgs{...})
+	MOVV R0, R7                     // ctxt uintptr
+
+	JAL crosscall2(SB)
+
+	// Get callback result.
+	MOVV (32+callbackArgs_result)(R29), R2
+
+	MOVV 16(R29), RSB
+	MOVV 8(R29), R31
+	ADDV $192, R29
+	RET
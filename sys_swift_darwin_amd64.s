@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include "textflag.h"
+#include "go_asm.h"
+
+// swiftcall calls a function like syscall9X with the Swift calling convention.
+// swiftcall takes a pointer to a struct like:
+// struct {
+//	args  *syscall9Args
+//	self  uintptr
+//	err   uintptr
+// }
+// self is passed in R13 and R12 is zeroed before the call and stored in err after it.
+// syscall9X uses both registers for arguments so the call is repeated here.
+GLOBL ·swiftcallABI0(SB), NOPTR|RODATA, $8
+DATA ·swiftcallABI0(SB)/8, $swiftcall(SB)
+TEXT swiftcall(SB), NOSPLIT|NOFRAME, $0
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $16, SP
+	MOVQ  DI, -8(BP) // save the pointer
+
+	MOVQ swiftcallArgs_self(DI), R13 // self
+	MOVQ swiftcallArgs_args(DI), DI  // args
+
+	// make space for a7 to a9 and the extra stack words after them keeping SP 16-byte aligned
+	MOVQ  syscall9Args_extra(DI), R11
+	XORL  CX, CX
+	TESTQ R11, R11
+	JZ    nostack
+	MOVQ  callExtra_nstack(R11), CX
+	MOVQ  callExtra_stack(R11), SI
+
+nostack:
+	LEAQ 4(CX), AX
+	ANDQ $~1, AX
+	SHLQ $3, AX
+	SUBQ AX, SP
+
+	// copy the extra stack words after a9
+	LEAQ 24(SP), DI
+	REP; MOVSQ
+	MOVQ -8(BP), DI
+	MOVQ swiftcallArgs_args(DI), DI
+
+	MOVQ syscall9Args_f1(DI), X0 // f1
+	MOVQ syscall9Args_f2(DI), X1 // f2
+	MOVQ syscall9Args_f3(DI), X2 // f3
+	MOVQ syscall9Args_f4(DI), X3 // f4
+	MOVQ syscall9Args_f5(DI), X4 // f5
+	MOVQ syscall9Args_f6(DI), X5 // f6
+	MOVQ syscall9Args_f7(DI), X6 // f7
+	MOVQ syscall9Args_f8(DI), X7 // f8
+
+	// the upper halves of the vector registers
+	MOVQ  syscall9Args_extra(DI), R11
+	TESTQ R11, R11
+	JZ    novec
+	MOVHPD (callExtra_hi+0)(R11), X0
+	MOVHPD (callExtra_hi+8)(R11), X1
+	MOVHPD (callExtra_hi+16)(R11), X2
+	MOVHPD (callExtra_hi+24)(R11), X3
+	MOVHPD (callExtra_hi+32)(R11), X4
+	MOVHPD (callExtra_hi+40)(R11), X5
+	MOVHPD (callExtra_hi+48)(R11), X6
+	MOVHPD (callExtra_hi+56)(R11), X7
+
+novec:
+	// push the remaining paramters onto the stack
+	MOVQ syscall9Args_a7(DI), R11 // a7
+	MOVQ R11, 0(SP)
+	MOVQ syscall9Args_a8(DI), R11 // a8
+	MOVQ R11, 8(SP)
+	MOVQ syscall9Args_a9(DI), R11 // a9
+	MOVQ R11, 16(SP)
+
+	MOVQ syscall9Args_fn(DI), R10 // fn
+	MOVQ syscall9Args_a2(DI), SI  // a2
+	MOVQ syscall9Args_a3(DI), DX  // a3
+	MOVQ syscall9Args_a4(DI), CX  // a4
+	MOVQ syscall9Args_a5(DI), R8  // a5
+	MOVQ syscall9Args_a6(DI), R9  // a6
+	MOVQ syscall9Args_a1(DI), DI  // a1
+	XORQ R12, R12                 // error
+	MOVL $8, AX                   // vararg: AL is an upper bound of the float args in registers
+
+	CALL R10
+
+	MOVQ -8(BP), DI                 // get the pointer back
+	MOVQ R12, swiftcallArgs_err(DI) // err
+	MOVQ swiftcallArgs_args(DI), DI
+	MOVQ AX, syscall9Args_r1(DI)    // r1
+	MOVQ X0, syscall9Args_r2(DI)    // r2
+	MOVQ DX, syscall9Args_r3(DI)    // r3
+	MOVQ X1, syscall9Args_rf2(DI)   // rf2
+
+	MOVQ   syscall9Args_extra(DI), R11
+	TESTQ  R11, R11
+	JZ     novecret
+	MOVHPD X0, callExtra_rhi(R11) // upper half of the vector in X0
+
+novecret:
+
+	XORL AX, AX // no error (it's ignored anyway)
+	MOVQ BP, SP
+	POPQ BP
+	RET
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include "textflag.h"
+#include "go_asm.h"
+
+// swiftcall calls a function like syscall9X with the Swift calling convention.
+// swiftcall takes a pointer to a struct like:
+// struct {
+//	args  *syscall9Args
+//	self  uintptr
+//	err   uintptr
+// }
+// self is passed in R20 and R21 is zeroed before the call and stored in err after it.
+// syscall9X doesn't use either register.
+GLOBL ·swiftcallABI0(SB), NOPTR|RODATA, $8
+DATA ·swiftcallABI0(SB)/8, $swiftcall(SB)
+TEXT swiftcall(SB), NOSPLIT, $16
+	MOVD R0, 8(RSP) // save the pointer
+
+	MOVD swiftcallArgs_self(R0), R20 // self
+	MOVD ZR, R21                     // error
+	MOVD swiftcallArgs_args(R0), R0  // args
+
+	BL syscall9X(SB)
+
+	MOVD 8(RSP), R0
+	MOVD R21, swiftcallArgs_err(R0) // err
+	RET
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include "textflag.h"
+#include "go_asm.h"
+
+// syscall9X calls a function in a DLL. It takes a pointer to syscall9Args
+// on the stack and passes a1 to a9 on the stack. Since SP is restored from BP
+// after the call it works for both stdcall functions which pop their arguments
+// and cdecl functions which don't.
+//
+// A float result is returned in ST0 on 386. If the x87 register stack isn't
+// empty after the call it is stored as a float64 in rf2 and rf3.
+GLOBL ·syscall9XABI0(SB), NOPTR|RODATA, $4
+DATA ·syscall9XABI0(SB)/4, $syscall9X(SB)
+TEXT syscall9X(SB), NOSPLIT|NOFRAME, $0
+	PUSHL BP
+	MOVL  SP, BP
+	PUSHL SI
+	MOVL  8(BP), SI // structure pointer
+
+	SUBL $36, SP
+	ANDL $~15, SP
+
+	MOVL syscall9Args_a1(SI), AX
+	MOVL AX, 0(SP)
+	MOVL syscall9Args_a2(SI), AX
+	MOVL AX, 4(SP)
+	MOVL syscall9Args_a3(SI), AX
+	MOVL AX, 8(SP)
+	MOVL syscall9Args_a4(SI), AX
+	MOVL AX, 12(SP)
+	MOVL syscall9Args_a5(SI), AX
+	MOVL AX, 16(SP)
+	MOVL syscall9Args_a6(SI), AX
+	MOVL AX, 20(SP)
+	MOVL syscall9Args_a7(SI), AX
+	MOVL AX, 24(SP)
+	MOVL syscall9Args_a8(SI), AX
+	MOVL AX, 28(SP)
+	MOVL syscall9Args_a9(SI), AX
+	MOVL AX, 32(SP)
+
+	MOVL syscall9Args_fn(SI), AX
+	CALL AX
+
+	// SI is callee-saved so it still points to the structure
+	MOVL AX, syscall9Args_r1(SI)
+	MOVL DX, syscall9Args_r2(SI)
+
+	// TOP of the x87 status word is 7 if a float was pushed
+	FSTSW AX
+	ANDL  $0x3800, AX
+	CMPL  AX, $0x3800
+	JNE   done
+	FMOVDP F0, syscall9Args_rf2(SI)
+
+done:
+	LEAL -4(BP), SP
+	POPL SI
+	POPL BP
+	RET
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+#include "textflag.h"
+#include "go_asm.h"
+
+// Offsets into the thread environment block (pointer in GS)
+#define TEB_StackLimit 0x10
+#define TEB_Self 0x30
+
+// Layout of the frame of syscall9XSEH above the shadow space and the stack arguments
+#define frame_args 80
+#define frame_prev 88
+#define frame_regs 96
+#define frame_xmm 160
+#define frame_size 320
+
+#define EXCEPTION_CONTINUE_SEARCH 0
+#define EXCEPTION_CONTINUE_EXECUTION -1
+
+// syscall9X calls a function with the Windows x64 calling convention. It takes a pointer
+// to syscall9Args in CX. The arguments are in a1 to a9 in the order of the parameters and
+// the first four are passed in both the integer and the float registers so that a float
+// parameter ends up in the XMM register of its position. Variadic functions expect the
+// integer registers and everything else ignores the one of the other kind. The result is
+// stored in r1 from AX and in r2 from X0.
+GLOBL ·syscall9XABI0(SB), NOPTR|RODATA, $8
+DATA ·syscall9XABI0(SB)/8, $syscall9X(SB)
+TEXT syscall9X(SB), NOSPLIT|NOFRAME, $0
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $80, SP // 32 bytes of shadow space, 5 stack arguments and syscall9Args
+
+	MOVQ CX, 72(SP)
+	MOVQ CX, R11
+
+	// push the parameters after the fourth onto the stack above the shadow space
+	MOVQ syscall9Args_a5(R11), AX
+	MOVQ AX, 32(SP)
+	MOVQ syscall9Args_a6(R11), AX
+	MOVQ AX, 40(SP)
+	MOVQ syscall9Args_a7(R11), AX
+	MOVQ AX, 48(SP)
+	MOVQ syscall9Args_a8(R11), AX
+	MOVQ AX, 56(SP)
+	MOVQ syscall9Args_a9(R11), AX
+	MOVQ AX, 64(SP)
+
+	MOVQ syscall9Args_a1(R11), CX
+	MOVQ syscall9Args_a2(R11), DX
+	MOVQ syscall9Args_a3(R11), R8
+	MOVQ syscall9Args_a4(R11), R9
+	MOVQ CX, X0
+	MOVQ DX, X1
+	MOVQ R8, X2
+	MOVQ R9, X3
+
+	MOVQ syscall9Args_fn(R11), R10
+	CALL R10
+
+	MOVQ 72(SP), R11
+	MOVQ AX, syscall9Args_r1(R11)
+	MOVQ X0, syscall9Args_r2(R11)
+
+	XORL AX, AX
+	MOVQ BP, SP
+	POPQ BP
+	RET
+
+// floatcallbackasm1 is called by the trampolines of floatcallbackasm with the arguments of a
+// callback in place. It finds the floatCallback of the trampoline from its return address,
+// moves the float parameters among the first four from X0 to X3 into the integer registers
+// of their position and calls the runtime callback with a copy of the stack arguments, or a
+// pointer to them if frame is set, since it can't return a float. The result is returned in
+// both AX and X0.
+GLOBL ·floatCallbackABI0(SB), NOPTR|RODATA, $8
+DATA ·floatCallbackABI0(SB)/8, $floatcallbackasm(SB)
+TEXT floatcallbackasm1(SB), NOSPLIT|NOFRAME, $0
+	// remove the return address into floatcallbackasm, we return to its caller
+	MOVQ 0(SP), AX
+	ADDQ $8, SP
+
+	// index of the trampoline, DX holds the second argument
+	MOVQ $floatcallbackasm(SB), R10
+	SUBQ R10, AX
+	MOVQ DX, R11
+	XORL DX, DX
+	MOVL $5, R10 // each CALL instruction in floatcallbackasm is 5 bytes long
+	DIVL R10
+	SUBQ $1, AX  // the return address is the next trampoline
+	MOVQ R11, DX
+
+	IMULQ $floatCallback__size, AX
+	LEAQ  ·floatCallbacks(SB), R10
+	ADDQ  AX, R10
+
+	MOVQ  floatCallback_floats(R10), AX
+	TESTQ $1, AX
+	JZ    float1
+	MOVQ  X0, CX
+
+float1:
+	TESTQ $2, AX
+	JZ    float2
+	MOVQ  X1, DX
+
+float2:
+	TESTQ $4, AX
+	JZ    float3
+	MOVQ  X2, R8
+
+float3:
+	TESTQ $8, AX
+	JZ    frame
+	MOVQ  X3, R9
+
+frame:
+	// BX is callee-saved and holds the stack pointer of the caller with the saved BX on top.
+	// The return address is at 8(BX), the shadow space at 16(BX) and the stack arguments at 48(BX).
+	SUBQ $8, SP
+	MOVQ BX, 0(SP)
+	MOVQ SP, BX
+
+	// shadow space and stack arguments rounded up to keep SP aligned to 16 bytes
+	MOVQ floatCallback_nstack(R10), AX
+	LEAQ 47(AX*8), R11
+	ANDQ $~15, R11
+	SUBQ R11, SP
+
+	// callbacks with more parameters than the runtime accepts take a pointer to them instead
+	MOVQ  floatCallback_frame(R10), R11
+	TESTQ R11, R11
+	JZ    copy
+	LEAQ  48(BX), R11
+	MOVQ  R11, 32(SP)
+	JMP   call
+
+copy:
+	TESTQ AX, AX
+	JZ    call
+	DECQ  AX
+	MOVQ  48(BX)(AX*8), R11
+	MOVQ  R11, 32(SP)(AX*8)
+	JMP   copy
+
+call:
+	MOVQ floatCallback_fn(R10), R10
+	CALL R10
+	MOVQ AX, X0
+
+	MOVQ BX, SP
+	MOVQ 0(SP), BX
+	ADDQ $8, SP
+	RET
+
+// syscall9XSEH calls a function with the Windows x64 calling convention. It takes a pointer
+// to syscall9Args in CX like syscall9X but the arguments are in a1 to a9 in the order
+// of the parameters and the first four are also passed in X0 to X3 for floats.
+//
+// While the function runs the TLS slot at sehTLSOffset points to the frame of syscall9XSEH.
+// If the function raises an exception sehHandler records it in syscall9Args and resumes
+// at sehResume with SP set to the frame, which restores the callee-saved registers the
+// function may have clobbered and returns as if the function had returned.
+GLOBL ·syscall9XSEHABI0(SB), NOPTR|RODATA, $8
+DATA ·syscall9XSEHABI0(SB)/8, $syscall9XSEH(SB)
+TEXT syscall9XSEH(SB), NOSPLIT|NOFRAME, $0
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $frame_size, SP
+
+	MOVQ   BX, (frame_regs+0)(SP)
+	MOVQ   SI, (frame_regs+8)(SP)
+	MOVQ   DI, (frame_regs+16)(SP)
+	MOVQ   R12, (frame_regs+24)(SP)
+	MOVQ   R13, (frame_regs+32)(SP)
+	MOVQ   R14, (frame_regs+40)(SP)
+	MOVQ   R15, (frame_regs+48)(SP)
+	MOVUPS X6, (frame_xmm+0)(SP)
+	MOVUPS X7, (frame_xmm+16)(SP)
+	MOVUPS X8, (frame_xmm+32)(SP)
+	MOVUPS X9, (frame_xmm+48)(SP)
+	MOVUPS X10, (frame_xmm+64)(SP)
+	MOVUPS X11, (frame_xmm+80)(SP)
+	MOVUPS X12, (frame_xmm+96)(SP)
+	MOVUPS X13, (frame_xmm+112)(SP)
+	MOVUPS X14, (frame_xmm+128)(SP)
+	MOVUPS X15, (frame_xmm+144)(SP)
+
+	// link the frame into the TLS slot
+	MOVQ CX, frame_args(SP)
+	MOVQ ·sehTLSOffset(SB), AX
+	MOVQ TEB_Self(GS), DX
+	MOVQ (DX)(AX*1), R10
+	MOVQ R10, frame_prev(SP)
+	MOVQ SP, (DX)(AX*1)
+
+	MOVQ CX, R11
+
+	// push the parameters after the fourth onto the stack above the shadow space
+	MOVQ syscall9Args_a5(R11), AX
+	MOVQ AX, 32(SP)
+	MOVQ syscall9Args_a6(R11), AX
+	MOVQ AX, 40(SP)
+	MOVQ syscall9Args_a7(R11), AX
+	MOVQ AX, 48(SP)
+	MOVQ syscall9Args_a8(R11), AX
+	MOVQ AX, 56(SP)
+	MOVQ syscall9Args_a9(R11), AX
+	MOVQ AX, 64(SP)
+
+	MOVQ syscall9Args_a1(R11), CX
+	MOVQ syscall9Args_a2(R11), DX
+	MOVQ syscall9Args_a3(R11), R8
+	MOVQ syscall9Args_a4(R11), R9
+	MOVQ CX, X0
+	MOVQ DX, X1
+	MOVQ R8, X2
+	MOVQ R9, X3
+
+	MOVQ syscall9Args_fn(R11), R10
+	CALL R10
+
+	MOVQ frame_args(SP), R11
+	MOVQ AX, syscall9Args_r1(R11)
+	MOVQ X0, syscall9Args_r2(R11)
+	JMP  sehResume(SB)
+
+// sehResume unlinks the frame of syscall9XSEH at SP, restores the registers saved in it
+// and returns from syscall9XSEH.
+TEXT sehResume(SB), NOSPLIT|NOFRAME, $0
+	MOVQ frame_prev(SP), R10
+	MOVQ ·sehTLSOffset(SB), AX
+	MOVQ TEB_Self(GS), DX
+	MOVQ R10, (DX)(AX*1)
+
+	MOVQ   (frame_regs+0)(SP), BX
+	MOVQ   (frame_regs+8)(SP), SI
+	MOVQ   (frame_regs+16)(SP), DI
+	MOVQ   (frame_regs+24)(SP), R12
+	MOVQ   (frame_regs+32)(SP), R13
+	MOVQ   (frame_regs+40)(SP), R14
+	MOVQ   (frame_regs+48)(SP), R15
+	MOVUPS (frame_xmm+0)(SP), X6
+	MOVUPS (frame_xmm+16)(SP), X7
+	MOVUPS (frame_xmm+32)(SP), X8
+	MOVUPS (frame_xmm+48)(SP), X9
+	MOVUPS (frame_xmm+64)(SP), X10
+	MOVUPS (frame_xmm+80)(SP), X11
+	MOVUPS (frame_xmm+96)(SP), X12
+	MOVUPS (frame_xmm+112)(SP), X13
+	MOVUPS (frame_xmm+128)(SP), X14
+	MOVUPS (frame_xmm+144)(SP), X15
+
+	// the frame was set up by syscall9XSEH so pop it without PUSH and POP
+	XORL AX, AX
+	MOVQ frame_size(SP), BP
+	LEAQ (frame_size+8)(SP), SP
+	RET
+
+// sehHandler is a vectored exception handler. It takes a pointer to EXCEPTION_POINTERS in CX.
+// It only handles exceptions raised below the innermost frame of syscall9XSEH on the stack
+// of the thread so that exceptions raised by Go code called back from C reach the runtime.
+GLOBL ·sehHandlerABI0(SB), NOPTR|RODATA, $8
+DATA ·sehHandlerABI0(SB)/8, $sehHandler(SB)
+TEXT sehHandler(SB), NOSPLIT|NOFRAME, $0
+	MOVQ ·sehTLSOffset(SB), AX
+	MOVQ TEB_Self(GS), DX
+	MOVQ (DX)(AX*1), R8    // frame of syscall9XSEH
+	TESTQ R8, R8
+	JZ   search
+	MOVQ 8(CX), R9         // CONTEXT
+	MOVQ 0x98(R9), R10     // CONTEXT.Rsp
+	CMPQ R10, TEB_StackLimit(DX)
+	JCS  search
+	CMPQ R10, R8
+	JCC  search
+
+	MOVQ 0(CX), R10        // EXCEPTION_RECORD
+	MOVL 0(R10), AX        // ExceptionCode
+	CMPL AX, $0x80000002   // EXCEPTION_DATATYPE_MISALIGNMENT
+	JEQ  translate
+	// only translate errors defined by the system, not software exceptions which have the
+	// customer bit set, and leave stack overflows alone as the guard page is gone
+	MOVL AX, R11
+	ANDL $0xE0000000, R11
+	CMPL R11, $0xC0000000
+	JNE  search
+	CMPL AX, $0xC00000FD   // EXCEPTION_STACK_OVERFLOW
+	JEQ  search
+
+translate:
+	MOVQ frame_args(R8), R11
+	MOVQ AX, syscall9Args_excCode(R11)
+	MOVQ 0x10(R10), DX     // ExceptionAddress
+	MOVQ DX, syscall9Args_excPC(R11)
+	// access violations and in-page errors have the kind of access and the address
+	// in the first two ExceptionInformation entries
+	CMPL AX, $0xC0000005
+	JEQ  access
+	CMPL AX, $0xC0000006
+	JNE  resume
+
+access:
+	CMPL 0x18(R10), $2     // NumberParameters
+	JCS  resume
+	MOVQ 0x20(R10), DX
+	MOVQ DX, syscall9Args_excWrite(R11)
+	MOVQ 0x28(R10), DX
+	MOVQ DX, syscall9Args_excAddr(R11)
+
+resume:
+	MOVQ R8, 0x98(R9)      // CONTEXT.Rsp
+	MOVQ $sehResume(SB), DX
+	MOVQ DX, 0xF8(R9)      // CONTEXT.Rip
+	MOVL $EXCEPTION_CONTINUE_EXECUTION, AX
+	RET
+
+search:
+	MOVL $EXCEPTION_CONTINUE_SEARCH, AX
+	RET
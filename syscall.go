@@ -5,9 +5,33 @@
 
 package purego
 
+import (
+	"runtime"
+	"unsafe"
+)
+
 const (
 	maxArgs     = 9
-	numOfFloats = 8 // arm64 and amd64 both have 8 float registers
+	numOfFloats = 8 // arm64, amd64 and mips64 all have 8 float registers
+)
+
+const (
+	// positionalArgs is true if the n-th argument is passed in the n-th integer or float register
+	// depending on its type as in the MIPS64 N64 ABI. Windows amd64 works the same but its
+	// arguments are set up in func.go regardless.
+	positionalArgs = runtime.GOARCH == "mips64" || runtime.GOARCH == "mips64le"
+	// signExtendUint32 is true if 32-bit unsigned integers must be sign-extended to 64 bits.
+	// MIPS64 requires it as its 32-bit instructions are undefined for values that aren't.
+	signExtendUint32 = runtime.GOARCH == "mips64" || runtime.GOARCH == "mips64le"
+	// bigEndian is true if a value smaller than a word is in the last bytes of the word.
+	bigEndian = runtime.GOARCH == "mips64"
+	// extraStackArgs is true if syscall9X can pass more stack words than a7 to a9 (a9 on arm64), which
+	// is needed for structs that the System V AMD64 ABI passes in memory and for variadic arguments
+	// on darwin/arm64.
+	extraStackArgs = runtime.GOARCH == "amd64" && runtime.GOOS != "windows" || runtime.GOARCH == "arm64"
+	// variadicOnStack is true if the variadic arguments of a C function are passed on the stack even
+	// if there are registers left as in the arm64 ABI of Apple.
+	variadicOnStack = (runtime.GOOS == "darwin" || runtime.GOOS == "ios") && runtime.GOARCH == "arm64"
 )
 
 // SyscallN takes fn, a C function pointer and a list of arguments as uintptr.
@@ -38,3 +62,16 @@ func SyscallN(fn uintptr, args ...uintptr) (r1, r2, err uintptr) {
 	copy(tmp[:], args)
 	return syscall_syscall9X(fn, tmp[0], tmp[1], tmp[2], tmp[3], tmp[4], tmp[5], tmp[6], tmp[7], tmp[8])
 }
+
+// callExtra holds the parts of a call that syscall9Args has no room for.
+// syscall9X only uses it on amd64 System V platforms and on arm64.
+type callExtra struct {
+	// stack points to nstack words that are passed on the stack after a7 to a9 on amd64
+	// and after a9 on arm64.
+	stack  unsafe.Pointer
+	nstack uintptr
+	// hi and rhi are the upper 64 bits of the vector registers a call with Vec128 arguments passes
+	// and returns. The lower 64 bits are in the float registers of syscall9Args.
+	hi  [numOfFloats]uintptr
+	rhi uintptr
+}
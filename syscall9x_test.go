@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux) && amd64
+
+package purego_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+// TestSyscall9XFrame checks that syscall9X doesn't write above its own frame. The frame of
+// asmcgocall that calls it holds the g the goroutine continues with after the call.
+func TestSyscall9XFrame(t *testing.T) {
+	library, err := getSystemLibrary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	libc, err := openLibrary(library)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var strlen func(s string) int
+	purego.RegisterLibFunc(&strlen, libc, "strlen")
+	done := make(chan int)
+	go func() {
+		n := 0
+		for i := 0; i < 1000; i++ {
+			n += strlen("purego")
+			runtime.Gosched()
+		}
+		done <- n
+	}()
+	if n := <-done; n != 6000 {
+		t.Errorf("strlen got a sum of %d wanted 6000", n)
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2022 The Ebitengine Authors
+
+//go:build cgo && (freebsd || linux) && !(amd64 || arm64 || mips64 || mips64le)
+
+package purego
+
+import (
+	_ "unsafe" // for go:linkname
+
+	"github.com/jwijenbergh/purego/internal/cgo"
+)
+
+var syscall9XABI0 = uintptr(cgo.Syscall9XABI0)
+
+// this is only here to make the assembly files happy :)
+type syscall9Args struct {
+	fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr
+	f1, f2, f3, f4, f5, f6, f7, f8         uintptr
+	r1, r2, err                            uintptr
+	// r3 and rf2 to rf4 are the other registers a struct is returned in: RDX and X1 on amd64
+	// and R1 and F1 to F3 on arm64. r1 and r2 hold the first integer and float register.
+	r3, rf2, rf3, rf4 uintptr
+	// arm64_r8 is passed in R8 on arm64 which holds the address a large struct is returned at.
+	arm64_r8 uintptr
+	// extra is only used on amd64 and arm64 System V platforms.
+	extra *callExtra
+}
+
+//go:nosplit
+func syscall_syscall9X(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2, err uintptr) {
+	return cgo.Syscall9X(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+func NewCallback(_ interface{}) uintptr {
+	panic("purego: NewCallback on FreeBSD and Linux is only supported on amd64/arm64")
+}
+
+func NewFrameCallback(func(f *CallbackFrame) uintptr) uintptr {
+	panic("purego: NewFrameCallback on FreeBSD and Linux is only supported on amd64/arm64")
+}
+
+func lookupCallback(uintptr) (callbackInfo, uintptr, bool) {
+	return callbackInfo{}, 0, false
+}
+
+// releaseCallback reports false since callbacks can't be released on this platform.
+func releaseCallback(uintptr) bool {
+	return false
+}
+
+func callbackCapacity() int {
+	return 0
+}
+
+func freeCallbacks() int {
+	return 0
+}
+
+func reserveCallbacks(n int) ([]int, bool) {
+	return nil, n == 0
+}
+
+func unreserveCallbacks([]int) {}
+
+func newReservedCallback(_ int, fn interface{}, _ []CallbackOption) uintptr {
+	return NewCallback(fn)
+}
+
+func callbackStatsOf(uintptr) (CallbackStats, bool) {
+	return CallbackStats{}, false
+}
+
+func allCallbackStats() []CallbackStats {
+	return nil
+}
@@ -1,14 +1,17 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2022 The Ebitengine Authors
 
-//go:build darwin || freebsd || (linux && (amd64 || arm64))
+//go:build darwin || ((freebsd || linux) && (amd64 || arm64)) || (cgo && linux && (mips64 || mips64le))
 
 package purego
 
 import (
 	"reflect"
 	"runtime"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/jwijenbergh/purego/internal/strings"
@@ -20,6 +23,14 @@ type syscall9Args struct {
 	fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr
 	f1, f2, f3, f4, f5, f6, f7, f8         uintptr
 	r1, r2, err                            uintptr
+	// r3 and rf2 to rf4 are the other registers a struct is returned in: RDX and X1 on amd64
+	// and R1 and F1 to F3 on arm64. r1 and r2 hold the first integer and float register.
+	// On mips64 r3 is R3 and rf2 to rf4 are unused.
+	r3, rf2, rf3, rf4 uintptr
+	// arm64_r8 is passed in R8 on arm64 which holds the address a large struct is returned at.
+	arm64_r8 uintptr
+	// extra is the rest of a call that needs more than the registers and a7 to a9. It is nil if there is none.
+	extra *callExtra
 }
 
 //go:nosplit
@@ -28,6 +39,8 @@ func syscall_syscall9X(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2,
 		fn, a1, a2, a3, a4, a5, a6, a7, a8, a9,
 		a1, a2, a3, a4, a5, a6, a7, a8,
 		r1, r2, err,
+		0, 0, 0, 0, 0,
+		nil,
 	}
 	runtime_cgocall(syscall9XABI0, unsafe.Pointer(&args))
 	return args.r1, args.r2, args.err
@@ -36,21 +49,42 @@ func syscall_syscall9X(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2,
 // NewCallback converts a Go function to a function pointer conforming to the C calling convention.
 // This is useful when interoperating with C code requiring callbacks. The argument is expected to be a
 // function with zero or one uintptr-sized result. The function must not have arguments with size larger than the size
-// of uintptr. Only a limited number of callbacks may be created in a single Go process, and any memory allocated
+// of uintptr except for structs which are passed by value following the C calling convention. Only a limited number of callbacks may be created in a single Go process, and any memory allocated
 // for these callbacks is never released. At least 2000 callbacks can always be created. Although this function
 // provides similar functionality to windows.NewCallback it is distinct.
+// fn can be a closure or a method value such as obj.Method whose state or receiver is kept alive with the
+// callback. Every call returns a new callback with a distinct address even for the same fn.
+// A first parameter of type context.Context receives the context of the native operation, see Correlate.
+// The callback may be called again while it runs, for example by the C function it calls, and may
+// create, call and release other callbacks. A callback takes the free slot with the lowest address,
+// including those of released callbacks, so the same sequence of calls to NewCallback and releases
+// gives the same addresses in every run regardless of the order the releases happened in.
 func NewCallback(fn interface{}) uintptr {
-	return compileCallback(fn)
+	return compileCallback(fn, &callbackConfig{})
+}
+
+// NewCallbackWith is like NewCallback but applies opts to the callback.
+func NewCallbackWith(fn interface{}, opts ...CallbackOption) uintptr {
+	cfg := newCallbackConfig(opts)
+	cfg.orig = reflect.ValueOf(fn)
+	return compileCallback(cfg.limit(fn), cfg)
 }
 
 // maxCb is the maximum number of callbacks
 // only increase this if you have added more to the callbackasm function
 const maxCB = 2000
 
-var cbs struct {
+var cbs callbacks
+
+// callbacks is the table of Go functions called from C through callbackasm.
+type callbacks struct {
 	lock  sync.Mutex
 	numFn int                  // the number of functions currently in cbs.funcs
+	free  []int                // the indices of released callbacks in increasing order
 	funcs [maxCB]reflect.Value // the saved callbacks
+	stats [maxCB]*latencyStats // allocated when a callback is first invoked with stats enabled
+	cfgs  [maxCB]callbackConfig
+	infos [maxCB]callbackInfo // kept after a callback is released until its slot is reused
 }
 
 type callbackArgs struct {
@@ -69,8 +103,23 @@ type callbackArgs struct {
 	result uintptr
 }
 
-func compileCallback(fn interface{}) uintptr {
-	val := reflect.ValueOf(fn)
+// NewFrameCallback is like NewCallback but fn reads the parameters from a CallbackFrame itself
+// instead of having them converted with reflection, which keeps reflection and its allocations out
+// of callbacks that are called often. The result is passed to C as a register so a smaller integer
+// can be returned as uintptr(x). See CallbackFrame.
+func NewFrameCallback(fn func(f *CallbackFrame) uintptr) uintptr {
+	return compileCallback(fn, &callbackConfig{frame: true})
+}
+
+func compileCallback(fn interface{}, cfg *callbackConfig) uintptr {
+	orig := cfg.orig
+	if !orig.IsValid() {
+		orig = reflect.ValueOf(fn)
+	}
+	cfg.orig = reflect.Value{}
+	slot := cfg.slot
+	cfg.slot = 0
+	val := reflect.ValueOf(splitWideArgs(cMarshalingFunc(fn)))
 	if val.Kind() != reflect.Func {
 		panic("purego: the type must be a function but was not")
 	}
@@ -80,8 +129,17 @@ func compileCallback(fn interface{}) uintptr {
 	ty := val.Type()
 	for i := 0; i < ty.NumIn(); i++ {
 		in := ty.In(i)
+		if i == 0 && in == contextType {
+			continue
+		}
+		if in == vec128Type {
+			panic("purego: Vec128 callback arguments are not supported")
+		}
 		switch in.Kind() {
-		case reflect.Struct, reflect.Interface, reflect.Func, reflect.Slice,
+		case reflect.Struct:
+			checkStruct(in)
+			continue
+		case reflect.Interface, reflect.Func, reflect.Slice,
 			reflect.Chan, reflect.Complex64, reflect.Complex128,
 			reflect.Map, reflect.Invalid:
 			panic("purego: unsupported argument type: " + in.Kind().String())
@@ -102,16 +160,116 @@ output:
 	}
 	cbs.lock.Lock()
 	defer cbs.lock.Unlock()
-	if cbs.numFn >= maxCB {
-		panic("purego: the maximum number of callbacks has been reached")
+	i := slot - 1
+	if slot == 0 {
+		var ok bool
+		if i, ok = cbs.alloc(); !ok {
+			panic("purego: the maximum number of callbacks has been reached" + callbackLeakHint())
+		}
+	}
+	cbs.funcs[i] = val
+	cbs.cfgs[i] = *cfg
+	cbs.infos[i] = newCallbackInfo(orig)
+	trackResource("callback", callbackasmAddr(i), cbs.infos[i].name)
+	return callbackasmAddr(i)
+}
+
+// releaseCallback frees the slot of the callback cb so that compileCallback reuses it.
+// It reports whether cb was a callback that wasn't released yet.
+func releaseCallback(cb uintptr) bool {
+	i, ok := callbackIndex(cb)
+	if !ok {
+		return false
+	}
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	if i >= cbs.numFn || !cbs.funcs[i].IsValid() {
+		return false
 	}
-	cbs.funcs[cbs.numFn] = val
-	cbs.numFn++
-	return callbackasmAddr(cbs.numFn - 1)
+	cbs.funcs[i] = reflect.Value{}
+	cbs.cfgs[i] = callbackConfig{}
+	cbs.stats[i] = nil
+	cbs.infos[i].released = true
+	cbs.release(i)
+	untrackResource("callback", cb)
+	return true
+}
+
+func callbackCapacity() int {
+	return maxCB
+}
+
+func freeCallbacks() int {
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	return maxCB - cbs.numFn + len(cbs.free)
+}
+
+// reserveCallbacks takes n free slots out of cbs so that only newReservedCallback uses them.
+func reserveCallbacks(n int) ([]int, bool) {
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	if maxCB-cbs.numFn+len(cbs.free) < n {
+		return nil, false
+	}
+	slots := make([]int, n)
+	for j := range slots {
+		slots[j], _ = cbs.alloc()
+	}
+	return slots, true
+}
+
+func unreserveCallbacks(slots []int) {
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	for _, i := range slots {
+		cbs.release(i)
+	}
+}
+
+// alloc takes the free slot with the lowest index, which is a released slot or else the one after
+// the slots used so far. The address of a callback thus only depends on which callbacks exist when
+// it is created and not on the order they were released in, for example by finalizers.
+// It reports false if all slots are used. c.lock must be held.
+func (c *callbacks) alloc() (int, bool) {
+	if len(c.free) > 0 {
+		i := c.free[0]
+		c.free = c.free[1:]
+		return i, true
+	}
+	if c.numFn >= maxCB {
+		return 0, false
+	}
+	c.numFn++
+	return c.numFn - 1, true
+}
+
+// release makes the slot i free again. c.lock must be held.
+func (c *callbacks) release(i int) {
+	j := sort.SearchInts(c.free, i)
+	c.free = append(c.free, 0)
+	copy(c.free[j+1:], c.free[j:])
+	c.free[j] = i
+}
+
+func newReservedCallback(slot int, fn interface{}, opts []CallbackOption) uintptr {
+	cfg := newCallbackConfig(opts)
+	cfg.orig = reflect.ValueOf(fn)
+	cfg.slot = slot + 1
+	return compileCallback(cfg.limit(fn), cfg)
 }
 
 const ptrSize = unsafe.Sizeof((*int)(nil))
 
+// wordValue returns the value of type t held in the register or stack slot w.
+func wordValue(t reflect.Type, w *uintptr) reflect.Value {
+	p := unsafe.Pointer(w)
+	if bigEndian && t.Size() < ptrSize {
+		p = unsafe.Add(p, ptrSize-t.Size())
+	}
+	return reflect.NewAt(t, p).Elem()
+}
+
 const callbackMaxFrame = 64 * ptrSize
 
 // callbackasm is implemented in zcallback_GOOS_GOARCH.s
@@ -129,9 +287,34 @@ var callbackWrap_call = callbackWrap
 // callbackWrap is called by assembly code which determines which Go function to call.
 // This function takes the arguments and passes them to the Go function and returns the result.
 func callbackWrap(a *callbackArgs) {
+	var stats *latencyStats
+	// the lock is only held to read the slot so that fn can call into C which calls back into Go,
+	// and the arguments are read from a which is on the stack of this call
 	cbs.lock.Lock()
 	fn := cbs.funcs[a.index]
+	cfg := cbs.cfgs[a.index]
+	if atomic.LoadInt32(&callbackStatsEnabled) != 0 {
+		if cbs.stats[a.index] == nil {
+			cbs.stats[a.index] = new(latencyStats)
+		}
+		stats = cbs.stats[a.index]
+	}
 	cbs.lock.Unlock()
+	if !fn.IsValid() {
+		panic("purego: a released callback was called")
+	}
+	if cfg.frame {
+		f := CallbackFrame{frame: a.args}
+		var start time.Time
+		if stats != nil {
+			start = time.Now()
+		}
+		a.result = fn.Interface().(func(*CallbackFrame) uintptr)(&f)
+		if stats != nil {
+			stats.record(start, time.Since(start))
+		}
+		return
+	}
 	fnType := fn.Type()
 	args := make([]reflect.Value, fnType.NumIn())
 	frame := (*[callbackMaxFrame]uintptr)(a.args)
@@ -140,7 +323,11 @@ func callbackWrap(a *callbackArgs) {
 	// stack points to the index into frame of the current stack element.
 	// The stack begins after the float and integer registers.
 	stack := numOfIntegerRegisters() + numOfFloats
+	withContext := len(args) > 0 && fnType.In(0) == contextType
 	for i := range args {
+		if i == 0 && withContext {
+			continue
+		}
 		var pos int
 		addInt := func() {
 			if intsN >= numOfIntegerRegisters() {
@@ -151,6 +338,9 @@ func callbackWrap(a *callbackArgs) {
 				pos = intsN + numOfFloats
 			}
 			intsN++
+			if positionalArgs {
+				floatsN = intsN
+			}
 		}
 		switch fnType.In(i).Kind() {
 		case reflect.Float32, reflect.Float64:
@@ -161,19 +351,50 @@ func callbackWrap(a *callbackArgs) {
 				pos = floatsN
 			}
 			floatsN++
-			args[i] = reflect.NewAt(fnType.In(i), unsafe.Pointer(&frame[pos])).Elem()
+			if positionalArgs {
+				intsN = floatsN
+			}
+			args[i] = wordValue(fnType.In(i), &frame[pos])
 		case reflect.String:
 			addInt()
-			args[i] = reflect.ValueOf(strings.GoString(frame[pos]))
+			if fnType.In(i) == wstringType {
+				args[i] = reflect.ValueOf(WString(GoWString(*(*unsafe.Pointer)(unsafe.Pointer(&frame[pos])))))
+			} else if cfg.stringArgs == Aliased {
+				args[i] = reflect.ValueOf(strings.GoStringNoCopy(frame[pos]))
+			} else if cfg.interned != nil {
+				args[i] = reflect.ValueOf(cfg.interned.goString(frame[pos]))
+			} else {
+				args[i] = reflect.ValueOf(strings.GoString(frame[pos]))
+			}
+		case reflect.Struct:
+			args[i] = callbackStruct(fnType.In(i), frame[:], &intsN, &floatsN, &stack)
 		default:
 			addInt()
-			args[i] = reflect.NewAt(fnType.In(i), unsafe.Pointer(&frame[pos])).Elem()
+			args[i] = wordValue(fnType.In(i), &frame[pos])
 		}
 	}
+	if withContext {
+		ctx := correlatedContext(args[1:])
+		args[0] = reflect.ValueOf(&ctx).Elem()
+		defer startTrace(ctx, "purego: callback")()
+	}
+	var start time.Time
+	if stats != nil {
+		start = time.Now()
+	}
 	ret := fn.Call(args)
+	if stats != nil {
+		stats.record(start, time.Since(start))
+	}
 	if len(ret) > 0 {
 		switch k := ret[0].Kind(); k {
-		case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr:
+		case reflect.Uint32:
+			if signExtendUint32 {
+				a.result = uintptr(int32(ret[0].Uint()))
+			} else {
+				a.result = uintptr(ret[0].Uint())
+			}
+		case reflect.Uint, reflect.Uint64, reflect.Uint16, reflect.Uint8, reflect.Uintptr:
 			a.result = uintptr(ret[0].Uint())
 		case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
 			a.result = uintptr(ret[0].Int())
@@ -193,26 +414,108 @@ func callbackWrap(a *callbackArgs) {
 	}
 }
 
+func callbackStatsOf(cb uintptr) (CallbackStats, bool) {
+	i, ok := callbackIndex(cb)
+	if !ok {
+		return CallbackStats{}, false
+	}
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	if i >= cbs.numFn || cbs.stats[i] == nil {
+		return CallbackStats{}, false
+	}
+	return cbs.callbackStats(i), true
+}
+
+func allCallbackStats() []CallbackStats {
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	var all []CallbackStats
+	for i := 0; i < cbs.numFn; i++ {
+		if cbs.stats[i] != nil {
+			all = append(all, cbs.callbackStats(i))
+		}
+	}
+	return all
+}
+
+// callbackStats returns the statistics of the i-th callback. cbs.lock must be held.
+func (c *callbacks) callbackStats(i int) CallbackStats {
+	s := c.stats[i].snapshot()
+	s.Callback = callbackasmAddr(i)
+	if f := runtime.FuncForPC(c.funcs[i].Pointer()); f != nil {
+		s.Name = f.Name()
+	}
+	return s
+}
+
+// lookupCallback returns the information about the callback whose entry in callbackasm contains
+// addr, such as a return address in a crash report, and the address of the callback.
+// It reports false if addr isn't in the entry of a callback that was created.
+func lookupCallback(addr uintptr) (callbackInfo, uintptr, bool) {
+	if addr < callbackasmABI0 {
+		return callbackInfo{}, 0, false
+	}
+	// any address in an entry of callbackasm belongs to its callback
+	i := (addr - callbackasmABI0) / (callbackasmAddr(1) - callbackasmAddr(0))
+	if i >= maxCB {
+		return callbackInfo{}, 0, false
+	}
+	cbs.lock.Lock()
+	defer cbs.lock.Unlock()
+	if int(i) >= cbs.numFn {
+		return callbackInfo{}, 0, false
+	}
+	return cbs.infos[i], callbackasmAddr(int(i)), true
+}
+
+// callbackIndex returns the index in cbs of the callback cb returned by callbackasmAddr.
+func callbackIndex(cb uintptr) (int, bool) {
+	if cb < callbackasmABI0 {
+		return 0, false
+	}
+	entrySize := callbackasmAddr(1) - callbackasmAddr(0)
+	off := cb - callbackasmABI0
+	if off%entrySize != 0 || off/entrySize >= maxCB {
+		return 0, false
+	}
+	return int(off / entrySize), true
+}
+
 // callbackasmAddr returns address of runtime.callbackasm
 // function adjusted by i.
-// On x86 and amd64, runtime.callbackasm is a series of CALL instructions,
+// On x86, runtime.callbackasm is a series of CALL instructions,
 // and we want callback to arrive at
 // correspondent call instruction instead of start of
-// runtime.callbackasm.
+// runtime.callbackasm. On amd64 each entry jumps instead of calling
+// so that the shadow stack of Intel CET matches the stack.
 // On ARM, runtime.callbackasm is a series of mov and branch instructions.
 // R12 is loaded with the callback index. Each entry is two instructions,
-// hence 8 bytes.
+// hence 8 bytes. On ARM64 each entry starts with a BTI landing pad for
+// the indirect call from C, hence 12 bytes.
 func callbackasmAddr(i int) uintptr {
 	var entrySize int
 	switch runtime.GOARCH {
 	default:
 		panic("purego: unsupported architecture")
-	case "386", "amd64":
+	case "386":
 		entrySize = 5
-	case "arm", "arm64":
-		// On ARM and ARM64, each entry is a MOV instruction
+	case "amd64":
+		// On AMD64, each entry is an ENDBR64 instruction followed by
+		// a MOV instruction and a JMP instruction
+		entrySize = 14
+	case "arm":
+		// On ARM, each entry is a MOV instruction
 		// followed by a branch instruction
 		entrySize = 8
+	case "arm64":
+		// On ARM64, each entry is a BTI instruction followed by
+		// a MOV instruction and a branch instruction
+		entrySize = 12
+	case "mips64", "mips64le":
+		// On MIPS64, each entry is a MOV instruction followed by
+		// a jump instruction and the NOP in its delay slot
+		entrySize = 12
 	}
 	return callbackasmABI0 + uintptr(i*entrySize)
 }
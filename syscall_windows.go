@@ -4,18 +4,37 @@
 package purego
 
 import (
+	"errors"
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
 	"syscall"
 	_ "unsafe" // only for go:linkname
 
 	"golang.org/x/sys/windows"
+
+	"github.com/jwijenbergh/purego/internal/fake"
 )
 
+// syscall9XABI0 is set by the assembly on 386 and amd64. It is 0 on arm where calls
+// fall back to syscall.Syscall9.
 var syscall9XABI0 uintptr
 
 type syscall9Args struct {
 	fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr
 	f1, f2, f3, f4, f5, f6, f7, f8         uintptr
 	r1, r2, err                            uintptr
+	// r3 and rf2 to rf4 are the other registers a struct is returned in: RDX and X1 on amd64
+	// and R1 and F1 to F3 on arm64. r1 and r2 hold the first integer and float register.
+	r3, rf2, rf3, rf4 uintptr
+	// arm64_r8 is passed in R8 on arm64 which holds the address a large struct is returned at.
+	arm64_r8 uintptr
+	// extra is only used on arm64.
+	extra *callExtra
+	// excCode, excPC, excAddr and excWrite describe the exception raised by the call
+	// if it was made with syscall9XSEH. excCode is 0 if there was none.
+	excCode, excPC, excAddr, excWrite uintptr
 }
 
 func syscall_syscall9X(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2, err uintptr) {
@@ -26,20 +45,215 @@ func syscall_syscall9X(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2,
 // NewCallback converts a Go function to a function pointer conforming to the stdcall calling convention.
 // This is useful when interoperating with Windows code requiring callbacks. The argument is expected to be a
 // function with one uintptr-sized result. The function must not have arguments with size larger than the
-// size of uintptr. Only a limited number of callbacks may be created in a single Go process, and any memory
+// size of uintptr except for int64, uint64 and float64 arguments on windows/386 which are passed in two
+// stack slots. Only a limited number of callbacks may be created in a single Go process, and any memory
 // allocated for these callbacks is never released. Between NewCallback and NewCallbackCDecl, at least 1024
-// callbacks can always be created. Although this function is similiar to the darwin version it may act
-// differently.
+// callbacks can always be created. On windows/amd64 fn may also have float32 and float64 parameters and
+// result which take one of 512 additional trampolines. So do callbacks with more than the 64 parameters
+// the runtime accepts, whose parameters after the fourth purego reads from the stack. Although this function
+// is similiar to the darwin version it may act differently. fn can be a closure or a method value such as
+// obj.Method. Unlike on other platforms the Go runtime returns the same callback for the same func value
+// unless it has floats or more than 64 parameters. Since callbacks are never released their addresses
+// follow the order they are first created in.
 func NewCallback(fn interface{}) uintptr {
-	return syscall.NewCallback(fn)
+	return newCallback(fn, reflect.ValueOf(fn), false)
+}
+
+// NewCallbackCDecl converts a Go function to a function pointer conforming to the cdecl calling convention.
+// It only differs from NewCallback on windows/386 where functions of the C runtime such as qsort expect
+// cdecl callbacks while the Windows API expects stdcall callbacks. A Go func passed as an argument to a
+// function registered with RegisterFunc is converted with NewCallback so pass the result of NewCallbackCDecl
+// as a uintptr to functions that expect a cdecl callback.
+func NewCallbackCDecl(fn interface{}) uintptr {
+	return newCallback(fn, reflect.ValueOf(fn), true)
+}
+
+// windowsCallbacks are the callbacks created by this package by address.
+var windowsCallbacks struct {
+	sync.Mutex
+	m        map[uintptr]callbackInfo
+	reserved int // the number of callbacks set aside by ReserveCallbacks
+}
+
+// maxRuntimeCallbacks is the number of callbacks syscall.NewCallback can create.
+const maxRuntimeCallbacks = 2000
+
+// newCallback creates the callback of fn which wraps orig, the function passed by the caller.
+func newCallback(fn interface{}, orig reflect.Value, cdecl bool) uintptr {
+	fn = splitWideArgs(cMarshalingFunc(fn))
+	// the runtime rejects floats so on amd64 they are taken as their bits and a trampoline
+	// moves the ones passed in XMM registers into the integer registers
+	var floats uintptr
+	float := floatCallbackABI0 != 0 && hasFloats(reflect.TypeOf(fn))
+	if float {
+		fn, floats = floatCallbackFunc(fn)
+	}
+	// the runtime limits the size of the argument frame so the trampoline passes the stack parameters
+	// of callbacks with more as a pointer
+	frame := floatCallbackABI0 != 0 && reflect.TypeOf(fn).Kind() == reflect.Func && reflect.TypeOf(fn).NumIn() > maxRuntimeCallbackArgs
+	if frame {
+		fn = frameCallbackFunc(fn)
+	}
+	var cb uintptr
+	if cdecl {
+		cb = syscall.NewCallbackCDecl(fn)
+	} else {
+		cb = syscall.NewCallback(fn)
+	}
+	if float || frame {
+		cb = newFloatCallback(cb, floats, reflect.TypeOf(fn).NumIn(), frame)
+	}
+	info := newCallbackInfo(orig)
+	windowsCallbacks.Lock()
+	if windowsCallbacks.m == nil {
+		windowsCallbacks.m = map[uintptr]callbackInfo{}
+	}
+	if _, ok := windowsCallbacks.m[cb]; !ok {
+		// the runtime returns the same callback for the same func so the first creation is kept
+		windowsCallbacks.m[cb] = info
+	}
+	windowsCallbacks.Unlock()
+	trackResource("callback", cb, info.name)
+	return cb
+}
+
+func lookupCallback(addr uintptr) (callbackInfo, uintptr, bool) {
+	windowsCallbacks.Lock()
+	defer windowsCallbacks.Unlock()
+	info, ok := windowsCallbacks.m[addr]
+	return info, addr, ok
+}
+
+// NewFrameCallback panics since the parameters of callbacks aren't available as a frame on Windows.
+func NewFrameCallback(func(f *CallbackFrame) uintptr) uintptr {
+	panic("purego: NewFrameCallback is not supported on Windows")
+}
+
+// NewCallbackWith is like NewCallback but applies opts to the callback.
+// Callbacks can't have string parameters on Windows so only WithMaxConcurrency has an effect.
+func NewCallbackWith(fn interface{}, opts ...CallbackOption) uintptr {
+	return newCallback(newCallbackConfig(opts).limit(fn), reflect.ValueOf(fn), false)
 }
 
 //go:linkname openLibrary openLibrary
 func openLibrary(name string) (uintptr, error) {
-	handle, err := windows.LoadLibrary(name)
-	return uintptr(handle), err
+	if h, ok := fake.Open(name); ok {
+		return h, nil
+	}
+	// a packaged process finds the DLLs it bundles in its package and the system DLLs,
+	// which aren't part of it, with LoadLibrary
+	if IsPackagedProcess() && isPackageRelative(name) {
+		if h, err := loadPackagedLibrary(name); err == nil {
+			trackResource("library", h, name)
+			return h, nil
+		} else if err != windows.ERROR_MOD_NOT_FOUND {
+			return 0, loadLibraryError(name, err)
+		}
+	}
+	handle, err := windows.LoadLibraryEx(loaderPath(name), 0, 0)
+	if err != nil {
+		return 0, loadLibraryError(name, err)
+	}
+	trackResource("library", uintptr(handle), name)
+	return uintptr(handle), nil
 }
 
 func loadSymbol(handle uintptr, name string) (uintptr, error) {
-	return windows.GetProcAddress(windows.Handle(handle), name)
+	if u, ok := fake.Symbol(handle, name); ok {
+		return u, nil
+	}
+	if fake.IsHandle(handle) {
+		return 0, errors.New("purego: undefined symbol: " + name)
+	}
+	addr, err := windows.GetProcAddress(windows.Handle(handle), name)
+	if err != nil && runtime.GOARCH == "386" {
+		if addr, ok := decoratedProc(windows.Handle(handle), name); ok {
+			return addr, nil
+		}
+	}
+	return addr, err
+}
+
+// maxStdcallArgBytes is the largest size of the arguments of a stdcall function decoratedProc looks for.
+const maxStdcallArgBytes = 256
+
+// decoratedProc looks up the function name by the names the 32-bit compilers of Microsoft decorate it with
+// since many DLLs only export the decorated names: _name@N and name@N of stdcall functions, where N is the
+// size of the arguments in bytes which is a multiple of 4, and _name of cdecl functions.
+func decoratedProc(handle windows.Handle, name string) (uintptr, bool) {
+	if addr, err := windows.GetProcAddress(handle, "_"+name); err == nil {
+		return addr, true
+	}
+	for n := 0; n <= maxStdcallArgBytes; n += 4 {
+		suffix := "@" + strconv.Itoa(n)
+		for _, decorated := range [...]string{"_" + name + suffix, name + suffix} {
+			if addr, err := windows.GetProcAddress(handle, decorated); err == nil {
+				return addr, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func closeLibrary(handle uintptr) error {
+	if fake.IsHandle(handle) {
+		return nil
+	}
+	untrackResource("library", handle)
+	return windows.FreeLibrary(windows.Handle(handle))
+}
+
+// releaseCallback reports false since callbacks can't be released on this platform.
+func releaseCallback(uintptr) bool {
+	return false
+}
+
+func callbackCapacity() int {
+	return maxRuntimeCallbacks
+}
+
+func freeCallbacks() int {
+	windowsCallbacks.Lock()
+	defer windowsCallbacks.Unlock()
+	return freeCallbacksLocked()
+}
+
+// freeCallbacksLocked returns the number of callbacks the runtime can still create for purego
+// which every entry of windowsCallbacks took one of. windowsCallbacks must be locked.
+func freeCallbacksLocked() int {
+	if n := maxRuntimeCallbacks - len(windowsCallbacks.m) - windowsCallbacks.reserved; n > 0 {
+		return n
+	}
+	return 0
+}
+
+// reserveCallbacks counts n callbacks as reserved since the runtime picks the slots itself.
+func reserveCallbacks(n int) ([]int, bool) {
+	windowsCallbacks.Lock()
+	defer windowsCallbacks.Unlock()
+	if freeCallbacksLocked() < n {
+		return nil, false
+	}
+	windowsCallbacks.reserved += n
+	return make([]int, n), true
+}
+
+func unreserveCallbacks(slots []int) {
+	windowsCallbacks.Lock()
+	windowsCallbacks.reserved -= len(slots)
+	windowsCallbacks.Unlock()
+}
+
+func newReservedCallback(_ int, fn interface{}, opts []CallbackOption) uintptr {
+	cb := NewCallbackWith(fn, opts...)
+	unreserveCallbacks([]int{0})
+	return cb
+}
+
+func callbackStatsOf(uintptr) (CallbackStats, bool) {
+	return CallbackStats{}, false
+}
+
+func allCallbackStats() []CallbackStats {
+	return nil
 }
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"math"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestNewCallbackCDecl(t *testing.T) {
+	libc, err := openLibrary("ucrtbase.dll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []int32{88, 56, 100, 2, 25}
+	sorted := []int32{2, 25, 56, 88, 100}
+	compare := purego.NewCallbackCDecl(func(a, b *int32) int32 {
+		return *a - *b
+	})
+	var qsort func(data []int32, nitems uintptr, size uintptr, compar uintptr)
+	purego.RegisterLibFunc(&qsort, libc, "qsort")
+	qsort(data, uintptr(len(data)), unsafe.Sizeof(int32(0)), compare)
+	for i := range data {
+		if data[i] != sorted[i] {
+			t.Errorf("got %d wanted %d at %d", data[i], sorted[i], i)
+		}
+	}
+}
+
+func TestCallbackWideArgs(t *testing.T) {
+	if runtime.GOARCH != "386" {
+		t.Skip("64-bit arguments only take two words on windows/386")
+	}
+	var got int64
+	var gotF float64
+	cb := purego.NewCallbackCDecl(func(a int64, b int32, f float64) uintptr {
+		got, gotF = a+int64(b), f
+		return 0
+	})
+	a, f := int64(-1<<40), math.Float64bits(2.5)
+	// 64-bit arguments take two stack slots with the low word first
+	purego.SyscallN(cb, uintptr(uint64(a)), uintptr(uint64(a)>>32), 3, uintptr(f), uintptr(f>>32))
+	if got != a+3 || gotF != 2.5 {
+		t.Errorf("got %d, %v wanted %d, 2.5", got, gotF, a+3)
+	}
+}
+
+func TestFloatReturn(t *testing.T) {
+	libc, err := openLibrary("ucrtbase.dll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var floor func(x float64) float64
+	purego.RegisterLibFunc(&floor, libc, "floor")
+	var floorf func(x float32) float32
+	purego.RegisterLibFunc(&floorf, libc, "floorf")
+	var labs func(x int32) int32
+	purego.RegisterLibFunc(&labs, libc, "labs")
+	var ldexp func(x float64, exp int32) float64
+	purego.RegisterLibFunc(&ldexp, libc, "ldexp")
+	var fmaf func(x, y, z float32) float32
+	purego.RegisterLibFunc(&fmaf, libc, "fmaf")
+
+	if got := floor(2.5); got != 2 {
+		t.Errorf("floor(2.5) = %v, want 2", got)
+	}
+	if got := floorf(-2.5); got != -3 {
+		t.Errorf("floorf(-2.5) = %v, want -3", got)
+	}
+	// an integer result leaves the x87 registers alone
+	if got := labs(-7); got != 7 {
+		t.Errorf("labs(-7) = %d, want 7", got)
+	}
+	if got := floor(1e10 + 0.5); got != 1e10 {
+		t.Errorf("floor(1e10 + 0.5) = %v, want 1e10", got)
+	}
+	// the integer takes the second integer register and the float the first float register
+	if got := ldexp(1.5, 3); got != 12 {
+		t.Errorf("ldexp(1.5, 3) = %v, want 12", got)
+	}
+	if got := fmaf(2, 3, 0.5); got != 6.5 {
+		t.Errorf("fmaf(2, 3, 0.5) = %v, want 6.5", got)
+	}
+}
+
+func TestCallbackFloats(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("float callbacks are only supported on windows/amd64")
+	}
+	// the floats among the first four parameters are passed in XMM registers and the rest on the stack
+	cb := purego.NewCallback(func(a int32, b float64, c float32, d int64, e float64, f float32) float64 {
+		return float64(a) + b + float64(c) + float64(d) + e + float64(f)
+	})
+	var call func(a int32, b float64, c float32, d int64, e float64, f float32) float64
+	purego.RegisterFunc(&call, cb)
+	if got := call(1, 0.5, 0.25, 100, 0.125, 1000); got != 1101.875 {
+		t.Errorf("got %v wanted 1101.875", got)
+	}
+
+	cbf := purego.NewCallbackCDecl(func(x float32) float32 { return x * 2 })
+	var callf func(x float32) float32
+	purego.RegisterFunc(&callf, cbf)
+	if got := callf(1.25); got != 2.5 {
+		t.Errorf("got %v wanted 2.5", got)
+	}
+	if info, ok := purego.LookupCallback(cbf); !ok || info.Signature != "func(float32) float32" {
+		t.Errorf("LookupCallback of a float callback got %+v, %v", info, ok)
+	}
+}
+
+func TestCallbackManyArgs(t *testing.T) {
+	if runtime.GOARCH != "amd64" {
+		t.Skip("callbacks with more parameters than the runtime accepts are only supported on windows/amd64")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libcbtest.dll")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := openLibrary(libFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 70 parameters where the second and the last are floats like many_callback
+	in := make([]reflect.Type, 70)
+	for i := range in {
+		in[i] = reflect.TypeOf(int64(0))
+	}
+	in[1], in[69] = reflect.TypeOf(float64(0)), reflect.TypeOf(float64(0))
+	var got []float64
+	fn := reflect.MakeFunc(reflect.FuncOf(in, []reflect.Type{reflect.TypeOf(int64(0))}, false), func(args []reflect.Value) []reflect.Value {
+		var sum int64
+		for _, a := range args {
+			if a.Kind() == reflect.Float64 {
+				got = append(got, a.Float())
+				sum += int64(a.Float())
+			} else {
+				sum += a.Int()
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(sum)}
+	})
+	cb := purego.NewCallback(fn.Interface())
+	var callMany func(cb uintptr) int64
+	purego.RegisterLibFunc(&callMany, lib, "callMany")
+	if sum := callMany(cb); sum != 70*71/2 {
+		t.Errorf("sum got %d wanted %d", sum, 70*71/2)
+	}
+	if len(got) != 2 || got[0] != 2 || got[1] != 70 {
+		t.Errorf("float parameters got %v wanted [2 70]", got)
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+import "sync"
+
+var pthreadSelf struct {
+	once sync.Once
+	addr uintptr
+}
+
+// curThreadID returns the pthread_t of the thread the goroutine runs on. A callback runs on the
+// thread C called it on until it returns so the ID tells nested calls on the same thread apart
+// from concurrent calls on other threads.
+func curThreadID() uintptr {
+	pthreadSelf.once.Do(func() {
+		pthreadSelf.addr = fnDlsym(RTLD_DEFAULT, "pthread_self")
+		if pthreadSelf.addr == 0 {
+			panic("purego: pthread_self not found")
+		}
+	})
+	id, _, _ := syscall_syscall9X(pthreadSelf.addr, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	return id
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "golang.org/x/sys/windows"
+
+// curThreadID returns the ID of the thread the goroutine runs on. A callback runs on the thread
+// C called it on until it returns so the ID tells nested calls on the same thread apart from
+// concurrent calls on other threads.
+func curThreadID() uintptr {
+	return uintptr(windows.GetCurrentThreadId())
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// ThreadLocal is a thread-local C variable, such as a variable declared with __thread or
+// _Thread_local that a library exports or one returned by an accessor function like errno.
+// Every OS thread has a copy of the variable of its own so its address is only valid on
+// the thread it was taken on. Since the Go scheduler moves goroutines between threads,
+// the variable must be accessed while the goroutine is locked to its thread, for example with Do:
+//
+//	// extern __thread int last_error;
+//	lastError, err := purego.NewThreadLocal(lib, "last_error")
+//	lastError.Do(func(p unsafe.Pointer) {
+//		doSomething() // a C function that sets last_error
+//		fmt.Println(*(*int32)(p))
+//	})
+//
+// Calls of C functions run on the thread of the calling goroutine so C functions called inside
+// Do see the same copy of the variable.
+type ThreadLocal struct {
+	addr func() uintptr
+}
+
+// NewThreadLocal returns the thread-local variable name exported by the library handle.
+// Windows can't export thread-local variables from a DLL so it returns an error there,
+// see ThreadLocalFunc instead.
+func NewThreadLocal(handle uintptr, name string) (*ThreadLocal, error) {
+	addr, err := threadLocalAddr(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	return &ThreadLocal{addr: addr}, nil
+}
+
+// ThreadLocal is like NewThreadLocal but looks up the variable name in the library.
+// Library.Rebind doesn't change the variable which stays the one of the previous library.
+func (l *Library) ThreadLocal(name string) (*ThreadLocal, error) {
+	return NewThreadLocal(l.Handle(), name)
+}
+
+// ThreadLocalFunc returns the thread-local variable whose address on the calling thread is returned
+// by the C function accessor which takes no arguments, such as __errno_location of glibc,
+// __error of macOS or _errno of the C runtime of Windows.
+func ThreadLocalFunc(accessor uintptr) *ThreadLocal {
+	if accessor == 0 {
+		panic("purego: accessor is nil")
+	}
+	return &ThreadLocal{addr: func() uintptr {
+		r1, _, _ := SyscallN(accessor)
+		return r1
+	}}
+}
+
+// Addr returns the address of the variable on the current thread. It is only useful if the goroutine
+// is locked to its thread with runtime.LockOSThread and must not be used after it is unlocked.
+func (t *ThreadLocal) Addr() unsafe.Pointer {
+	addr := t.addr()
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
+
+// Do locks the goroutine to its thread and calls fn with the address of the variable on the thread.
+// The address must not be used after fn returns.
+func (t *ThreadLocal) Do(fn func(p unsafe.Pointer)) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	fn(t.Addr())
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+// tlvDescriptor is struct tlv_descriptor of dyld which the symbol of a thread-local variable points to.
+type tlvDescriptor struct {
+	thunk  uintptr // void *(*thunk)(struct tlv_descriptor *)
+	key    uintptr
+	offset uintptr
+}
+
+// threadLocalAddr returns a function that returns the address of the thread-local variable name on the
+// calling thread by calling the thunk of its descriptor like the code the compiler generates does.
+func threadLocalAddr(handle uintptr, name string) (func() uintptr, error) {
+	sym, err := Dlsym(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fake.Func(sym); ok {
+		return nil, Dlerror{"purego: fake symbol " + name + " is not a variable"}
+	}
+	desc := (*tlvDescriptor)(*(*unsafe.Pointer)(unsafe.Pointer(&sym)))
+	return func() uintptr {
+		r1, _, _ := SyscallN(desc.thunk, sym)
+		return r1
+	}, nil
+}
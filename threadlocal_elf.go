@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import "github.com/jwijenbergh/purego/internal/fake"
+
+// threadLocalAddr returns a function that returns the address of the thread-local variable name on the
+// calling thread. The dynamic loaders of glibc, musl and FreeBSD resolve the symbol of a thread-local
+// variable to its address on the thread that calls dlsym, allocating the block of the library if needed.
+func threadLocalAddr(handle uintptr, name string) (func() uintptr, error) {
+	sym, err := Dlsym(handle, name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fake.Func(sym); ok {
+		return nil, Dlerror{"purego: fake symbol " + name + " is not a variable"}
+	}
+	return func() uintptr {
+		sym, err := Dlsym(handle, name)
+		if err != nil {
+			panic(err)
+		}
+		return sym
+	}, nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestThreadLocal(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libtlstest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libtlstest", "tls.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var setValue func(v int32)
+	purego.RegisterLibFunc(&setValue, lib, "set_tls_value")
+	location, err := purego.Dlsym(lib, "tls_value_location")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported, err := purego.NewThreadLocal(lib, "tls_value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tl := range []struct {
+		name string
+		*purego.ThreadLocal
+	}{
+		{"NewThreadLocal", exported},
+		{"ThreadLocalFunc", purego.ThreadLocalFunc(location)},
+	} {
+		tl.Do(func(p unsafe.Pointer) {
+			setValue(42)
+			if got := *(*int32)(p); got != 42 {
+				t.Errorf("%s: value after set_tls_value(42) is %d", tl.name, got)
+			}
+			// another goroutine can't run on this thread while it is locked
+			done := make(chan int32)
+			go tl.Do(func(p unsafe.Pointer) {
+				done <- *(*int32)(p)
+			})
+			if got := <-done; got != 7 {
+				t.Errorf("%s: value on another thread is %d wanted 7", tl.name, got)
+			}
+			*(*int32)(p) = 7
+		})
+	}
+
+	if _, err := purego.NewThreadLocal(lib, "missing"); err == nil {
+		t.Errorf("NewThreadLocal of a missing variable succeeded")
+	}
+}
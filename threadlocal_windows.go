@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import "errors"
+
+// threadLocalAddr returns an error since a DLL can't export its thread-local variables.
+func threadLocalAddr(handle uintptr, name string) (func() uintptr, error) {
+	return nil, errors.New("purego: thread-local variables can't be exported on Windows: " + name)
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && (darwin || freebsd || linux)
+
+package purego
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// RegisterTraceback registers C traceback functions with runtime.SetCgoTraceback so that crashes
+// and CPU profile samples inside a C function show the name of the function and the path of its
+// library instead of stopping at runtime.cgocall. Only the innermost C frame is shown.
+//
+// The runtime only calls traceback functions in binaries built with cgo so RegisterTraceback
+// returns an error when CGO_ENABLED=0. It also returns an error if different traceback functions,
+// for example from a symbolizer package, are already registered.
+func RegisterTraceback() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("purego: %v", r)
+		}
+	}()
+	runtime.SetCgoTraceback(0, cgoTraceback(), nil, cgoSymbolizer())
+	return nil
+}
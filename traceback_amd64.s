@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && (darwin || freebsd || linux)
+
+#include "textflag.h"
+
+// The functions below are registered with runtime.SetCgoTraceback and are called
+// by runtime/cgo with the C calling convention.
+
+// tracebackABI0 and symbolizerABI0 hold the addresses of the functions
+GLOBL ·tracebackABI0(SB), NOPTR|RODATA, $8
+DATA ·tracebackABI0(SB)/8, $purego_traceback(SB)
+GLOBL ·symbolizerABI0(SB), NOPTR|RODATA, $8
+DATA ·symbolizerABI0(SB)/8, $purego_symbolizer(SB)
+
+// purego_traceback takes a pointer to a struct like:
+// struct {
+//	context    uintptr
+//	sigContext uintptr
+//	buf        *uintptr
+//	max        uintptr
+// }
+// It only reports the PC a signal interrupted. Unwinding C frames from a signal handler
+// isn't safe without frame pointers and the PC is enough to name the C function and library.
+TEXT purego_traceback(SB), NOSPLIT|NOFRAME, $0
+	MOVQ  24(DI), DX // max
+	TESTQ DX, DX
+	JZ    done
+	MOVQ  16(DI), CX // buf
+	MOVQ  $0, 0(CX)  // terminate an empty traceback
+	CMPQ  DX, $2
+	JB    done
+	MOVQ  8(DI), AX  // sigContext
+	TESTQ AX, AX
+	JZ    done
+
+#ifdef GOOS_darwin
+	MOVQ 48(AX), AX  // uc_mcontext
+	MOVQ 144(AX), AX // uc_mcontext->__ss.__rip
+#endif
+#ifdef GOOS_freebsd
+	MOVQ 176(AX), AX // uc_mcontext.mc_rip
+#endif
+#ifdef GOOS_linux
+	MOVQ 168(AX), AX // uc_mcontext.gregs[REG_RIP]
+#endif
+
+	MOVQ AX, 0(CX)
+	MOVQ $0, 8(CX)
+
+done:
+	RET
+
+// purego_symbolizer takes a pointer to a struct like:
+// struct {
+//	pc     uintptr
+//	file   *byte
+//	lineno uintptr
+//	fn     *byte
+//	entry  uintptr
+//	more   uintptr
+//	data   uintptr
+// }
+// It names the function and library of pc with dladdr.
+TEXT purego_symbolizer(SB), NOSPLIT|NOFRAME, $0
+	PUSHQ BP
+	MOVQ  SP, BP
+	SUBQ  $48, SP    // Dl_info at 0(SP)
+	MOVQ  DI, 32(SP) // save the pointer
+
+	MOVQ  $0, 40(DI) // more
+	MOVQ  0(DI), DI  // pc
+	TESTQ DI, DI
+	JZ    unknown
+	MOVQ  SP, SI
+	CALL  purego_dladdr(SB)
+	MOVQ  32(SP), DI // get the pointer back
+	TESTL AX, AX
+	JZ    unknown
+
+	MOVQ 0(SP), AX  // dli_fname
+	MOVQ AX, 8(DI)  // file
+	MOVQ $0, 16(DI) // lineno
+	MOVQ 16(SP), AX // dli_sname
+	MOVQ AX, 24(DI) // fn
+	MOVQ 24(SP), AX // dli_saddr
+	MOVQ AX, 32(DI) // entry
+	JMP  done
+
+unknown:
+	MOVQ 32(SP), DI
+	MOVQ $0, 8(DI)
+	MOVQ $0, 24(DI)
+	MOVQ $0, 32(DI)
+
+done:
+	ADDQ $48, SP
+	MOVQ BP, SP
+	POPQ BP
+	RET
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && (darwin || freebsd || linux)
+
+#include "textflag.h"
+
+// The functions below are registered with runtime.SetCgoTraceback and are called
+// by runtime/cgo with the C calling convention.
+
+// tracebackABI0 and symbolizerABI0 hold the addresses of the functions
+GLOBL ·tracebackABI0(SB), NOPTR|RODATA, $8
+DATA ·tracebackABI0(SB)/8, $purego_traceback(SB)
+GLOBL ·symbolizerABI0(SB), NOPTR|RODATA, $8
+DATA ·symbolizerABI0(SB)/8, $purego_symbolizer(SB)
+
+// purego_traceback takes a pointer to a struct like:
+// struct {
+//	context    uintptr
+//	sigContext uintptr
+//	buf        *uintptr
+//	max        uintptr
+// }
+// It only reports the PC a signal interrupted. Unwinding C frames from a signal handler
+// isn't safe without frame pointers and the PC is enough to name the C function and library.
+TEXT purego_traceback(SB), NOSPLIT|NOFRAME, $0
+	MOVD 24(R0), R3 // max
+	CBZ  R3, done
+	MOVD 16(R0), R2 // buf
+	MOVD ZR, 0(R2)  // terminate an empty traceback
+	CMP  $2, R3
+	BLO  done
+	MOVD 8(R0), R1  // sigContext
+	CBZ  R1, done
+
+#ifdef GOOS_darwin
+	MOVD 48(R1), R1  // uc_mcontext
+	MOVD 272(R1), R1 // uc_mcontext->__ss.__pc
+#endif
+#ifdef GOOS_freebsd
+	MOVD 272(R1), R1 // uc_mcontext.mc_gpregs.gp_elr
+#endif
+#ifdef GOOS_linux
+	MOVD 440(R1), R1 // uc_mcontext.pc
+#endif
+
+	MOVD R1, 0(R2)
+	MOVD ZR, 8(R2)
+
+done:
+	RET
+
+// purego_symbolizer takes a pointer to a struct like:
+// struct {
+//	pc     uintptr
+//	file   *byte
+//	lineno uintptr
+//	fn     *byte
+//	entry  uintptr
+//	more   uintptr
+//	data   uintptr
+// }
+// It names the function and library of pc with dladdr.
+TEXT purego_symbolizer(SB), NOSPLIT, $0
+	SUB  $48, RSP    // Dl_info at 0(RSP)
+	MOVD R0, 32(RSP) // save the pointer
+
+	MOVD ZR, 40(R0) // more
+	MOVD 0(R0), R0  // pc
+	CBZ  R0, unknown
+	MOVD RSP, R1
+	BL   purego_dladdr(SB)
+	MOVD R0, R3
+	MOVD 32(RSP), R0 // get the pointer back
+	CBZW R3, unknown
+
+	MOVD 0(RSP), R1  // dli_fname
+	MOVD R1, 8(R0)   // file
+	MOVD ZR, 16(R0)  // lineno
+	MOVD 16(RSP), R1 // dli_sname
+	MOVD R1, 24(R0)  // fn
+	MOVD 24(RSP), R1 // dli_saddr
+	MOVD R1, 32(R0)  // entry
+	B    done
+
+unknown:
+	MOVD 32(RSP), R0
+	MOVD ZR, 8(R0)
+	MOVD ZR, 24(R0)
+	MOVD ZR, 32(R0)
+
+done:
+	ADD $48, RSP
+	RET
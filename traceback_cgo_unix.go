@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && (freebsd || linux) && !(amd64 || arm64)
+
+package purego
+
+import (
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/cgotraceback"
+)
+
+func cgoTraceback() unsafe.Pointer {
+	return cgotraceback.Traceback
+}
+
+func cgoSymbolizer() unsafe.Pointer {
+	return cgotraceback.Symbolizer
+}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (!cgo && (darwin || freebsd || linux)) || windows
+
+package purego
+
+import "errors"
+
+// RegisterTraceback registers C traceback functions with runtime.SetCgoTraceback so that crashes
+// and CPU profile samples inside a C function show the name of the function and the path of its
+// library instead of stopping at runtime.cgocall.
+//
+// The runtime only calls traceback functions in binaries built with cgo so it always returns an
+// error on this platform.
+func RegisterTraceback() error {
+	return errors.New("purego: C tracebacks require cgo")
+}
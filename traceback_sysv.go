@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && (darwin || ((freebsd || linux) && (amd64 || arm64)))
+
+package purego
+
+import "unsafe"
+
+// tracebackABI0 and symbolizerABI0 are the addresses of purego_traceback and
+// purego_symbolizer in traceback_GOARCH.s.
+var tracebackABI0, symbolizerABI0 uintptr
+
+func cgoTraceback() unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&tracebackABI0))
+}
+
+func cgoSymbolizer() unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&symbolizerABI0))
+}
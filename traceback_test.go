@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build cgo && (darwin || freebsd || linux)
+
+package purego_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestRegisterTraceback(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libcbtest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libcbtest", "callback.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+	sym, err := purego.Dlsym(lib, "callCallback")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := purego.RegisterTraceback(); err != nil {
+		t.Fatal(err)
+	}
+	// registering the same functions again is allowed
+	if err := purego.RegisterTraceback(); err != nil {
+		t.Errorf("second RegisterTraceback failed: %v", err)
+	}
+
+	// the symbolizer is used for every PC that isn't Go code
+	frames := runtime.CallersFrames([]uintptr{sym})
+	frame, _ := frames.Next()
+	if frame.Function != "callCallback" {
+		t.Errorf("Function got %q wanted %q", frame.Function, "callCallback")
+	}
+	if filepath.Base(frame.File) != filepath.Base(libFileName) {
+		t.Errorf("File got %q wanted %q", frame.File, libFileName)
+	}
+	if frame.Entry != sym {
+		t.Errorf("Entry got %#x wanted %#x", frame.Entry, sym)
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+// VaList is a C va_list received by a callback, for example by the log callbacks of libvlc
+// and FFmpeg:
+//
+//	void (*callback)(void *avcl, int level, const char *fmt, va_list vl);
+//
+// which is written as
+//
+//	func(avcl unsafe.Pointer, level int32, fmt string, vl purego.VaList)
+//
+// The arguments are read with Args or formatted with Sprintf. A VaList is only valid
+// until the callback returns.
+type VaList uintptr
+
+// Args returns a reader of the arguments in v. Reading doesn't change v so it can
+// still be formatted or passed to a C function afterwards.
+func (v VaList) Args() *VaArgs {
+	return &VaArgs{s: v.state()}
+}
+
+// VaArgs reads the arguments of a VaList in order like va_arg. The caller must know
+// the types of the arguments, usually from the format string. Like in C, integers
+// smaller than int are promoted to int and float to double.
+type VaArgs struct {
+	s vaState
+}
+
+// Int32 reads the next int or unsigned int.
+func (a *VaArgs) Int32() int32 {
+	return *(*int32)(a.s.next(4, false))
+}
+
+// Int64 reads the next long long or unsigned long long. It also reads a long on
+// 64-bit platforms other than Windows.
+func (a *VaArgs) Int64() int64 {
+	return *(*int64)(a.s.next(8, false))
+}
+
+// Pointer reads the next pointer, which is also the size of size_t.
+func (a *VaArgs) Pointer() uintptr {
+	return *(*uintptr)(a.s.next(unsafe.Sizeof(uintptr(0)), false))
+}
+
+// String reads the next char* and copies the null-terminated string it points to.
+func (a *VaArgs) String() string {
+	return strings.GoString(a.Pointer())
+}
+
+// Float64 reads the next double.
+func (a *VaArgs) Float64() float64 {
+	return math.Float64frombits(*(*uint64)(a.s.next(8, true)))
+}
+
+var vaLibc struct {
+	once sync.Once
+	err  error
+	// vsnprintf returns the length of the formatted string even if buf is too small.
+	vsnprintf func(buf *byte, size uintptr, format string, ap VaList) int32
+	// vscprintf returns the length of the formatted string. It is only set on Windows
+	// where vsnprintf returns -1 instead.
+	vscprintf func(format string, ap VaList) int32
+}
+
+func loadVaLibc() error {
+	vaLibc.once.Do(func() {
+		name, vsnprintf := "", "vsnprintf"
+		switch runtime.GOOS {
+		case "darwin":
+			name = "/usr/lib/libSystem.B.dylib"
+		case "freebsd":
+			name = "libc.so.7"
+		case "linux":
+			name = "libc.so.6"
+		case "windows":
+			name, vsnprintf = "msvcrt.dll", "_vsnprintf"
+		}
+		lib, err := openLibrary(name)
+		if err != nil {
+			vaLibc.err = err
+			return
+		}
+		sym, err := loadSymbol(lib, vsnprintf)
+		if err != nil {
+			vaLibc.err = err
+			return
+		}
+		RegisterFunc(&vaLibc.vsnprintf, sym)
+		if runtime.GOOS == "windows" {
+			sym, err := loadSymbol(lib, "_vscprintf")
+			if err != nil {
+				vaLibc.err = err
+				return
+			}
+			RegisterFunc(&vaLibc.vscprintf, sym)
+		}
+	})
+	return vaLibc.err
+}
+
+// Sprintf formats the arguments in v with the printf format string format using vsnprintf
+// from libc. It panics if libc can't be loaded.
+func (v VaList) Sprintf(format string) string {
+	if err := loadVaLibc(); err != nil {
+		panic("purego: loading vsnprintf: " + err.Error())
+	}
+	// vsnprintf consumes the va_list so each call gets its own copy like va_copy
+	probe, keep := v.copy()
+	var n int32
+	if vaLibc.vscprintf != nil {
+		n = vaLibc.vscprintf(format, probe)
+	} else {
+		n = vaLibc.vsnprintf(nil, 0, format, probe)
+	}
+	runtime.KeepAlive(keep)
+	if n <= 0 {
+		return ""
+	}
+	buf := make([]byte, n+1)
+	ap, keep := v.copy()
+	vaLibc.vsnprintf(&buf[0], uintptr(len(buf)), format, ap)
+	runtime.KeepAlive(keep)
+	return string(buf[:n])
+}
+
+// vaPointer converts the address p of a va_list argument to a pointer.
+func vaPointer(p uintptr) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&p))
+}
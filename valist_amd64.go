@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego
+
+import "unsafe"
+
+// vaState is the System V va_list struct __va_list_tag. A va_list is an array of one
+// so a function receives a pointer to it.
+type vaState struct {
+	gpOffset        uint32 // offset in regSaveArea of the next integer register
+	fpOffset        uint32 // offset in regSaveArea of the next SSE register
+	overflowArgArea uintptr
+	regSaveArea     uintptr
+}
+
+const (
+	vaGPEnd = 6 * 8          // the six integer registers
+	vaFPEnd = vaGPEnd + 8*16 // followed by the eight SSE registers
+)
+
+func (v VaList) state() vaState {
+	return *(*vaState)(vaPointer(uintptr(v)))
+}
+
+func (s *vaState) next(size uintptr, float bool) unsafe.Pointer {
+	var p uintptr
+	switch {
+	case !float && s.gpOffset < vaGPEnd:
+		p = s.regSaveArea + uintptr(s.gpOffset)
+		s.gpOffset += 8
+	case float && s.fpOffset < vaFPEnd:
+		p = s.regSaveArea + uintptr(s.fpOffset)
+		s.fpOffset += 16
+	default:
+		p = s.overflowArgArea
+		s.overflowArgArea += 8
+	}
+	return vaPointer(p)
+}
+
+// copy returns a copy of v like va_copy and the memory to keep alive while it is used.
+func (v VaList) copy() (VaList, interface{}) {
+	s := new(vaState)
+	*s = v.state()
+	return VaList(unsafe.Pointer(s)), s
+}
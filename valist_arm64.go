@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build freebsd || linux
+
+package purego
+
+import "unsafe"
+
+// vaState is the AAPCS64 va_list struct. It is larger than 16 bytes so a function
+// receives a pointer to a copy of it.
+type vaState struct {
+	stack  uintptr // the next argument on the stack
+	grTop  uintptr // the end of the saved integer registers
+	vrTop  uintptr // the end of the saved SIMD registers
+	grOffs int32   // negative offset from grTop of the next integer register
+	vrOffs int32   // negative offset from vrTop of the next SIMD register
+}
+
+func (v VaList) state() vaState {
+	return *(*vaState)(vaPointer(uintptr(v)))
+}
+
+func (s *vaState) next(size uintptr, float bool) unsafe.Pointer {
+	var p uintptr
+	switch {
+	case !float && s.grOffs < 0:
+		p = s.grTop + uintptr(int(s.grOffs))
+		s.grOffs += 8
+	case float && s.vrOffs < 0:
+		p = s.vrTop + uintptr(int(s.vrOffs))
+		s.vrOffs += 16
+	default:
+		p = s.stack
+		s.stack += 8
+	}
+	return vaPointer(p)
+}
+
+// copy returns a copy of v like va_copy and the memory to keep alive while it is used.
+func (v VaList) copy() (VaList, interface{}) {
+	s := new(vaState)
+	*s = v.state()
+	return VaList(unsafe.Pointer(s)), s
+}
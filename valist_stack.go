@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build (darwin || freebsd || linux || windows) && ((darwin && arm64) || windows || (!amd64 && !arm64))
+
+package purego
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// vaState is a va_list that points to the next argument in memory, where every argument
+// takes a word or two words for 64-bit values on 32-bit platforms.
+type vaState struct {
+	p uintptr
+}
+
+const vaSlot = unsafe.Sizeof(uintptr(0))
+
+func (v VaList) state() vaState {
+	return vaState{p: uintptr(v)}
+}
+
+func (s *vaState) next(size uintptr, _ bool) unsafe.Pointer {
+	p := s.p
+	if size < vaSlot {
+		if bigEndian {
+			// the value is in the last bytes of its slot
+			p += vaSlot - size
+		}
+		size = vaSlot
+	}
+	if runtime.GOARCH == "arm" {
+		// 64-bit values are aligned to 8 bytes
+		s.p = alignUp(s.p, size)
+		p = s.p
+	}
+	s.p += size
+	return vaPointer(p)
+}
+
+// copy returns a copy of v like va_copy and the memory to keep alive while it is used.
+func (v VaList) copy() (VaList, interface{}) {
+	return v, nil
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego/internal/fake"
+)
+
+// RegisterLibVar sets the pointer vptr points to to the address of the variable name exported by the
+// library handle, such as the tunables, version strings and configuration structs many C libraries
+// expose as globals. The type of the pointer must match the C variable:
+//
+//	// extern int opterr;
+//	var opterr *int32
+//	purego.RegisterLibVar(&opterr, libc, "opterr")
+//	*opterr = 0
+//
+//	// extern const char version[];
+//	var version *[16]byte
+//	// extern const char *name;
+//	var name **byte
+//
+// vptr may also point to an unsafe.Pointer or uintptr which is set to the address. Reads and writes
+// through the pointer access the memory of the library directly so they are not synchronized with C
+// and the pointer must not be used after the library is closed. The variable must not be set to Go
+// pointers since the garbage collector doesn't see them. It panics if the symbol can't be found.
+func RegisterLibVar(vptr interface{}, handle uintptr, name string) {
+	sym, err := loadSymbol(handle, name)
+	if err != nil {
+		panic(err)
+	}
+	registerVar(vptr, sym)
+}
+
+// RegisterLibVarE is like RegisterLibVar but returns an error instead of panicking.
+func RegisterLibVarE(vptr interface{}, handle uintptr, name string) (err error) {
+	defer recoverError(&err)
+	RegisterLibVar(vptr, handle, name)
+	return nil
+}
+
+// RegisterVar is like RegisterLibVar but looks up the variable name in the library.
+// Library.Rebind doesn't change the pointer which keeps pointing into the previous library.
+func (l *Library) RegisterVar(vptr interface{}, name string) {
+	sym, err := l.Lookup(name)
+	if err != nil {
+		panic(err)
+	}
+	registerVar(vptr, sym)
+}
+
+// RegisterVarE is like l.RegisterVar but returns an error instead of panicking.
+func (l *Library) RegisterVarE(vptr interface{}, name string) (err error) {
+	defer recoverError(&err)
+	l.RegisterVar(vptr, name)
+	return nil
+}
+
+func registerVar(vptr interface{}, addr uintptr) {
+	v := reflect.ValueOf(vptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic("purego: vptr must be a pointer to a pointer")
+	}
+	if _, ok := fake.Func(addr); ok {
+		panic("purego: fake symbols are functions and can't be registered as variables")
+	}
+	v = v.Elem()
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.NewAt(v.Type().Elem(), *(*unsafe.Pointer)(unsafe.Pointer(&addr))))
+	case reflect.UnsafePointer:
+		v.SetPointer(*(*unsafe.Pointer)(unsafe.Pointer(&addr)))
+	case reflect.Uintptr:
+		v.SetUint(uint64(addr))
+	default:
+		panic("purego: vptr must be a pointer to a pointer, unsafe.Pointer or uintptr but is " + v.Type().String())
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+	"github.com/jwijenbergh/purego/internal/strings"
+)
+
+func TestRegisterLibVar(t *testing.T) {
+	libFileName := filepath.Join(t.TempDir(), "libvartest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libvartest", "var.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var counter *int32
+	purego.RegisterLibVar(&counter, lib, "counter")
+	var getCounter func() int32
+	purego.RegisterLibFunc(&getCounter, lib, "get_counter")
+	if *counter != 1 {
+		t.Errorf("counter is %d wanted 1", *counter)
+	}
+	*counter = 42
+	if got := getCounter(); got != 42 {
+		t.Errorf("get_counter got %d wanted 42", got)
+	}
+
+	var version *[6]byte
+	purego.RegisterLibVar(&version, lib, "version")
+	if got := string(version[:5]); got != "1.2.3" {
+		t.Errorf("version is %q wanted %q", got, "1.2.3")
+	}
+
+	var name **byte
+	purego.RegisterLibVar(&name, lib, "name")
+	if got := strings.GoString(uintptr(unsafe.Pointer(*name))); got != "purego" {
+		t.Errorf("name is %q wanted %q", got, "purego")
+	}
+
+	var config *struct {
+		Level int32
+		Ratio float64
+	}
+	purego.RegisterLibVar(&config, lib, "config")
+	var configProduct func() float64
+	purego.RegisterLibFunc(&configProduct, lib, "config_product")
+	config.Level = 10
+	if got := configProduct(); got != 5 {
+		t.Errorf("config_product got %v wanted 5", got)
+	}
+
+	var addr uintptr
+	purego.RegisterLibVar(&addr, lib, "counter")
+	if addr != uintptr(unsafe.Pointer(counter)) {
+		t.Errorf("address of counter is %#x wanted %p", addr, counter)
+	}
+
+	if err := purego.RegisterLibVarE(&counter, lib, "missing"); err == nil {
+		t.Errorf("RegisterLibVarE of a missing variable succeeded")
+	}
+	var notPointer int32
+	if err := purego.RegisterLibVarE(&notPointer, lib, "counter"); err == nil {
+		t.Errorf("RegisterLibVarE into an int32 succeeded")
+	}
+}
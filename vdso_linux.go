@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+var vdso struct {
+	once sync.Once
+	err  error
+	syms map[string]uintptr // the run-time addresses of the functions of the vDSO
+}
+
+// LookupVDSO returns the address of the function name exported by the vDSO, the shared object
+// the Linux kernel maps into every process so that functions such as clock_gettime, gettimeofday
+// and getcpu run without entering the kernel. The address is called like any other C function
+// with RegisterFunc or SyscallN:
+//
+//	addr, err := purego.LookupVDSO("clock_gettime")
+//	var clockGettime func(clock int32, ts *unix.Timespec) int32
+//	purego.RegisterFunc(&clockGettime, addr)
+//
+// The vDSO exports its functions with a prefix that depends on the architecture such as
+// __vdso_clock_gettime on amd64 and __kernel_clock_gettime on arm64. name may be given with or
+// without it. Which functions exist also depends on the architecture and the kernel; getcpu for
+// example is missing on arm64. The vDSO is found through the auxiliary vector in /proc/self/auxv.
+func LookupVDSO(name string) (uintptr, error) {
+	vdso.once.Do(func() {
+		vdso.syms, vdso.err = loadVDSO()
+	})
+	if vdso.err != nil {
+		return 0, vdso.err
+	}
+	for _, prefix := range []string{"", "__vdso_", "__kernel_"} {
+		if addr, ok := vdso.syms[prefix+name]; ok {
+			return addr, nil
+		}
+	}
+	return 0, errors.New("purego: the vDSO doesn't export " + name)
+}
+
+// loadVDSO reads the dynamic symbol table of the vDSO from memory.
+func loadVDSO() (map[string]uintptr, error) {
+	base, err := vdsoBase()
+	if err != nil {
+		return nil, err
+	}
+	// the image of the vDSO is mapped in full up to its section headers which come last
+	ident := unsafe.Slice((*byte)(imagePointer(base)), elf.EI_NIDENT)
+	if !bytes.Equal(ident[:4], []byte(elf.ELFMAG)) {
+		return nil, errors.New("purego: the vDSO isn't an ELF image")
+	}
+	var size uintptr
+	if elf.Class(ident[elf.EI_CLASS]) == elf.ELFCLASS64 {
+		h := (*elf.Header64)(imagePointer(base))
+		size = uintptr(h.Shoff) + uintptr(h.Shnum)*uintptr(h.Shentsize)
+	} else {
+		h := (*elf.Header32)(imagePointer(base))
+		size = uintptr(h.Shoff) + uintptr(h.Shnum)*uintptr(h.Shentsize)
+	}
+	f, err := elf.NewFile(bytes.NewReader(unsafe.Slice((*byte)(imagePointer(base)), size)))
+	if err != nil {
+		return nil, errors.New("purego: reading the vDSO: " + err.Error())
+	}
+	// the symbols hold link-time addresses relative to the segment that maps the start of the image
+	bias := base
+	for _, p := range f.Progs {
+		if p.Type == elf.PT_LOAD && p.Off == 0 {
+			bias -= uintptr(p.Vaddr)
+			break
+		}
+	}
+	list, err := f.DynamicSymbols()
+	if err != nil {
+		return nil, errors.New("purego: reading the symbols of the vDSO: " + err.Error())
+	}
+	syms := map[string]uintptr{}
+	for _, s := range list {
+		if s.Section == elf.SHN_UNDEF || elf.ST_TYPE(s.Info) != elf.STT_FUNC {
+			continue
+		}
+		syms[s.Name] = bias + uintptr(s.Value)
+	}
+	return syms, nil
+}
+
+// vdsoBase returns the address of the vDSO from the auxiliary vector.
+func vdsoBase() (uintptr, error) {
+	base, err := auxvValue(atSysinfoEHdr)
+	if err != nil {
+		return 0, err
+	}
+	if base == 0 {
+		return 0, errors.New("purego: the process has no vDSO")
+	}
+	return base, nil
+}
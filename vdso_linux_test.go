@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego_test
+
+import (
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestLookupVDSO(t *testing.T) {
+	addr, err := purego.LookupVDSO("clock_gettime")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const clockRealtime = 0
+	var clockGettime func(clock int32, ts *syscall.Timespec) int32
+	purego.RegisterFunc(&clockGettime, addr)
+	before := time.Now()
+	var ts syscall.Timespec
+	if r := clockGettime(clockRealtime, &ts); r != 0 {
+		t.Fatalf("clock_gettime returned %d", r)
+	}
+	got := time.Unix(ts.Unix())
+	if d := got.Sub(before); d < -time.Second || d > time.Minute {
+		t.Errorf("clock_gettime returned %v which is %v from time.Now", got, d)
+	}
+
+	if runtime.GOARCH == "amd64" {
+		getcpu, err := purego.LookupVDSO("__vdso_getcpu")
+		if err != nil {
+			t.Fatal(err)
+		}
+		cpu := ^uint32(0)
+		if r, _, _ := purego.SyscallN(getcpu, uintptr(unsafe.Pointer(&cpu)), 0, 0); r != 0 || cpu == ^uint32(0) {
+			t.Errorf("getcpu returned %d with cpu %d", int32(r), cpu)
+		}
+	}
+	if _, err := purego.LookupVDSO("does_not_exist"); err == nil {
+		t.Errorf("LookupVDSO of a missing function succeeded")
+	}
+}
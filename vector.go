@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"runtime"
+)
+
+// Vec128 is a 128-bit vector such as __m128, __m128d and __m128i on amd64 or float32x4_t
+// and the other 128-bit NEON types on arm64. As an argument or return value of a function
+// registered with RegisterFunc it is passed in a whole XMM or NEON register like C does
+// and takes one of the float registers. Its bytes are in memory order so the first
+// element of a vector is in the lowest bytes as on the little-endian amd64 and arm64.
+//
+//	// __m128 scale(__m128 v, float s);
+//	var scale func(v purego.Vec128, s float32) purego.Vec128
+//	v := scale(purego.Float32x4(1, 2, 3, 4), 2)
+//	fmt.Println(v.Float32x4()) // [2 4 6 8]
+//
+// Vectors are only supported on amd64 and arm64 except Windows and only as arguments and
+// return values while the float registers last. They can't be passed on the stack, as
+// struct fields or to callbacks.
+type Vec128 [16]byte
+
+var vec128Type = reflect.TypeOf(Vec128{})
+
+// Float32x4 returns the vector of four float32.
+func Float32x4(a, b, c, d float32) Vec128 {
+	var v Vec128
+	for i, f := range [...]float32{a, b, c, d} {
+		binary.LittleEndian.PutUint32(v[i*4:], math.Float32bits(f))
+	}
+	return v
+}
+
+// Float64x2 returns the vector of two float64.
+func Float64x2(a, b float64) Vec128 {
+	return Uint64x2(math.Float64bits(a), math.Float64bits(b))
+}
+
+// Uint64x2 returns the vector of two uint64.
+func Uint64x2(a, b uint64) Vec128 {
+	var v Vec128
+	binary.LittleEndian.PutUint64(v[:8], a)
+	binary.LittleEndian.PutUint64(v[8:], b)
+	return v
+}
+
+// Float32x4 returns the four float32 in v.
+func (v Vec128) Float32x4() [4]float32 {
+	var f [4]float32
+	for i := range f {
+		f[i] = math.Float32frombits(binary.LittleEndian.Uint32(v[i*4:]))
+	}
+	return f
+}
+
+// Float64x2 returns the two float64 in v.
+func (v Vec128) Float64x2() [2]float64 {
+	u := v.Uint64x2()
+	return [2]float64{math.Float64frombits(u[0]), math.Float64frombits(u[1])}
+}
+
+// Uint64x2 returns the two uint64 in v.
+func (v Vec128) Uint64x2() [2]uint64 {
+	return [2]uint64{binary.LittleEndian.Uint64(v[:8]), binary.LittleEndian.Uint64(v[8:])}
+}
+
+// vectorsSupported is true if syscall9X passes and returns the upper halves of the vector registers.
+const vectorsSupported = (runtime.GOARCH == "amd64" || runtime.GOARCH == "arm64") && runtime.GOOS != "windows"
+
+// vectorArgs reports whether the function type ty has a Vec128 parameter or result.
+// It panics if the platform doesn't support vectors.
+func vectorArgs(ty reflect.Type) bool {
+	uses := ty.NumOut() == 1 && ty.Out(0) == vec128Type
+	for i := 0; i < ty.NumIn(); i++ {
+		if ty.In(i) == vec128Type {
+			uses = true
+		}
+	}
+	if uses && !vectorsSupported {
+		panic("purego: Vec128 is not supported on " + runtime.GOOS + "/" + runtime.GOARCH)
+	}
+	return uses
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux
+
+package purego_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestVec128(t *testing.T) {
+	if runtime.GOARCH != "amd64" && runtime.GOARCH != "arm64" {
+		t.Skip("vectors are only supported on amd64 and arm64")
+	}
+	libFileName := filepath.Join(t.TempDir(), "libvectest.so")
+	if err := buildSharedLib("CC", libFileName, filepath.Join("libvectest", "vector.c")); err != nil {
+		t.Fatal(err)
+	}
+	lib, err := purego.Dlopen(libFileName, purego.RTLD_NOW|purego.RTLD_GLOBAL)
+	if err != nil {
+		t.Fatalf("Dlopen(%q) failed: %v", libFileName, err)
+	}
+	defer purego.Dlclose(lib)
+
+	var float4Scale func(v purego.Vec128, s float32) purego.Vec128
+	purego.RegisterLibFunc(&float4Scale, lib, "float4_scale")
+	if got, want := float4Scale(purego.Float32x4(1, 2, 3, 4), 2).Float32x4(), [4]float32{2, 4, 6, 8}; got != want {
+		t.Errorf("float4_scale got %v wanted %v", got, want)
+	}
+
+	var double2Add func(a purego.Vec128, b float64, c purego.Vec128) purego.Vec128
+	purego.RegisterLibFunc(&double2Add, lib, "double2_add")
+	if got, want := double2Add(purego.Float64x2(1, 2), 0.5, purego.Float64x2(10, 20)).Float64x2(), [2]float64{11.5, 22.5}; got != want {
+		t.Errorf("double2_add got %v wanted %v", got, want)
+	}
+
+	var float4Sum func(a int64, v0, v1, v2, v3, v4, v5, v6, v7 purego.Vec128, b int64) int64
+	purego.RegisterLibFunc(&float4Sum, lib, "float4_sum")
+	v := purego.Float32x4(1, 2, 3, 4)
+	if got := float4Sum(100, v, v, v, v, v, v, v, v, 1000); got != 100+8*10+1000 {
+		t.Errorf("float4_sum got %d wanted %d", got, 100+8*10+1000)
+	}
+
+	var tooMany func(v0, v1, v2, v3, v4, v5, v6, v7, v8 purego.Vec128)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registering a function with more vectors than float registers didn't panic")
+		}
+	}()
+	purego.RegisterLibFunc(&tooMany, lib, "float4_sum")
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+// APIVersion is the version of the purego API. Bindings can check it to support several
+// versions of purego at once.
+//
+// Within an APIVersion exported identifiers are never removed or changed incompatibly.
+// An identifier that is superseded stays as a deprecated alias that keeps working. Its
+// documentation has a "Deprecated:" paragraph naming the replacement and every use is logged
+// as a runtime/trace event in the category "purego.deprecated", so that running a program
+// under `go test -trace` or runtime/trace shows the code that still has to migrate.
+// Deprecated aliases are only removed when APIVersion is incremented.
+const APIVersion = 1
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || freebsd || linux || windows
+
+package purego
+
+import (
+	"reflect"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// WString is a string that is passed to and returned from C as a NUL-terminated wchar_t*
+// for libraries with wide-character APIs such as ncursesw, ICU or ODBC. wchar_t is UTF-32
+// on Linux, macOS and FreeBSD and UTF-16 on Windows. Like a string argument the wchar_t*
+// is only valid during the call, and a returned wchar_t* is copied into a Go string:
+//
+//	// int waddwstr(WINDOW *win, const wchar_t *wstr);
+//	var waddwstr func(win uintptr, wstr purego.WString) int32
+//	waddwstr(win, "héllo wörld")
+//
+// Invalid UTF-8 is converted to U+FFFD. A WString parameter of a callback receives a copy
+// of the wchar_t* C passes.
+type WString string
+
+var wstringType = reflect.TypeOf(WString(""))
+
+// WCString returns a copy of s as a NUL-terminated wchar_t* that is valid until the next call of Reset.
+func (a *Arena) WCString(s string) unsafe.Pointer {
+	if wcharSize == 2 {
+		u := utf16.Encode([]rune(s))
+		w := unsafe.Slice((*uint16)(a.Alloc(2*uintptr(len(u)+1), 2)), len(u)+1)
+		copy(w, u)
+		return unsafe.Pointer(&w[0])
+	}
+	w := unsafe.Slice((*uint32)(a.Alloc(4*uintptr(utf8.RuneCountInString(s)+1), 4)), utf8.RuneCountInString(s)+1)
+	i := 0
+	for _, r := range s {
+		w[i] = uint32(r)
+		i++
+	}
+	return unsafe.Pointer(&w[0])
+}
+
+// GoWString copies the NUL-terminated wchar_t* p into a Go string. Invalid code points and
+// unpaired UTF-16 surrogates are converted to U+FFFD. A nil p is the empty string.
+func GoWString(p unsafe.Pointer) string {
+	if p == nil {
+		return ""
+	}
+	var runes []rune
+	if wcharSize == 2 {
+		var n int
+		for *(*uint16)(unsafe.Add(p, 2*n)) != 0 {
+			n++
+		}
+		runes = utf16.Decode(unsafe.Slice((*uint16)(p), n))
+	} else {
+		for i := 0; ; i++ {
+			c := *(*uint32)(unsafe.Add(p, 4*i))
+			if c == 0 {
+				break
+			}
+			r := rune(c)
+			if !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			runes = append(runes, r)
+		}
+	}
+	return string(runes)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+//go:build darwin || ((freebsd || linux) && (!cgo || amd64 || arm64)) || (linux && (mips64 || mips64le))
+
+package purego_test
+
+import (
+	"testing"
+
+	"github.com/jwijenbergh/purego"
+)
+
+func TestWString(t *testing.T) {
+	name, err := getSystemLibrary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	libc, err := openLibrary(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const s = "héllo wörld 😀"
+
+	var wcslen func(s purego.WString) uintptr
+	purego.RegisterLibFunc(&wcslen, libc, "wcslen")
+	if got, want := wcslen(s), uintptr(len([]rune(s))); got != want {
+		t.Errorf("wcslen got %d wanted %d", got, want)
+	}
+	if got := wcslen(""); got != 0 {
+		t.Errorf("wcslen of the empty string got %d", got)
+	}
+
+	var wcschr func(s purego.WString, c rune) purego.WString
+	purego.RegisterLibFunc(&wcschr, libc, "wcschr")
+	if got := wcschr(s, 'w'); got != "wörld 😀" {
+		t.Errorf("wcschr got %q", got)
+	}
+	if got := wcschr(s, 'z'); got != "" {
+		t.Errorf("wcschr of a missing character got %q", got)
+	}
+
+	var got purego.WString
+	cb := purego.NewCallback(func(s purego.WString) { got = s })
+	var call func(s purego.WString)
+	purego.RegisterFunc(&call, cb)
+	call(s)
+	if got != s {
+		t.Errorf("callback got %q wanted %q", got, s)
+	}
+}
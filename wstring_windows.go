@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+package purego
+
+// wcharSize is the size of wchar_t which holds a UTF-16 code unit.
+const wcharSize = 2
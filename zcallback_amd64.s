@@ -7,2008 +7,6010 @@
 // called from the start, instead runtime·compilecallback
 // always returns address into runtime·callbackasm offset
 // appropriately so different callbacks start with different
-// CALL instruction in runtime·callbackasm. This determines
-// which Go callback function is executed later on.
+// entries in runtime·callbackasm. Each entry is an ENDBR64
+// landing pad for the indirect call, a MOV of its index to AX
+// and a JMP to callbackasm1 which doesn't push a return address
+// that would be missing from the shadow stack of Intel CET.
 #include "textflag.h"
 
 TEXT callbackasm(SB), NOSPLIT|NOFRAME, $0
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
-	CALL callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $0, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $2, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $3, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $4, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $5, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $6, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $7, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $8, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $9, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $10, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $11, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $12, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $13, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $14, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $15, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $16, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $17, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $18, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $19, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $20, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $21, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $22, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $23, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $24, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $25, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $26, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $27, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $28, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $29, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $30, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $31, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $32, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $33, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $34, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $35, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $36, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $37, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $38, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $39, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $40, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $41, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $42, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $43, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $44, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $45, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $46, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $47, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $48, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $49, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $50, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $51, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $52, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $53, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $54, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $55, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $56, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $57, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $58, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $59, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $60, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $61, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $62, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $63, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $64, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $65, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $66, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $67, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $68, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $69, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $70, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $71, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $72, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $73, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $74, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $75, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $76, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $77, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $78, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $79, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $80, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $81, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $82, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $83, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $84, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $85, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $86, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $87, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $88, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $89, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $90, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $91, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $92, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $93, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $94, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $95, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $96, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $97, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $98, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $99, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $100, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $101, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $102, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $103, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $104, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $105, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $106, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $107, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $108, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $109, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $110, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $111, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $112, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $113, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $114, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $115, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $116, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $117, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $118, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $119, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $120, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $121, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $122, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $123, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $124, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $125, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $126, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $127, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $128, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $129, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $130, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $131, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $132, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $133, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $134, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $135, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $136, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $137, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $138, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $139, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $140, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $141, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $142, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $143, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $144, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $145, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $146, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $147, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $148, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $149, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $150, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $151, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $152, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $153, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $154, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $155, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $156, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $157, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $158, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $159, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $160, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $161, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $162, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $163, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $164, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $165, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $166, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $167, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $168, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $169, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $170, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $171, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $172, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $173, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $174, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $175, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $176, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $177, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $178, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $179, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $180, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $181, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $182, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $183, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $184, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $185, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $186, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $187, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $188, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $189, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $190, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $191, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $192, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $193, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $194, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $195, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $196, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $197, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $198, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $199, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $200, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $201, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $202, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $203, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $204, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $205, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $206, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $207, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $208, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $209, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $210, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $211, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $212, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $213, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $214, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $215, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $216, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $217, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $218, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $219, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $220, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $221, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $222, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $223, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $224, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $225, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $226, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $227, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $228, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $229, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $230, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $231, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $232, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $233, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $234, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $235, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $236, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $237, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $238, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $239, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $240, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $241, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $242, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $243, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $244, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $245, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $246, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $247, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $248, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $249, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $250, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $251, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $252, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $253, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $254, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $255, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $256, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $257, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $258, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $259, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $260, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $261, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $262, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $263, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $264, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $265, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $266, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $267, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $268, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $269, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $270, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $271, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $272, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $273, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $274, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $275, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $276, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $277, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $278, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $279, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $280, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $281, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $282, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $283, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $284, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $285, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $286, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $287, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $288, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $289, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $290, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $291, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $292, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $293, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $294, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $295, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $296, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $297, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $298, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $299, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $300, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $301, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $302, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $303, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $304, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $305, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $306, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $307, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $308, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $309, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $310, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $311, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $312, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $313, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $314, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $315, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $316, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $317, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $318, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $319, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $320, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $321, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $322, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $323, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $324, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $325, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $326, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $327, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $328, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $329, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $330, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $331, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $332, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $333, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $334, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $335, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $336, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $337, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $338, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $339, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $340, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $341, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $342, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $343, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $344, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $345, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $346, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $347, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $348, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $349, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $350, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $351, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $352, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $353, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $354, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $355, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $356, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $357, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $358, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $359, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $360, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $361, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $362, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $363, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $364, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $365, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $366, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $367, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $368, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $369, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $370, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $371, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $372, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $373, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $374, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $375, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $376, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $377, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $378, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $379, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $380, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $381, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $382, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $383, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $384, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $385, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $386, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $387, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $388, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $389, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $390, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $391, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $392, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $393, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $394, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $395, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $396, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $397, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $398, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $399, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $400, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $401, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $402, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $403, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $404, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $405, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $406, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $407, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $408, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $409, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $410, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $411, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $412, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $413, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $414, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $415, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $416, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $417, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $418, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $419, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $420, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $421, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $422, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $423, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $424, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $425, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $426, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $427, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $428, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $429, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $430, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $431, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $432, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $433, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $434, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $435, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $436, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $437, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $438, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $439, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $440, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $441, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $442, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $443, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $444, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $445, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $446, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $447, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $448, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $449, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $450, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $451, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $452, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $453, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $454, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $455, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $456, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $457, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $458, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $459, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $460, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $461, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $462, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $463, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $464, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $465, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $466, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $467, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $468, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $469, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $470, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $471, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $472, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $473, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $474, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $475, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $476, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $477, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $478, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $479, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $480, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $481, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $482, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $483, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $484, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $485, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $486, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $487, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $488, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $489, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $490, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $491, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $492, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $493, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $494, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $495, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $496, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $497, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $498, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $499, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $500, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $501, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $502, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $503, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $504, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $505, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $506, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $507, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $508, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $509, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $510, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $511, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $512, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $513, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $514, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $515, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $516, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $517, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $518, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $519, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $520, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $521, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $522, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $523, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $524, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $525, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $526, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $527, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $528, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $529, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $530, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $531, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $532, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $533, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $534, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $535, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $536, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $537, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $538, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $539, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $540, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $541, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $542, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $543, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $544, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $545, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $546, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $547, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $548, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $549, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $550, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $551, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $552, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $553, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $554, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $555, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $556, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $557, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $558, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $559, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $560, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $561, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $562, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $563, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $564, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $565, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $566, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $567, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $568, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $569, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $570, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $571, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $572, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $573, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $574, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $575, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $576, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $577, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $578, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $579, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $580, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $581, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $582, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $583, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $584, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $585, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $586, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $587, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $588, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $589, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $590, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $591, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $592, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $593, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $594, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $595, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $596, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $597, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $598, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $599, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $600, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $601, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $602, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $603, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $604, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $605, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $606, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $607, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $608, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $609, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $610, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $611, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $612, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $613, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $614, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $615, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $616, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $617, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $618, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $619, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $620, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $621, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $622, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $623, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $624, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $625, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $626, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $627, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $628, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $629, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $630, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $631, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $632, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $633, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $634, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $635, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $636, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $637, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $638, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $639, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $640, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $641, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $642, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $643, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $644, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $645, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $646, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $647, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $648, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $649, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $650, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $651, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $652, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $653, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $654, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $655, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $656, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $657, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $658, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $659, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $660, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $661, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $662, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $663, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $664, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $665, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $666, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $667, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $668, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $669, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $670, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $671, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $672, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $673, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $674, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $675, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $676, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $677, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $678, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $679, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $680, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $681, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $682, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $683, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $684, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $685, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $686, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $687, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $688, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $689, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $690, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $691, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $692, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $693, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $694, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $695, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $696, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $697, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $698, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $699, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $700, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $701, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $702, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $703, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $704, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $705, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $706, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $707, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $708, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $709, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $710, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $711, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $712, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $713, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $714, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $715, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $716, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $717, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $718, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $719, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $720, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $721, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $722, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $723, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $724, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $725, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $726, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $727, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $728, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $729, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $730, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $731, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $732, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $733, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $734, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $735, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $736, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $737, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $738, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $739, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $740, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $741, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $742, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $743, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $744, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $745, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $746, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $747, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $748, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $749, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $750, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $751, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $752, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $753, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $754, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $755, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $756, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $757, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $758, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $759, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $760, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $761, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $762, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $763, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $764, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $765, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $766, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $767, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $768, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $769, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $770, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $771, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $772, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $773, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $774, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $775, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $776, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $777, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $778, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $779, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $780, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $781, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $782, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $783, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $784, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $785, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $786, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $787, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $788, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $789, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $790, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $791, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $792, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $793, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $794, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $795, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $796, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $797, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $798, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $799, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $800, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $801, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $802, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $803, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $804, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $805, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $806, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $807, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $808, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $809, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $810, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $811, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $812, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $813, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $814, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $815, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $816, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $817, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $818, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $819, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $820, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $821, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $822, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $823, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $824, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $825, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $826, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $827, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $828, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $829, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $830, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $831, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $832, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $833, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $834, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $835, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $836, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $837, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $838, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $839, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $840, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $841, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $842, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $843, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $844, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $845, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $846, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $847, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $848, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $849, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $850, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $851, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $852, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $853, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $854, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $855, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $856, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $857, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $858, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $859, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $860, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $861, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $862, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $863, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $864, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $865, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $866, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $867, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $868, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $869, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $870, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $871, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $872, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $873, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $874, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $875, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $876, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $877, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $878, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $879, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $880, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $881, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $882, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $883, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $884, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $885, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $886, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $887, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $888, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $889, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $890, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $891, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $892, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $893, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $894, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $895, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $896, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $897, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $898, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $899, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $900, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $901, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $902, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $903, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $904, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $905, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $906, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $907, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $908, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $909, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $910, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $911, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $912, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $913, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $914, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $915, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $916, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $917, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $918, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $919, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $920, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $921, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $922, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $923, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $924, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $925, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $926, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $927, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $928, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $929, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $930, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $931, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $932, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $933, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $934, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $935, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $936, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $937, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $938, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $939, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $940, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $941, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $942, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $943, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $944, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $945, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $946, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $947, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $948, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $949, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $950, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $951, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $952, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $953, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $954, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $955, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $956, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $957, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $958, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $959, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $960, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $961, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $962, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $963, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $964, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $965, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $966, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $967, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $968, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $969, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $970, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $971, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $972, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $973, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $974, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $975, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $976, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $977, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $978, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $979, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $980, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $981, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $982, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $983, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $984, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $985, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $986, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $987, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $988, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $989, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $990, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $991, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $992, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $993, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $994, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $995, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $996, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $997, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $998, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $999, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1000, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1001, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1002, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1003, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1004, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1005, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1006, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1007, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1008, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1009, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1010, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1011, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1012, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1013, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1014, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1015, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1016, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1017, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1018, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1019, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1020, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1021, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1022, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1023, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1024, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1025, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1026, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1027, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1028, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1029, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1030, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1031, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1032, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1033, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1034, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1035, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1036, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1037, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1038, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1039, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1040, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1041, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1042, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1043, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1044, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1045, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1046, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1047, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1048, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1049, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1050, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1051, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1052, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1053, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1054, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1055, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1056, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1057, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1058, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1059, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1060, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1061, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1062, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1063, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1064, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1065, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1066, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1067, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1068, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1069, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1070, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1071, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1072, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1073, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1074, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1075, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1076, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1077, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1078, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1079, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1080, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1081, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1082, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1083, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1084, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1085, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1086, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1087, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1088, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1089, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1090, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1091, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1092, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1093, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1094, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1095, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1096, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1097, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1098, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1099, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1100, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1101, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1102, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1103, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1104, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1105, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1106, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1107, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1108, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1109, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1110, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1111, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1112, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1113, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1114, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1115, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1116, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1117, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1118, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1119, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1120, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1121, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1122, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1123, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1124, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1125, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1126, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1127, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1128, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1129, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1130, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1131, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1132, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1133, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1134, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1135, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1136, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1137, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1138, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1139, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1140, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1141, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1142, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1143, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1144, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1145, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1146, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1147, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1148, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1149, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1150, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1151, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1152, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1153, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1154, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1155, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1156, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1157, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1158, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1159, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1160, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1161, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1162, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1163, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1164, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1165, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1166, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1167, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1168, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1169, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1170, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1171, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1172, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1173, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1174, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1175, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1176, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1177, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1178, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1179, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1180, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1181, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1182, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1183, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1184, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1185, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1186, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1187, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1188, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1189, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1190, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1191, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1192, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1193, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1194, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1195, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1196, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1197, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1198, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1199, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1200, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1201, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1202, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1203, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1204, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1205, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1206, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1207, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1208, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1209, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1210, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1211, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1212, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1213, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1214, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1215, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1216, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1217, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1218, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1219, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1220, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1221, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1222, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1223, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1224, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1225, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1226, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1227, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1228, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1229, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1230, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1231, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1232, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1233, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1234, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1235, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1236, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1237, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1238, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1239, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1240, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1241, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1242, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1243, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1244, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1245, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1246, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1247, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1248, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1249, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1250, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1251, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1252, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1253, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1254, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1255, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1256, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1257, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1258, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1259, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1260, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1261, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1262, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1263, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1264, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1265, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1266, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1267, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1268, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1269, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1270, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1271, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1272, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1273, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1274, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1275, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1276, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1277, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1278, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1279, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1280, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1281, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1282, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1283, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1284, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1285, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1286, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1287, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1288, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1289, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1290, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1291, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1292, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1293, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1294, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1295, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1296, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1297, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1298, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1299, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1300, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1301, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1302, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1303, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1304, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1305, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1306, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1307, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1308, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1309, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1310, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1311, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1312, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1313, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1314, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1315, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1316, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1317, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1318, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1319, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1320, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1321, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1322, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1323, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1324, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1325, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1326, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1327, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1328, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1329, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1330, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1331, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1332, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1333, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1334, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1335, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1336, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1337, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1338, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1339, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1340, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1341, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1342, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1343, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1344, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1345, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1346, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1347, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1348, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1349, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1350, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1351, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1352, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1353, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1354, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1355, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1356, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1357, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1358, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1359, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1360, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1361, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1362, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1363, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1364, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1365, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1366, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1367, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1368, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1369, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1370, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1371, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1372, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1373, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1374, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1375, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1376, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1377, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1378, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1379, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1380, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1381, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1382, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1383, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1384, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1385, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1386, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1387, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1388, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1389, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1390, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1391, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1392, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1393, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1394, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1395, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1396, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1397, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1398, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1399, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1400, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1401, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1402, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1403, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1404, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1405, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1406, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1407, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1408, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1409, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1410, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1411, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1412, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1413, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1414, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1415, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1416, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1417, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1418, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1419, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1420, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1421, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1422, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1423, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1424, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1425, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1426, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1427, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1428, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1429, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1430, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1431, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1432, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1433, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1434, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1435, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1436, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1437, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1438, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1439, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1440, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1441, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1442, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1443, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1444, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1445, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1446, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1447, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1448, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1449, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1450, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1451, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1452, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1453, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1454, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1455, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1456, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1457, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1458, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1459, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1460, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1461, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1462, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1463, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1464, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1465, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1466, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1467, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1468, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1469, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1470, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1471, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1472, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1473, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1474, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1475, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1476, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1477, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1478, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1479, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1480, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1481, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1482, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1483, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1484, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1485, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1486, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1487, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1488, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1489, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1490, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1491, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1492, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1493, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1494, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1495, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1496, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1497, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1498, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1499, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1500, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1501, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1502, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1503, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1504, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1505, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1506, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1507, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1508, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1509, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1510, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1511, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1512, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1513, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1514, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1515, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1516, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1517, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1518, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1519, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1520, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1521, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1522, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1523, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1524, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1525, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1526, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1527, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1528, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1529, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1530, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1531, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1532, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1533, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1534, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1535, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1536, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1537, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1538, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1539, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1540, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1541, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1542, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1543, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1544, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1545, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1546, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1547, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1548, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1549, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1550, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1551, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1552, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1553, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1554, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1555, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1556, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1557, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1558, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1559, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1560, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1561, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1562, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1563, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1564, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1565, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1566, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1567, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1568, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1569, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1570, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1571, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1572, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1573, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1574, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1575, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1576, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1577, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1578, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1579, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1580, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1581, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1582, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1583, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1584, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1585, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1586, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1587, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1588, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1589, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1590, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1591, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1592, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1593, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1594, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1595, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1596, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1597, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1598, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1599, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1600, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1601, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1602, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1603, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1604, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1605, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1606, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1607, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1608, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1609, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1610, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1611, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1612, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1613, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1614, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1615, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1616, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1617, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1618, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1619, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1620, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1621, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1622, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1623, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1624, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1625, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1626, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1627, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1628, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1629, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1630, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1631, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1632, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1633, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1634, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1635, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1636, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1637, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1638, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1639, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1640, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1641, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1642, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1643, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1644, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1645, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1646, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1647, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1648, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1649, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1650, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1651, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1652, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1653, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1654, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1655, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1656, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1657, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1658, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1659, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1660, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1661, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1662, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1663, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1664, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1665, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1666, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1667, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1668, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1669, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1670, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1671, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1672, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1673, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1674, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1675, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1676, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1677, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1678, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1679, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1680, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1681, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1682, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1683, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1684, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1685, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1686, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1687, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1688, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1689, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1690, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1691, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1692, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1693, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1694, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1695, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1696, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1697, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1698, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1699, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1700, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1701, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1702, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1703, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1704, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1705, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1706, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1707, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1708, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1709, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1710, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1711, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1712, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1713, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1714, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1715, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1716, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1717, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1718, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1719, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1720, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1721, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1722, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1723, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1724, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1725, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1726, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1727, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1728, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1729, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1730, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1731, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1732, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1733, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1734, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1735, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1736, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1737, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1738, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1739, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1740, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1741, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1742, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1743, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1744, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1745, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1746, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1747, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1748, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1749, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1750, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1751, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1752, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1753, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1754, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1755, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1756, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1757, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1758, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1759, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1760, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1761, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1762, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1763, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1764, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1765, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1766, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1767, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1768, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1769, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1770, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1771, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1772, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1773, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1774, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1775, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1776, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1777, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1778, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1779, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1780, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1781, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1782, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1783, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1784, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1785, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1786, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1787, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1788, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1789, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1790, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1791, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1792, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1793, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1794, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1795, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1796, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1797, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1798, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1799, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1800, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1801, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1802, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1803, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1804, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1805, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1806, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1807, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1808, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1809, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1810, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1811, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1812, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1813, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1814, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1815, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1816, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1817, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1818, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1819, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1820, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1821, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1822, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1823, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1824, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1825, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1826, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1827, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1828, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1829, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1830, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1831, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1832, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1833, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1834, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1835, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1836, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1837, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1838, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1839, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1840, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1841, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1842, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1843, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1844, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1845, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1846, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1847, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1848, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1849, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1850, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1851, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1852, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1853, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1854, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1855, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1856, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1857, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1858, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1859, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1860, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1861, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1862, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1863, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1864, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1865, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1866, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1867, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1868, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1869, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1870, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1871, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1872, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1873, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1874, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1875, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1876, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1877, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1878, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1879, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1880, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1881, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1882, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1883, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1884, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1885, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1886, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1887, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1888, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1889, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1890, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1891, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1892, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1893, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1894, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1895, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1896, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1897, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1898, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1899, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1900, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1901, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1902, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1903, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1904, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1905, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1906, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1907, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1908, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1909, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1910, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1911, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1912, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1913, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1914, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1915, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1916, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1917, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1918, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1919, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1920, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1921, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1922, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1923, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1924, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1925, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1926, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1927, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1928, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1929, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1930, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1931, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1932, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1933, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1934, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1935, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1936, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1937, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1938, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1939, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1940, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1941, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1942, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1943, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1944, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1945, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1946, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1947, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1948, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1949, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1950, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1951, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1952, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1953, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1954, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1955, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1956, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1957, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1958, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1959, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1960, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1961, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1962, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1963, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1964, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1965, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1966, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1967, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1968, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1969, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1970, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1971, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1972, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1973, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1974, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1975, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1976, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1977, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1978, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1979, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1980, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1981, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1982, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1983, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1984, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1985, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1986, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1987, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1988, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1989, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1990, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1991, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1992, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1993, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1994, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1995, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1996, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1997, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1998, AX
+	JMP  callbackasm1(SB)
+	BYTE $0xf3; BYTE $0x0f; BYTE $0x1e; BYTE $0xfa // ENDBR64
+	MOVL $1999, AX
+	JMP  callbackasm1(SB)
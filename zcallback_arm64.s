@@ -3,4012 +3,6013 @@
 //go:build darwin || freebsd || linux
 
 // External code calls into callbackasm at an offset corresponding
-// to the callback index. Callbackasm is a table of MOV and B instructions.
-// The MOV instruction loads R12 with the callback index, and the
-// B instruction branches to callbackasm1.
+// to the callback index. Callbackasm is a table of BTI, MOV and B instructions.
+// The BTI instruction is the landing pad of the indirect call, the MOV
+// instruction loads R12 with the callback index, and the B instruction
+// branches to callbackasm1.
 // callbackasm1 takes the callback index from R12 and
 // indexes into an array that stores information about each callback.
 // It then calls the Go implementation for that callback.
 #include "textflag.h"
 
 TEXT callbackasm(SB), NOSPLIT|NOFRAME, $0
+	WORD $0xd503245f // BTI C
 	MOVD $0, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $2, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $3, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $4, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $5, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $6, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $7, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $8, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $9, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $10, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $11, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $12, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $13, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $14, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $15, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $16, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $17, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $18, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $19, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $20, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $21, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $22, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $23, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $24, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $25, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $26, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $27, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $28, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $29, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $30, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $31, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $32, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $33, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $34, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $35, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $36, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $37, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $38, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $39, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $40, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $41, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $42, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $43, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $44, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $45, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $46, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $47, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $48, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $49, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $50, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $51, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $52, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $53, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $54, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $55, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $56, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $57, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $58, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $59, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $60, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $61, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $62, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $63, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $64, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $65, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $66, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $67, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $68, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $69, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $70, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $71, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $72, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $73, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $74, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $75, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $76, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $77, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $78, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $79, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $80, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $81, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $82, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $83, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $84, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $85, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $86, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $87, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $88, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $89, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $90, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $91, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $92, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $93, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $94, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $95, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $96, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $97, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $98, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $99, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $100, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $101, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $102, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $103, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $104, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $105, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $106, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $107, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $108, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $109, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $110, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $111, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $112, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $113, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $114, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $115, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $116, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $117, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $118, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $119, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $120, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $121, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $122, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $123, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $124, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $125, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $126, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $127, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $128, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $129, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $130, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $131, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $132, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $133, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $134, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $135, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $136, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $137, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $138, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $139, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $140, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $141, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $142, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $143, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $144, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $145, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $146, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $147, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $148, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $149, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $150, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $151, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $152, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $153, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $154, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $155, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $156, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $157, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $158, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $159, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $160, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $161, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $162, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $163, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $164, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $165, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $166, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $167, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $168, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $169, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $170, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $171, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $172, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $173, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $174, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $175, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $176, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $177, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $178, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $179, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $180, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $181, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $182, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $183, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $184, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $185, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $186, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $187, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $188, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $189, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $190, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $191, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $192, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $193, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $194, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $195, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $196, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $197, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $198, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $199, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $200, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $201, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $202, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $203, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $204, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $205, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $206, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $207, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $208, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $209, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $210, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $211, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $212, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $213, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $214, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $215, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $216, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $217, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $218, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $219, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $220, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $221, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $222, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $223, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $224, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $225, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $226, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $227, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $228, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $229, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $230, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $231, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $232, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $233, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $234, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $235, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $236, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $237, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $238, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $239, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $240, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $241, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $242, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $243, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $244, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $245, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $246, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $247, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $248, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $249, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $250, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $251, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $252, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $253, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $254, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $255, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $256, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $257, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $258, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $259, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $260, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $261, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $262, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $263, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $264, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $265, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $266, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $267, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $268, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $269, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $270, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $271, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $272, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $273, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $274, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $275, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $276, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $277, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $278, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $279, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $280, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $281, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $282, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $283, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $284, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $285, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $286, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $287, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $288, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $289, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $290, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $291, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $292, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $293, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $294, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $295, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $296, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $297, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $298, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $299, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $300, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $301, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $302, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $303, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $304, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $305, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $306, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $307, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $308, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $309, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $310, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $311, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $312, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $313, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $314, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $315, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $316, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $317, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $318, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $319, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $320, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $321, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $322, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $323, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $324, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $325, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $326, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $327, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $328, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $329, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $330, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $331, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $332, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $333, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $334, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $335, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $336, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $337, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $338, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $339, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $340, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $341, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $342, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $343, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $344, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $345, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $346, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $347, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $348, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $349, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $350, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $351, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $352, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $353, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $354, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $355, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $356, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $357, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $358, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $359, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $360, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $361, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $362, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $363, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $364, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $365, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $366, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $367, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $368, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $369, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $370, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $371, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $372, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $373, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $374, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $375, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $376, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $377, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $378, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $379, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $380, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $381, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $382, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $383, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $384, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $385, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $386, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $387, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $388, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $389, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $390, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $391, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $392, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $393, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $394, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $395, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $396, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $397, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $398, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $399, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $400, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $401, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $402, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $403, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $404, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $405, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $406, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $407, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $408, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $409, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $410, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $411, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $412, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $413, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $414, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $415, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $416, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $417, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $418, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $419, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $420, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $421, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $422, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $423, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $424, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $425, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $426, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $427, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $428, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $429, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $430, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $431, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $432, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $433, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $434, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $435, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $436, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $437, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $438, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $439, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $440, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $441, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $442, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $443, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $444, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $445, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $446, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $447, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $448, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $449, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $450, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $451, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $452, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $453, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $454, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $455, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $456, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $457, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $458, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $459, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $460, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $461, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $462, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $463, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $464, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $465, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $466, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $467, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $468, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $469, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $470, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $471, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $472, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $473, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $474, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $475, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $476, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $477, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $478, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $479, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $480, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $481, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $482, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $483, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $484, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $485, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $486, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $487, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $488, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $489, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $490, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $491, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $492, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $493, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $494, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $495, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $496, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $497, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $498, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $499, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $500, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $501, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $502, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $503, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $504, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $505, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $506, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $507, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $508, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $509, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $510, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $511, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $512, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $513, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $514, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $515, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $516, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $517, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $518, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $519, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $520, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $521, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $522, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $523, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $524, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $525, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $526, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $527, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $528, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $529, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $530, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $531, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $532, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $533, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $534, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $535, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $536, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $537, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $538, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $539, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $540, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $541, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $542, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $543, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $544, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $545, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $546, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $547, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $548, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $549, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $550, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $551, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $552, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $553, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $554, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $555, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $556, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $557, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $558, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $559, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $560, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $561, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $562, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $563, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $564, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $565, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $566, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $567, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $568, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $569, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $570, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $571, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $572, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $573, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $574, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $575, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $576, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $577, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $578, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $579, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $580, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $581, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $582, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $583, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $584, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $585, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $586, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $587, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $588, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $589, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $590, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $591, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $592, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $593, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $594, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $595, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $596, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $597, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $598, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $599, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $600, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $601, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $602, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $603, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $604, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $605, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $606, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $607, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $608, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $609, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $610, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $611, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $612, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $613, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $614, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $615, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $616, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $617, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $618, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $619, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $620, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $621, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $622, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $623, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $624, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $625, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $626, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $627, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $628, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $629, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $630, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $631, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $632, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $633, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $634, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $635, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $636, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $637, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $638, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $639, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $640, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $641, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $642, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $643, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $644, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $645, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $646, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $647, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $648, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $649, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $650, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $651, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $652, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $653, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $654, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $655, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $656, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $657, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $658, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $659, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $660, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $661, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $662, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $663, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $664, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $665, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $666, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $667, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $668, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $669, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $670, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $671, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $672, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $673, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $674, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $675, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $676, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $677, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $678, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $679, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $680, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $681, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $682, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $683, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $684, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $685, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $686, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $687, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $688, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $689, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $690, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $691, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $692, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $693, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $694, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $695, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $696, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $697, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $698, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $699, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $700, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $701, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $702, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $703, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $704, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $705, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $706, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $707, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $708, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $709, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $710, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $711, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $712, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $713, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $714, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $715, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $716, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $717, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $718, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $719, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $720, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $721, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $722, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $723, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $724, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $725, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $726, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $727, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $728, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $729, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $730, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $731, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $732, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $733, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $734, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $735, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $736, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $737, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $738, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $739, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $740, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $741, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $742, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $743, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $744, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $745, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $746, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $747, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $748, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $749, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $750, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $751, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $752, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $753, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $754, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $755, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $756, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $757, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $758, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $759, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $760, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $761, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $762, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $763, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $764, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $765, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $766, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $767, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $768, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $769, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $770, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $771, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $772, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $773, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $774, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $775, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $776, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $777, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $778, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $779, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $780, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $781, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $782, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $783, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $784, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $785, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $786, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $787, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $788, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $789, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $790, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $791, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $792, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $793, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $794, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $795, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $796, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $797, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $798, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $799, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $800, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $801, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $802, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $803, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $804, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $805, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $806, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $807, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $808, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $809, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $810, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $811, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $812, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $813, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $814, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $815, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $816, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $817, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $818, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $819, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $820, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $821, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $822, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $823, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $824, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $825, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $826, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $827, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $828, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $829, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $830, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $831, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $832, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $833, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $834, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $835, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $836, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $837, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $838, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $839, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $840, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $841, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $842, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $843, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $844, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $845, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $846, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $847, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $848, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $849, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $850, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $851, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $852, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $853, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $854, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $855, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $856, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $857, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $858, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $859, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $860, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $861, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $862, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $863, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $864, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $865, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $866, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $867, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $868, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $869, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $870, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $871, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $872, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $873, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $874, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $875, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $876, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $877, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $878, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $879, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $880, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $881, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $882, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $883, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $884, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $885, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $886, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $887, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $888, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $889, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $890, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $891, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $892, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $893, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $894, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $895, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $896, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $897, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $898, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $899, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $900, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $901, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $902, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $903, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $904, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $905, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $906, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $907, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $908, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $909, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $910, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $911, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $912, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $913, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $914, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $915, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $916, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $917, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $918, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $919, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $920, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $921, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $922, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $923, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $924, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $925, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $926, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $927, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $928, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $929, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $930, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $931, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $932, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $933, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $934, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $935, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $936, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $937, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $938, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $939, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $940, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $941, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $942, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $943, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $944, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $945, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $946, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $947, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $948, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $949, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $950, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $951, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $952, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $953, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $954, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $955, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $956, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $957, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $958, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $959, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $960, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $961, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $962, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $963, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $964, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $965, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $966, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $967, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $968, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $969, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $970, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $971, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $972, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $973, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $974, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $975, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $976, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $977, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $978, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $979, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $980, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $981, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $982, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $983, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $984, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $985, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $986, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $987, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $988, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $989, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $990, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $991, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $992, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $993, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $994, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $995, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $996, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $997, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $998, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $999, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1000, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1001, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1002, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1003, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1004, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1005, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1006, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1007, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1008, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1009, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1010, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1011, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1012, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1013, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1014, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1015, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1016, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1017, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1018, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1019, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1020, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1021, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1022, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1023, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1024, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1025, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1026, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1027, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1028, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1029, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1030, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1031, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1032, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1033, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1034, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1035, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1036, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1037, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1038, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1039, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1040, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1041, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1042, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1043, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1044, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1045, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1046, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1047, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1048, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1049, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1050, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1051, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1052, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1053, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1054, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1055, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1056, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1057, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1058, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1059, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1060, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1061, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1062, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1063, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1064, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1065, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1066, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1067, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1068, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1069, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1070, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1071, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1072, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1073, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1074, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1075, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1076, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1077, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1078, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1079, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1080, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1081, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1082, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1083, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1084, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1085, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1086, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1087, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1088, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1089, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1090, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1091, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1092, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1093, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1094, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1095, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1096, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1097, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1098, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1099, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1100, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1101, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1102, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1103, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1104, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1105, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1106, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1107, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1108, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1109, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1110, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1111, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1112, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1113, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1114, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1115, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1116, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1117, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1118, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1119, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1120, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1121, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1122, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1123, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1124, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1125, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1126, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1127, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1128, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1129, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1130, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1131, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1132, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1133, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1134, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1135, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1136, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1137, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1138, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1139, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1140, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1141, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1142, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1143, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1144, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1145, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1146, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1147, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1148, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1149, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1150, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1151, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1152, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1153, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1154, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1155, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1156, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1157, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1158, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1159, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1160, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1161, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1162, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1163, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1164, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1165, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1166, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1167, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1168, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1169, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1170, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1171, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1172, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1173, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1174, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1175, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1176, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1177, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1178, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1179, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1180, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1181, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1182, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1183, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1184, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1185, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1186, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1187, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1188, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1189, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1190, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1191, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1192, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1193, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1194, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1195, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1196, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1197, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1198, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1199, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1200, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1201, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1202, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1203, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1204, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1205, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1206, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1207, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1208, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1209, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1210, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1211, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1212, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1213, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1214, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1215, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1216, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1217, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1218, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1219, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1220, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1221, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1222, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1223, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1224, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1225, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1226, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1227, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1228, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1229, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1230, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1231, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1232, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1233, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1234, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1235, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1236, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1237, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1238, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1239, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1240, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1241, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1242, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1243, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1244, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1245, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1246, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1247, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1248, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1249, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1250, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1251, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1252, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1253, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1254, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1255, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1256, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1257, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1258, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1259, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1260, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1261, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1262, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1263, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1264, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1265, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1266, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1267, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1268, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1269, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1270, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1271, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1272, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1273, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1274, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1275, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1276, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1277, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1278, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1279, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1280, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1281, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1282, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1283, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1284, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1285, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1286, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1287, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1288, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1289, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1290, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1291, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1292, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1293, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1294, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1295, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1296, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1297, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1298, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1299, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1300, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1301, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1302, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1303, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1304, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1305, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1306, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1307, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1308, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1309, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1310, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1311, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1312, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1313, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1314, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1315, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1316, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1317, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1318, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1319, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1320, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1321, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1322, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1323, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1324, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1325, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1326, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1327, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1328, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1329, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1330, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1331, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1332, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1333, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1334, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1335, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1336, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1337, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1338, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1339, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1340, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1341, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1342, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1343, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1344, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1345, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1346, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1347, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1348, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1349, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1350, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1351, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1352, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1353, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1354, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1355, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1356, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1357, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1358, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1359, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1360, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1361, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1362, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1363, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1364, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1365, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1366, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1367, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1368, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1369, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1370, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1371, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1372, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1373, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1374, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1375, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1376, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1377, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1378, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1379, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1380, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1381, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1382, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1383, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1384, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1385, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1386, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1387, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1388, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1389, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1390, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1391, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1392, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1393, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1394, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1395, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1396, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1397, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1398, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1399, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1400, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1401, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1402, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1403, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1404, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1405, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1406, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1407, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1408, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1409, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1410, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1411, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1412, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1413, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1414, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1415, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1416, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1417, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1418, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1419, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1420, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1421, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1422, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1423, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1424, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1425, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1426, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1427, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1428, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1429, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1430, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1431, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1432, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1433, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1434, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1435, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1436, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1437, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1438, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1439, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1440, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1441, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1442, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1443, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1444, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1445, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1446, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1447, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1448, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1449, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1450, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1451, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1452, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1453, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1454, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1455, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1456, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1457, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1458, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1459, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1460, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1461, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1462, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1463, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1464, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1465, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1466, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1467, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1468, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1469, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1470, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1471, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1472, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1473, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1474, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1475, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1476, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1477, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1478, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1479, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1480, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1481, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1482, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1483, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1484, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1485, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1486, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1487, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1488, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1489, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1490, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1491, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1492, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1493, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1494, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1495, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1496, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1497, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1498, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1499, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1500, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1501, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1502, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1503, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1504, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1505, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1506, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1507, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1508, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1509, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1510, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1511, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1512, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1513, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1514, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1515, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1516, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1517, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1518, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1519, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1520, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1521, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1522, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1523, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1524, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1525, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1526, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1527, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1528, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1529, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1530, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1531, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1532, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1533, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1534, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1535, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1536, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1537, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1538, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1539, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1540, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1541, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1542, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1543, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1544, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1545, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1546, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1547, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1548, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1549, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1550, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1551, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1552, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1553, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1554, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1555, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1556, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1557, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1558, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1559, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1560, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1561, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1562, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1563, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1564, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1565, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1566, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1567, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1568, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1569, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1570, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1571, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1572, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1573, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1574, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1575, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1576, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1577, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1578, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1579, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1580, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1581, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1582, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1583, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1584, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1585, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1586, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1587, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1588, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1589, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1590, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1591, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1592, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1593, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1594, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1595, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1596, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1597, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1598, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1599, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1600, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1601, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1602, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1603, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1604, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1605, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1606, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1607, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1608, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1609, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1610, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1611, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1612, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1613, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1614, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1615, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1616, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1617, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1618, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1619, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1620, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1621, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1622, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1623, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1624, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1625, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1626, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1627, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1628, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1629, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1630, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1631, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1632, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1633, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1634, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1635, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1636, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1637, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1638, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1639, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1640, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1641, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1642, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1643, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1644, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1645, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1646, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1647, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1648, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1649, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1650, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1651, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1652, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1653, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1654, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1655, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1656, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1657, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1658, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1659, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1660, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1661, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1662, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1663, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1664, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1665, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1666, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1667, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1668, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1669, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1670, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1671, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1672, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1673, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1674, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1675, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1676, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1677, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1678, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1679, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1680, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1681, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1682, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1683, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1684, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1685, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1686, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1687, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1688, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1689, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1690, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1691, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1692, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1693, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1694, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1695, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1696, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1697, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1698, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1699, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1700, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1701, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1702, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1703, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1704, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1705, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1706, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1707, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1708, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1709, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1710, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1711, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1712, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1713, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1714, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1715, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1716, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1717, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1718, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1719, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1720, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1721, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1722, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1723, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1724, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1725, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1726, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1727, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1728, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1729, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1730, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1731, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1732, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1733, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1734, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1735, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1736, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1737, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1738, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1739, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1740, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1741, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1742, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1743, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1744, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1745, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1746, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1747, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1748, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1749, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1750, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1751, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1752, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1753, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1754, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1755, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1756, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1757, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1758, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1759, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1760, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1761, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1762, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1763, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1764, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1765, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1766, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1767, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1768, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1769, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1770, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1771, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1772, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1773, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1774, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1775, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1776, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1777, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1778, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1779, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1780, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1781, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1782, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1783, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1784, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1785, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1786, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1787, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1788, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1789, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1790, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1791, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1792, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1793, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1794, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1795, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1796, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1797, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1798, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1799, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1800, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1801, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1802, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1803, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1804, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1805, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1806, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1807, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1808, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1809, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1810, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1811, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1812, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1813, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1814, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1815, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1816, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1817, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1818, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1819, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1820, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1821, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1822, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1823, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1824, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1825, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1826, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1827, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1828, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1829, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1830, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1831, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1832, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1833, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1834, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1835, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1836, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1837, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1838, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1839, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1840, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1841, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1842, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1843, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1844, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1845, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1846, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1847, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1848, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1849, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1850, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1851, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1852, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1853, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1854, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1855, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1856, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1857, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1858, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1859, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1860, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1861, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1862, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1863, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1864, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1865, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1866, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1867, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1868, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1869, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1870, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1871, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1872, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1873, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1874, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1875, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1876, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1877, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1878, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1879, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1880, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1881, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1882, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1883, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1884, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1885, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1886, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1887, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1888, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1889, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1890, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1891, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1892, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1893, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1894, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1895, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1896, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1897, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1898, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1899, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1900, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1901, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1902, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1903, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1904, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1905, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1906, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1907, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1908, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1909, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1910, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1911, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1912, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1913, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1914, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1915, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1916, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1917, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1918, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1919, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1920, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1921, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1922, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1923, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1924, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1925, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1926, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1927, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1928, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1929, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1930, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1931, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1932, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1933, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1934, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1935, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1936, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1937, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1938, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1939, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1940, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1941, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1942, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1943, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1944, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1945, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1946, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1947, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1948, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1949, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1950, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1951, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1952, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1953, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1954, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1955, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1956, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1957, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1958, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1959, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1960, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1961, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1962, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1963, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1964, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1965, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1966, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1967, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1968, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1969, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1970, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1971, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1972, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1973, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1974, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1975, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1976, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1977, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1978, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1979, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1980, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1981, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1982, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1983, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1984, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1985, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1986, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1987, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1988, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1989, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1990, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1991, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1992, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1993, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1994, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1995, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1996, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1997, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1998, R12
 	B    callbackasm1(SB)
+	WORD $0xd503245f // BTI C
 	MOVD $1999, R12
 	B    callbackasm1(SB)
@@ -0,0 +1,4014 @@
+// Code generated by wincallback.go using 'go generate'. DO NOT EDIT.
+
+//go:build cgo && linux && (mips64 || mips64le)
+
+// External code calls into callbackasm at an offset corresponding
+// to the callback index. Callbackasm is a table of MOV and JMP instructions.
+// The MOV instruction loads R1 with the callback index, and the
+// JMP instruction branches to callbackasm1.
+// callbackasm1 takes the callback index from R1 and
+// indexes into an array that stores information about each callback.
+// It then calls the Go implementation for that callback.
+#include "textflag.h"
+
+TEXT callbackasm(SB), NOSPLIT|NOFRAME, $0
+	MOVV $0, R1
+	JMP  callbackasm1(SB)
+	MOVV $1, R1
+	JMP  callbackasm1(SB)
+	MOVV $2, R1
+	JMP  callbackasm1(SB)
+	MOVV $3, R1
+	JMP  callbackasm1(SB)
+	MOVV $4, R1
+	JMP  callbackasm1(SB)
+	MOVV $5, R1
+	JMP  callbackasm1(SB)
+	MOVV $6, R1
+	JMP  callbackasm1(SB)
+	MOVV $7, R1
+	JMP  callbackasm1(SB)
+	MOVV $8, R1
+	JMP  callbackasm1(SB)
+	MOVV $9, R1
+	JMP  callbackasm1(SB)
+	MOVV $10, R1
+	JMP  callbackasm1(SB)
+	MOVV $11, R1
+	JMP  callbackasm1(SB)
+	MOVV $12, R1
+	JMP  callbackasm1(SB)
+	MOVV $13, R1
+	JMP  callbackasm1(SB)
+	MOVV $14, R1
+	JMP  callbackasm1(SB)
+	MOVV $15, R1
+	JMP  callbackasm1(SB)
+	MOVV $16, R1
+	JMP  callbackasm1(SB)
+	MOVV $17, R1
+	JMP  callbackasm1(SB)
+	MOVV $18, R1
+	JMP  callbackasm1(SB)
+	MOVV $19, R1
+	JMP  callbackasm1(SB)
+	MOVV $20, R1
+	JMP  callbackasm1(SB)
+	MOVV $21, R1
+	JMP  callbackasm1(SB)
+	MOVV $22, R1
+	JMP  callbackasm1(SB)
+	MOVV $23, R1
+	JMP  callbackasm1(SB)
+	MOVV $24, R1
+	JMP  callbackasm1(SB)
+	MOVV $25, R1
+	JMP  callbackasm1(SB)
+	MOVV $26, R1
+	JMP  callbackasm1(SB)
+	MOVV $27, R1
+	JMP  callbackasm1(SB)
+	MOVV $28, R1
+	JMP  callbackasm1(SB)
+	MOVV $29, R1
+	JMP  callbackasm1(SB)
+	MOVV $30, R1
+	JMP  callbackasm1(SB)
+	MOVV $31, R1
+	JMP  callbackasm1(SB)
+	MOVV $32, R1
+	JMP  callbackasm1(SB)
+	MOVV $33, R1
+	JMP  callbackasm1(SB)
+	MOVV $34, R1
+	JMP  callbackasm1(SB)
+	MOVV $35, R1
+	JMP  callbackasm1(SB)
+	MOVV $36, R1
+	JMP  callbackasm1(SB)
+	MOVV $37, R1
+	JMP  callbackasm1(SB)
+	MOVV $38, R1
+	JMP  callbackasm1(SB)
+	MOVV $39, R1
+	JMP  callbackasm1(SB)
+	MOVV $40, R1
+	JMP  callbackasm1(SB)
+	MOVV $41, R1
+	JMP  callbackasm1(SB)
+	MOVV $42, R1
+	JMP  callbackasm1(SB)
+	MOVV $43, R1
+	JMP  callbackasm1(SB)
+	MOVV $44, R1
+	JMP  callbackasm1(SB)
+	MOVV $45, R1
+	JMP  callbackasm1(SB)
+	MOVV $46, R1
+	JMP  callbackasm1(SB)
+	MOVV $47, R1
+	JMP  callbackasm1(SB)
+	MOVV $48, R1
+	JMP  callbackasm1(SB)
+	MOVV $49, R1
+	JMP  callbackasm1(SB)
+	MOVV $50, R1
+	JMP  callbackasm1(SB)
+	MOVV $51, R1
+	JMP  callbackasm1(SB)
+	MOVV $52, R1
+	JMP  callbackasm1(SB)
+	MOVV $53, R1
+	JMP  callbackasm1(SB)
+	MOVV $54, R1
+	JMP  callbackasm1(SB)
+	MOVV $55, R1
+	JMP  callbackasm1(SB)
+	MOVV $56, R1
+	JMP  callbackasm1(SB)
+	MOVV $57, R1
+	JMP  callbackasm1(SB)
+	MOVV $58, R1
+	JMP  callbackasm1(SB)
+	MOVV $59, R1
+	JMP  callbackasm1(SB)
+	MOVV $60, R1
+	JMP  callbackasm1(SB)
+	MOVV $61, R1
+	JMP  callbackasm1(SB)
+	MOVV $62, R1
+	JMP  callbackasm1(SB)
+	MOVV $63, R1
+	JMP  callbackasm1(SB)
+	MOVV $64, R1
+	JMP  callbackasm1(SB)
+	MOVV $65, R1
+	JMP  callbackasm1(SB)
+	MOVV $66, R1
+	JMP  callbackasm1(SB)
+	MOVV $67, R1
+	JMP  callbackasm1(SB)
+	MOVV $68, R1
+	JMP  callbackasm1(SB)
+	MOVV $69, R1
+	JMP  callbackasm1(SB)
+	MOVV $70, R1
+	JMP  callbackasm1(SB)
+	MOVV $71, R1
+	JMP  callbackasm1(SB)
+	MOVV $72, R1
+	JMP  callbackasm1(SB)
+	MOVV $73, R1
+	JMP  callbackasm1(SB)
+	MOVV $74, R1
+	JMP  callbackasm1(SB)
+	MOVV $75, R1
+	JMP  callbackasm1(SB)
+	MOVV $76, R1
+	JMP  callbackasm1(SB)
+	MOVV $77, R1
+	JMP  callbackasm1(SB)
+	MOVV $78, R1
+	JMP  callbackasm1(SB)
+	MOVV $79, R1
+	JMP  callbackasm1(SB)
+	MOVV $80, R1
+	JMP  callbackasm1(SB)
+	MOVV $81, R1
+	JMP  callbackasm1(SB)
+	MOVV $82, R1
+	JMP  callbackasm1(SB)
+	MOVV $83, R1
+	JMP  callbackasm1(SB)
+	MOVV $84, R1
+	JMP  callbackasm1(SB)
+	MOVV $85, R1
+	JMP  callbackasm1(SB)
+	MOVV $86, R1
+	JMP  callbackasm1(SB)
+	MOVV $87, R1
+	JMP  callbackasm1(SB)
+	MOVV $88, R1
+	JMP  callbackasm1(SB)
+	MOVV $89, R1
+	JMP  callbackasm1(SB)
+	MOVV $90, R1
+	JMP  callbackasm1(SB)
+	MOVV $91, R1
+	JMP  callbackasm1(SB)
+	MOVV $92, R1
+	JMP  callbackasm1(SB)
+	MOVV $93, R1
+	JMP  callbackasm1(SB)
+	MOVV $94, R1
+	JMP  callbackasm1(SB)
+	MOVV $95, R1
+	JMP  callbackasm1(SB)
+	MOVV $96, R1
+	JMP  callbackasm1(SB)
+	MOVV $97, R1
+	JMP  callbackasm1(SB)
+	MOVV $98, R1
+	JMP  callbackasm1(SB)
+	MOVV $99, R1
+	JMP  callbackasm1(SB)
+	MOVV $100, R1
+	JMP  callbackasm1(SB)
+	MOVV $101, R1
+	JMP  callbackasm1(SB)
+	MOVV $102, R1
+	JMP  callbackasm1(SB)
+	MOVV $103, R1
+	JMP  callbackasm1(SB)
+	MOVV $104, R1
+	JMP  callbackasm1(SB)
+	MOVV $105, R1
+	JMP  callbackasm1(SB)
+	MOVV $106, R1
+	JMP  callbackasm1(SB)
+	MOVV $107, R1
+	JMP  callbackasm1(SB)
+	MOVV $108, R1
+	JMP  callbackasm1(SB)
+	MOVV $109, R1
+	JMP  callbackasm1(SB)
+	MOVV $110, R1
+	JMP  callbackasm1(SB)
+	MOVV $111, R1
+	JMP  callbackasm1(SB)
+	MOVV $112, R1
+	JMP  callbackasm1(SB)
+	MOVV $113, R1
+	JMP  callbackasm1(SB)
+	MOVV $114, R1
+	JMP  callbackasm1(SB)
+	MOVV $115, R1
+	JMP  callbackasm1(SB)
+	MOVV $116, R1
+	JMP  callbackasm1(SB)
+	MOVV $117, R1
+	JMP  callbackasm1(SB)
+	MOVV $118, R1
+	JMP  callbackasm1(SB)
+	MOVV $119, R1
+	JMP  callbackasm1(SB)
+	MOVV $120, R1
+	JMP  callbackasm1(SB)
+	MOVV $121, R1
+	JMP  callbackasm1(SB)
+	MOVV $122, R1
+	JMP  callbackasm1(SB)
+	MOVV $123, R1
+	JMP  callbackasm1(SB)
+	MOVV $124, R1
+	JMP  callbackasm1(SB)
+	MOVV $125, R1
+	JMP  callbackasm1(SB)
+	MOVV $126, R1
+	JMP  callbackasm1(SB)
+	MOVV $127, R1
+	JMP  callbackasm1(SB)
+	MOVV $128, R1
+	JMP  callbackasm1(SB)
+	MOVV $129, R1
+	JMP  callbackasm1(SB)
+	MOVV $130, R1
+	JMP  callbackasm1(SB)
+	MOVV $131, R1
+	JMP  callbackasm1(SB)
+	MOVV $132, R1
+	JMP  callbackasm1(SB)
+	MOVV $133, R1
+	JMP  callbackasm1(SB)
+	MOVV $134, R1
+	JMP  callbackasm1(SB)
+	MOVV $135, R1
+	JMP  callbackasm1(SB)
+	MOVV $136, R1
+	JMP  callbackasm1(SB)
+	MOVV $137, R1
+	JMP  callbackasm1(SB)
+	MOVV $138, R1
+	JMP  callbackasm1(SB)
+	MOVV $139, R1
+	JMP  callbackasm1(SB)
+	MOVV $140, R1
+	JMP  callbackasm1(SB)
+	MOVV $141, R1
+	JMP  callbackasm1(SB)
+	MOVV $142, R1
+	JMP  callbackasm1(SB)
+	MOVV $143, R1
+	JMP  callbackasm1(SB)
+	MOVV $144, R1
+	JMP  callbackasm1(SB)
+	MOVV $145, R1
+	JMP  callbackasm1(SB)
+	MOVV $146, R1
+	JMP  callbackasm1(SB)
+	MOVV $147, R1
+	JMP  callbackasm1(SB)
+	MOVV $148, R1
+	JMP  callbackasm1(SB)
+	MOVV $149, R1
+	JMP  callbackasm1(SB)
+	MOVV $150, R1
+	JMP  callbackasm1(SB)
+	MOVV $151, R1
+	JMP  callbackasm1(SB)
+	MOVV $152, R1
+	JMP  callbackasm1(SB)
+	MOVV $153, R1
+	JMP  callbackasm1(SB)
+	MOVV $154, R1
+	JMP  callbackasm1(SB)
+	MOVV $155, R1
+	JMP  callbackasm1(SB)
+	MOVV $156, R1
+	JMP  callbackasm1(SB)
+	MOVV $157, R1
+	JMP  callbackasm1(SB)
+	MOVV $158, R1
+	JMP  callbackasm1(SB)
+	MOVV $159, R1
+	JMP  callbackasm1(SB)
+	MOVV $160, R1
+	JMP  callbackasm1(SB)
+	MOVV $161, R1
+	JMP  callbackasm1(SB)
+	MOVV $162, R1
+	JMP  callbackasm1(SB)
+	MOVV $163, R1
+	JMP  callbackasm1(SB)
+	MOVV $164, R1
+	JMP  callbackasm1(SB)
+	MOVV $165, R1
+	JMP  callbackasm1(SB)
+	MOVV $166, R1
+	JMP  callbackasm1(SB)
+	MOVV $167, R1
+	JMP  callbackasm1(SB)
+	MOVV $168, R1
+	JMP  callbackasm1(SB)
+	MOVV $169, R1
+	JMP  callbackasm1(SB)
+	MOVV $170, R1
+	JMP  callbackasm1(SB)
+	MOVV $171, R1
+	JMP  callbackasm1(SB)
+	MOVV $172, R1
+	JMP  callbackasm1(SB)
+	MOVV $173, R1
+	JMP  callbackasm1(SB)
+	MOVV $174, R1
+	JMP  callbackasm1(SB)
+	MOVV $175, R1
+	JMP  callbackasm1(SB)
+	MOVV $176, R1
+	JMP  callbackasm1(SB)
+	MOVV $177, R1
+	JMP  callbackasm1(SB)
+	MOVV $178, R1
+	JMP  callbackasm1(SB)
+	MOVV $179, R1
+	JMP  callbackasm1(SB)
+	MOVV $180, R1
+	JMP  callbackasm1(SB)
+	MOVV $181, R1
+	JMP  callbackasm1(SB)
+	MOVV $182, R1
+	JMP  callbackasm1(SB)
+	MOVV $183, R1
+	JMP  callbackasm1(SB)
+	MOVV $184, R1
+	JMP  callbackasm1(SB)
+	MOVV $185, R1
+	JMP  callbackasm1(SB)
+	MOVV $186, R1
+	JMP  callbackasm1(SB)
+	MOVV $187, R1
+	JMP  callbackasm1(SB)
+	MOVV $188, R1
+	JMP  callbackasm1(SB)
+	MOVV $189, R1
+	JMP  callbackasm1(SB)
+	MOVV $190, R1
+	JMP  callbackasm1(SB)
+	MOVV $191, R1
+	JMP  callbackasm1(SB)
+	MOVV $192, R1
+	JMP  callbackasm1(SB)
+	MOVV $193, R1
+	JMP  callbackasm1(SB)
+	MOVV $194, R1
+	JMP  callbackasm1(SB)
+	MOVV $195, R1
+	JMP  callbackasm1(SB)
+	MOVV $196, R1
+	JMP  callbackasm1(SB)
+	MOVV $197, R1
+	JMP  callbackasm1(SB)
+	MOVV $198, R1
+	JMP  callbackasm1(SB)
+	MOVV $199, R1
+	JMP  callbackasm1(SB)
+	MOVV $200, R1
+	JMP  callbackasm1(SB)
+	MOVV $201, R1
+	JMP  callbackasm1(SB)
+	MOVV $202, R1
+	JMP  callbackasm1(SB)
+	MOVV $203, R1
+	JMP  callbackasm1(SB)
+	MOVV $204, R1
+	JMP  callbackasm1(SB)
+	MOVV $205, R1
+	JMP  callbackasm1(SB)
+	MOVV $206, R1
+	JMP  callbackasm1(SB)
+	MOVV $207, R1
+	JMP  callbackasm1(SB)
+	MOVV $208, R1
+	JMP  callbackasm1(SB)
+	MOVV $209, R1
+	JMP  callbackasm1(SB)
+	MOVV $210, R1
+	JMP  callbackasm1(SB)
+	MOVV $211, R1
+	JMP  callbackasm1(SB)
+	MOVV $212, R1
+	JMP  callbackasm1(SB)
+	MOVV $213, R1
+	JMP  callbackasm1(SB)
+	MOVV $214, R1
+	JMP  callbackasm1(SB)
+	MOVV $215, R1
+	JMP  callbackasm1(SB)
+	MOVV $216, R1
+	JMP  callbackasm1(SB)
+	MOVV $217, R1
+	JMP  callbackasm1(SB)
+	MOVV $218, R1
+	JMP  callbackasm1(SB)
+	MOVV $219, R1
+	JMP  callbackasm1(SB)
+	MOVV $220, R1
+	JMP  callbackasm1(SB)
+	MOVV $221, R1
+	JMP  callbackasm1(SB)
+	MOVV $222, R1
+	JMP  callbackasm1(SB)
+	MOVV $223, R1
+	JMP  callbackasm1(SB)
+	MOVV $224, R1
+	JMP  callbackasm1(SB)
+	MOVV $225, R1
+	JMP  callbackasm1(SB)
+	MOVV $226, R1
+	JMP  callbackasm1(SB)
+	MOVV $227, R1
+	JMP  callbackasm1(SB)
+	MOVV $228, R1
+	JMP  callbackasm1(SB)
+	MOVV $229, R1
+	JMP  callbackasm1(SB)
+	MOVV $230, R1
+	JMP  callbackasm1(SB)
+	MOVV $231, R1
+	JMP  callbackasm1(SB)
+	MOVV $232, R1
+	JMP  callbackasm1(SB)
+	MOVV $233, R1
+	JMP  callbackasm1(SB)
+	MOVV $234, R1
+	JMP  callbackasm1(SB)
+	MOVV $235, R1
+	JMP  callbackasm1(SB)
+	MOVV $236, R1
+	JMP  callbackasm1(SB)
+	MOVV $237, R1
+	JMP  callbackasm1(SB)
+	MOVV $238, R1
+	JMP  callbackasm1(SB)
+	MOVV $239, R1
+	JMP  callbackasm1(SB)
+	MOVV $240, R1
+	JMP  callbackasm1(SB)
+	MOVV $241, R1
+	JMP  callbackasm1(SB)
+	MOVV $242, R1
+	JMP  callbackasm1(SB)
+	MOVV $243, R1
+	JMP  callbackasm1(SB)
+	MOVV $244, R1
+	JMP  callbackasm1(SB)
+	MOVV $245, R1
+	JMP  callbackasm1(SB)
+	MOVV $246, R1
+	JMP  callbackasm1(SB)
+	MOVV $247, R1
+	JMP  callbackasm1(SB)
+	MOVV $248, R1
+	JMP  callbackasm1(SB)
+	MOVV $249, R1
+	JMP  callbackasm1(SB)
+	MOVV $250, R1
+	JMP  callbackasm1(SB)
+	MOVV $251, R1
+	JMP  callbackasm1(SB)
+	MOVV $252, R1
+	JMP  callbackasm1(SB)
+	MOVV $253, R1
+	JMP  callbackasm1(SB)
+	MOVV $254, R1
+	JMP  callbackasm1(SB)
+	MOVV $255, R1
+	JMP  callbackasm1(SB)
+	MOVV $256, R1
+	JMP  callbackasm1(SB)
+	MOVV $257, R1
+	JMP  callbackasm1(SB)
+	MOVV $258, R1
+	JMP  callbackasm1(SB)
+	MOVV $259, R1
+	JMP  callbackasm1(SB)
+	MOVV $260, R1
+	JMP  callbackasm1(SB)
+	MOVV $261, R1
+	JMP  callbackasm1(SB)
+	MOVV $262, R1
+	JMP  callbackasm1(SB)
+	MOVV $263, R1
+	JMP  callbackasm1(SB)
+	MOVV $264, R1
+	JMP  callbackasm1(SB)
+	MOVV $265, R1
+	JMP  callbackasm1(SB)
+	MOVV $266, R1
+	JMP  callbackasm1(SB)
+	MOVV $267, R1
+	JMP  callbackasm1(SB)
+	MOVV $268, R1
+	JMP  callbackasm1(SB)
+	MOVV $269, R1
+	JMP  callbackasm1(SB)
+	MOVV $270, R1
+	JMP  callbackasm1(SB)
+	MOVV $271, R1
+	JMP  callbackasm1(SB)
+	MOVV $272, R1
+	JMP  callbackasm1(SB)
+	MOVV $273, R1
+	JMP  callbackasm1(SB)
+	MOVV $274, R1
+	JMP  callbackasm1(SB)
+	MOVV $275, R1
+	JMP  callbackasm1(SB)
+	MOVV $276, R1
+	JMP  callbackasm1(SB)
+	MOVV $277, R1
+	JMP  callbackasm1(SB)
+	MOVV $278, R1
+	JMP  callbackasm1(SB)
+	MOVV $279, R1
+	JMP  callbackasm1(SB)
+	MOVV $280, R1
+	JMP  callbackasm1(SB)
+	MOVV $281, R1
+	JMP  callbackasm1(SB)
+	MOVV $282, R1
+	JMP  callbackasm1(SB)
+	MOVV $283, R1
+	JMP  callbackasm1(SB)
+	MOVV $284, R1
+	JMP  callbackasm1(SB)
+	MOVV $285, R1
+	JMP  callbackasm1(SB)
+	MOVV $286, R1
+	JMP  callbackasm1(SB)
+	MOVV $287, R1
+	JMP  callbackasm1(SB)
+	MOVV $288, R1
+	JMP  callbackasm1(SB)
+	MOVV $289, R1
+	JMP  callbackasm1(SB)
+	MOVV $290, R1
+	JMP  callbackasm1(SB)
+	MOVV $291, R1
+	JMP  callbackasm1(SB)
+	MOVV $292, R1
+	JMP  callbackasm1(SB)
+	MOVV $293, R1
+	JMP  callbackasm1(SB)
+	MOVV $294, R1
+	JMP  callbackasm1(SB)
+	MOVV $295, R1
+	JMP  callbackasm1(SB)
+	MOVV $296, R1
+	JMP  callbackasm1(SB)
+	MOVV $297, R1
+	JMP  callbackasm1(SB)
+	MOVV $298, R1
+	JMP  callbackasm1(SB)
+	MOVV $299, R1
+	JMP  callbackasm1(SB)
+	MOVV $300, R1
+	JMP  callbackasm1(SB)
+	MOVV $301, R1
+	JMP  callbackasm1(SB)
+	MOVV $302, R1
+	JMP  callbackasm1(SB)
+	MOVV $303, R1
+	JMP  callbackasm1(SB)
+	MOVV $304, R1
+	JMP  callbackasm1(SB)
+	MOVV $305, R1
+	JMP  callbackasm1(SB)
+	MOVV $306, R1
+	JMP  callbackasm1(SB)
+	MOVV $307, R1
+	JMP  callbackasm1(SB)
+	MOVV $308, R1
+	JMP  callbackasm1(SB)
+	MOVV $309, R1
+	JMP  callbackasm1(SB)
+	MOVV $310, R1
+	JMP  callbackasm1(SB)
+	MOVV $311, R1
+	JMP  callbackasm1(SB)
+	MOVV $312, R1
+	JMP  callbackasm1(SB)
+	MOVV $313, R1
+	JMP  callbackasm1(SB)
+	MOVV $314, R1
+	JMP  callbackasm1(SB)
+	MOVV $315, R1
+	JMP  callbackasm1(SB)
+	MOVV $316, R1
+	JMP  callbackasm1(SB)
+	MOVV $317, R1
+	JMP  callbackasm1(SB)
+	MOVV $318, R1
+	JMP  callbackasm1(SB)
+	MOVV $319, R1
+	JMP  callbackasm1(SB)
+	MOVV $320, R1
+	JMP  callbackasm1(SB)
+	MOVV $321, R1
+	JMP  callbackasm1(SB)
+	MOVV $322, R1
+	JMP  callbackasm1(SB)
+	MOVV $323, R1
+	JMP  callbackasm1(SB)
+	MOVV $324, R1
+	JMP  callbackasm1(SB)
+	MOVV $325, R1
+	JMP  callbackasm1(SB)
+	MOVV $326, R1
+	JMP  callbackasm1(SB)
+	MOVV $327, R1
+	JMP  callbackasm1(SB)
+	MOVV $328, R1
+	JMP  callbackasm1(SB)
+	MOVV $329, R1
+	JMP  callbackasm1(SB)
+	MOVV $330, R1
+	JMP  callbackasm1(SB)
+	MOVV $331, R1
+	JMP  callbackasm1(SB)
+	MOVV $332, R1
+	JMP  callbackasm1(SB)
+	MOVV $333, R1
+	JMP  callbackasm1(SB)
+	MOVV $334, R1
+	JMP  callbackasm1(SB)
+	MOVV $335, R1
+	JMP  callbackasm1(SB)
+	MOVV $336, R1
+	JMP  callbackasm1(SB)
+	MOVV $337, R1
+	JMP  callbackasm1(SB)
+	MOVV $338, R1
+	JMP  callbackasm1(SB)
+	MOVV $339, R1
+	JMP  callbackasm1(SB)
+	MOVV $340, R1
+	JMP  callbackasm1(SB)
+	MOVV $341, R1
+	JMP  callbackasm1(SB)
+	MOVV $342, R1
+	JMP  callbackasm1(SB)
+	MOVV $343, R1
+	JMP  callbackasm1(SB)
+	MOVV $344, R1
+	JMP  callbackasm1(SB)
+	MOVV $345, R1
+	JMP  callbackasm1(SB)
+	MOVV $346, R1
+	JMP  callbackasm1(SB)
+	MOVV $347, R1
+	JMP  callbackasm1(SB)
+	MOVV $348, R1
+	JMP  callbackasm1(SB)
+	MOVV $349, R1
+	JMP  callbackasm1(SB)
+	MOVV $350, R1
+	JMP  callbackasm1(SB)
+	MOVV $351, R1
+	JMP  callbackasm1(SB)
+	MOVV $352, R1
+	JMP  callbackasm1(SB)
+	MOVV $353, R1
+	JMP  callbackasm1(SB)
+	MOVV $354, R1
+	JMP  callbackasm1(SB)
+	MOVV $355, R1
+	JMP  callbackasm1(SB)
+	MOVV $356, R1
+	JMP  callbackasm1(SB)
+	MOVV $357, R1
+	JMP  callbackasm1(SB)
+	MOVV $358, R1
+	JMP  callbackasm1(SB)
+	MOVV $359, R1
+	JMP  callbackasm1(SB)
+	MOVV $360, R1
+	JMP  callbackasm1(SB)
+	MOVV $361, R1
+	JMP  callbackasm1(SB)
+	MOVV $362, R1
+	JMP  callbackasm1(SB)
+	MOVV $363, R1
+	JMP  callbackasm1(SB)
+	MOVV $364, R1
+	JMP  callbackasm1(SB)
+	MOVV $365, R1
+	JMP  callbackasm1(SB)
+	MOVV $366, R1
+	JMP  callbackasm1(SB)
+	MOVV $367, R1
+	JMP  callbackasm1(SB)
+	MOVV $368, R1
+	JMP  callbackasm1(SB)
+	MOVV $369, R1
+	JMP  callbackasm1(SB)
+	MOVV $370, R1
+	JMP  callbackasm1(SB)
+	MOVV $371, R1
+	JMP  callbackasm1(SB)
+	MOVV $372, R1
+	JMP  callbackasm1(SB)
+	MOVV $373, R1
+	JMP  callbackasm1(SB)
+	MOVV $374, R1
+	JMP  callbackasm1(SB)
+	MOVV $375, R1
+	JMP  callbackasm1(SB)
+	MOVV $376, R1
+	JMP  callbackasm1(SB)
+	MOVV $377, R1
+	JMP  callbackasm1(SB)
+	MOVV $378, R1
+	JMP  callbackasm1(SB)
+	MOVV $379, R1
+	JMP  callbackasm1(SB)
+	MOVV $380, R1
+	JMP  callbackasm1(SB)
+	MOVV $381, R1
+	JMP  callbackasm1(SB)
+	MOVV $382, R1
+	JMP  callbackasm1(SB)
+	MOVV $383, R1
+	JMP  callbackasm1(SB)
+	MOVV $384, R1
+	JMP  callbackasm1(SB)
+	MOVV $385, R1
+	JMP  callbackasm1(SB)
+	MOVV $386, R1
+	JMP  callbackasm1(SB)
+	MOVV $387, R1
+	JMP  callbackasm1(SB)
+	MOVV $388, R1
+	JMP  callbackasm1(SB)
+	MOVV $389, R1
+	JMP  callbackasm1(SB)
+	MOVV $390, R1
+	JMP  callbackasm1(SB)
+	MOVV $391, R1
+	JMP  callbackasm1(SB)
+	MOVV $392, R1
+	JMP  callbackasm1(SB)
+	MOVV $393, R1
+	JMP  callbackasm1(SB)
+	MOVV $394, R1
+	JMP  callbackasm1(SB)
+	MOVV $395, R1
+	JMP  callbackasm1(SB)
+	MOVV $396, R1
+	JMP  callbackasm1(SB)
+	MOVV $397, R1
+	JMP  callbackasm1(SB)
+	MOVV $398, R1
+	JMP  callbackasm1(SB)
+	MOVV $399, R1
+	JMP  callbackasm1(SB)
+	MOVV $400, R1
+	JMP  callbackasm1(SB)
+	MOVV $401, R1
+	JMP  callbackasm1(SB)
+	MOVV $402, R1
+	JMP  callbackasm1(SB)
+	MOVV $403, R1
+	JMP  callbackasm1(SB)
+	MOVV $404, R1
+	JMP  callbackasm1(SB)
+	MOVV $405, R1
+	JMP  callbackasm1(SB)
+	MOVV $406, R1
+	JMP  callbackasm1(SB)
+	MOVV $407, R1
+	JMP  callbackasm1(SB)
+	MOVV $408, R1
+	JMP  callbackasm1(SB)
+	MOVV $409, R1
+	JMP  callbackasm1(SB)
+	MOVV $410, R1
+	JMP  callbackasm1(SB)
+	MOVV $411, R1
+	JMP  callbackasm1(SB)
+	MOVV $412, R1
+	JMP  callbackasm1(SB)
+	MOVV $413, R1
+	JMP  callbackasm1(SB)
+	MOVV $414, R1
+	JMP  callbackasm1(SB)
+	MOVV $415, R1
+	JMP  callbackasm1(SB)
+	MOVV $416, R1
+	JMP  callbackasm1(SB)
+	MOVV $417, R1
+	JMP  callbackasm1(SB)
+	MOVV $418, R1
+	JMP  callbackasm1(SB)
+	MOVV $419, R1
+	JMP  callbackasm1(SB)
+	MOVV $420, R1
+	JMP  callbackasm1(SB)
+	MOVV $421, R1
+	JMP  callbackasm1(SB)
+	MOVV $422, R1
+	JMP  callbackasm1(SB)
+	MOVV $423, R1
+	JMP  callbackasm1(SB)
+	MOVV $424, R1
+	JMP  callbackasm1(SB)
+	MOVV $425, R1
+	JMP  callbackasm1(SB)
+	MOVV $426, R1
+	JMP  callbackasm1(SB)
+	MOVV $427, R1
+	JMP  callbackasm1(SB)
+	MOVV $428, R1
+	JMP  callbackasm1(SB)
+	MOVV $429, R1
+	JMP  callbackasm1(SB)
+	MOVV $430, R1
+	JMP  callbackasm1(SB)
+	MOVV $431, R1
+	JMP  callbackasm1(SB)
+	MOVV $432, R1
+	JMP  callbackasm1(SB)
+	MOVV $433, R1
+	JMP  callbackasm1(SB)
+	MOVV $434, R1
+	JMP  callbackasm1(SB)
+	MOVV $435, R1
+	JMP  callbackasm1(SB)
+	MOVV $436, R1
+	JMP  callbackasm1(SB)
+	MOVV $437, R1
+	JMP  callbackasm1(SB)
+	MOVV $438, R1
+	JMP  callbackasm1(SB)
+	MOVV $439, R1
+	JMP  callbackasm1(SB)
+	MOVV $440, R1
+	JMP  callbackasm1(SB)
+	MOVV $441, R1
+	JMP  callbackasm1(SB)
+	MOVV $442, R1
+	JMP  callbackasm1(SB)
+	MOVV $443, R1
+	JMP  callbackasm1(SB)
+	MOVV $444, R1
+	JMP  callbackasm1(SB)
+	MOVV $445, R1
+	JMP  callbackasm1(SB)
+	MOVV $446, R1
+	JMP  callbackasm1(SB)
+	MOVV $447, R1
+	JMP  callbackasm1(SB)
+	MOVV $448, R1
+	JMP  callbackasm1(SB)
+	MOVV $449, R1
+	JMP  callbackasm1(SB)
+	MOVV $450, R1
+	JMP  callbackasm1(SB)
+	MOVV $451, R1
+	JMP  callbackasm1(SB)
+	MOVV $452, R1
+	JMP  callbackasm1(SB)
+	MOVV $453, R1
+	JMP  callbackasm1(SB)
+	MOVV $454, R1
+	JMP  callbackasm1(SB)
+	MOVV $455, R1
+	JMP  callbackasm1(SB)
+	MOVV $456, R1
+	JMP  callbackasm1(SB)
+	MOVV $457, R1
+	JMP  callbackasm1(SB)
+	MOVV $458, R1
+	JMP  callbackasm1(SB)
+	MOVV $459, R1
+	JMP  callbackasm1(SB)
+	MOVV $460, R1
+	JMP  callbackasm1(SB)
+	MOVV $461, R1
+	JMP  callbackasm1(SB)
+	MOVV $462, R1
+	JMP  callbackasm1(SB)
+	MOVV $463, R1
+	JMP  callbackasm1(SB)
+	MOVV $464, R1
+	JMP  callbackasm1(SB)
+	MOVV $465, R1
+	JMP  callbackasm1(SB)
+	MOVV $466, R1
+	JMP  callbackasm1(SB)
+	MOVV $467, R1
+	JMP  callbackasm1(SB)
+	MOVV $468, R1
+	JMP  callbackasm1(SB)
+	MOVV $469, R1
+	JMP  callbackasm1(SB)
+	MOVV $470, R1
+	JMP  callbackasm1(SB)
+	MOVV $471, R1
+	JMP  callbackasm1(SB)
+	MOVV $472, R1
+	JMP  callbackasm1(SB)
+	MOVV $473, R1
+	JMP  callbackasm1(SB)
+	MOVV $474, R1
+	JMP  callbackasm1(SB)
+	MOVV $475, R1
+	JMP  callbackasm1(SB)
+	MOVV $476, R1
+	JMP  callbackasm1(SB)
+	MOVV $477, R1
+	JMP  callbackasm1(SB)
+	MOVV $478, R1
+	JMP  callbackasm1(SB)
+	MOVV $479, R1
+	JMP  callbackasm1(SB)
+	MOVV $480, R1
+	JMP  callbackasm1(SB)
+	MOVV $481, R1
+	JMP  callbackasm1(SB)
+	MOVV $482, R1
+	JMP  callbackasm1(SB)
+	MOVV $483, R1
+	JMP  callbackasm1(SB)
+	MOVV $484, R1
+	JMP  callbackasm1(SB)
+	MOVV $485, R1
+	JMP  callbackasm1(SB)
+	MOVV $486, R1
+	JMP  callbackasm1(SB)
+	MOVV $487, R1
+	JMP  callbackasm1(SB)
+	MOVV $488, R1
+	JMP  callbackasm1(SB)
+	MOVV $489, R1
+	JMP  callbackasm1(SB)
+	MOVV $490, R1
+	JMP  callbackasm1(SB)
+	MOVV $491, R1
+	JMP  callbackasm1(SB)
+	MOVV $492, R1
+	JMP  callbackasm1(SB)
+	MOVV $493, R1
+	JMP  callbackasm1(SB)
+	MOVV $494, R1
+	JMP  callbackasm1(SB)
+	MOVV $495, R1
+	JMP  callbackasm1(SB)
+	MOVV $496, R1
+	JMP  callbackasm1(SB)
+	MOVV $497, R1
+	JMP  callbackasm1(SB)
+	MOVV $498, R1
+	JMP  callbackasm1(SB)
+	MOVV $499, R1
+	JMP  callbackasm1(SB)
+	MOVV $500, R1
+	JMP  callbackasm1(SB)
+	MOVV $501, R1
+	JMP  callbackasm1(SB)
+	MOVV $502, R1
+	JMP  callbackasm1(SB)
+	MOVV $503, R1
+	JMP  callbackasm1(SB)
+	MOVV $504, R1
+	JMP  callbackasm1(SB)
+	MOVV $505, R1
+	JMP  callbackasm1(SB)
+	MOVV $506, R1
+	JMP  callbackasm1(SB)
+	MOVV $507, R1
+	JMP  callbackasm1(SB)
+	MOVV $508, R1
+	JMP  callbackasm1(SB)
+	MOVV $509, R1
+	JMP  callbackasm1(SB)
+	MOVV $510, R1
+	JMP  callbackasm1(SB)
+	MOVV $511, R1
+	JMP  callbackasm1(SB)
+	MOVV $512, R1
+	JMP  callbackasm1(SB)
+	MOVV $513, R1
+	JMP  callbackasm1(SB)
+	MOVV $514, R1
+	JMP  callbackasm1(SB)
+	MOVV $515, R1
+	JMP  callbackasm1(SB)
+	MOVV $516, R1
+	JMP  callbackasm1(SB)
+	MOVV $517, R1
+	JMP  callbackasm1(SB)
+	MOVV $518, R1
+	JMP  callbackasm1(SB)
+	MOVV $519, R1
+	JMP  callbackasm1(SB)
+	MOVV $520, R1
+	JMP  callbackasm1(SB)
+	MOVV $521, R1
+	JMP  callbackasm1(SB)
+	MOVV $522, R1
+	JMP  callbackasm1(SB)
+	MOVV $523, R1
+	JMP  callbackasm1(SB)
+	MOVV $524, R1
+	JMP  callbackasm1(SB)
+	MOVV $525, R1
+	JMP  callbackasm1(SB)
+	MOVV $526, R1
+	JMP  callbackasm1(SB)
+	MOVV $527, R1
+	JMP  callbackasm1(SB)
+	MOVV $528, R1
+	JMP  callbackasm1(SB)
+	MOVV $529, R1
+	JMP  callbackasm1(SB)
+	MOVV $530, R1
+	JMP  callbackasm1(SB)
+	MOVV $531, R1
+	JMP  callbackasm1(SB)
+	MOVV $532, R1
+	JMP  callbackasm1(SB)
+	MOVV $533, R1
+	JMP  callbackasm1(SB)
+	MOVV $534, R1
+	JMP  callbackasm1(SB)
+	MOVV $535, R1
+	JMP  callbackasm1(SB)
+	MOVV $536, R1
+	JMP  callbackasm1(SB)
+	MOVV $537, R1
+	JMP  callbackasm1(SB)
+	MOVV $538, R1
+	JMP  callbackasm1(SB)
+	MOVV $539, R1
+	JMP  callbackasm1(SB)
+	MOVV $540, R1
+	JMP  callbackasm1(SB)
+	MOVV $541, R1
+	JMP  callbackasm1(SB)
+	MOVV $542, R1
+	JMP  callbackasm1(SB)
+	MOVV $543, R1
+	JMP  callbackasm1(SB)
+	MOVV $544, R1
+	JMP  callbackasm1(SB)
+	MOVV $545, R1
+	JMP  callbackasm1(SB)
+	MOVV $546, R1
+	JMP  callbackasm1(SB)
+	MOVV $547, R1
+	JMP  callbackasm1(SB)
+	MOVV $548, R1
+	JMP  callbackasm1(SB)
+	MOVV $549, R1
+	JMP  callbackasm1(SB)
+	MOVV $550, R1
+	JMP  callbackasm1(SB)
+	MOVV $551, R1
+	JMP  callbackasm1(SB)
+	MOVV $552, R1
+	JMP  callbackasm1(SB)
+	MOVV $553, R1
+	JMP  callbackasm1(SB)
+	MOVV $554, R1
+	JMP  callbackasm1(SB)
+	MOVV $555, R1
+	JMP  callbackasm1(SB)
+	MOVV $556, R1
+	JMP  callbackasm1(SB)
+	MOVV $557, R1
+	JMP  callbackasm1(SB)
+	MOVV $558, R1
+	JMP  callbackasm1(SB)
+	MOVV $559, R1
+	JMP  callbackasm1(SB)
+	MOVV $560, R1
+	JMP  callbackasm1(SB)
+	MOVV $561, R1
+	JMP  callbackasm1(SB)
+	MOVV $562, R1
+	JMP  callbackasm1(SB)
+	MOVV $563, R1
+	JMP  callbackasm1(SB)
+	MOVV $564, R1
+	JMP  callbackasm1(SB)
+	MOVV $565, R1
+	JMP  callbackasm1(SB)
+	MOVV $566, R1
+	JMP  callbackasm1(SB)
+	MOVV $567, R1
+	JMP  callbackasm1(SB)
+	MOVV $568, R1
+	JMP  callbackasm1(SB)
+	MOVV $569, R1
+	JMP  callbackasm1(SB)
+	MOVV $570, R1
+	JMP  callbackasm1(SB)
+	MOVV $571, R1
+	JMP  callbackasm1(SB)
+	MOVV $572, R1
+	JMP  callbackasm1(SB)
+	MOVV $573, R1
+	JMP  callbackasm1(SB)
+	MOVV $574, R1
+	JMP  callbackasm1(SB)
+	MOVV $575, R1
+	JMP  callbackasm1(SB)
+	MOVV $576, R1
+	JMP  callbackasm1(SB)
+	MOVV $577, R1
+	JMP  callbackasm1(SB)
+	MOVV $578, R1
+	JMP  callbackasm1(SB)
+	MOVV $579, R1
+	JMP  callbackasm1(SB)
+	MOVV $580, R1
+	JMP  callbackasm1(SB)
+	MOVV $581, R1
+	JMP  callbackasm1(SB)
+	MOVV $582, R1
+	JMP  callbackasm1(SB)
+	MOVV $583, R1
+	JMP  callbackasm1(SB)
+	MOVV $584, R1
+	JMP  callbackasm1(SB)
+	MOVV $585, R1
+	JMP  callbackasm1(SB)
+	MOVV $586, R1
+	JMP  callbackasm1(SB)
+	MOVV $587, R1
+	JMP  callbackasm1(SB)
+	MOVV $588, R1
+	JMP  callbackasm1(SB)
+	MOVV $589, R1
+	JMP  callbackasm1(SB)
+	MOVV $590, R1
+	JMP  callbackasm1(SB)
+	MOVV $591, R1
+	JMP  callbackasm1(SB)
+	MOVV $592, R1
+	JMP  callbackasm1(SB)
+	MOVV $593, R1
+	JMP  callbackasm1(SB)
+	MOVV $594, R1
+	JMP  callbackasm1(SB)
+	MOVV $595, R1
+	JMP  callbackasm1(SB)
+	MOVV $596, R1
+	JMP  callbackasm1(SB)
+	MOVV $597, R1
+	JMP  callbackasm1(SB)
+	MOVV $598, R1
+	JMP  callbackasm1(SB)
+	MOVV $599, R1
+	JMP  callbackasm1(SB)
+	MOVV $600, R1
+	JMP  callbackasm1(SB)
+	MOVV $601, R1
+	JMP  callbackasm1(SB)
+	MOVV $602, R1
+	JMP  callbackasm1(SB)
+	MOVV $603, R1
+	JMP  callbackasm1(SB)
+	MOVV $604, R1
+	JMP  callbackasm1(SB)
+	MOVV $605, R1
+	JMP  callbackasm1(SB)
+	MOVV $606, R1
+	JMP  callbackasm1(SB)
+	MOVV $607, R1
+	JMP  callbackasm1(SB)
+	MOVV $608, R1
+	JMP  callbackasm1(SB)
+	MOVV $609, R1
+	JMP  callbackasm1(SB)
+	MOVV $610, R1
+	JMP  callbackasm1(SB)
+	MOVV $611, R1
+	JMP  callbackasm1(SB)
+	MOVV $612, R1
+	JMP  callbackasm1(SB)
+	MOVV $613, R1
+	JMP  callbackasm1(SB)
+	MOVV $614, R1
+	JMP  callbackasm1(SB)
+	MOVV $615, R1
+	JMP  callbackasm1(SB)
+	MOVV $616, R1
+	JMP  callbackasm1(SB)
+	MOVV $617, R1
+	JMP  callbackasm1(SB)
+	MOVV $618, R1
+	JMP  callbackasm1(SB)
+	MOVV $619, R1
+	JMP  callbackasm1(SB)
+	MOVV $620, R1
+	JMP  callbackasm1(SB)
+	MOVV $621, R1
+	JMP  callbackasm1(SB)
+	MOVV $622, R1
+	JMP  callbackasm1(SB)
+	MOVV $623, R1
+	JMP  callbackasm1(SB)
+	MOVV $624, R1
+	JMP  callbackasm1(SB)
+	MOVV $625, R1
+	JMP  callbackasm1(SB)
+	MOVV $626, R1
+	JMP  callbackasm1(SB)
+	MOVV $627, R1
+	JMP  callbackasm1(SB)
+	MOVV $628, R1
+	JMP  callbackasm1(SB)
+	MOVV $629, R1
+	JMP  callbackasm1(SB)
+	MOVV $630, R1
+	JMP  callbackasm1(SB)
+	MOVV $631, R1
+	JMP  callbackasm1(SB)
+	MOVV $632, R1
+	JMP  callbackasm1(SB)
+	MOVV $633, R1
+	JMP  callbackasm1(SB)
+	MOVV $634, R1
+	JMP  callbackasm1(SB)
+	MOVV $635, R1
+	JMP  callbackasm1(SB)
+	MOVV $636, R1
+	JMP  callbackasm1(SB)
+	MOVV $637, R1
+	JMP  callbackasm1(SB)
+	MOVV $638, R1
+	JMP  callbackasm1(SB)
+	MOVV $639, R1
+	JMP  callbackasm1(SB)
+	MOVV $640, R1
+	JMP  callbackasm1(SB)
+	MOVV $641, R1
+	JMP  callbackasm1(SB)
+	MOVV $642, R1
+	JMP  callbackasm1(SB)
+	MOVV $643, R1
+	JMP  callbackasm1(SB)
+	MOVV $644, R1
+	JMP  callbackasm1(SB)
+	MOVV $645, R1
+	JMP  callbackasm1(SB)
+	MOVV $646, R1
+	JMP  callbackasm1(SB)
+	MOVV $647, R1
+	JMP  callbackasm1(SB)
+	MOVV $648, R1
+	JMP  callbackasm1(SB)
+	MOVV $649, R1
+	JMP  callbackasm1(SB)
+	MOVV $650, R1
+	JMP  callbackasm1(SB)
+	MOVV $651, R1
+	JMP  callbackasm1(SB)
+	MOVV $652, R1
+	JMP  callbackasm1(SB)
+	MOVV $653, R1
+	JMP  callbackasm1(SB)
+	MOVV $654, R1
+	JMP  callbackasm1(SB)
+	MOVV $655, R1
+	JMP  callbackasm1(SB)
+	MOVV $656, R1
+	JMP  callbackasm1(SB)
+	MOVV $657, R1
+	JMP  callbackasm1(SB)
+	MOVV $658, R1
+	JMP  callbackasm1(SB)
+	MOVV $659, R1
+	JMP  callbackasm1(SB)
+	MOVV $660, R1
+	JMP  callbackasm1(SB)
+	MOVV $661, R1
+	JMP  callbackasm1(SB)
+	MOVV $662, R1
+	JMP  callbackasm1(SB)
+	MOVV $663, R1
+	JMP  callbackasm1(SB)
+	MOVV $664, R1
+	JMP  callbackasm1(SB)
+	MOVV $665, R1
+	JMP  callbackasm1(SB)
+	MOVV $666, R1
+	JMP  callbackasm1(SB)
+	MOVV $667, R1
+	JMP  callbackasm1(SB)
+	MOVV $668, R1
+	JMP  callbackasm1(SB)
+	MOVV $669, R1
+	JMP  callbackasm1(SB)
+	MOVV $670, R1
+	JMP  callbackasm1(SB)
+	MOVV $671, R1
+	JMP  callbackasm1(SB)
+	MOVV $672, R1
+	JMP  callbackasm1(SB)
+	MOVV $673, R1
+	JMP  callbackasm1(SB)
+	MOVV $674, R1
+	JMP  callbackasm1(SB)
+	MOVV $675, R1
+	JMP  callbackasm1(SB)
+	MOVV $676, R1
+	JMP  callbackasm1(SB)
+	MOVV $677, R1
+	JMP  callbackasm1(SB)
+	MOVV $678, R1
+	JMP  callbackasm1(SB)
+	MOVV $679, R1
+	JMP  callbackasm1(SB)
+	MOVV $680, R1
+	JMP  callbackasm1(SB)
+	MOVV $681, R1
+	JMP  callbackasm1(SB)
+	MOVV $682, R1
+	JMP  callbackasm1(SB)
+	MOVV $683, R1
+	JMP  callbackasm1(SB)
+	MOVV $684, R1
+	JMP  callbackasm1(SB)
+	MOVV $685, R1
+	JMP  callbackasm1(SB)
+	MOVV $686, R1
+	JMP  callbackasm1(SB)
+	MOVV $687, R1
+	JMP  callbackasm1(SB)
+	MOVV $688, R1
+	JMP  callbackasm1(SB)
+	MOVV $689, R1
+	JMP  callbackasm1(SB)
+	MOVV $690, R1
+	JMP  callbackasm1(SB)
+	MOVV $691, R1
+	JMP  callbackasm1(SB)
+	MOVV $692, R1
+	JMP  callbackasm1(SB)
+	MOVV $693, R1
+	JMP  callbackasm1(SB)
+	MOVV $694, R1
+	JMP  callbackasm1(SB)
+	MOVV $695, R1
+	JMP  callbackasm1(SB)
+	MOVV $696, R1
+	JMP  callbackasm1(SB)
+	MOVV $697, R1
+	JMP  callbackasm1(SB)
+	MOVV $698, R1
+	JMP  callbackasm1(SB)
+	MOVV $699, R1
+	JMP  callbackasm1(SB)
+	MOVV $700, R1
+	JMP  callbackasm1(SB)
+	MOVV $701, R1
+	JMP  callbackasm1(SB)
+	MOVV $702, R1
+	JMP  callbackasm1(SB)
+	MOVV $703, R1
+	JMP  callbackasm1(SB)
+	MOVV $704, R1
+	JMP  callbackasm1(SB)
+	MOVV $705, R1
+	JMP  callbackasm1(SB)
+	MOVV $706, R1
+	JMP  callbackasm1(SB)
+	MOVV $707, R1
+	JMP  callbackasm1(SB)
+	MOVV $708, R1
+	JMP  callbackasm1(SB)
+	MOVV $709, R1
+	JMP  callbackasm1(SB)
+	MOVV $710, R1
+	JMP  callbackasm1(SB)
+	MOVV $711, R1
+	JMP  callbackasm1(SB)
+	MOVV $712, R1
+	JMP  callbackasm1(SB)
+	MOVV $713, R1
+	JMP  callbackasm1(SB)
+	MOVV $714, R1
+	JMP  callbackasm1(SB)
+	MOVV $715, R1
+	JMP  callbackasm1(SB)
+	MOVV $716, R1
+	JMP  callbackasm1(SB)
+	MOVV $717, R1
+	JMP  callbackasm1(SB)
+	MOVV $718, R1
+	JMP  callbackasm1(SB)
+	MOVV $719, R1
+	JMP  callbackasm1(SB)
+	MOVV $720, R1
+	JMP  callbackasm1(SB)
+	MOVV $721, R1
+	JMP  callbackasm1(SB)
+	MOVV $722, R1
+	JMP  callbackasm1(SB)
+	MOVV $723, R1
+	JMP  callbackasm1(SB)
+	MOVV $724, R1
+	JMP  callbackasm1(SB)
+	MOVV $725, R1
+	JMP  callbackasm1(SB)
+	MOVV $726, R1
+	JMP  callbackasm1(SB)
+	MOVV $727, R1
+	JMP  callbackasm1(SB)
+	MOVV $728, R1
+	JMP  callbackasm1(SB)
+	MOVV $729, R1
+	JMP  callbackasm1(SB)
+	MOVV $730, R1
+	JMP  callbackasm1(SB)
+	MOVV $731, R1
+	JMP  callbackasm1(SB)
+	MOVV $732, R1
+	JMP  callbackasm1(SB)
+	MOVV $733, R1
+	JMP  callbackasm1(SB)
+	MOVV $734, R1
+	JMP  callbackasm1(SB)
+	MOVV $735, R1
+	JMP  callbackasm1(SB)
+	MOVV $736, R1
+	JMP  callbackasm1(SB)
+	MOVV $737, R1
+	JMP  callbackasm1(SB)
+	MOVV $738, R1
+	JMP  callbackasm1(SB)
+	MOVV $739, R1
+	JMP  callbackasm1(SB)
+	MOVV $740, R1
+	JMP  callbackasm1(SB)
+	MOVV $741, R1
+	JMP  callbackasm1(SB)
+	MOVV $742, R1
+	JMP  callbackasm1(SB)
+	MOVV $743, R1
+	JMP  callbackasm1(SB)
+	MOVV $744, R1
+	JMP  callbackasm1(SB)
+	MOVV $745, R1
+	JMP  callbackasm1(SB)
+	MOVV $746, R1
+	JMP  callbackasm1(SB)
+	MOVV $747, R1
+	JMP  callbackasm1(SB)
+	MOVV $748, R1
+	JMP  callbackasm1(SB)
+	MOVV $749, R1
+	JMP  callbackasm1(SB)
+	MOVV $750, R1
+	JMP  callbackasm1(SB)
+	MOVV $751, R1
+	JMP  callbackasm1(SB)
+	MOVV $752, R1
+	JMP  callbackasm1(SB)
+	MOVV $753, R1
+	JMP  callbackasm1(SB)
+	MOVV $754, R1
+	JMP  callbackasm1(SB)
+	MOVV $755, R1
+	JMP  callbackasm1(SB)
+	MOVV $756, R1
+	JMP  callbackasm1(SB)
+	MOVV $757, R1
+	JMP  callbackasm1(SB)
+	MOVV $758, R1
+	JMP  callbackasm1(SB)
+	MOVV $759, R1
+	JMP  callbackasm1(SB)
+	MOVV $760, R1
+	JMP  callbackasm1(SB)
+	MOVV $761, R1
+	JMP  callbackasm1(SB)
+	MOVV $762, R1
+	JMP  callbackasm1(SB)
+	MOVV $763, R1
+	JMP  callbackasm1(SB)
+	MOVV $764, R1
+	JMP  callbackasm1(SB)
+	MOVV $765, R1
+	JMP  callbackasm1(SB)
+	MOVV $766, R1
+	JMP  callbackasm1(SB)
+	MOVV $767, R1
+	JMP  callbackasm1(SB)
+	MOVV $768, R1
+	JMP  callbackasm1(SB)
+	MOVV $769, R1
+	JMP  callbackasm1(SB)
+	MOVV $770, R1
+	JMP  callbackasm1(SB)
+	MOVV $771, R1
+	JMP  callbackasm1(SB)
+	MOVV $772, R1
+	JMP  callbackasm1(SB)
+	MOVV $773, R1
+	JMP  callbackasm1(SB)
+	MOVV $774, R1
+	JMP  callbackasm1(SB)
+	MOVV $775, R1
+	JMP  callbackasm1(SB)
+	MOVV $776, R1
+	JMP  callbackasm1(SB)
+	MOVV $777, R1
+	JMP  callbackasm1(SB)
+	MOVV $778, R1
+	JMP  callbackasm1(SB)
+	MOVV $779, R1
+	JMP  callbackasm1(SB)
+	MOVV $780, R1
+	JMP  callbackasm1(SB)
+	MOVV $781, R1
+	JMP  callbackasm1(SB)
+	MOVV $782, R1
+	JMP  callbackasm1(SB)
+	MOVV $783, R1
+	JMP  callbackasm1(SB)
+	MOVV $784, R1
+	JMP  callbackasm1(SB)
+	MOVV $785, R1
+	JMP  callbackasm1(SB)
+	MOVV $786, R1
+	JMP  callbackasm1(SB)
+	MOVV $787, R1
+	JMP  callbackasm1(SB)
+	MOVV $788, R1
+	JMP  callbackasm1(SB)
+	MOVV $789, R1
+	JMP  callbackasm1(SB)
+	MOVV $790, R1
+	JMP  callbackasm1(SB)
+	MOVV $791, R1
+	JMP  callbackasm1(SB)
+	MOVV $792, R1
+	JMP  callbackasm1(SB)
+	MOVV $793, R1
+	JMP  callbackasm1(SB)
+	MOVV $794, R1
+	JMP  callbackasm1(SB)
+	MOVV $795, R1
+	JMP  callbackasm1(SB)
+	MOVV $796, R1
+	JMP  callbackasm1(SB)
+	MOVV $797, R1
+	JMP  callbackasm1(SB)
+	MOVV $798, R1
+	JMP  callbackasm1(SB)
+	MOVV $799, R1
+	JMP  callbackasm1(SB)
+	MOVV $800, R1
+	JMP  callbackasm1(SB)
+	MOVV $801, R1
+	JMP  callbackasm1(SB)
+	MOVV $802, R1
+	JMP  callbackasm1(SB)
+	MOVV $803, R1
+	JMP  callbackasm1(SB)
+	MOVV $804, R1
+	JMP  callbackasm1(SB)
+	MOVV $805, R1
+	JMP  callbackasm1(SB)
+	MOVV $806, R1
+	JMP  callbackasm1(SB)
+	MOVV $807, R1
+	JMP  callbackasm1(SB)
+	MOVV $808, R1
+	JMP  callbackasm1(SB)
+	MOVV $809, R1
+	JMP  callbackasm1(SB)
+	MOVV $810, R1
+	JMP  callbackasm1(SB)
+	MOVV $811, R1
+	JMP  callbackasm1(SB)
+	MOVV $812, R1
+	JMP  callbackasm1(SB)
+	MOVV $813, R1
+	JMP  callbackasm1(SB)
+	MOVV $814, R1
+	JMP  callbackasm1(SB)
+	MOVV $815, R1
+	JMP  callbackasm1(SB)
+	MOVV $816, R1
+	JMP  callbackasm1(SB)
+	MOVV $817, R1
+	JMP  callbackasm1(SB)
+	MOVV $818, R1
+	JMP  callbackasm1(SB)
+	MOVV $819, R1
+	JMP  callbackasm1(SB)
+	MOVV $820, R1
+	JMP  callbackasm1(SB)
+	MOVV $821, R1
+	JMP  callbackasm1(SB)
+	MOVV $822, R1
+	JMP  callbackasm1(SB)
+	MOVV $823, R1
+	JMP  callbackasm1(SB)
+	MOVV $824, R1
+	JMP  callbackasm1(SB)
+	MOVV $825, R1
+	JMP  callbackasm1(SB)
+	MOVV $826, R1
+	JMP  callbackasm1(SB)
+	MOVV $827, R1
+	JMP  callbackasm1(SB)
+	MOVV $828, R1
+	JMP  callbackasm1(SB)
+	MOVV $829, R1
+	JMP  callbackasm1(SB)
+	MOVV $830, R1
+	JMP  callbackasm1(SB)
+	MOVV $831, R1
+	JMP  callbackasm1(SB)
+	MOVV $832, R1
+	JMP  callbackasm1(SB)
+	MOVV $833, R1
+	JMP  callbackasm1(SB)
+	MOVV $834, R1
+	JMP  callbackasm1(SB)
+	MOVV $835, R1
+	JMP  callbackasm1(SB)
+	MOVV $836, R1
+	JMP  callbackasm1(SB)
+	MOVV $837, R1
+	JMP  callbackasm1(SB)
+	MOVV $838, R1
+	JMP  callbackasm1(SB)
+	MOVV $839, R1
+	JMP  callbackasm1(SB)
+	MOVV $840, R1
+	JMP  callbackasm1(SB)
+	MOVV $841, R1
+	JMP  callbackasm1(SB)
+	MOVV $842, R1
+	JMP  callbackasm1(SB)
+	MOVV $843, R1
+	JMP  callbackasm1(SB)
+	MOVV $844, R1
+	JMP  callbackasm1(SB)
+	MOVV $845, R1
+	JMP  callbackasm1(SB)
+	MOVV $846, R1
+	JMP  callbackasm1(SB)
+	MOVV $847, R1
+	JMP  callbackasm1(SB)
+	MOVV $848, R1
+	JMP  callbackasm1(SB)
+	MOVV $849, R1
+	JMP  callbackasm1(SB)
+	MOVV $850, R1
+	JMP  callbackasm1(SB)
+	MOVV $851, R1
+	JMP  callbackasm1(SB)
+	MOVV $852, R1
+	JMP  callbackasm1(SB)
+	MOVV $853, R1
+	JMP  callbackasm1(SB)
+	MOVV $854, R1
+	JMP  callbackasm1(SB)
+	MOVV $855, R1
+	JMP  callbackasm1(SB)
+	MOVV $856, R1
+	JMP  callbackasm1(SB)
+	MOVV $857, R1
+	JMP  callbackasm1(SB)
+	MOVV $858, R1
+	JMP  callbackasm1(SB)
+	MOVV $859, R1
+	JMP  callbackasm1(SB)
+	MOVV $860, R1
+	JMP  callbackasm1(SB)
+	MOVV $861, R1
+	JMP  callbackasm1(SB)
+	MOVV $862, R1
+	JMP  callbackasm1(SB)
+	MOVV $863, R1
+	JMP  callbackasm1(SB)
+	MOVV $864, R1
+	JMP  callbackasm1(SB)
+	MOVV $865, R1
+	JMP  callbackasm1(SB)
+	MOVV $866, R1
+	JMP  callbackasm1(SB)
+	MOVV $867, R1
+	JMP  callbackasm1(SB)
+	MOVV $868, R1
+	JMP  callbackasm1(SB)
+	MOVV $869, R1
+	JMP  callbackasm1(SB)
+	MOVV $870, R1
+	JMP  callbackasm1(SB)
+	MOVV $871, R1
+	JMP  callbackasm1(SB)
+	MOVV $872, R1
+	JMP  callbackasm1(SB)
+	MOVV $873, R1
+	JMP  callbackasm1(SB)
+	MOVV $874, R1
+	JMP  callbackasm1(SB)
+	MOVV $875, R1
+	JMP  callbackasm1(SB)
+	MOVV $876, R1
+	JMP  callbackasm1(SB)
+	MOVV $877, R1
+	JMP  callbackasm1(SB)
+	MOVV $878, R1
+	JMP  callbackasm1(SB)
+	MOVV $879, R1
+	JMP  callbackasm1(SB)
+	MOVV $880, R1
+	JMP  callbackasm1(SB)
+	MOVV $881, R1
+	JMP  callbackasm1(SB)
+	MOVV $882, R1
+	JMP  callbackasm1(SB)
+	MOVV $883, R1
+	JMP  callbackasm1(SB)
+	MOVV $884, R1
+	JMP  callbackasm1(SB)
+	MOVV $885, R1
+	JMP  callbackasm1(SB)
+	MOVV $886, R1
+	JMP  callbackasm1(SB)
+	MOVV $887, R1
+	JMP  callbackasm1(SB)
+	MOVV $888, R1
+	JMP  callbackasm1(SB)
+	MOVV $889, R1
+	JMP  callbackasm1(SB)
+	MOVV $890, R1
+	JMP  callbackasm1(SB)
+	MOVV $891, R1
+	JMP  callbackasm1(SB)
+	MOVV $892, R1
+	JMP  callbackasm1(SB)
+	MOVV $893, R1
+	JMP  callbackasm1(SB)
+	MOVV $894, R1
+	JMP  callbackasm1(SB)
+	MOVV $895, R1
+	JMP  callbackasm1(SB)
+	MOVV $896, R1
+	JMP  callbackasm1(SB)
+	MOVV $897, R1
+	JMP  callbackasm1(SB)
+	MOVV $898, R1
+	JMP  callbackasm1(SB)
+	MOVV $899, R1
+	JMP  callbackasm1(SB)
+	MOVV $900, R1
+	JMP  callbackasm1(SB)
+	MOVV $901, R1
+	JMP  callbackasm1(SB)
+	MOVV $902, R1
+	JMP  callbackasm1(SB)
+	MOVV $903, R1
+	JMP  callbackasm1(SB)
+	MOVV $904, R1
+	JMP  callbackasm1(SB)
+	MOVV $905, R1
+	JMP  callbackasm1(SB)
+	MOVV $906, R1
+	JMP  callbackasm1(SB)
+	MOVV $907, R1
+	JMP  callbackasm1(SB)
+	MOVV $908, R1
+	JMP  callbackasm1(SB)
+	MOVV $909, R1
+	JMP  callbackasm1(SB)
+	MOVV $910, R1
+	JMP  callbackasm1(SB)
+	MOVV $911, R1
+	JMP  callbackasm1(SB)
+	MOVV $912, R1
+	JMP  callbackasm1(SB)
+	MOVV $913, R1
+	JMP  callbackasm1(SB)
+	MOVV $914, R1
+	JMP  callbackasm1(SB)
+	MOVV $915, R1
+	JMP  callbackasm1(SB)
+	MOVV $916, R1
+	JMP  callbackasm1(SB)
+	MOVV $917, R1
+	JMP  callbackasm1(SB)
+	MOVV $918, R1
+	JMP  callbackasm1(SB)
+	MOVV $919, R1
+	JMP  callbackasm1(SB)
+	MOVV $920, R1
+	JMP  callbackasm1(SB)
+	MOVV $921, R1
+	JMP  callbackasm1(SB)
+	MOVV $922, R1
+	JMP  callbackasm1(SB)
+	MOVV $923, R1
+	JMP  callbackasm1(SB)
+	MOVV $924, R1
+	JMP  callbackasm1(SB)
+	MOVV $925, R1
+	JMP  callbackasm1(SB)
+	MOVV $926, R1
+	JMP  callbackasm1(SB)
+	MOVV $927, R1
+	JMP  callbackasm1(SB)
+	MOVV $928, R1
+	JMP  callbackasm1(SB)
+	MOVV $929, R1
+	JMP  callbackasm1(SB)
+	MOVV $930, R1
+	JMP  callbackasm1(SB)
+	MOVV $931, R1
+	JMP  callbackasm1(SB)
+	MOVV $932, R1
+	JMP  callbackasm1(SB)
+	MOVV $933, R1
+	JMP  callbackasm1(SB)
+	MOVV $934, R1
+	JMP  callbackasm1(SB)
+	MOVV $935, R1
+	JMP  callbackasm1(SB)
+	MOVV $936, R1
+	JMP  callbackasm1(SB)
+	MOVV $937, R1
+	JMP  callbackasm1(SB)
+	MOVV $938, R1
+	JMP  callbackasm1(SB)
+	MOVV $939, R1
+	JMP  callbackasm1(SB)
+	MOVV $940, R1
+	JMP  callbackasm1(SB)
+	MOVV $941, R1
+	JMP  callbackasm1(SB)
+	MOVV $942, R1
+	JMP  callbackasm1(SB)
+	MOVV $943, R1
+	JMP  callbackasm1(SB)
+	MOVV $944, R1
+	JMP  callbackasm1(SB)
+	MOVV $945, R1
+	JMP  callbackasm1(SB)
+	MOVV $946, R1
+	JMP  callbackasm1(SB)
+	MOVV $947, R1
+	JMP  callbackasm1(SB)
+	MOVV $948, R1
+	JMP  callbackasm1(SB)
+	MOVV $949, R1
+	JMP  callbackasm1(SB)
+	MOVV $950, R1
+	JMP  callbackasm1(SB)
+	MOVV $951, R1
+	JMP  callbackasm1(SB)
+	MOVV $952, R1
+	JMP  callbackasm1(SB)
+	MOVV $953, R1
+	JMP  callbackasm1(SB)
+	MOVV $954, R1
+	JMP  callbackasm1(SB)
+	MOVV $955, R1
+	JMP  callbackasm1(SB)
+	MOVV $956, R1
+	JMP  callbackasm1(SB)
+	MOVV $957, R1
+	JMP  callbackasm1(SB)
+	MOVV $958, R1
+	JMP  callbackasm1(SB)
+	MOVV $959, R1
+	JMP  callbackasm1(SB)
+	MOVV $960, R1
+	JMP  callbackasm1(SB)
+	MOVV $961, R1
+	JMP  callbackasm1(SB)
+	MOVV $962, R1
+	JMP  callbackasm1(SB)
+	MOVV $963, R1
+	JMP  callbackasm1(SB)
+	MOVV $964, R1
+	JMP  callbackasm1(SB)
+	MOVV $965, R1
+	JMP  callbackasm1(SB)
+	MOVV $966, R1
+	JMP  callbackasm1(SB)
+	MOVV $967, R1
+	JMP  callbackasm1(SB)
+	MOVV $968, R1
+	JMP  callbackasm1(SB)
+	MOVV $969, R1
+	JMP  callbackasm1(SB)
+	MOVV $970, R1
+	JMP  callbackasm1(SB)
+	MOVV $971, R1
+	JMP  callbackasm1(SB)
+	MOVV $972, R1
+	JMP  callbackasm1(SB)
+	MOVV $973, R1
+	JMP  callbackasm1(SB)
+	MOVV $974, R1
+	JMP  callbackasm1(SB)
+	MOVV $975, R1
+	JMP  callbackasm1(SB)
+	MOVV $976, R1
+	JMP  callbackasm1(SB)
+	MOVV $977, R1
+	JMP  callbackasm1(SB)
+	MOVV $978, R1
+	JMP  callbackasm1(SB)
+	MOVV $979, R1
+	JMP  callbackasm1(SB)
+	MOVV $980, R1
+	JMP  callbackasm1(SB)
+	MOVV $981, R1
+	JMP  callbackasm1(SB)
+	MOVV $982, R1
+	JMP  callbackasm1(SB)
+	MOVV $983, R1
+	JMP  callbackasm1(SB)
+	MOVV $984, R1
+	JMP  callbackasm1(SB)
+	MOVV $985, R1
+	JMP  callbackasm1(SB)
+	MOVV $986, R1
+	JMP  callbackasm1(SB)
+	MOVV $987, R1
+	JMP  callbackasm1(SB)
+	MOVV $988, R1
+	JMP  callbackasm1(SB)
+	MOVV $989, R1
+	JMP  callbackasm1(SB)
+	MOVV $990, R1
+	JMP  callbackasm1(SB)
+	MOVV $991, R1
+	JMP  callbackasm1(SB)
+	MOVV $992, R1
+	JMP  callbackasm1(SB)
+	MOVV $993, R1
+	JMP  callbackasm1(SB)
+	MOVV $994, R1
+	JMP  callbackasm1(SB)
+	MOVV $995, R1
+	JMP  callbackasm1(SB)
+	MOVV $996, R1
+	JMP  callbackasm1(SB)
+	MOVV $997, R1
+	JMP  callbackasm1(SB)
+	MOVV $998, R1
+	JMP  callbackasm1(SB)
+	MOVV $999, R1
+	JMP  callbackasm1(SB)
+	MOVV $1000, R1
+	JMP  callbackasm1(SB)
+	MOVV $1001, R1
+	JMP  callbackasm1(SB)
+	MOVV $1002, R1
+	JMP  callbackasm1(SB)
+	MOVV $1003, R1
+	JMP  callbackasm1(SB)
+	MOVV $1004, R1
+	JMP  callbackasm1(SB)
+	MOVV $1005, R1
+	JMP  callbackasm1(SB)
+	MOVV $1006, R1
+	JMP  callbackasm1(SB)
+	MOVV $1007, R1
+	JMP  callbackasm1(SB)
+	MOVV $1008, R1
+	JMP  callbackasm1(SB)
+	MOVV $1009, R1
+	JMP  callbackasm1(SB)
+	MOVV $1010, R1
+	JMP  callbackasm1(SB)
+	MOVV $1011, R1
+	JMP  callbackasm1(SB)
+	MOVV $1012, R1
+	JMP  callbackasm1(SB)
+	MOVV $1013, R1
+	JMP  callbackasm1(SB)
+	MOVV $1014, R1
+	JMP  callbackasm1(SB)
+	MOVV $1015, R1
+	JMP  callbackasm1(SB)
+	MOVV $1016, R1
+	JMP  callbackasm1(SB)
+	MOVV $1017, R1
+	JMP  callbackasm1(SB)
+	MOVV $1018, R1
+	JMP  callbackasm1(SB)
+	MOVV $1019, R1
+	JMP  callbackasm1(SB)
+	MOVV $1020, R1
+	JMP  callbackasm1(SB)
+	MOVV $1021, R1
+	JMP  callbackasm1(SB)
+	MOVV $1022, R1
+	JMP  callbackasm1(SB)
+	MOVV $1023, R1
+	JMP  callbackasm1(SB)
+	MOVV $1024, R1
+	JMP  callbackasm1(SB)
+	MOVV $1025, R1
+	JMP  callbackasm1(SB)
+	MOVV $1026, R1
+	JMP  callbackasm1(SB)
+	MOVV $1027, R1
+	JMP  callbackasm1(SB)
+	MOVV $1028, R1
+	JMP  callbackasm1(SB)
+	MOVV $1029, R1
+	JMP  callbackasm1(SB)
+	MOVV $1030, R1
+	JMP  callbackasm1(SB)
+	MOVV $1031, R1
+	JMP  callbackasm1(SB)
+	MOVV $1032, R1
+	JMP  callbackasm1(SB)
+	MOVV $1033, R1
+	JMP  callbackasm1(SB)
+	MOVV $1034, R1
+	JMP  callbackasm1(SB)
+	MOVV $1035, R1
+	JMP  callbackasm1(SB)
+	MOVV $1036, R1
+	JMP  callbackasm1(SB)
+	MOVV $1037, R1
+	JMP  callbackasm1(SB)
+	MOVV $1038, R1
+	JMP  callbackasm1(SB)
+	MOVV $1039, R1
+	JMP  callbackasm1(SB)
+	MOVV $1040, R1
+	JMP  callbackasm1(SB)
+	MOVV $1041, R1
+	JMP  callbackasm1(SB)
+	MOVV $1042, R1
+	JMP  callbackasm1(SB)
+	MOVV $1043, R1
+	JMP  callbackasm1(SB)
+	MOVV $1044, R1
+	JMP  callbackasm1(SB)
+	MOVV $1045, R1
+	JMP  callbackasm1(SB)
+	MOVV $1046, R1
+	JMP  callbackasm1(SB)
+	MOVV $1047, R1
+	JMP  callbackasm1(SB)
+	MOVV $1048, R1
+	JMP  callbackasm1(SB)
+	MOVV $1049, R1
+	JMP  callbackasm1(SB)
+	MOVV $1050, R1
+	JMP  callbackasm1(SB)
+	MOVV $1051, R1
+	JMP  callbackasm1(SB)
+	MOVV $1052, R1
+	JMP  callbackasm1(SB)
+	MOVV $1053, R1
+	JMP  callbackasm1(SB)
+	MOVV $1054, R1
+	JMP  callbackasm1(SB)
+	MOVV $1055, R1
+	JMP  callbackasm1(SB)
+	MOVV $1056, R1
+	JMP  callbackasm1(SB)
+	MOVV $1057, R1
+	JMP  callbackasm1(SB)
+	MOVV $1058, R1
+	JMP  callbackasm1(SB)
+	MOVV $1059, R1
+	JMP  callbackasm1(SB)
+	MOVV $1060, R1
+	JMP  callbackasm1(SB)
+	MOVV $1061, R1
+	JMP  callbackasm1(SB)
+	MOVV $1062, R1
+	JMP  callbackasm1(SB)
+	MOVV $1063, R1
+	JMP  callbackasm1(SB)
+	MOVV $1064, R1
+	JMP  callbackasm1(SB)
+	MOVV $1065, R1
+	JMP  callbackasm1(SB)
+	MOVV $1066, R1
+	JMP  callbackasm1(SB)
+	MOVV $1067, R1
+	JMP  callbackasm1(SB)
+	MOVV $1068, R1
+	JMP  callbackasm1(SB)
+	MOVV $1069, R1
+	JMP  callbackasm1(SB)
+	MOVV $1070, R1
+	JMP  callbackasm1(SB)
+	MOVV $1071, R1
+	JMP  callbackasm1(SB)
+	MOVV $1072, R1
+	JMP  callbackasm1(SB)
+	MOVV $1073, R1
+	JMP  callbackasm1(SB)
+	MOVV $1074, R1
+	JMP  callbackasm1(SB)
+	MOVV $1075, R1
+	JMP  callbackasm1(SB)
+	MOVV $1076, R1
+	JMP  callbackasm1(SB)
+	MOVV $1077, R1
+	JMP  callbackasm1(SB)
+	MOVV $1078, R1
+	JMP  callbackasm1(SB)
+	MOVV $1079, R1
+	JMP  callbackasm1(SB)
+	MOVV $1080, R1
+	JMP  callbackasm1(SB)
+	MOVV $1081, R1
+	JMP  callbackasm1(SB)
+	MOVV $1082, R1
+	JMP  callbackasm1(SB)
+	MOVV $1083, R1
+	JMP  callbackasm1(SB)
+	MOVV $1084, R1
+	JMP  callbackasm1(SB)
+	MOVV $1085, R1
+	JMP  callbackasm1(SB)
+	MOVV $1086, R1
+	JMP  callbackasm1(SB)
+	MOVV $1087, R1
+	JMP  callbackasm1(SB)
+	MOVV $1088, R1
+	JMP  callbackasm1(SB)
+	MOVV $1089, R1
+	JMP  callbackasm1(SB)
+	MOVV $1090, R1
+	JMP  callbackasm1(SB)
+	MOVV $1091, R1
+	JMP  callbackasm1(SB)
+	MOVV $1092, R1
+	JMP  callbackasm1(SB)
+	MOVV $1093, R1
+	JMP  callbackasm1(SB)
+	MOVV $1094, R1
+	JMP  callbackasm1(SB)
+	MOVV $1095, R1
+	JMP  callbackasm1(SB)
+	MOVV $1096, R1
+	JMP  callbackasm1(SB)
+	MOVV $1097, R1
+	JMP  callbackasm1(SB)
+	MOVV $1098, R1
+	JMP  callbackasm1(SB)
+	MOVV $1099, R1
+	JMP  callbackasm1(SB)
+	MOVV $1100, R1
+	JMP  callbackasm1(SB)
+	MOVV $1101, R1
+	JMP  callbackasm1(SB)
+	MOVV $1102, R1
+	JMP  callbackasm1(SB)
+	MOVV $1103, R1
+	JMP  callbackasm1(SB)
+	MOVV $1104, R1
+	JMP  callbackasm1(SB)
+	MOVV $1105, R1
+	JMP  callbackasm1(SB)
+	MOVV $1106, R1
+	JMP  callbackasm1(SB)
+	MOVV $1107, R1
+	JMP  callbackasm1(SB)
+	MOVV $1108, R1
+	JMP  callbackasm1(SB)
+	MOVV $1109, R1
+	JMP  callbackasm1(SB)
+	MOVV $1110, R1
+	JMP  callbackasm1(SB)
+	MOVV $1111, R1
+	JMP  callbackasm1(SB)
+	MOVV $1112, R1
+	JMP  callbackasm1(SB)
+	MOVV $1113, R1
+	JMP  callbackasm1(SB)
+	MOVV $1114, R1
+	JMP  callbackasm1(SB)
+	MOVV $1115, R1
+	JMP  callbackasm1(SB)
+	MOVV $1116, R1
+	JMP  callbackasm1(SB)
+	MOVV $1117, R1
+	JMP  callbackasm1(SB)
+	MOVV $1118, R1
+	JMP  callbackasm1(SB)
+	MOVV $1119, R1
+	JMP  callbackasm1(SB)
+	MOVV $1120, R1
+	JMP  callbackasm1(SB)
+	MOVV $1121, R1
+	JMP  callbackasm1(SB)
+	MOVV $1122, R1
+	JMP  callbackasm1(SB)
+	MOVV $1123, R1
+	JMP  callbackasm1(SB)
+	MOVV $1124, R1
+	JMP  callbackasm1(SB)
+	MOVV $1125, R1
+	JMP  callbackasm1(SB)
+	MOVV $1126, R1
+	JMP  callbackasm1(SB)
+	MOVV $1127, R1
+	JMP  callbackasm1(SB)
+	MOVV $1128, R1
+	JMP  callbackasm1(SB)
+	MOVV $1129, R1
+	JMP  callbackasm1(SB)
+	MOVV $1130, R1
+	JMP  callbackasm1(SB)
+	MOVV $1131, R1
+	JMP  callbackasm1(SB)
+	MOVV $1132, R1
+	JMP  callbackasm1(SB)
+	MOVV $1133, R1
+	JMP  callbackasm1(SB)
+	MOVV $1134, R1
+	JMP  callbackasm1(SB)
+	MOVV $1135, R1
+	JMP  callbackasm1(SB)
+	MOVV $1136, R1
+	JMP  callbackasm1(SB)
+	MOVV $1137, R1
+	JMP  callbackasm1(SB)
+	MOVV $1138, R1
+	JMP  callbackasm1(SB)
+	MOVV $1139, R1
+	JMP  callbackasm1(SB)
+	MOVV $1140, R1
+	JMP  callbackasm1(SB)
+	MOVV $1141, R1
+	JMP  callbackasm1(SB)
+	MOVV $1142, R1
+	JMP  callbackasm1(SB)
+	MOVV $1143, R1
+	JMP  callbackasm1(SB)
+	MOVV $1144, R1
+	JMP  callbackasm1(SB)
+	MOVV $1145, R1
+	JMP  callbackasm1(SB)
+	MOVV $1146, R1
+	JMP  callbackasm1(SB)
+	MOVV $1147, R1
+	JMP  callbackasm1(SB)
+	MOVV $1148, R1
+	JMP  callbackasm1(SB)
+	MOVV $1149, R1
+	JMP  callbackasm1(SB)
+	MOVV $1150, R1
+	JMP  callbackasm1(SB)
+	MOVV $1151, R1
+	JMP  callbackasm1(SB)
+	MOVV $1152, R1
+	JMP  callbackasm1(SB)
+	MOVV $1153, R1
+	JMP  callbackasm1(SB)
+	MOVV $1154, R1
+	JMP  callbackasm1(SB)
+	MOVV $1155, R1
+	JMP  callbackasm1(SB)
+	MOVV $1156, R1
+	JMP  callbackasm1(SB)
+	MOVV $1157, R1
+	JMP  callbackasm1(SB)
+	MOVV $1158, R1
+	JMP  callbackasm1(SB)
+	MOVV $1159, R1
+	JMP  callbackasm1(SB)
+	MOVV $1160, R1
+	JMP  callbackasm1(SB)
+	MOVV $1161, R1
+	JMP  callbackasm1(SB)
+	MOVV $1162, R1
+	JMP  callbackasm1(SB)
+	MOVV $1163, R1
+	JMP  callbackasm1(SB)
+	MOVV $1164, R1
+	JMP  callbackasm1(SB)
+	MOVV $1165, R1
+	JMP  callbackasm1(SB)
+	MOVV $1166, R1
+	JMP  callbackasm1(SB)
+	MOVV $1167, R1
+	JMP  callbackasm1(SB)
+	MOVV $1168, R1
+	JMP  callbackasm1(SB)
+	MOVV $1169, R1
+	JMP  callbackasm1(SB)
+	MOVV $1170, R1
+	JMP  callbackasm1(SB)
+	MOVV $1171, R1
+	JMP  callbackasm1(SB)
+	MOVV $1172, R1
+	JMP  callbackasm1(SB)
+	MOVV $1173, R1
+	JMP  callbackasm1(SB)
+	MOVV $1174, R1
+	JMP  callbackasm1(SB)
+	MOVV $1175, R1
+	JMP  callbackasm1(SB)
+	MOVV $1176, R1
+	JMP  callbackasm1(SB)
+	MOVV $1177, R1
+	JMP  callbackasm1(SB)
+	MOVV $1178, R1
+	JMP  callbackasm1(SB)
+	MOVV $1179, R1
+	JMP  callbackasm1(SB)
+	MOVV $1180, R1
+	JMP  callbackasm1(SB)
+	MOVV $1181, R1
+	JMP  callbackasm1(SB)
+	MOVV $1182, R1
+	JMP  callbackasm1(SB)
+	MOVV $1183, R1
+	JMP  callbackasm1(SB)
+	MOVV $1184, R1
+	JMP  callbackasm1(SB)
+	MOVV $1185, R1
+	JMP  callbackasm1(SB)
+	MOVV $1186, R1
+	JMP  callbackasm1(SB)
+	MOVV $1187, R1
+	JMP  callbackasm1(SB)
+	MOVV $1188, R1
+	JMP  callbackasm1(SB)
+	MOVV $1189, R1
+	JMP  callbackasm1(SB)
+	MOVV $1190, R1
+	JMP  callbackasm1(SB)
+	MOVV $1191, R1
+	JMP  callbackasm1(SB)
+	MOVV $1192, R1
+	JMP  callbackasm1(SB)
+	MOVV $1193, R1
+	JMP  callbackasm1(SB)
+	MOVV $1194, R1
+	JMP  callbackasm1(SB)
+	MOVV $1195, R1
+	JMP  callbackasm1(SB)
+	MOVV $1196, R1
+	JMP  callbackasm1(SB)
+	MOVV $1197, R1
+	JMP  callbackasm1(SB)
+	MOVV $1198, R1
+	JMP  callbackasm1(SB)
+	MOVV $1199, R1
+	JMP  callbackasm1(SB)
+	MOVV $1200, R1
+	JMP  callbackasm1(SB)
+	MOVV $1201, R1
+	JMP  callbackasm1(SB)
+	MOVV $1202, R1
+	JMP  callbackasm1(SB)
+	MOVV $1203, R1
+	JMP  callbackasm1(SB)
+	MOVV $1204, R1
+	JMP  callbackasm1(SB)
+	MOVV $1205, R1
+	JMP  callbackasm1(SB)
+	MOVV $1206, R1
+	JMP  callbackasm1(SB)
+	MOVV $1207, R1
+	JMP  callbackasm1(SB)
+	MOVV $1208, R1
+	JMP  callbackasm1(SB)
+	MOVV $1209, R1
+	JMP  callbackasm1(SB)
+	MOVV $1210, R1
+	JMP  callbackasm1(SB)
+	MOVV $1211, R1
+	JMP  callbackasm1(SB)
+	MOVV $1212, R1
+	JMP  callbackasm1(SB)
+	MOVV $1213, R1
+	JMP  callbackasm1(SB)
+	MOVV $1214, R1
+	JMP  callbackasm1(SB)
+	MOVV $1215, R1
+	JMP  callbackasm1(SB)
+	MOVV $1216, R1
+	JMP  callbackasm1(SB)
+	MOVV $1217, R1
+	JMP  callbackasm1(SB)
+	MOVV $1218, R1
+	JMP  callbackasm1(SB)
+	MOVV $1219, R1
+	JMP  callbackasm1(SB)
+	MOVV $1220, R1
+	JMP  callbackasm1(SB)
+	MOVV $1221, R1
+	JMP  callbackasm1(SB)
+	MOVV $1222, R1
+	JMP  callbackasm1(SB)
+	MOVV $1223, R1
+	JMP  callbackasm1(SB)
+	MOVV $1224, R1
+	JMP  callbackasm1(SB)
+	MOVV $1225, R1
+	JMP  callbackasm1(SB)
+	MOVV $1226, R1
+	JMP  callbackasm1(SB)
+	MOVV $1227, R1
+	JMP  callbackasm1(SB)
+	MOVV $1228, R1
+	JMP  callbackasm1(SB)
+	MOVV $1229, R1
+	JMP  callbackasm1(SB)
+	MOVV $1230, R1
+	JMP  callbackasm1(SB)
+	MOVV $1231, R1
+	JMP  callbackasm1(SB)
+	MOVV $1232, R1
+	JMP  callbackasm1(SB)
+	MOVV $1233, R1
+	JMP  callbackasm1(SB)
+	MOVV $1234, R1
+	JMP  callbackasm1(SB)
+	MOVV $1235, R1
+	JMP  callbackasm1(SB)
+	MOVV $1236, R1
+	JMP  callbackasm1(SB)
+	MOVV $1237, R1
+	JMP  callbackasm1(SB)
+	MOVV $1238, R1
+	JMP  callbackasm1(SB)
+	MOVV $1239, R1
+	JMP  callbackasm1(SB)
+	MOVV $1240, R1
+	JMP  callbackasm1(SB)
+	MOVV $1241, R1
+	JMP  callbackasm1(SB)
+	MOVV $1242, R1
+	JMP  callbackasm1(SB)
+	MOVV $1243, R1
+	JMP  callbackasm1(SB)
+	MOVV $1244, R1
+	JMP  callbackasm1(SB)
+	MOVV $1245, R1
+	JMP  callbackasm1(SB)
+	MOVV $1246, R1
+	JMP  callbackasm1(SB)
+	MOVV $1247, R1
+	JMP  callbackasm1(SB)
+	MOVV $1248, R1
+	JMP  callbackasm1(SB)
+	MOVV $1249, R1
+	JMP  callbackasm1(SB)
+	MOVV $1250, R1
+	JMP  callbackasm1(SB)
+	MOVV $1251, R1
+	JMP  callbackasm1(SB)
+	MOVV $1252, R1
+	JMP  callbackasm1(SB)
+	MOVV $1253, R1
+	JMP  callbackasm1(SB)
+	MOVV $1254, R1
+	JMP  callbackasm1(SB)
+	MOVV $1255, R1
+	JMP  callbackasm1(SB)
+	MOVV $1256, R1
+	JMP  callbackasm1(SB)
+	MOVV $1257, R1
+	JMP  callbackasm1(SB)
+	MOVV $1258, R1
+	JMP  callbackasm1(SB)
+	MOVV $1259, R1
+	JMP  callbackasm1(SB)
+	MOVV $1260, R1
+	JMP  callbackasm1(SB)
+	MOVV $1261, R1
+	JMP  callbackasm1(SB)
+	MOVV $1262, R1
+	JMP  callbackasm1(SB)
+	MOVV $1263, R1
+	JMP  callbackasm1(SB)
+	MOVV $1264, R1
+	JMP  callbackasm1(SB)
+	MOVV $1265, R1
+	JMP  callbackasm1(SB)
+	MOVV $1266, R1
+	JMP  callbackasm1(SB)
+	MOVV $1267, R1
+	JMP  callbackasm1(SB)
+	MOVV $1268, R1
+	JMP  callbackasm1(SB)
+	MOVV $1269, R1
+	JMP  callbackasm1(SB)
+	MOVV $1270, R1
+	JMP  callbackasm1(SB)
+	MOVV $1271, R1
+	JMP  callbackasm1(SB)
+	MOVV $1272, R1
+	JMP  callbackasm1(SB)
+	MOVV $1273, R1
+	JMP  callbackasm1(SB)
+	MOVV $1274, R1
+	JMP  callbackasm1(SB)
+	MOVV $1275, R1
+	JMP  callbackasm1(SB)
+	MOVV $1276, R1
+	JMP  callbackasm1(SB)
+	MOVV $1277, R1
+	JMP  callbackasm1(SB)
+	MOVV $1278, R1
+	JMP  callbackasm1(SB)
+	MOVV $1279, R1
+	JMP  callbackasm1(SB)
+	MOVV $1280, R1
+	JMP  callbackasm1(SB)
+	MOVV $1281, R1
+	JMP  callbackasm1(SB)
+	MOVV $1282, R1
+	JMP  callbackasm1(SB)
+	MOVV $1283, R1
+	JMP  callbackasm1(SB)
+	MOVV $1284, R1
+	JMP  callbackasm1(SB)
+	MOVV $1285, R1
+	JMP  callbackasm1(SB)
+	MOVV $1286, R1
+	JMP  callbackasm1(SB)
+	MOVV $1287, R1
+	JMP  callbackasm1(SB)
+	MOVV $1288, R1
+	JMP  callbackasm1(SB)
+	MOVV $1289, R1
+	JMP  callbackasm1(SB)
+	MOVV $1290, R1
+	JMP  callbackasm1(SB)
+	MOVV $1291, R1
+	JMP  callbackasm1(SB)
+	MOVV $1292, R1
+	JMP  callbackasm1(SB)
+	MOVV $1293, R1
+	JMP  callbackasm1(SB)
+	MOVV $1294, R1
+	JMP  callbackasm1(SB)
+	MOVV $1295, R1
+	JMP  callbackasm1(SB)
+	MOVV $1296, R1
+	JMP  callbackasm1(SB)
+	MOVV $1297, R1
+	JMP  callbackasm1(SB)
+	MOVV $1298, R1
+	JMP  callbackasm1(SB)
+	MOVV $1299, R1
+	JMP  callbackasm1(SB)
+	MOVV $1300, R1
+	JMP  callbackasm1(SB)
+	MOVV $1301, R1
+	JMP  callbackasm1(SB)
+	MOVV $1302, R1
+	JMP  callbackasm1(SB)
+	MOVV $1303, R1
+	JMP  callbackasm1(SB)
+	MOVV $1304, R1
+	JMP  callbackasm1(SB)
+	MOVV $1305, R1
+	JMP  callbackasm1(SB)
+	MOVV $1306, R1
+	JMP  callbackasm1(SB)
+	MOVV $1307, R1
+	JMP  callbackasm1(SB)
+	MOVV $1308, R1
+	JMP  callbackasm1(SB)
+	MOVV $1309, R1
+	JMP  callbackasm1(SB)
+	MOVV $1310, R1
+	JMP  callbackasm1(SB)
+	MOVV $1311, R1
+	JMP  callbackasm1(SB)
+	MOVV $1312, R1
+	JMP  callbackasm1(SB)
+	MOVV $1313, R1
+	JMP  callbackasm1(SB)
+	MOVV $1314, R1
+	JMP  callbackasm1(SB)
+	MOVV $1315, R1
+	JMP  callbackasm1(SB)
+	MOVV $1316, R1
+	JMP  callbackasm1(SB)
+	MOVV $1317, R1
+	JMP  callbackasm1(SB)
+	MOVV $1318, R1
+	JMP  callbackasm1(SB)
+	MOVV $1319, R1
+	JMP  callbackasm1(SB)
+	MOVV $1320, R1
+	JMP  callbackasm1(SB)
+	MOVV $1321, R1
+	JMP  callbackasm1(SB)
+	MOVV $1322, R1
+	JMP  callbackasm1(SB)
+	MOVV $1323, R1
+	JMP  callbackasm1(SB)
+	MOVV $1324, R1
+	JMP  callbackasm1(SB)
+	MOVV $1325, R1
+	JMP  callbackasm1(SB)
+	MOVV $1326, R1
+	JMP  callbackasm1(SB)
+	MOVV $1327, R1
+	JMP  callbackasm1(SB)
+	MOVV $1328, R1
+	JMP  callbackasm1(SB)
+	MOVV $1329, R1
+	JMP  callbackasm1(SB)
+	MOVV $1330, R1
+	JMP  callbackasm1(SB)
+	MOVV $1331, R1
+	JMP  callbackasm1(SB)
+	MOVV $1332, R1
+	JMP  callbackasm1(SB)
+	MOVV $1333, R1
+	JMP  callbackasm1(SB)
+	MOVV $1334, R1
+	JMP  callbackasm1(SB)
+	MOVV $1335, R1
+	JMP  callbackasm1(SB)
+	MOVV $1336, R1
+	JMP  callbackasm1(SB)
+	MOVV $1337, R1
+	JMP  callbackasm1(SB)
+	MOVV $1338, R1
+	JMP  callbackasm1(SB)
+	MOVV $1339, R1
+	JMP  callbackasm1(SB)
+	MOVV $1340, R1
+	JMP  callbackasm1(SB)
+	MOVV $1341, R1
+	JMP  callbackasm1(SB)
+	MOVV $1342, R1
+	JMP  callbackasm1(SB)
+	MOVV $1343, R1
+	JMP  callbackasm1(SB)
+	MOVV $1344, R1
+	JMP  callbackasm1(SB)
+	MOVV $1345, R1
+	JMP  callbackasm1(SB)
+	MOVV $1346, R1
+	JMP  callbackasm1(SB)
+	MOVV $1347, R1
+	JMP  callbackasm1(SB)
+	MOVV $1348, R1
+	JMP  callbackasm1(SB)
+	MOVV $1349, R1
+	JMP  callbackasm1(SB)
+	MOVV $1350, R1
+	JMP  callbackasm1(SB)
+	MOVV $1351, R1
+	JMP  callbackasm1(SB)
+	MOVV $1352, R1
+	JMP  callbackasm1(SB)
+	MOVV $1353, R1
+	JMP  callbackasm1(SB)
+	MOVV $1354, R1
+	JMP  callbackasm1(SB)
+	MOVV $1355, R1
+	JMP  callbackasm1(SB)
+	MOVV $1356, R1
+	JMP  callbackasm1(SB)
+	MOVV $1357, R1
+	JMP  callbackasm1(SB)
+	MOVV $1358, R1
+	JMP  callbackasm1(SB)
+	MOVV $1359, R1
+	JMP  callbackasm1(SB)
+	MOVV $1360, R1
+	JMP  callbackasm1(SB)
+	MOVV $1361, R1
+	JMP  callbackasm1(SB)
+	MOVV $1362, R1
+	JMP  callbackasm1(SB)
+	MOVV $1363, R1
+	JMP  callbackasm1(SB)
+	MOVV $1364, R1
+	JMP  callbackasm1(SB)
+	MOVV $1365, R1
+	JMP  callbackasm1(SB)
+	MOVV $1366, R1
+	JMP  callbackasm1(SB)
+	MOVV $1367, R1
+	JMP  callbackasm1(SB)
+	MOVV $1368, R1
+	JMP  callbackasm1(SB)
+	MOVV $1369, R1
+	JMP  callbackasm1(SB)
+	MOVV $1370, R1
+	JMP  callbackasm1(SB)
+	MOVV $1371, R1
+	JMP  callbackasm1(SB)
+	MOVV $1372, R1
+	JMP  callbackasm1(SB)
+	MOVV $1373, R1
+	JMP  callbackasm1(SB)
+	MOVV $1374, R1
+	JMP  callbackasm1(SB)
+	MOVV $1375, R1
+	JMP  callbackasm1(SB)
+	MOVV $1376, R1
+	JMP  callbackasm1(SB)
+	MOVV $1377, R1
+	JMP  callbackasm1(SB)
+	MOVV $1378, R1
+	JMP  callbackasm1(SB)
+	MOVV $1379, R1
+	JMP  callbackasm1(SB)
+	MOVV $1380, R1
+	JMP  callbackasm1(SB)
+	MOVV $1381, R1
+	JMP  callbackasm1(SB)
+	MOVV $1382, R1
+	JMP  callbackasm1(SB)
+	MOVV $1383, R1
+	JMP  callbackasm1(SB)
+	MOVV $1384, R1
+	JMP  callbackasm1(SB)
+	MOVV $1385, R1
+	JMP  callbackasm1(SB)
+	MOVV $1386, R1
+	JMP  callbackasm1(SB)
+	MOVV $1387, R1
+	JMP  callbackasm1(SB)
+	MOVV $1388, R1
+	JMP  callbackasm1(SB)
+	MOVV $1389, R1
+	JMP  callbackasm1(SB)
+	MOVV $1390, R1
+	JMP  callbackasm1(SB)
+	MOVV $1391, R1
+	JMP  callbackasm1(SB)
+	MOVV $1392, R1
+	JMP  callbackasm1(SB)
+	MOVV $1393, R1
+	JMP  callbackasm1(SB)
+	MOVV $1394, R1
+	JMP  callbackasm1(SB)
+	MOVV $1395, R1
+	JMP  callbackasm1(SB)
+	MOVV $1396, R1
+	JMP  callbackasm1(SB)
+	MOVV $1397, R1
+	JMP  callbackasm1(SB)
+	MOVV $1398, R1
+	JMP  callbackasm1(SB)
+	MOVV $1399, R1
+	JMP  callbackasm1(SB)
+	MOVV $1400, R1
+	JMP  callbackasm1(SB)
+	MOVV $1401, R1
+	JMP  callbackasm1(SB)
+	MOVV $1402, R1
+	JMP  callbackasm1(SB)
+	MOVV $1403, R1
+	JMP  callbackasm1(SB)
+	MOVV $1404, R1
+	JMP  callbackasm1(SB)
+	MOVV $1405, R1
+	JMP  callbackasm1(SB)
+	MOVV $1406, R1
+	JMP  callbackasm1(SB)
+	MOVV $1407, R1
+	JMP  callbackasm1(SB)
+	MOVV $1408, R1
+	JMP  callbackasm1(SB)
+	MOVV $1409, R1
+	JMP  callbackasm1(SB)
+	MOVV $1410, R1
+	JMP  callbackasm1(SB)
+	MOVV $1411, R1
+	JMP  callbackasm1(SB)
+	MOVV $1412, R1
+	JMP  callbackasm1(SB)
+	MOVV $1413, R1
+	JMP  callbackasm1(SB)
+	MOVV $1414, R1
+	JMP  callbackasm1(SB)
+	MOVV $1415, R1
+	JMP  callbackasm1(SB)
+	MOVV $1416, R1
+	JMP  callbackasm1(SB)
+	MOVV $1417, R1
+	JMP  callbackasm1(SB)
+	MOVV $1418, R1
+	JMP  callbackasm1(SB)
+	MOVV $1419, R1
+	JMP  callbackasm1(SB)
+	MOVV $1420, R1
+	JMP  callbackasm1(SB)
+	MOVV $1421, R1
+	JMP  callbackasm1(SB)
+	MOVV $1422, R1
+	JMP  callbackasm1(SB)
+	MOVV $1423, R1
+	JMP  callbackasm1(SB)
+	MOVV $1424, R1
+	JMP  callbackasm1(SB)
+	MOVV $1425, R1
+	JMP  callbackasm1(SB)
+	MOVV $1426, R1
+	JMP  callbackasm1(SB)
+	MOVV $1427, R1
+	JMP  callbackasm1(SB)
+	MOVV $1428, R1
+	JMP  callbackasm1(SB)
+	MOVV $1429, R1
+	JMP  callbackasm1(SB)
+	MOVV $1430, R1
+	JMP  callbackasm1(SB)
+	MOVV $1431, R1
+	JMP  callbackasm1(SB)
+	MOVV $1432, R1
+	JMP  callbackasm1(SB)
+	MOVV $1433, R1
+	JMP  callbackasm1(SB)
+	MOVV $1434, R1
+	JMP  callbackasm1(SB)
+	MOVV $1435, R1
+	JMP  callbackasm1(SB)
+	MOVV $1436, R1
+	JMP  callbackasm1(SB)
+	MOVV $1437, R1
+	JMP  callbackasm1(SB)
+	MOVV $1438, R1
+	JMP  callbackasm1(SB)
+	MOVV $1439, R1
+	JMP  callbackasm1(SB)
+	MOVV $1440, R1
+	JMP  callbackasm1(SB)
+	MOVV $1441, R1
+	JMP  callbackasm1(SB)
+	MOVV $1442, R1
+	JMP  callbackasm1(SB)
+	MOVV $1443, R1
+	JMP  callbackasm1(SB)
+	MOVV $1444, R1
+	JMP  callbackasm1(SB)
+	MOVV $1445, R1
+	JMP  callbackasm1(SB)
+	MOVV $1446, R1
+	JMP  callbackasm1(SB)
+	MOVV $1447, R1
+	JMP  callbackasm1(SB)
+	MOVV $1448, R1
+	JMP  callbackasm1(SB)
+	MOVV $1449, R1
+	JMP  callbackasm1(SB)
+	MOVV $1450, R1
+	JMP  callbackasm1(SB)
+	MOVV $1451, R1
+	JMP  callbackasm1(SB)
+	MOVV $1452, R1
+	JMP  callbackasm1(SB)
+	MOVV $1453, R1
+	JMP  callbackasm1(SB)
+	MOVV $1454, R1
+	JMP  callbackasm1(SB)
+	MOVV $1455, R1
+	JMP  callbackasm1(SB)
+	MOVV $1456, R1
+	JMP  callbackasm1(SB)
+	MOVV $1457, R1
+	JMP  callbackasm1(SB)
+	MOVV $1458, R1
+	JMP  callbackasm1(SB)
+	MOVV $1459, R1
+	JMP  callbackasm1(SB)
+	MOVV $1460, R1
+	JMP  callbackasm1(SB)
+	MOVV $1461, R1
+	JMP  callbackasm1(SB)
+	MOVV $1462, R1
+	JMP  callbackasm1(SB)
+	MOVV $1463, R1
+	JMP  callbackasm1(SB)
+	MOVV $1464, R1
+	JMP  callbackasm1(SB)
+	MOVV $1465, R1
+	JMP  callbackasm1(SB)
+	MOVV $1466, R1
+	JMP  callbackasm1(SB)
+	MOVV $1467, R1
+	JMP  callbackasm1(SB)
+	MOVV $1468, R1
+	JMP  callbackasm1(SB)
+	MOVV $1469, R1
+	JMP  callbackasm1(SB)
+	MOVV $1470, R1
+	JMP  callbackasm1(SB)
+	MOVV $1471, R1
+	JMP  callbackasm1(SB)
+	MOVV $1472, R1
+	JMP  callbackasm1(SB)
+	MOVV $1473, R1
+	JMP  callbackasm1(SB)
+	MOVV $1474, R1
+	JMP  callbackasm1(SB)
+	MOVV $1475, R1
+	JMP  callbackasm1(SB)
+	MOVV $1476, R1
+	JMP  callbackasm1(SB)
+	MOVV $1477, R1
+	JMP  callbackasm1(SB)
+	MOVV $1478, R1
+	JMP  callbackasm1(SB)
+	MOVV $1479, R1
+	JMP  callbackasm1(SB)
+	MOVV $1480, R1
+	JMP  callbackasm1(SB)
+	MOVV $1481, R1
+	JMP  callbackasm1(SB)
+	MOVV $1482, R1
+	JMP  callbackasm1(SB)
+	MOVV $1483, R1
+	JMP  callbackasm1(SB)
+	MOVV $1484, R1
+	JMP  callbackasm1(SB)
+	MOVV $1485, R1
+	JMP  callbackasm1(SB)
+	MOVV $1486, R1
+	JMP  callbackasm1(SB)
+	MOVV $1487, R1
+	JMP  callbackasm1(SB)
+	MOVV $1488, R1
+	JMP  callbackasm1(SB)
+	MOVV $1489, R1
+	JMP  callbackasm1(SB)
+	MOVV $1490, R1
+	JMP  callbackasm1(SB)
+	MOVV $1491, R1
+	JMP  callbackasm1(SB)
+	MOVV $1492, R1
+	JMP  callbackasm1(SB)
+	MOVV $1493, R1
+	JMP  callbackasm1(SB)
+	MOVV $1494, R1
+	JMP  callbackasm1(SB)
+	MOVV $1495, R1
+	JMP  callbackasm1(SB)
+	MOVV $1496, R1
+	JMP  callbackasm1(SB)
+	MOVV $1497, R1
+	JMP  callbackasm1(SB)
+	MOVV $1498, R1
+	JMP  callbackasm1(SB)
+	MOVV $1499, R1
+	JMP  callbackasm1(SB)
+	MOVV $1500, R1
+	JMP  callbackasm1(SB)
+	MOVV $1501, R1
+	JMP  callbackasm1(SB)
+	MOVV $1502, R1
+	JMP  callbackasm1(SB)
+	MOVV $1503, R1
+	JMP  callbackasm1(SB)
+	MOVV $1504, R1
+	JMP  callbackasm1(SB)
+	MOVV $1505, R1
+	JMP  callbackasm1(SB)
+	MOVV $1506, R1
+	JMP  callbackasm1(SB)
+	MOVV $1507, R1
+	JMP  callbackasm1(SB)
+	MOVV $1508, R1
+	JMP  callbackasm1(SB)
+	MOVV $1509, R1
+	JMP  callbackasm1(SB)
+	MOVV $1510, R1
+	JMP  callbackasm1(SB)
+	MOVV $1511, R1
+	JMP  callbackasm1(SB)
+	MOVV $1512, R1
+	JMP  callbackasm1(SB)
+	MOVV $1513, R1
+	JMP  callbackasm1(SB)
+	MOVV $1514, R1
+	JMP  callbackasm1(SB)
+	MOVV $1515, R1
+	JMP  callbackasm1(SB)
+	MOVV $1516, R1
+	JMP  callbackasm1(SB)
+	MOVV $1517, R1
+	JMP  callbackasm1(SB)
+	MOVV $1518, R1
+	JMP  callbackasm1(SB)
+	MOVV $1519, R1
+	JMP  callbackasm1(SB)
+	MOVV $1520, R1
+	JMP  callbackasm1(SB)
+	MOVV $1521, R1
+	JMP  callbackasm1(SB)
+	MOVV $1522, R1
+	JMP  callbackasm1(SB)
+	MOVV $1523, R1
+	JMP  callbackasm1(SB)
+	MOVV $1524, R1
+	JMP  callbackasm1(SB)
+	MOVV $1525, R1
+	JMP  callbackasm1(SB)
+	MOVV $1526, R1
+	JMP  callbackasm1(SB)
+	MOVV $1527, R1
+	JMP  callbackasm1(SB)
+	MOVV $1528, R1
+	JMP  callbackasm1(SB)
+	MOVV $1529, R1
+	JMP  callbackasm1(SB)
+	MOVV $1530, R1
+	JMP  callbackasm1(SB)
+	MOVV $1531, R1
+	JMP  callbackasm1(SB)
+	MOVV $1532, R1
+	JMP  callbackasm1(SB)
+	MOVV $1533, R1
+	JMP  callbackasm1(SB)
+	MOVV $1534, R1
+	JMP  callbackasm1(SB)
+	MOVV $1535, R1
+	JMP  callbackasm1(SB)
+	MOVV $1536, R1
+	JMP  callbackasm1(SB)
+	MOVV $1537, R1
+	JMP  callbackasm1(SB)
+	MOVV $1538, R1
+	JMP  callbackasm1(SB)
+	MOVV $1539, R1
+	JMP  callbackasm1(SB)
+	MOVV $1540, R1
+	JMP  callbackasm1(SB)
+	MOVV $1541, R1
+	JMP  callbackasm1(SB)
+	MOVV $1542, R1
+	JMP  callbackasm1(SB)
+	MOVV $1543, R1
+	JMP  callbackasm1(SB)
+	MOVV $1544, R1
+	JMP  callbackasm1(SB)
+	MOVV $1545, R1
+	JMP  callbackasm1(SB)
+	MOVV $1546, R1
+	JMP  callbackasm1(SB)
+	MOVV $1547, R1
+	JMP  callbackasm1(SB)
+	MOVV $1548, R1
+	JMP  callbackasm1(SB)
+	MOVV $1549, R1
+	JMP  callbackasm1(SB)
+	MOVV $1550, R1
+	JMP  callbackasm1(SB)
+	MOVV $1551, R1
+	JMP  callbackasm1(SB)
+	MOVV $1552, R1
+	JMP  callbackasm1(SB)
+	MOVV $1553, R1
+	JMP  callbackasm1(SB)
+	MOVV $1554, R1
+	JMP  callbackasm1(SB)
+	MOVV $1555, R1
+	JMP  callbackasm1(SB)
+	MOVV $1556, R1
+	JMP  callbackasm1(SB)
+	MOVV $1557, R1
+	JMP  callbackasm1(SB)
+	MOVV $1558, R1
+	JMP  callbackasm1(SB)
+	MOVV $1559, R1
+	JMP  callbackasm1(SB)
+	MOVV $1560, R1
+	JMP  callbackasm1(SB)
+	MOVV $1561, R1
+	JMP  callbackasm1(SB)
+	MOVV $1562, R1
+	JMP  callbackasm1(SB)
+	MOVV $1563, R1
+	JMP  callbackasm1(SB)
+	MOVV $1564, R1
+	JMP  callbackasm1(SB)
+	MOVV $1565, R1
+	JMP  callbackasm1(SB)
+	MOVV $1566, R1
+	JMP  callbackasm1(SB)
+	MOVV $1567, R1
+	JMP  callbackasm1(SB)
+	MOVV $1568, R1
+	JMP  callbackasm1(SB)
+	MOVV $1569, R1
+	JMP  callbackasm1(SB)
+	MOVV $1570, R1
+	JMP  callbackasm1(SB)
+	MOVV $1571, R1
+	JMP  callbackasm1(SB)
+	MOVV $1572, R1
+	JMP  callbackasm1(SB)
+	MOVV $1573, R1
+	JMP  callbackasm1(SB)
+	MOVV $1574, R1
+	JMP  callbackasm1(SB)
+	MOVV $1575, R1
+	JMP  callbackasm1(SB)
+	MOVV $1576, R1
+	JMP  callbackasm1(SB)
+	MOVV $1577, R1
+	JMP  callbackasm1(SB)
+	MOVV $1578, R1
+	JMP  callbackasm1(SB)
+	MOVV $1579, R1
+	JMP  callbackasm1(SB)
+	MOVV $1580, R1
+	JMP  callbackasm1(SB)
+	MOVV $1581, R1
+	JMP  callbackasm1(SB)
+	MOVV $1582, R1
+	JMP  callbackasm1(SB)
+	MOVV $1583, R1
+	JMP  callbackasm1(SB)
+	MOVV $1584, R1
+	JMP  callbackasm1(SB)
+	MOVV $1585, R1
+	JMP  callbackasm1(SB)
+	MOVV $1586, R1
+	JMP  callbackasm1(SB)
+	MOVV $1587, R1
+	JMP  callbackasm1(SB)
+	MOVV $1588, R1
+	JMP  callbackasm1(SB)
+	MOVV $1589, R1
+	JMP  callbackasm1(SB)
+	MOVV $1590, R1
+	JMP  callbackasm1(SB)
+	MOVV $1591, R1
+	JMP  callbackasm1(SB)
+	MOVV $1592, R1
+	JMP  callbackasm1(SB)
+	MOVV $1593, R1
+	JMP  callbackasm1(SB)
+	MOVV $1594, R1
+	JMP  callbackasm1(SB)
+	MOVV $1595, R1
+	JMP  callbackasm1(SB)
+	MOVV $1596, R1
+	JMP  callbackasm1(SB)
+	MOVV $1597, R1
+	JMP  callbackasm1(SB)
+	MOVV $1598, R1
+	JMP  callbackasm1(SB)
+	MOVV $1599, R1
+	JMP  callbackasm1(SB)
+	MOVV $1600, R1
+	JMP  callbackasm1(SB)
+	MOVV $1601, R1
+	JMP  callbackasm1(SB)
+	MOVV $1602, R1
+	JMP  callbackasm1(SB)
+	MOVV $1603, R1
+	JMP  callbackasm1(SB)
+	MOVV $1604, R1
+	JMP  callbackasm1(SB)
+	MOVV $1605, R1
+	JMP  callbackasm1(SB)
+	MOVV $1606, R1
+	JMP  callbackasm1(SB)
+	MOVV $1607, R1
+	JMP  callbackasm1(SB)
+	MOVV $1608, R1
+	JMP  callbackasm1(SB)
+	MOVV $1609, R1
+	JMP  callbackasm1(SB)
+	MOVV $1610, R1
+	JMP  callbackasm1(SB)
+	MOVV $1611, R1
+	JMP  callbackasm1(SB)
+	MOVV $1612, R1
+	JMP  callbackasm1(SB)
+	MOVV $1613, R1
+	JMP  callbackasm1(SB)
+	MOVV $1614, R1
+	JMP  callbackasm1(SB)
+	MOVV $1615, R1
+	JMP  callbackasm1(SB)
+	MOVV $1616, R1
+	JMP  callbackasm1(SB)
+	MOVV $1617, R1
+	JMP  callbackasm1(SB)
+	MOVV $1618, R1
+	JMP  callbackasm1(SB)
+	MOVV $1619, R1
+	JMP  callbackasm1(SB)
+	MOVV $1620, R1
+	JMP  callbackasm1(SB)
+	MOVV $1621, R1
+	JMP  callbackasm1(SB)
+	MOVV $1622, R1
+	JMP  callbackasm1(SB)
+	MOVV $1623, R1
+	JMP  callbackasm1(SB)
+	MOVV $1624, R1
+	JMP  callbackasm1(SB)
+	MOVV $1625, R1
+	JMP  callbackasm1(SB)
+	MOVV $1626, R1
+	JMP  callbackasm1(SB)
+	MOVV $1627, R1
+	JMP  callbackasm1(SB)
+	MOVV $1628, R1
+	JMP  callbackasm1(SB)
+	MOVV $1629, R1
+	JMP  callbackasm1(SB)
+	MOVV $1630, R1
+	JMP  callbackasm1(SB)
+	MOVV $1631, R1
+	JMP  callbackasm1(SB)
+	MOVV $1632, R1
+	JMP  callbackasm1(SB)
+	MOVV $1633, R1
+	JMP  callbackasm1(SB)
+	MOVV $1634, R1
+	JMP  callbackasm1(SB)
+	MOVV $1635, R1
+	JMP  callbackasm1(SB)
+	MOVV $1636, R1
+	JMP  callbackasm1(SB)
+	MOVV $1637, R1
+	JMP  callbackasm1(SB)
+	MOVV $1638, R1
+	JMP  callbackasm1(SB)
+	MOVV $1639, R1
+	JMP  callbackasm1(SB)
+	MOVV $1640, R1
+	JMP  callbackasm1(SB)
+	MOVV $1641, R1
+	JMP  callbackasm1(SB)
+	MOVV $1642, R1
+	JMP  callbackasm1(SB)
+	MOVV $1643, R1
+	JMP  callbackasm1(SB)
+	MOVV $1644, R1
+	JMP  callbackasm1(SB)
+	MOVV $1645, R1
+	JMP  callbackasm1(SB)
+	MOVV $1646, R1
+	JMP  callbackasm1(SB)
+	MOVV $1647, R1
+	JMP  callbackasm1(SB)
+	MOVV $1648, R1
+	JMP  callbackasm1(SB)
+	MOVV $1649, R1
+	JMP  callbackasm1(SB)
+	MOVV $1650, R1
+	JMP  callbackasm1(SB)
+	MOVV $1651, R1
+	JMP  callbackasm1(SB)
+	MOVV $1652, R1
+	JMP  callbackasm1(SB)
+	MOVV $1653, R1
+	JMP  callbackasm1(SB)
+	MOVV $1654, R1
+	JMP  callbackasm1(SB)
+	MOVV $1655, R1
+	JMP  callbackasm1(SB)
+	MOVV $1656, R1
+	JMP  callbackasm1(SB)
+	MOVV $1657, R1
+	JMP  callbackasm1(SB)
+	MOVV $1658, R1
+	JMP  callbackasm1(SB)
+	MOVV $1659, R1
+	JMP  callbackasm1(SB)
+	MOVV $1660, R1
+	JMP  callbackasm1(SB)
+	MOVV $1661, R1
+	JMP  callbackasm1(SB)
+	MOVV $1662, R1
+	JMP  callbackasm1(SB)
+	MOVV $1663, R1
+	JMP  callbackasm1(SB)
+	MOVV $1664, R1
+	JMP  callbackasm1(SB)
+	MOVV $1665, R1
+	JMP  callbackasm1(SB)
+	MOVV $1666, R1
+	JMP  callbackasm1(SB)
+	MOVV $1667, R1
+	JMP  callbackasm1(SB)
+	MOVV $1668, R1
+	JMP  callbackasm1(SB)
+	MOVV $1669, R1
+	JMP  callbackasm1(SB)
+	MOVV $1670, R1
+	JMP  callbackasm1(SB)
+	MOVV $1671, R1
+	JMP  callbackasm1(SB)
+	MOVV $1672, R1
+	JMP  callbackasm1(SB)
+	MOVV $1673, R1
+	JMP  callbackasm1(SB)
+	MOVV $1674, R1
+	JMP  callbackasm1(SB)
+	MOVV $1675, R1
+	JMP  callbackasm1(SB)
+	MOVV $1676, R1
+	JMP  callbackasm1(SB)
+	MOVV $1677, R1
+	JMP  callbackasm1(SB)
+	MOVV $1678, R1
+	JMP  callbackasm1(SB)
+	MOVV $1679, R1
+	JMP  callbackasm1(SB)
+	MOVV $1680, R1
+	JMP  callbackasm1(SB)
+	MOVV $1681, R1
+	JMP  callbackasm1(SB)
+	MOVV $1682, R1
+	JMP  callbackasm1(SB)
+	MOVV $1683, R1
+	JMP  callbackasm1(SB)
+	MOVV $1684, R1
+	JMP  callbackasm1(SB)
+	MOVV $1685, R1
+	JMP  callbackasm1(SB)
+	MOVV $1686, R1
+	JMP  callbackasm1(SB)
+	MOVV $1687, R1
+	JMP  callbackasm1(SB)
+	MOVV $1688, R1
+	JMP  callbackasm1(SB)
+	MOVV $1689, R1
+	JMP  callbackasm1(SB)
+	MOVV $1690, R1
+	JMP  callbackasm1(SB)
+	MOVV $1691, R1
+	JMP  callbackasm1(SB)
+	MOVV $1692, R1
+	JMP  callbackasm1(SB)
+	MOVV $1693, R1
+	JMP  callbackasm1(SB)
+	MOVV $1694, R1
+	JMP  callbackasm1(SB)
+	MOVV $1695, R1
+	JMP  callbackasm1(SB)
+	MOVV $1696, R1
+	JMP  callbackasm1(SB)
+	MOVV $1697, R1
+	JMP  callbackasm1(SB)
+	MOVV $1698, R1
+	JMP  callbackasm1(SB)
+	MOVV $1699, R1
+	JMP  callbackasm1(SB)
+	MOVV $1700, R1
+	JMP  callbackasm1(SB)
+	MOVV $1701, R1
+	JMP  callbackasm1(SB)
+	MOVV $1702, R1
+	JMP  callbackasm1(SB)
+	MOVV $1703, R1
+	JMP  callbackasm1(SB)
+	MOVV $1704, R1
+	JMP  callbackasm1(SB)
+	MOVV $1705, R1
+	JMP  callbackasm1(SB)
+	MOVV $1706, R1
+	JMP  callbackasm1(SB)
+	MOVV $1707, R1
+	JMP  callbackasm1(SB)
+	MOVV $1708, R1
+	JMP  callbackasm1(SB)
+	MOVV $1709, R1
+	JMP  callbackasm1(SB)
+	MOVV $1710, R1
+	JMP  callbackasm1(SB)
+	MOVV $1711, R1
+	JMP  callbackasm1(SB)
+	MOVV $1712, R1
+	JMP  callbackasm1(SB)
+	MOVV $1713, R1
+	JMP  callbackasm1(SB)
+	MOVV $1714, R1
+	JMP  callbackasm1(SB)
+	MOVV $1715, R1
+	JMP  callbackasm1(SB)
+	MOVV $1716, R1
+	JMP  callbackasm1(SB)
+	MOVV $1717, R1
+	JMP  callbackasm1(SB)
+	MOVV $1718, R1
+	JMP  callbackasm1(SB)
+	MOVV $1719, R1
+	JMP  callbackasm1(SB)
+	MOVV $1720, R1
+	JMP  callbackasm1(SB)
+	MOVV $1721, R1
+	JMP  callbackasm1(SB)
+	MOVV $1722, R1
+	JMP  callbackasm1(SB)
+	MOVV $1723, R1
+	JMP  callbackasm1(SB)
+	MOVV $1724, R1
+	JMP  callbackasm1(SB)
+	MOVV $1725, R1
+	JMP  callbackasm1(SB)
+	MOVV $1726, R1
+	JMP  callbackasm1(SB)
+	MOVV $1727, R1
+	JMP  callbackasm1(SB)
+	MOVV $1728, R1
+	JMP  callbackasm1(SB)
+	MOVV $1729, R1
+	JMP  callbackasm1(SB)
+	MOVV $1730, R1
+	JMP  callbackasm1(SB)
+	MOVV $1731, R1
+	JMP  callbackasm1(SB)
+	MOVV $1732, R1
+	JMP  callbackasm1(SB)
+	MOVV $1733, R1
+	JMP  callbackasm1(SB)
+	MOVV $1734, R1
+	JMP  callbackasm1(SB)
+	MOVV $1735, R1
+	JMP  callbackasm1(SB)
+	MOVV $1736, R1
+	JMP  callbackasm1(SB)
+	MOVV $1737, R1
+	JMP  callbackasm1(SB)
+	MOVV $1738, R1
+	JMP  callbackasm1(SB)
+	MOVV $1739, R1
+	JMP  callbackasm1(SB)
+	MOVV $1740, R1
+	JMP  callbackasm1(SB)
+	MOVV $1741, R1
+	JMP  callbackasm1(SB)
+	MOVV $1742, R1
+	JMP  callbackasm1(SB)
+	MOVV $1743, R1
+	JMP  callbackasm1(SB)
+	MOVV $1744, R1
+	JMP  callbackasm1(SB)
+	MOVV $1745, R1
+	JMP  callbackasm1(SB)
+	MOVV $1746, R1
+	JMP  callbackasm1(SB)
+	MOVV $1747, R1
+	JMP  callbackasm1(SB)
+	MOVV $1748, R1
+	JMP  callbackasm1(SB)
+	MOVV $1749, R1
+	JMP  callbackasm1(SB)
+	MOVV $1750, R1
+	JMP  callbackasm1(SB)
+	MOVV $1751, R1
+	JMP  callbackasm1(SB)
+	MOVV $1752, R1
+	JMP  callbackasm1(SB)
+	MOVV $1753, R1
+	JMP  callbackasm1(SB)
+	MOVV $1754, R1
+	JMP  callbackasm1(SB)
+	MOVV $1755, R1
+	JMP  callbackasm1(SB)
+	MOVV $1756, R1
+	JMP  callbackasm1(SB)
+	MOVV $1757, R1
+	JMP  callbackasm1(SB)
+	MOVV $1758, R1
+	JMP  callbackasm1(SB)
+	MOVV $1759, R1
+	JMP  callbackasm1(SB)
+	MOVV $1760, R1
+	JMP  callbackasm1(SB)
+	MOVV $1761, R1
+	JMP  callbackasm1(SB)
+	MOVV $1762, R1
+	JMP  callbackasm1(SB)
+	MOVV $1763, R1
+	JMP  callbackasm1(SB)
+	MOVV $1764, R1
+	JMP  callbackasm1(SB)
+	MOVV $1765, R1
+	JMP  callbackasm1(SB)
+	MOVV $1766, R1
+	JMP  callbackasm1(SB)
+	MOVV $1767, R1
+	JMP  callbackasm1(SB)
+	MOVV $1768, R1
+	JMP  callbackasm1(SB)
+	MOVV $1769, R1
+	JMP  callbackasm1(SB)
+	MOVV $1770, R1
+	JMP  callbackasm1(SB)
+	MOVV $1771, R1
+	JMP  callbackasm1(SB)
+	MOVV $1772, R1
+	JMP  callbackasm1(SB)
+	MOVV $1773, R1
+	JMP  callbackasm1(SB)
+	MOVV $1774, R1
+	JMP  callbackasm1(SB)
+	MOVV $1775, R1
+	JMP  callbackasm1(SB)
+	MOVV $1776, R1
+	JMP  callbackasm1(SB)
+	MOVV $1777, R1
+	JMP  callbackasm1(SB)
+	MOVV $1778, R1
+	JMP  callbackasm1(SB)
+	MOVV $1779, R1
+	JMP  callbackasm1(SB)
+	MOVV $1780, R1
+	JMP  callbackasm1(SB)
+	MOVV $1781, R1
+	JMP  callbackasm1(SB)
+	MOVV $1782, R1
+	JMP  callbackasm1(SB)
+	MOVV $1783, R1
+	JMP  callbackasm1(SB)
+	MOVV $1784, R1
+	JMP  callbackasm1(SB)
+	MOVV $1785, R1
+	JMP  callbackasm1(SB)
+	MOVV $1786, R1
+	JMP  callbackasm1(SB)
+	MOVV $1787, R1
+	JMP  callbackasm1(SB)
+	MOVV $1788, R1
+	JMP  callbackasm1(SB)
+	MOVV $1789, R1
+	JMP  callbackasm1(SB)
+	MOVV $1790, R1
+	JMP  callbackasm1(SB)
+	MOVV $1791, R1
+	JMP  callbackasm1(SB)
+	MOVV $1792, R1
+	JMP  callbackasm1(SB)
+	MOVV $1793, R1
+	JMP  callbackasm1(SB)
+	MOVV $1794, R1
+	JMP  callbackasm1(SB)
+	MOVV $1795, R1
+	JMP  callbackasm1(SB)
+	MOVV $1796, R1
+	JMP  callbackasm1(SB)
+	MOVV $1797, R1
+	JMP  callbackasm1(SB)
+	MOVV $1798, R1
+	JMP  callbackasm1(SB)
+	MOVV $1799, R1
+	JMP  callbackasm1(SB)
+	MOVV $1800, R1
+	JMP  callbackasm1(SB)
+	MOVV $1801, R1
+	JMP  callbackasm1(SB)
+	MOVV $1802, R1
+	JMP  callbackasm1(SB)
+	MOVV $1803, R1
+	JMP  callbackasm1(SB)
+	MOVV $1804, R1
+	JMP  callbackasm1(SB)
+	MOVV $1805, R1
+	JMP  callbackasm1(SB)
+	MOVV $1806, R1
+	JMP  callbackasm1(SB)
+	MOVV $1807, R1
+	JMP  callbackasm1(SB)
+	MOVV $1808, R1
+	JMP  callbackasm1(SB)
+	MOVV $1809, R1
+	JMP  callbackasm1(SB)
+	MOVV $1810, R1
+	JMP  callbackasm1(SB)
+	MOVV $1811, R1
+	JMP  callbackasm1(SB)
+	MOVV $1812, R1
+	JMP  callbackasm1(SB)
+	MOVV $1813, R1
+	JMP  callbackasm1(SB)
+	MOVV $1814, R1
+	JMP  callbackasm1(SB)
+	MOVV $1815, R1
+	JMP  callbackasm1(SB)
+	MOVV $1816, R1
+	JMP  callbackasm1(SB)
+	MOVV $1817, R1
+	JMP  callbackasm1(SB)
+	MOVV $1818, R1
+	JMP  callbackasm1(SB)
+	MOVV $1819, R1
+	JMP  callbackasm1(SB)
+	MOVV $1820, R1
+	JMP  callbackasm1(SB)
+	MOVV $1821, R1
+	JMP  callbackasm1(SB)
+	MOVV $1822, R1
+	JMP  callbackasm1(SB)
+	MOVV $1823, R1
+	JMP  callbackasm1(SB)
+	MOVV $1824, R1
+	JMP  callbackasm1(SB)
+	MOVV $1825, R1
+	JMP  callbackasm1(SB)
+	MOVV $1826, R1
+	JMP  callbackasm1(SB)
+	MOVV $1827, R1
+	JMP  callbackasm1(SB)
+	MOVV $1828, R1
+	JMP  callbackasm1(SB)
+	MOVV $1829, R1
+	JMP  callbackasm1(SB)
+	MOVV $1830, R1
+	JMP  callbackasm1(SB)
+	MOVV $1831, R1
+	JMP  callbackasm1(SB)
+	MOVV $1832, R1
+	JMP  callbackasm1(SB)
+	MOVV $1833, R1
+	JMP  callbackasm1(SB)
+	MOVV $1834, R1
+	JMP  callbackasm1(SB)
+	MOVV $1835, R1
+	JMP  callbackasm1(SB)
+	MOVV $1836, R1
+	JMP  callbackasm1(SB)
+	MOVV $1837, R1
+	JMP  callbackasm1(SB)
+	MOVV $1838, R1
+	JMP  callbackasm1(SB)
+	MOVV $1839, R1
+	JMP  callbackasm1(SB)
+	MOVV $1840, R1
+	JMP  callbackasm1(SB)
+	MOVV $1841, R1
+	JMP  callbackasm1(SB)
+	MOVV $1842, R1
+	JMP  callbackasm1(SB)
+	MOVV $1843, R1
+	JMP  callbackasm1(SB)
+	MOVV $1844, R1
+	JMP  callbackasm1(SB)
+	MOVV $1845, R1
+	JMP  callbackasm1(SB)
+	MOVV $1846, R1
+	JMP  callbackasm1(SB)
+	MOVV $1847, R1
+	JMP  callbackasm1(SB)
+	MOVV $1848, R1
+	JMP  callbackasm1(SB)
+	MOVV $1849, R1
+	JMP  callbackasm1(SB)
+	MOVV $1850, R1
+	JMP  callbackasm1(SB)
+	MOVV $1851, R1
+	JMP  callbackasm1(SB)
+	MOVV $1852, R1
+	JMP  callbackasm1(SB)
+	MOVV $1853, R1
+	JMP  callbackasm1(SB)
+	MOVV $1854, R1
+	JMP  callbackasm1(SB)
+	MOVV $1855, R1
+	JMP  callbackasm1(SB)
+	MOVV $1856, R1
+	JMP  callbackasm1(SB)
+	MOVV $1857, R1
+	JMP  callbackasm1(SB)
+	MOVV $1858, R1
+	JMP  callbackasm1(SB)
+	MOVV $1859, R1
+	JMP  callbackasm1(SB)
+	MOVV $1860, R1
+	JMP  callbackasm1(SB)
+	MOVV $1861, R1
+	JMP  callbackasm1(SB)
+	MOVV $1862, R1
+	JMP  callbackasm1(SB)
+	MOVV $1863, R1
+	JMP  callbackasm1(SB)
+	MOVV $1864, R1
+	JMP  callbackasm1(SB)
+	MOVV $1865, R1
+	JMP  callbackasm1(SB)
+	MOVV $1866, R1
+	JMP  callbackasm1(SB)
+	MOVV $1867, R1
+	JMP  callbackasm1(SB)
+	MOVV $1868, R1
+	JMP  callbackasm1(SB)
+	MOVV $1869, R1
+	JMP  callbackasm1(SB)
+	MOVV $1870, R1
+	JMP  callbackasm1(SB)
+	MOVV $1871, R1
+	JMP  callbackasm1(SB)
+	MOVV $1872, R1
+	JMP  callbackasm1(SB)
+	MOVV $1873, R1
+	JMP  callbackasm1(SB)
+	MOVV $1874, R1
+	JMP  callbackasm1(SB)
+	MOVV $1875, R1
+	JMP  callbackasm1(SB)
+	MOVV $1876, R1
+	JMP  callbackasm1(SB)
+	MOVV $1877, R1
+	JMP  callbackasm1(SB)
+	MOVV $1878, R1
+	JMP  callbackasm1(SB)
+	MOVV $1879, R1
+	JMP  callbackasm1(SB)
+	MOVV $1880, R1
+	JMP  callbackasm1(SB)
+	MOVV $1881, R1
+	JMP  callbackasm1(SB)
+	MOVV $1882, R1
+	JMP  callbackasm1(SB)
+	MOVV $1883, R1
+	JMP  callbackasm1(SB)
+	MOVV $1884, R1
+	JMP  callbackasm1(SB)
+	MOVV $1885, R1
+	JMP  callbackasm1(SB)
+	MOVV $1886, R1
+	JMP  callbackasm1(SB)
+	MOVV $1887, R1
+	JMP  callbackasm1(SB)
+	MOVV $1888, R1
+	JMP  callbackasm1(SB)
+	MOVV $1889, R1
+	JMP  callbackasm1(SB)
+	MOVV $1890, R1
+	JMP  callbackasm1(SB)
+	MOVV $1891, R1
+	JMP  callbackasm1(SB)
+	MOVV $1892, R1
+	JMP  callbackasm1(SB)
+	MOVV $1893, R1
+	JMP  callbackasm1(SB)
+	MOVV $1894, R1
+	JMP  callbackasm1(SB)
+	MOVV $1895, R1
+	JMP  callbackasm1(SB)
+	MOVV $1896, R1
+	JMP  callbackasm1(SB)
+	MOVV $1897, R1
+	JMP  callbackasm1(SB)
+	MOVV $1898, R1
+	JMP  callbackasm1(SB)
+	MOVV $1899, R1
+	JMP  callbackasm1(SB)
+	MOVV $1900, R1
+	JMP  callbackasm1(SB)
+	MOVV $1901, R1
+	JMP  callbackasm1(SB)
+	MOVV $1902, R1
+	JMP  callbackasm1(SB)
+	MOVV $1903, R1
+	JMP  callbackasm1(SB)
+	MOVV $1904, R1
+	JMP  callbackasm1(SB)
+	MOVV $1905, R1
+	JMP  callbackasm1(SB)
+	MOVV $1906, R1
+	JMP  callbackasm1(SB)
+	MOVV $1907, R1
+	JMP  callbackasm1(SB)
+	MOVV $1908, R1
+	JMP  callbackasm1(SB)
+	MOVV $1909, R1
+	JMP  callbackasm1(SB)
+	MOVV $1910, R1
+	JMP  callbackasm1(SB)
+	MOVV $1911, R1
+	JMP  callbackasm1(SB)
+	MOVV $1912, R1
+	JMP  callbackasm1(SB)
+	MOVV $1913, R1
+	JMP  callbackasm1(SB)
+	MOVV $1914, R1
+	JMP  callbackasm1(SB)
+	MOVV $1915, R1
+	JMP  callbackasm1(SB)
+	MOVV $1916, R1
+	JMP  callbackasm1(SB)
+	MOVV $1917, R1
+	JMP  callbackasm1(SB)
+	MOVV $1918, R1
+	JMP  callbackasm1(SB)
+	MOVV $1919, R1
+	JMP  callbackasm1(SB)
+	MOVV $1920, R1
+	JMP  callbackasm1(SB)
+	MOVV $1921, R1
+	JMP  callbackasm1(SB)
+	MOVV $1922, R1
+	JMP  callbackasm1(SB)
+	MOVV $1923, R1
+	JMP  callbackasm1(SB)
+	MOVV $1924, R1
+	JMP  callbackasm1(SB)
+	MOVV $1925, R1
+	JMP  callbackasm1(SB)
+	MOVV $1926, R1
+	JMP  callbackasm1(SB)
+	MOVV $1927, R1
+	JMP  callbackasm1(SB)
+	MOVV $1928, R1
+	JMP  callbackasm1(SB)
+	MOVV $1929, R1
+	JMP  callbackasm1(SB)
+	MOVV $1930, R1
+	JMP  callbackasm1(SB)
+	MOVV $1931, R1
+	JMP  callbackasm1(SB)
+	MOVV $1932, R1
+	JMP  callbackasm1(SB)
+	MOVV $1933, R1
+	JMP  callbackasm1(SB)
+	MOVV $1934, R1
+	JMP  callbackasm1(SB)
+	MOVV $1935, R1
+	JMP  callbackasm1(SB)
+	MOVV $1936, R1
+	JMP  callbackasm1(SB)
+	MOVV $1937, R1
+	JMP  callbackasm1(SB)
+	MOVV $1938, R1
+	JMP  callbackasm1(SB)
+	MOVV $1939, R1
+	JMP  callbackasm1(SB)
+	MOVV $1940, R1
+	JMP  callbackasm1(SB)
+	MOVV $1941, R1
+	JMP  callbackasm1(SB)
+	MOVV $1942, R1
+	JMP  callbackasm1(SB)
+	MOVV $1943, R1
+	JMP  callbackasm1(SB)
+	MOVV $1944, R1
+	JMP  callbackasm1(SB)
+	MOVV $1945, R1
+	JMP  callbackasm1(SB)
+	MOVV $1946, R1
+	JMP  callbackasm1(SB)
+	MOVV $1947, R1
+	JMP  callbackasm1(SB)
+	MOVV $1948, R1
+	JMP  callbackasm1(SB)
+	MOVV $1949, R1
+	JMP  callbackasm1(SB)
+	MOVV $1950, R1
+	JMP  callbackasm1(SB)
+	MOVV $1951, R1
+	JMP  callbackasm1(SB)
+	MOVV $1952, R1
+	JMP  callbackasm1(SB)
+	MOVV $1953, R1
+	JMP  callbackasm1(SB)
+	MOVV $1954, R1
+	JMP  callbackasm1(SB)
+	MOVV $1955, R1
+	JMP  callbackasm1(SB)
+	MOVV $1956, R1
+	JMP  callbackasm1(SB)
+	MOVV $1957, R1
+	JMP  callbackasm1(SB)
+	MOVV $1958, R1
+	JMP  callbackasm1(SB)
+	MOVV $1959, R1
+	JMP  callbackasm1(SB)
+	MOVV $1960, R1
+	JMP  callbackasm1(SB)
+	MOVV $1961, R1
+	JMP  callbackasm1(SB)
+	MOVV $1962, R1
+	JMP  callbackasm1(SB)
+	MOVV $1963, R1
+	JMP  callbackasm1(SB)
+	MOVV $1964, R1
+	JMP  callbackasm1(SB)
+	MOVV $1965, R1
+	JMP  callbackasm1(SB)
+	MOVV $1966, R1
+	JMP  callbackasm1(SB)
+	MOVV $1967, R1
+	JMP  callbackasm1(SB)
+	MOVV $1968, R1
+	JMP  callbackasm1(SB)
+	MOVV $1969, R1
+	JMP  callbackasm1(SB)
+	MOVV $1970, R1
+	JMP  callbackasm1(SB)
+	MOVV $1971, R1
+	JMP  callbackasm1(SB)
+	MOVV $1972, R1
+	JMP  callbackasm1(SB)
+	MOVV $1973, R1
+	JMP  callbackasm1(SB)
+	MOVV $1974, R1
+	JMP  callbackasm1(SB)
+	MOVV $1975, R1
+	JMP  callbackasm1(SB)
+	MOVV $1976, R1
+	JMP  callbackasm1(SB)
+	MOVV $1977, R1
+	JMP  callbackasm1(SB)
+	MOVV $1978, R1
+	JMP  callbackasm1(SB)
+	MOVV $1979, R1
+	JMP  callbackasm1(SB)
+	MOVV $1980, R1
+	JMP  callbackasm1(SB)
+	MOVV $1981, R1
+	JMP  callbackasm1(SB)
+	MOVV $1982, R1
+	JMP  callbackasm1(SB)
+	MOVV $1983, R1
+	JMP  callbackasm1(SB)
+	MOVV $1984, R1
+	JMP  callbackasm1(SB)
+	MOVV $1985, R1
+	JMP  callbackasm1(SB)
+	MOVV $1986, R1
+	JMP  callbackasm1(SB)
+	MOVV $1987, R1
+	JMP  callbackasm1(SB)
+	MOVV $1988, R1
+	JMP  callbackasm1(SB)
+	MOVV $1989, R1
+	JMP  callbackasm1(SB)
+	MOVV $1990, R1
+	JMP  callbackasm1(SB)
+	MOVV $1991, R1
+	JMP  callbackasm1(SB)
+	MOVV $1992, R1
+	JMP  callbackasm1(SB)
+	MOVV $1993, R1
+	JMP  callbackasm1(SB)
+	MOVV $1994, R1
+	JMP  callbackasm1(SB)
+	MOVV $1995, R1
+	JMP  callbackasm1(SB)
+	MOVV $1996, R1
+	JMP  callbackasm1(SB)
+	MOVV $1997, R1
+	JMP  callbackasm1(SB)
+	MOVV $1998, R1
+	JMP  callbackasm1(SB)
+	MOVV $1999, R1
+	JMP  callbackasm1(SB)
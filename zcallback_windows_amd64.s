@@ -0,0 +1,523 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2023 The Ebitengine Authors
+
+// floatcallbackasm is called by external code to execute a Go callback
+// with float parameters or a float result. Like callbackasm, the address
+// of a callback is an offset into floatcallbackasm so that every callback
+// starts with a different CALL instruction which determines the
+// floatCallback that floatcallbackasm1 uses.
+#include "textflag.h"
+
+TEXT floatcallbackasm(SB), NOSPLIT|NOFRAME, $0
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)
+	CALL floatcallbackasm1(SB)